@@ -0,0 +1,92 @@
+package platform
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// ops for audit log errors.
+var (
+	OpRecordAuditEvent = "RecordAuditEvent"
+	OpFindAuditEvents  = "FindAuditEvents"
+)
+
+// AuditResourceType is the resource type permissions to read the audit log are granted on.
+const AuditResourceType ResourceType = "audit"
+
+// AuditEvent records a single mutation made through the API.
+type AuditEvent struct {
+	ID   ID        `json:"id"`
+	Time time.Time `json:"time"`
+
+	// ActorID and ActorKind identify the authorizer that made the change.
+	ActorID   ID     `json:"actorID,omitempty"`
+	ActorKind string `json:"actorKind,omitempty"`
+	// UserID is the user the authorizer belongs to.
+	UserID ID `json:"userID,omitempty"`
+
+	Action       Action       `json:"action"`
+	ResourceType ResourceType `json:"resourceType"`
+	ResourceID   ID           `json:"resourceID,omitempty"`
+	// OrgID is the organization the resource belongs to, if any.
+	OrgID ID `json:"orgID,omitempty"`
+
+	// Before and After are the resource as it was before and after the change.
+	// Before is empty for creations and After is empty for deletions.
+	Before json.RawMessage `json:"before,omitempty"`
+	After  json.RawMessage `json:"after,omitempty"`
+}
+
+// AuditFilter represents a set of filters that restrict the returned audit events.
+type AuditFilter struct {
+	OrgID        *ID
+	ResourceType *ResourceType
+	ActorID      *ID
+	// Start and Stop bound the time of the returned events; a zero time is unbounded.
+	Start time.Time
+	Stop  time.Time
+
+	// Allowed, if set, restricts the events to those it returns true for. It
+	// is set by authorizing services, so that events are paged after they are
+	// authorized.
+	Allowed func(e *AuditEvent) bool
+}
+
+// Matches returns true if the event passes the filter.
+func (f AuditFilter) Matches(e *AuditEvent) bool {
+	if f.OrgID != nil && e.OrgID != *f.OrgID {
+		return false
+	}
+	if f.ResourceType != nil && e.ResourceType != *f.ResourceType {
+		return false
+	}
+	if f.ActorID != nil && e.ActorID != *f.ActorID {
+		return false
+	}
+	if !f.Start.IsZero() && e.Time.Before(f.Start) {
+		return false
+	}
+	if !f.Stop.IsZero() && !e.Time.Before(f.Stop) {
+		return false
+	}
+	if f.Allowed != nil && !f.Allowed(e) {
+		return false
+	}
+	return true
+}
+
+// AuditLogService records and retrieves audit events.
+type AuditLogService interface {
+	// RecordAuditEvent stores the event and sets its ID.
+	RecordAuditEvent(ctx context.Context, e *AuditEvent) error
+
+	// FindAuditEvents returns the events that match the filter, ordered by time, and the total count of matching events.
+	FindAuditEvents(ctx context.Context, filter AuditFilter, opt ...FindOptions) ([]*AuditEvent, int, error)
+}
+
+// DefaultAuditFindOptions are the default options for the audit log.
+var DefaultAuditFindOptions = FindOptions{
+	Descending: true,
+	Limit:      100,
+}
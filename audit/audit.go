@@ -0,0 +1,89 @@
+// Package audit provides service implementations that record an audit event
+// for every mutation made through them.
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/influxdata/platform"
+	platcontext "github.com/influxdata/platform/context"
+	"go.uber.org/zap"
+)
+
+// Recorder builds audit events for mutations and stores them in an audit log.
+type Recorder struct {
+	AuditLogService platform.AuditLogService
+	Logger          *zap.Logger
+
+	now func() time.Time
+}
+
+// NewRecorder constructs a Recorder that stores events in s.
+func NewRecorder(s platform.AuditLogService, logger *zap.Logger) *Recorder {
+	return &Recorder{
+		AuditLogService: s,
+		Logger:          logger,
+		now:             time.Now,
+	}
+}
+
+// change describes a mutation to a single resource.
+type change struct {
+	action       platform.Action
+	resourceType platform.ResourceType
+	resourceID   platform.ID
+	orgID        platform.ID
+	before       interface{}
+	after        interface{}
+}
+
+// record stores an event for the change. The mutation has already been applied by the
+// time it is recorded, so failures are logged rather than returned to the caller.
+func (r *Recorder) record(ctx context.Context, c change) {
+	e := &platform.AuditEvent{
+		Time:         r.now(),
+		Action:       c.action,
+		ResourceType: c.resourceType,
+		ResourceID:   c.resourceID,
+		OrgID:        c.orgID,
+	}
+
+	if a, err := platcontext.GetAuthorizer(ctx); err == nil {
+		e.ActorID = a.Identifier()
+		e.ActorKind = a.Kind()
+		e.UserID = a.GetUserID()
+	}
+
+	var err error
+	if e.Before, err = snapshot(c.before); err != nil {
+		r.logError(e, err)
+		return
+	}
+	if e.After, err = snapshot(c.after); err != nil {
+		r.logError(e, err)
+		return
+	}
+
+	if err := r.AuditLogService.RecordAuditEvent(ctx, e); err != nil {
+		r.logError(e, err)
+	}
+}
+
+func (r *Recorder) logError(e *platform.AuditEvent, err error) {
+	r.Logger.Error("failed to record audit event",
+		zap.String("action", string(e.Action)),
+		zap.String("resource_type", string(e.ResourceType)),
+		zap.Stringer("resource_id", e.ResourceID),
+		zap.Error(err),
+	)
+}
+
+// snapshot encodes a resource for the before or after field of an event.
+func snapshot(v interface{}) (json.RawMessage, error) {
+	if v == nil {
+		return nil, nil
+	}
+	return json.Marshal(v)
+}
@@ -0,0 +1,167 @@
+package audit_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/audit"
+	platcontext "github.com/influxdata/platform/context"
+	"github.com/influxdata/platform/inmem"
+	"github.com/influxdata/platform/mock"
+	platformtesting "github.com/influxdata/platform/testing"
+	"go.uber.org/zap"
+)
+
+var (
+	authID   = platformtesting.MustIDBase16("020f755c3c082000")
+	userID   = platformtesting.MustIDBase16("020f755c3c082001")
+	orgID    = platformtesting.MustIDBase16("020f755c3c082002")
+	bucketID = platformtesting.MustIDBase16("020f755c3c082003")
+)
+
+func newContext() context.Context {
+	return platcontext.SetAuthorizer(context.Background(), &platform.Authorization{
+		ID:     authID,
+		UserID: userID,
+		Status: platform.Active,
+	})
+}
+
+func TestBucketService(t *testing.T) {
+	svc := inmem.NewService()
+	ctx := newContext()
+
+	buckets := map[platform.ID]platform.Bucket{}
+	bs := mock.NewBucketService()
+	bs.FindBucketByIDFn = func(ctx context.Context, id platform.ID) (*platform.Bucket, error) {
+		b, ok := buckets[id]
+		if !ok {
+			return nil, &platform.Error{Code: platform.ENotFound}
+		}
+		return &b, nil
+	}
+	bs.CreateBucketFn = func(ctx context.Context, b *platform.Bucket) error {
+		b.ID = bucketID
+		buckets[b.ID] = *b
+		return nil
+	}
+	bs.UpdateBucketFn = func(ctx context.Context, id platform.ID, upd platform.BucketUpdate) (*platform.Bucket, error) {
+		b := buckets[id]
+		b.Name = *upd.Name
+		buckets[id] = b
+		return &b, nil
+	}
+	bs.DeleteBucketFn = func(ctx context.Context, id platform.ID) error {
+		delete(buckets, id)
+		return nil
+	}
+
+	s := audit.NewBucketService(bs, audit.NewRecorder(svc, zap.NewNop()))
+
+	b := &platform.Bucket{Name: "b1", OrganizationID: orgID}
+	if err := s.CreateBucket(ctx, b); err != nil {
+		t.Fatal(err)
+	}
+	name := "b2"
+	if _, err := s.UpdateBucket(ctx, b.ID, platform.BucketUpdate{Name: &name}); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.DeleteBucket(ctx, b.ID); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.DeleteBucket(ctx, b.ID); err == nil {
+		t.Fatal("expected deleting a missing bucket to fail")
+	}
+
+	es, n, err := svc.FindAuditEvents(ctx, platform.AuditFilter{}, platform.FindOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 3 {
+		t.Fatalf("got %d audit events, want 3", n)
+	}
+
+	for i, want := range []struct {
+		action platform.Action
+		before string
+		after  string
+	}{
+		{action: platform.CreateAction, after: "b1"},
+		{action: platform.WriteAction, before: "b1", after: "b2"},
+		{action: platform.DeleteAction, before: "b2"},
+	} {
+		e := es[i]
+		if e.Action != want.action {
+			t.Errorf("event %d: action is %q, want %q", i, e.Action, want.action)
+		}
+		if e.ResourceType != platform.BucketResourceType || e.ResourceID != b.ID || e.OrgID != orgID {
+			t.Errorf("event %d: resource is %s %s in org %s, want bucket %s in org %s", i, e.ResourceType, e.ResourceID, e.OrgID, b.ID, orgID)
+		}
+		if e.ActorID != authID || e.ActorKind != "authorization" || e.UserID != userID {
+			t.Errorf("event %d: actor is %s %s (user %s), want authorization %s (user %s)", i, e.ActorKind, e.ActorID, e.UserID, authID, userID)
+		}
+		if got := bucketName(t, e.Before); got != want.before {
+			t.Errorf("event %d: before is %q, want %q", i, got, want.before)
+		}
+		if got := bucketName(t, e.After); got != want.after {
+			t.Errorf("event %d: after is %q, want %q", i, got, want.after)
+		}
+	}
+}
+
+func TestAuthorizationService_RedactsToken(t *testing.T) {
+	svc := inmem.NewService()
+	auths := &mock.AuthorizationService{
+		CreateAuthorizationFn: func(ctx context.Context, a *platform.Authorization) error {
+			a.ID = authID
+			a.Token = "secret-token"
+			return nil
+		},
+	}
+	s := audit.NewAuthorizationService(auths, audit.NewRecorder(svc, zap.NewNop()))
+
+	a := &platform.Authorization{UserID: userID}
+	if err := s.CreateAuthorization(newContext(), a); err != nil {
+		t.Fatal(err)
+	}
+	if a.Token != "secret-token" {
+		t.Errorf("token returned to the caller is %q, want it unchanged", a.Token)
+	}
+
+	es, _, err := svc.FindAuditEvents(context.Background(), platform.AuditFilter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(es) != 1 {
+		t.Fatalf("got %d audit events, want 1", len(es))
+	}
+
+	var after platform.Authorization
+	if err := json.Unmarshal(es[0].After, &after); err != nil {
+		t.Fatal(err)
+	}
+	if after.Token != "" {
+		t.Errorf("audit event recorded token %q", after.Token)
+	}
+	if after.ID != authID {
+		t.Errorf("audit event recorded authorization %s, want %s", after.ID, authID)
+	}
+	if es[0].Time.After(time.Now()) {
+		t.Errorf("audit event time %s is in the future", es[0].Time)
+	}
+}
+
+func bucketName(t *testing.T, raw json.RawMessage) string {
+	t.Helper()
+	if len(raw) == 0 {
+		return ""
+	}
+	var b platform.Bucket
+	if err := json.Unmarshal(raw, &b); err != nil {
+		t.Fatal(err)
+	}
+	return b.Name
+}
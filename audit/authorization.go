@@ -0,0 +1,89 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/influxdata/platform"
+)
+
+var _ platform.AuthorizationService = (*AuthorizationService)(nil)
+
+// AuthorizationService wraps a platform.AuthorizationService and records its mutations.
+// Tokens are never written to the audit log.
+type AuthorizationService struct {
+	platform.AuthorizationService
+	r *Recorder
+}
+
+// NewAuthorizationService constructs an instance of an auditing authorization service.
+func NewAuthorizationService(s platform.AuthorizationService, r *Recorder) *AuthorizationService {
+	return &AuthorizationService{
+		AuthorizationService: s,
+		r:                    r,
+	}
+}
+
+// redact returns a copy of the authorization without its token.
+func redact(a *platform.Authorization) *platform.Authorization {
+	c := *a
+	c.Token = ""
+	return &c
+}
+
+// CreateAuthorization creates the authorization and records its creation.
+func (s *AuthorizationService) CreateAuthorization(ctx context.Context, a *platform.Authorization) error {
+	if err := s.AuthorizationService.CreateAuthorization(ctx, a); err != nil {
+		return err
+	}
+
+	s.r.record(ctx, change{
+		action:       platform.CreateAction,
+		resourceType: platform.TokenResourceType,
+		resourceID:   a.ID,
+		after:        redact(a),
+	})
+	return nil
+}
+
+// SetAuthorizationStatus updates the status and records the authorization before and after the update.
+func (s *AuthorizationService) SetAuthorizationStatus(ctx context.Context, id platform.ID, status platform.Status) error {
+	before, err := s.AuthorizationService.FindAuthorizationByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.AuthorizationService.SetAuthorizationStatus(ctx, id, status); err != nil {
+		return err
+	}
+
+	after := redact(before)
+	after.Status = status
+	s.r.record(ctx, change{
+		action:       platform.WriteAction,
+		resourceType: platform.TokenResourceType,
+		resourceID:   id,
+		before:       redact(before),
+		after:        after,
+	})
+	return nil
+}
+
+// DeleteAuthorization deletes the authorization and records it as it was before deletion.
+func (s *AuthorizationService) DeleteAuthorization(ctx context.Context, id platform.ID) error {
+	before, err := s.AuthorizationService.FindAuthorizationByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.AuthorizationService.DeleteAuthorization(ctx, id); err != nil {
+		return err
+	}
+
+	s.r.record(ctx, change{
+		action:       platform.DeleteAction,
+		resourceType: platform.TokenResourceType,
+		resourceID:   id,
+		before:       redact(before),
+	})
+	return nil
+}
@@ -0,0 +1,83 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/influxdata/platform"
+)
+
+var _ platform.BucketService = (*BucketService)(nil)
+
+// BucketService wraps a platform.BucketService and records its mutations.
+type BucketService struct {
+	platform.BucketService
+	r *Recorder
+}
+
+// NewBucketService constructs an instance of an auditing bucket service.
+func NewBucketService(s platform.BucketService, r *Recorder) *BucketService {
+	return &BucketService{
+		BucketService: s,
+		r:             r,
+	}
+}
+
+// CreateBucket creates the bucket and records its creation.
+func (s *BucketService) CreateBucket(ctx context.Context, b *platform.Bucket) error {
+	if err := s.BucketService.CreateBucket(ctx, b); err != nil {
+		return err
+	}
+
+	s.r.record(ctx, change{
+		action:       platform.CreateAction,
+		resourceType: platform.BucketResourceType,
+		resourceID:   b.ID,
+		orgID:        b.OrganizationID,
+		after:        b,
+	})
+	return nil
+}
+
+// UpdateBucket updates the bucket and records it before and after the update.
+func (s *BucketService) UpdateBucket(ctx context.Context, id platform.ID, upd platform.BucketUpdate) (*platform.Bucket, error) {
+	before, err := s.BucketService.FindBucketByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := s.BucketService.UpdateBucket(ctx, id, upd)
+	if err != nil {
+		return nil, err
+	}
+
+	s.r.record(ctx, change{
+		action:       platform.WriteAction,
+		resourceType: platform.BucketResourceType,
+		resourceID:   id,
+		orgID:        b.OrganizationID,
+		before:       before,
+		after:        b,
+	})
+	return b, nil
+}
+
+// DeleteBucket deletes the bucket and records it as it was before deletion.
+func (s *BucketService) DeleteBucket(ctx context.Context, id platform.ID) error {
+	before, err := s.BucketService.FindBucketByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.BucketService.DeleteBucket(ctx, id); err != nil {
+		return err
+	}
+
+	s.r.record(ctx, change{
+		action:       platform.DeleteAction,
+		resourceType: platform.BucketResourceType,
+		resourceID:   id,
+		orgID:        before.OrganizationID,
+		before:       before,
+	})
+	return nil
+}
@@ -0,0 +1,126 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/influxdata/platform"
+)
+
+var _ platform.DashboardService = (*DashboardService)(nil)
+
+// DashboardService wraps a platform.DashboardService and records its mutations.
+// Changes to a dashboard's cells are recorded as writes to the dashboard.
+type DashboardService struct {
+	platform.DashboardService
+	r *Recorder
+}
+
+// NewDashboardService constructs an instance of an auditing dashboard service.
+func NewDashboardService(s platform.DashboardService, r *Recorder) *DashboardService {
+	return &DashboardService{
+		DashboardService: s,
+		r:                r,
+	}
+}
+
+// CreateDashboard creates the dashboard and records its creation.
+func (s *DashboardService) CreateDashboard(ctx context.Context, d *platform.Dashboard) error {
+	if err := s.DashboardService.CreateDashboard(ctx, d); err != nil {
+		return err
+	}
+
+	s.r.record(ctx, change{
+		action:       platform.CreateAction,
+		resourceType: platform.DashboardResourceType,
+		resourceID:   d.ID,
+		after:        d,
+	})
+	return nil
+}
+
+// UpdateDashboard updates the dashboard and records it before and after the update.
+func (s *DashboardService) UpdateDashboard(ctx context.Context, id platform.ID, upd platform.DashboardUpdate) (*platform.Dashboard, error) {
+	var d *platform.Dashboard
+	err := s.write(ctx, id, func() (err error) {
+		d, err = s.DashboardService.UpdateDashboard(ctx, id, upd)
+		return err
+	})
+	return d, err
+}
+
+// AddDashboardCell adds the cell and records the dashboard before and after the change.
+func (s *DashboardService) AddDashboardCell(ctx context.Context, id platform.ID, c *platform.Cell, opts platform.AddDashboardCellOptions) error {
+	return s.write(ctx, id, func() error {
+		return s.DashboardService.AddDashboardCell(ctx, id, c, opts)
+	})
+}
+
+// RemoveDashboardCell removes the cell and records the dashboard before and after the change.
+func (s *DashboardService) RemoveDashboardCell(ctx context.Context, dashboardID, cellID platform.ID) error {
+	return s.write(ctx, dashboardID, func() error {
+		return s.DashboardService.RemoveDashboardCell(ctx, dashboardID, cellID)
+	})
+}
+
+// UpdateDashboardCell updates the cell and records the dashboard before and after the change.
+func (s *DashboardService) UpdateDashboardCell(ctx context.Context, dashboardID, cellID platform.ID, upd platform.CellUpdate) (*platform.Cell, error) {
+	var c *platform.Cell
+	err := s.write(ctx, dashboardID, func() (err error) {
+		c, err = s.DashboardService.UpdateDashboardCell(ctx, dashboardID, cellID, upd)
+		return err
+	})
+	return c, err
+}
+
+// ReplaceDashboardCells replaces the cells and records the dashboard before and after the change.
+func (s *DashboardService) ReplaceDashboardCells(ctx context.Context, id platform.ID, cs []*platform.Cell) error {
+	return s.write(ctx, id, func() error {
+		return s.DashboardService.ReplaceDashboardCells(ctx, id, cs)
+	})
+}
+
+// DeleteDashboard deletes the dashboard and records it as it was before deletion.
+func (s *DashboardService) DeleteDashboard(ctx context.Context, id platform.ID) error {
+	before, err := s.DashboardService.FindDashboardByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.DashboardService.DeleteDashboard(ctx, id); err != nil {
+		return err
+	}
+
+	s.r.record(ctx, change{
+		action:       platform.DeleteAction,
+		resourceType: platform.DashboardResourceType,
+		resourceID:   id,
+		before:       before,
+	})
+	return nil
+}
+
+// write applies fn to the dashboard and records the dashboard before and after.
+func (s *DashboardService) write(ctx context.Context, id platform.ID, fn func() error) error {
+	before, err := s.DashboardService.FindDashboardByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := fn(); err != nil {
+		return err
+	}
+
+	after, err := s.DashboardService.FindDashboardByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	s.r.record(ctx, change{
+		action:       platform.WriteAction,
+		resourceType: platform.DashboardResourceType,
+		resourceID:   id,
+		before:       before,
+		after:        after,
+	})
+	return nil
+}
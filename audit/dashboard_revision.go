@@ -0,0 +1,51 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/influxdata/platform"
+)
+
+var _ platform.DashboardRevisionService = (*DashboardRevisionService)(nil)
+
+// DashboardRevisionService wraps a platform.DashboardRevisionService and records
+// restores as writes to the dashboard.
+type DashboardRevisionService struct {
+	platform.DashboardRevisionService
+	r *Recorder
+}
+
+// NewDashboardRevisionService constructs an instance of an auditing dashboard revision service.
+func NewDashboardRevisionService(s platform.DashboardRevisionService, r *Recorder) *DashboardRevisionService {
+	return &DashboardRevisionService{
+		DashboardRevisionService: s,
+		r:                        r,
+	}
+}
+
+// RestoreDashboardRevision restores the revision and records the dashboard
+// before and after the restore. The dashboard before is its latest revision.
+func (s *DashboardRevisionService) RestoreDashboardRevision(ctx context.Context, id platform.ID, revision int) (*platform.Dashboard, error) {
+	var before interface{}
+	rs, _, err := s.DashboardRevisionService.FindDashboardRevisions(ctx, id, platform.FindOptions{Descending: true, Limit: 1})
+	if err != nil {
+		return nil, err
+	}
+	if len(rs) > 0 {
+		before = rs[0].Dashboard
+	}
+
+	d, err := s.DashboardRevisionService.RestoreDashboardRevision(ctx, id, revision)
+	if err != nil {
+		return nil, err
+	}
+
+	s.r.record(ctx, change{
+		action:       platform.WriteAction,
+		resourceType: platform.DashboardResourceType,
+		resourceID:   id,
+		before:       before,
+		after:        d,
+	})
+	return d, nil
+}
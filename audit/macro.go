@@ -0,0 +1,105 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/influxdata/platform"
+)
+
+var _ platform.MacroService = (*MacroService)(nil)
+
+// MacroService wraps a platform.MacroService and records its mutations.
+type MacroService struct {
+	platform.MacroService
+	r *Recorder
+}
+
+// NewMacroService constructs an instance of an auditing macro service.
+func NewMacroService(s platform.MacroService, r *Recorder) *MacroService {
+	return &MacroService{
+		MacroService: s,
+		r:            r,
+	}
+}
+
+// CreateMacro creates the macro and records its creation.
+func (s *MacroService) CreateMacro(ctx context.Context, m *platform.Macro) error {
+	if err := s.MacroService.CreateMacro(ctx, m); err != nil {
+		return err
+	}
+
+	s.r.record(ctx, change{
+		action:       platform.CreateAction,
+		resourceType: platform.MacroResourceType,
+		resourceID:   m.ID,
+		after:        m,
+	})
+	return nil
+}
+
+// UpdateMacro updates the macro and records it before and after the update.
+func (s *MacroService) UpdateMacro(ctx context.Context, id platform.ID, upd *platform.MacroUpdate) (*platform.Macro, error) {
+	before, err := s.MacroService.FindMacroByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := s.MacroService.UpdateMacro(ctx, id, upd)
+	if err != nil {
+		return nil, err
+	}
+
+	s.r.record(ctx, change{
+		action:       platform.WriteAction,
+		resourceType: platform.MacroResourceType,
+		resourceID:   id,
+		before:       before,
+		after:        m,
+	})
+	return m, nil
+}
+
+// ReplaceMacro replaces the macro and records it before and after the replacement.
+func (s *MacroService) ReplaceMacro(ctx context.Context, m *platform.Macro) error {
+	var before interface{}
+	if b, err := s.MacroService.FindMacroByID(ctx, m.ID); err == nil {
+		before = b
+	}
+
+	if err := s.MacroService.ReplaceMacro(ctx, m); err != nil {
+		return err
+	}
+
+	action := platform.WriteAction
+	if before == nil {
+		action = platform.CreateAction
+	}
+	s.r.record(ctx, change{
+		action:       action,
+		resourceType: platform.MacroResourceType,
+		resourceID:   m.ID,
+		before:       before,
+		after:        m,
+	})
+	return nil
+}
+
+// DeleteMacro deletes the macro and records it as it was before deletion.
+func (s *MacroService) DeleteMacro(ctx context.Context, id platform.ID) error {
+	before, err := s.MacroService.FindMacroByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.MacroService.DeleteMacro(ctx, id); err != nil {
+		return err
+	}
+
+	s.r.record(ctx, change{
+		action:       platform.DeleteAction,
+		resourceType: platform.MacroResourceType,
+		resourceID:   id,
+		before:       before,
+	})
+	return nil
+}
@@ -0,0 +1,83 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/influxdata/platform"
+)
+
+var _ platform.OrganizationService = (*OrganizationService)(nil)
+
+// OrganizationService wraps a platform.OrganizationService and records its mutations.
+type OrganizationService struct {
+	platform.OrganizationService
+	r *Recorder
+}
+
+// NewOrganizationService constructs an instance of an auditing organization service.
+func NewOrganizationService(s platform.OrganizationService, r *Recorder) *OrganizationService {
+	return &OrganizationService{
+		OrganizationService: s,
+		r:                   r,
+	}
+}
+
+// CreateOrganization creates the organization and records its creation.
+func (s *OrganizationService) CreateOrganization(ctx context.Context, o *platform.Organization) error {
+	if err := s.OrganizationService.CreateOrganization(ctx, o); err != nil {
+		return err
+	}
+
+	s.r.record(ctx, change{
+		action:       platform.CreateAction,
+		resourceType: platform.OrgResourceType,
+		resourceID:   o.ID,
+		orgID:        o.ID,
+		after:        o,
+	})
+	return nil
+}
+
+// UpdateOrganization updates the organization and records it before and after the update.
+func (s *OrganizationService) UpdateOrganization(ctx context.Context, id platform.ID, upd platform.OrganizationUpdate) (*platform.Organization, error) {
+	before, err := s.OrganizationService.FindOrganizationByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	o, err := s.OrganizationService.UpdateOrganization(ctx, id, upd)
+	if err != nil {
+		return nil, err
+	}
+
+	s.r.record(ctx, change{
+		action:       platform.WriteAction,
+		resourceType: platform.OrgResourceType,
+		resourceID:   id,
+		orgID:        id,
+		before:       before,
+		after:        o,
+	})
+	return o, nil
+}
+
+// DeleteOrganization deletes the organization and records it as it was before deletion.
+func (s *OrganizationService) DeleteOrganization(ctx context.Context, id platform.ID) error {
+	before, err := s.OrganizationService.FindOrganizationByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.OrganizationService.DeleteOrganization(ctx, id); err != nil {
+		return err
+	}
+
+	s.r.record(ctx, change{
+		action:       platform.DeleteAction,
+		resourceType: platform.OrgResourceType,
+		resourceID:   id,
+		orgID:        id,
+		before:       before,
+	})
+	return nil
+}
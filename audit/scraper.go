@@ -0,0 +1,96 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/influxdata/platform"
+)
+
+var _ platform.ScraperTargetStoreService = (*ScraperTargetStoreService)(nil)
+
+// ScraperTargetStoreService wraps a platform.ScraperTargetStoreService and records its mutations.
+// Header values are never written to the audit log since they may carry credentials.
+type ScraperTargetStoreService struct {
+	platform.ScraperTargetStoreService
+	r *Recorder
+}
+
+// NewScraperTargetStoreService constructs an instance of an auditing scraper target service.
+func NewScraperTargetStoreService(s platform.ScraperTargetStoreService, r *Recorder) *ScraperTargetStoreService {
+	return &ScraperTargetStoreService{
+		ScraperTargetStoreService: s,
+		r:                         r,
+	}
+}
+
+// redactTarget returns a copy of the target with its header values removed.
+func redactTarget(t *platform.ScraperTarget) *platform.ScraperTarget {
+	c := *t
+	if len(t.Headers) > 0 {
+		c.Headers = make(map[string]string, len(t.Headers))
+		for k := range t.Headers {
+			c.Headers[k] = ""
+		}
+	}
+	return &c
+}
+
+// AddTarget adds the target and records its creation.
+func (s *ScraperTargetStoreService) AddTarget(ctx context.Context, t *platform.ScraperTarget) error {
+	if err := s.ScraperTargetStoreService.AddTarget(ctx, t); err != nil {
+		return err
+	}
+
+	s.r.record(ctx, change{
+		action:       platform.CreateAction,
+		resourceType: platform.ScraperResourceType,
+		resourceID:   t.ID,
+		orgID:        t.OrgID,
+		after:        redactTarget(t),
+	})
+	return nil
+}
+
+// UpdateTarget updates the target and records it before and after the update.
+func (s *ScraperTargetStoreService) UpdateTarget(ctx context.Context, upd *platform.ScraperTarget) (*platform.ScraperTarget, error) {
+	before, err := s.ScraperTargetStoreService.GetTargetByID(ctx, upd.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := s.ScraperTargetStoreService.UpdateTarget(ctx, upd)
+	if err != nil {
+		return nil, err
+	}
+
+	s.r.record(ctx, change{
+		action:       platform.WriteAction,
+		resourceType: platform.ScraperResourceType,
+		resourceID:   t.ID,
+		orgID:        t.OrgID,
+		before:       redactTarget(before),
+		after:        redactTarget(t),
+	})
+	return t, nil
+}
+
+// RemoveTarget removes the target and records it as it was before removal.
+func (s *ScraperTargetStoreService) RemoveTarget(ctx context.Context, id platform.ID) error {
+	before, err := s.ScraperTargetStoreService.GetTargetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.ScraperTargetStoreService.RemoveTarget(ctx, id); err != nil {
+		return err
+	}
+
+	s.r.record(ctx, change{
+		action:       platform.DeleteAction,
+		resourceType: platform.ScraperResourceType,
+		resourceID:   id,
+		orgID:        before.OrgID,
+		before:       redactTarget(before),
+	})
+	return nil
+}
@@ -0,0 +1,93 @@
+package audit
+
+import (
+	"context"
+	"sort"
+
+	"github.com/influxdata/platform"
+)
+
+var _ platform.SecretService = (*SecretService)(nil)
+
+// SecretService wraps a platform.SecretService and records its mutations.
+// Only the keys of changed secrets are written to the audit log, never their values.
+type SecretService struct {
+	platform.SecretService
+	r *Recorder
+}
+
+// NewSecretService constructs an instance of an auditing secret service.
+func NewSecretService(s platform.SecretService, r *Recorder) *SecretService {
+	return &SecretService{
+		SecretService: s,
+		r:             r,
+	}
+}
+
+// secretKeys is the audit representation of a change to secrets.
+type secretKeys struct {
+	Keys []string `json:"keys"`
+}
+
+func keysOf(m map[string]string) secretKeys {
+	ks := make([]string, 0, len(m))
+	for k := range m {
+		ks = append(ks, k)
+	}
+	sort.Strings(ks)
+	return secretKeys{Keys: ks}
+}
+
+// PutSecret stores the secret and records its key.
+func (s *SecretService) PutSecret(ctx context.Context, orgID platform.ID, k string, v string) error {
+	if err := s.SecretService.PutSecret(ctx, orgID, k, v); err != nil {
+		return err
+	}
+
+	s.recordWrite(ctx, orgID, secretKeys{Keys: []string{k}})
+	return nil
+}
+
+// PutSecrets replaces the organization's secrets and records their keys.
+func (s *SecretService) PutSecrets(ctx context.Context, orgID platform.ID, m map[string]string) error {
+	if err := s.SecretService.PutSecrets(ctx, orgID, m); err != nil {
+		return err
+	}
+
+	s.recordWrite(ctx, orgID, keysOf(m))
+	return nil
+}
+
+// PatchSecrets updates the secrets and records their keys.
+func (s *SecretService) PatchSecrets(ctx context.Context, orgID platform.ID, m map[string]string) error {
+	if err := s.SecretService.PatchSecrets(ctx, orgID, m); err != nil {
+		return err
+	}
+
+	s.recordWrite(ctx, orgID, keysOf(m))
+	return nil
+}
+
+// DeleteSecret deletes the secrets and records their keys.
+func (s *SecretService) DeleteSecret(ctx context.Context, orgID platform.ID, ks ...string) error {
+	if err := s.SecretService.DeleteSecret(ctx, orgID, ks...); err != nil {
+		return err
+	}
+
+	s.r.record(ctx, change{
+		action:       platform.DeleteAction,
+		resourceType: platform.SecretResourceType,
+		orgID:        orgID,
+		before:       secretKeys{Keys: ks},
+	})
+	return nil
+}
+
+func (s *SecretService) recordWrite(ctx context.Context, orgID platform.ID, keys secretKeys) {
+	s.r.record(ctx, change{
+		action:       platform.WriteAction,
+		resourceType: platform.SecretResourceType,
+		orgID:        orgID,
+		after:        keys,
+	})
+}
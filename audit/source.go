@@ -0,0 +1,93 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/influxdata/platform"
+)
+
+var _ platform.SourceService = (*SourceService)(nil)
+
+// SourceService wraps a platform.SourceService and records its mutations.
+// Source credentials are never written to the audit log.
+type SourceService struct {
+	platform.SourceService
+	r *Recorder
+}
+
+// NewSourceService constructs an instance of an auditing source service.
+func NewSourceService(s platform.SourceService, r *Recorder) *SourceService {
+	return &SourceService{
+		SourceService: s,
+		r:             r,
+	}
+}
+
+// redactSource returns a copy of the source without its credentials.
+func redactSource(src *platform.Source) *platform.Source {
+	c := *src
+	c.Token = ""
+	c.Password = ""
+	c.SharedSecret = ""
+	return &c
+}
+
+// CreateSource creates the source and records its creation.
+func (s *SourceService) CreateSource(ctx context.Context, src *platform.Source) error {
+	if err := s.SourceService.CreateSource(ctx, src); err != nil {
+		return err
+	}
+
+	s.r.record(ctx, change{
+		action:       platform.CreateAction,
+		resourceType: platform.SourceResourceType,
+		resourceID:   src.ID,
+		orgID:        src.OrganizationID,
+		after:        redactSource(src),
+	})
+	return nil
+}
+
+// UpdateSource updates the source and records it before and after the update.
+func (s *SourceService) UpdateSource(ctx context.Context, id platform.ID, upd platform.SourceUpdate) (*platform.Source, error) {
+	before, err := s.SourceService.FindSourceByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	src, err := s.SourceService.UpdateSource(ctx, id, upd)
+	if err != nil {
+		return nil, err
+	}
+
+	s.r.record(ctx, change{
+		action:       platform.WriteAction,
+		resourceType: platform.SourceResourceType,
+		resourceID:   id,
+		orgID:        src.OrganizationID,
+		before:       redactSource(before),
+		after:        redactSource(src),
+	})
+	return src, nil
+}
+
+// DeleteSource deletes the source and records it as it was before deletion.
+func (s *SourceService) DeleteSource(ctx context.Context, id platform.ID) error {
+	before, err := s.SourceService.FindSourceByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.SourceService.DeleteSource(ctx, id); err != nil {
+		return err
+	}
+
+	s.r.record(ctx, change{
+		action:       platform.DeleteAction,
+		resourceType: platform.SourceResourceType,
+		resourceID:   id,
+		orgID:        before.OrganizationID,
+		before:       redactSource(before),
+	})
+	return nil
+}
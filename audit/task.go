@@ -0,0 +1,127 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/influxdata/platform"
+)
+
+var _ platform.TaskService = (*TaskService)(nil)
+
+// TaskService wraps a platform.TaskService and records its mutations.
+// Cancelling and retrying runs are recorded as writes to the task.
+type TaskService struct {
+	platform.TaskService
+	r *Recorder
+}
+
+// NewTaskService constructs an instance of an auditing task service.
+func NewTaskService(s platform.TaskService, r *Recorder) *TaskService {
+	return &TaskService{
+		TaskService: s,
+		r:           r,
+	}
+}
+
+// CreateTask creates the task and records its creation.
+func (s *TaskService) CreateTask(ctx context.Context, t *platform.Task) error {
+	if err := s.TaskService.CreateTask(ctx, t); err != nil {
+		return err
+	}
+
+	s.r.record(ctx, change{
+		action:       platform.CreateAction,
+		resourceType: platform.TaskResourceType,
+		resourceID:   t.ID,
+		orgID:        t.Organization,
+		after:        t,
+	})
+	return nil
+}
+
+// UpdateTask updates the task and records it before and after the update.
+func (s *TaskService) UpdateTask(ctx context.Context, id platform.ID, upd platform.TaskUpdate) (*platform.Task, error) {
+	before, err := s.TaskService.FindTaskByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := s.TaskService.UpdateTask(ctx, id, upd)
+	if err != nil {
+		return nil, err
+	}
+
+	s.r.record(ctx, change{
+		action:       platform.WriteAction,
+		resourceType: platform.TaskResourceType,
+		resourceID:   id,
+		orgID:        t.Organization,
+		before:       before,
+		after:        t,
+	})
+	return t, nil
+}
+
+// DeleteTask deletes the task and records it as it was before deletion.
+func (s *TaskService) DeleteTask(ctx context.Context, id platform.ID) error {
+	before, err := s.TaskService.FindTaskByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.TaskService.DeleteTask(ctx, id); err != nil {
+		return err
+	}
+
+	s.r.record(ctx, change{
+		action:       platform.DeleteAction,
+		resourceType: platform.TaskResourceType,
+		resourceID:   id,
+		orgID:        before.Organization,
+		before:       before,
+	})
+	return nil
+}
+
+// CancelRun cancels the run and records the cancellation against the task.
+func (s *TaskService) CancelRun(ctx context.Context, taskID, runID platform.ID) error {
+	t, err := s.TaskService.FindTaskByID(ctx, taskID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.TaskService.CancelRun(ctx, taskID, runID); err != nil {
+		return err
+	}
+
+	s.r.record(ctx, change{
+		action:       platform.WriteAction,
+		resourceType: platform.TaskResourceType,
+		resourceID:   taskID,
+		orgID:        t.Organization,
+		after:        map[string]interface{}{"canceledRun": runID},
+	})
+	return nil
+}
+
+// RetryRun retries the run and records the new run against the task.
+func (s *TaskService) RetryRun(ctx context.Context, taskID, runID platform.ID) (*platform.Run, error) {
+	t, err := s.TaskService.FindTaskByID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	run, err := s.TaskService.RetryRun(ctx, taskID, runID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.r.record(ctx, change{
+		action:       platform.WriteAction,
+		resourceType: platform.TaskResourceType,
+		resourceID:   taskID,
+		orgID:        t.Organization,
+		after:        map[string]interface{}{"retriedRun": run},
+	})
+	return run, nil
+}
@@ -0,0 +1,81 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	"github.com/influxdata/platform"
+)
+
+var _ platform.TelegrafConfigStore = (*TelegrafConfigService)(nil)
+
+// TelegrafConfigService wraps a platform.TelegrafConfigStore and records its mutations.
+type TelegrafConfigService struct {
+	platform.TelegrafConfigStore
+	r *Recorder
+}
+
+// NewTelegrafConfigService constructs an instance of an auditing telegraf config service.
+func NewTelegrafConfigService(s platform.TelegrafConfigStore, r *Recorder) *TelegrafConfigService {
+	return &TelegrafConfigService{
+		TelegrafConfigStore: s,
+		r:                   r,
+	}
+}
+
+// CreateTelegrafConfig creates the telegraf config and records its creation.
+func (s *TelegrafConfigService) CreateTelegrafConfig(ctx context.Context, tc *platform.TelegrafConfig, userID platform.ID, now time.Time) error {
+	if err := s.TelegrafConfigStore.CreateTelegrafConfig(ctx, tc, userID, now); err != nil {
+		return err
+	}
+
+	s.r.record(ctx, change{
+		action:       platform.CreateAction,
+		resourceType: platform.TelegrafResourceType,
+		resourceID:   tc.ID,
+		after:        tc,
+	})
+	return nil
+}
+
+// UpdateTelegrafConfig updates the telegraf config and records it before and after the update.
+func (s *TelegrafConfigService) UpdateTelegrafConfig(ctx context.Context, id platform.ID, tc *platform.TelegrafConfig, userID platform.ID, now time.Time) (*platform.TelegrafConfig, error) {
+	before, err := s.TelegrafConfigStore.FindTelegrafConfigByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	after, err := s.TelegrafConfigStore.UpdateTelegrafConfig(ctx, id, tc, userID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	s.r.record(ctx, change{
+		action:       platform.WriteAction,
+		resourceType: platform.TelegrafResourceType,
+		resourceID:   id,
+		before:       before,
+		after:        after,
+	})
+	return after, nil
+}
+
+// DeleteTelegrafConfig deletes the telegraf config and records it as it was before deletion.
+func (s *TelegrafConfigService) DeleteTelegrafConfig(ctx context.Context, id platform.ID) error {
+	before, err := s.TelegrafConfigStore.FindTelegrafConfigByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.TelegrafConfigStore.DeleteTelegrafConfig(ctx, id); err != nil {
+		return err
+	}
+
+	s.r.record(ctx, change{
+		action:       platform.DeleteAction,
+		resourceType: platform.TelegrafResourceType,
+		resourceID:   id,
+		before:       before,
+	})
+	return nil
+}
@@ -0,0 +1,80 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/influxdata/platform"
+)
+
+var _ platform.UserService = (*UserService)(nil)
+
+// UserService wraps a platform.UserService and records its mutations.
+type UserService struct {
+	platform.UserService
+	r *Recorder
+}
+
+// NewUserService constructs an instance of an auditing user service.
+func NewUserService(s platform.UserService, r *Recorder) *UserService {
+	return &UserService{
+		UserService: s,
+		r:           r,
+	}
+}
+
+// CreateUser creates the user and records its creation.
+func (s *UserService) CreateUser(ctx context.Context, u *platform.User) error {
+	if err := s.UserService.CreateUser(ctx, u); err != nil {
+		return err
+	}
+
+	s.r.record(ctx, change{
+		action:       platform.CreateAction,
+		resourceType: platform.UserResourceType,
+		resourceID:   u.ID,
+		after:        u,
+	})
+	return nil
+}
+
+// UpdateUser updates the user and records it before and after the update.
+func (s *UserService) UpdateUser(ctx context.Context, id platform.ID, upd platform.UserUpdate) (*platform.User, error) {
+	before, err := s.UserService.FindUserByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := s.UserService.UpdateUser(ctx, id, upd)
+	if err != nil {
+		return nil, err
+	}
+
+	s.r.record(ctx, change{
+		action:       platform.WriteAction,
+		resourceType: platform.UserResourceType,
+		resourceID:   id,
+		before:       before,
+		after:        u,
+	})
+	return u, nil
+}
+
+// DeleteUser deletes the user and records it as it was before deletion.
+func (s *UserService) DeleteUser(ctx context.Context, id platform.ID) error {
+	before, err := s.UserService.FindUserByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.UserService.DeleteUser(ctx, id); err != nil {
+		return err
+	}
+
+	s.r.record(ctx, change{
+		action:       platform.DeleteAction,
+		resourceType: platform.UserResourceType,
+		resourceID:   id,
+		before:       before,
+	})
+	return nil
+}
@@ -0,0 +1,52 @@
+package authorizer
+
+import (
+	"context"
+
+	"github.com/influxdata/platform"
+)
+
+var _ platform.AuditLogService = (*AuditLogService)(nil)
+
+// AuditLogService wraps a platform.AuditLogService and authorizes actions
+// against it appropriately.
+type AuditLogService struct {
+	s platform.AuditLogService
+}
+
+// NewAuditLogService constructs an instance of an authorizing audit log service.
+func NewAuditLogService(s platform.AuditLogService) *AuditLogService {
+	return &AuditLogService{
+		s: s,
+	}
+}
+
+func authorizeAuditEvent(ctx context.Context, a platform.Action, e *platform.AuditEvent) error {
+	if !e.OrgID.Valid() {
+		return IsAllowed(ctx, platform.NewTypePermission(a, platform.AuditResourceType))
+	}
+	return IsAllowed(ctx, platform.NewPermission(a, platform.AuditResourceType, e.OrgID))
+}
+
+// RecordAuditEvent checks to see if the authorizer on context has write access to the audit log.
+func (s *AuditLogService) RecordAuditEvent(ctx context.Context, e *platform.AuditEvent) error {
+	if err := authorizeAuditEvent(ctx, platform.WriteAction, e); err != nil {
+		return err
+	}
+
+	return s.s.RecordAuditEvent(ctx, e)
+}
+
+// FindAuditEvents retrieves the events that match the provided filter and that are authorized.
+// Events are authorized before they are paged.
+func (s *AuditLogService) FindAuditEvents(ctx context.Context, filter platform.AuditFilter, opt ...platform.FindOptions) ([]*platform.AuditEvent, int, error) {
+	allowed := filter.Allowed
+	filter.Allowed = func(e *platform.AuditEvent) bool {
+		if allowed != nil && !allowed(e) {
+			return false
+		}
+		return authorizeAuditEvent(ctx, platform.ReadAction, e) == nil
+	}
+
+	return s.s.FindAuditEvents(ctx, filter, opt...)
+}
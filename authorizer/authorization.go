@@ -0,0 +1,123 @@
+package authorizer
+
+import (
+	"context"
+
+	"github.com/influxdata/platform"
+	platcontext "github.com/influxdata/platform/context"
+)
+
+var _ platform.AuthorizationService = (*AuthorizationService)(nil)
+
+// AuthorizationService wraps a platform.AuthorizationService and authorizes actions
+// against it appropriately. Users have access to their own authorizations.
+type AuthorizationService struct {
+	s platform.AuthorizationService
+}
+
+// NewAuthorizationService constructs an instance of an authorizing authorization service.
+func NewAuthorizationService(s platform.AuthorizationService) *AuthorizationService {
+	return &AuthorizationService{
+		s: s,
+	}
+}
+
+// authorizeToken checks that the authorization belongs to the user on the
+// context, or that the authorizer on context has access to it.
+func authorizeToken(ctx context.Context, a platform.Action, auth *platform.Authorization) error {
+	if ca, err := platcontext.GetAuthorizer(ctx); err == nil && ca.GetUserID().Valid() && ca.GetUserID() == auth.UserID {
+		return nil
+	}
+
+	return IsAllowed(ctx, platform.NewResourcePermission(auth.ID, a, platform.TokenResourceType))
+}
+
+// FindAuthorizationByID checks to see if the authorizer on context has read access to the authorization.
+func (s *AuthorizationService) FindAuthorizationByID(ctx context.Context, id platform.ID) (*platform.Authorization, error) {
+	a, err := s.s.FindAuthorizationByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeToken(ctx, platform.ReadAction, a); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// FindAuthorizationByToken checks to see if the authorizer on context has read access to the authorization.
+func (s *AuthorizationService) FindAuthorizationByToken(ctx context.Context, t string) (*platform.Authorization, error) {
+	a, err := s.s.FindAuthorizationByToken(ctx, t)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeToken(ctx, platform.ReadAction, a); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// FindAuthorizations retrieves all authorizations that match the provided filter and then filters the list down to only the resources that are authorized.
+func (s *AuthorizationService) FindAuthorizations(ctx context.Context, filter platform.AuthorizationFilter, opt ...platform.FindOptions) ([]*platform.Authorization, int, error) {
+	as, _, err := s.s.FindAuthorizations(ctx, filter, opt...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	auths := as[:0]
+	for _, a := range as {
+		if authorizeToken(ctx, platform.ReadAction, a) == nil {
+			auths = append(auths, a)
+		}
+	}
+
+	return auths, len(auths), nil
+}
+
+// CreateAuthorization checks to see if the authorizer on context has create
+// access to authorizations and holds every permission of the new one, so that
+// no authorization grants more than its creator has.
+func (s *AuthorizationService) CreateAuthorization(ctx context.Context, a *platform.Authorization) error {
+	if err := IsAllowed(ctx, platform.NewTypePermission(platform.CreateAction, platform.TokenResourceType)); err != nil {
+		return err
+	}
+
+	for _, p := range a.Permissions {
+		if err := IsAllowed(ctx, p); err != nil {
+			return err
+		}
+	}
+
+	return s.s.CreateAuthorization(ctx, a)
+}
+
+// SetAuthorizationStatus checks to see if the authorizer on context has write access to the authorization.
+func (s *AuthorizationService) SetAuthorizationStatus(ctx context.Context, id platform.ID, status platform.Status) error {
+	a, err := s.s.FindAuthorizationByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := authorizeToken(ctx, platform.WriteAction, a); err != nil {
+		return err
+	}
+
+	return s.s.SetAuthorizationStatus(ctx, id, status)
+}
+
+// DeleteAuthorization checks to see if the authorizer on context has delete access to the authorization.
+func (s *AuthorizationService) DeleteAuthorization(ctx context.Context, id platform.ID) error {
+	a, err := s.s.FindAuthorizationByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := authorizeToken(ctx, platform.DeleteAction, a); err != nil {
+		return err
+	}
+
+	return s.s.DeleteAuthorization(ctx, id)
+}
@@ -0,0 +1,60 @@
+package authorizer_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/authorizer"
+	"github.com/influxdata/platform/mock"
+)
+
+func TestAuthorizationService_CreateAuthorization(t *testing.T) {
+	tests := []struct {
+		name        string
+		permissions []platform.Permission
+		auth        *platform.Authorization
+		wantErr     string
+	}{
+		{
+			name: "permissions held by the creator",
+			permissions: []platform.Permission{
+				platform.NewTypePermission(platform.CreateAction, platform.TokenResourceType),
+				platform.NewPermission(platform.ReadAction, platform.BucketResourceType, orgOneID),
+			},
+			auth: &platform.Authorization{
+				Permissions: []platform.Permission{platform.NewPermissionAtID(bucketOneID, platform.ReadAction, platform.BucketResourceType, orgOneID)},
+			},
+		},
+		{
+			name: "permissions beyond those of the creator",
+			permissions: []platform.Permission{
+				platform.NewTypePermission(platform.CreateAction, platform.TokenResourceType),
+				platform.NewPermission(platform.ReadAction, platform.BucketResourceType, orgOneID),
+			},
+			auth: &platform.Authorization{
+				Permissions: []platform.Permission{platform.NewPermission(platform.ReadAction, platform.BucketResourceType, orgTwoID)},
+			},
+			wantErr: platform.EForbidden,
+		},
+		{
+			name:        "not allowed to create tokens",
+			permissions: []platform.Permission{platform.NewPermission(platform.ReadAction, platform.BucketResourceType, orgOneID)},
+			auth:        &platform.Authorization{},
+			wantErr:     platform.EForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := mock.NewAuthorizationService()
+			m.CreateAuthorizationFn = func(context.Context, *platform.Authorization) error { return nil }
+			s := authorizer.NewAuthorizationService(m)
+
+			err := s.CreateAuthorization(withPermissions(tt.permissions...), tt.auth)
+			if code := platform.ErrorCode(err); code != tt.wantErr {
+				t.Errorf("CreateAuthorization() error code = %q, want %q (%v)", code, tt.wantErr, err)
+			}
+		})
+	}
+}
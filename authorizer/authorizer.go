@@ -0,0 +1,70 @@
+// Package authorizer provides service implementations that check the
+// permissions of the authorizer on the request context before delegating
+// to an underlying service.
+package authorizer
+
+import (
+	"context"
+
+	"github.com/influxdata/platform"
+	platcontext "github.com/influxdata/platform/context"
+)
+
+// IsAllowed checks that the authorizer on the context grants the permission.
+func IsAllowed(ctx context.Context, p platform.Permission) error {
+	a, err := platcontext.GetAuthorizer(ctx)
+	if err != nil {
+		return &platform.Error{
+			Code: platform.EInternal,
+			Err:  err,
+		}
+	}
+
+	if !a.Allowed(p) {
+		return &platform.Error{
+			Code: platform.EForbidden,
+			Msg:  a.Kind() + " is unauthorized to " + p.String(),
+		}
+	}
+
+	return nil
+}
+
+// organizationID returns id if it is valid, and otherwise looks up the ID of the organization named name.
+func organizationID(ctx context.Context, s platform.OrganizationService, id platform.ID, name string) (platform.ID, error) {
+	if id.Valid() || name == "" {
+		return id, nil
+	}
+
+	o, err := s.FindOrganization(ctx, platform.OrganizationFilter{Name: &name})
+	if err != nil {
+		return 0, err
+	}
+	return o.ID, nil
+}
+
+// grantOwnership maps the user of the authorizer on the context as an owner of
+// a resource it created. Resources of types that do not belong to an
+// organization are only accessible through such mappings to those without a
+// grant on every resource of the type.
+func grantOwnership(ctx context.Context, s platform.UserResourceMappingService, rt platform.ResourceType, id platform.ID) error {
+	a, err := platcontext.GetAuthorizer(ctx)
+	if err != nil {
+		return &platform.Error{
+			Code: platform.EInternal,
+			Err:  err,
+		}
+	}
+
+	userID := a.GetUserID()
+	if !userID.Valid() {
+		return nil
+	}
+
+	return s.CreateUserResourceMapping(ctx, &platform.UserResourceMapping{
+		ResourceID:   id,
+		ResourceType: rt,
+		UserID:       userID,
+		UserType:     platform.Owner,
+	})
+}
@@ -0,0 +1,36 @@
+package authorizer
+
+import (
+	"context"
+	"io"
+
+	"github.com/influxdata/platform"
+)
+
+var _ platform.BackupService = (*BackupService)(nil)
+
+// BackupService wraps a platform.BackupService and authorizes actions
+// against it appropriately.
+type BackupService struct {
+	s platform.BackupService
+}
+
+// NewBackupService constructs an instance of an authorizing backup service.
+func NewBackupService(s platform.BackupService) *BackupService {
+	return &BackupService{
+		s: s,
+	}
+}
+
+// Backup checks to see if the authorizer on context has read access to every
+// resource type across all organizations, since backups include the whole
+// metadata store whatever the filter.
+func (s *BackupService) Backup(ctx context.Context, w io.Writer, filter platform.BackupFilter) error {
+	for _, rt := range platform.AllResourceTypes {
+		if err := IsAllowed(ctx, platform.NewTypePermission(platform.ReadAction, rt)); err != nil {
+			return err
+		}
+	}
+
+	return s.s.Backup(ctx, w, filter)
+}
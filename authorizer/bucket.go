@@ -0,0 +1,116 @@
+package authorizer
+
+import (
+	"context"
+
+	"github.com/influxdata/platform"
+)
+
+var _ platform.BucketService = (*BucketService)(nil)
+
+// BucketService wraps a platform.BucketService and authorizes actions
+// against it appropriately.
+type BucketService struct {
+	s    platform.BucketService
+	orgs platform.OrganizationService
+}
+
+// NewBucketService constructs an instance of an authorizing bucket service.
+// The organization service resolves the organization of buckets created by organization name.
+func NewBucketService(s platform.BucketService, orgs platform.OrganizationService) *BucketService {
+	return &BucketService{
+		s:    s,
+		orgs: orgs,
+	}
+}
+
+func authorizeBucket(ctx context.Context, a platform.Action, b *platform.Bucket) error {
+	return IsAllowed(ctx, platform.NewPermissionAtID(b.ID, a, platform.BucketResourceType, b.OrganizationID))
+}
+
+// FindBucketByID checks to see if the authorizer on context has read access to the id provided.
+func (s *BucketService) FindBucketByID(ctx context.Context, id platform.ID) (*platform.Bucket, error) {
+	b, err := s.s.FindBucketByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeBucket(ctx, platform.ReadAction, b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// FindBucket retrieves the bucket and checks to see if the authorizer on context has read access to the bucket.
+func (s *BucketService) FindBucket(ctx context.Context, filter platform.BucketFilter) (*platform.Bucket, error) {
+	b, err := s.s.FindBucket(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeBucket(ctx, platform.ReadAction, b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// FindBuckets retrieves all buckets that match the provided filter and then filters the list down to only the resources that are authorized.
+func (s *BucketService) FindBuckets(ctx context.Context, filter platform.BucketFilter, opt ...platform.FindOptions) ([]*platform.Bucket, int, error) {
+	bs, _, err := s.s.FindBuckets(ctx, filter, opt...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	buckets := bs[:0]
+	for _, b := range bs {
+		if authorizeBucket(ctx, platform.ReadAction, b) == nil {
+			buckets = append(buckets, b)
+		}
+	}
+
+	return buckets, len(buckets), nil
+}
+
+// CreateBucket checks to see if the authorizer on context has create access to buckets in the bucket's organization.
+func (s *BucketService) CreateBucket(ctx context.Context, b *platform.Bucket) error {
+	orgID, err := organizationID(ctx, s.orgs, b.OrganizationID, b.Organization)
+	if err != nil {
+		return err
+	}
+
+	if err := IsAllowed(ctx, platform.NewPermission(platform.CreateAction, platform.BucketResourceType, orgID)); err != nil {
+		return err
+	}
+
+	return s.s.CreateBucket(ctx, b)
+}
+
+// UpdateBucket checks to see if the authorizer on context has write access to the bucket provided.
+func (s *BucketService) UpdateBucket(ctx context.Context, id platform.ID, upd platform.BucketUpdate) (*platform.Bucket, error) {
+	b, err := s.s.FindBucketByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeBucket(ctx, platform.WriteAction, b); err != nil {
+		return nil, err
+	}
+
+	return s.s.UpdateBucket(ctx, id, upd)
+}
+
+// DeleteBucket checks to see if the authorizer on context has delete access to the bucket provided.
+func (s *BucketService) DeleteBucket(ctx context.Context, id platform.ID) error {
+	b, err := s.s.FindBucketByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := authorizeBucket(ctx, platform.DeleteAction, b); err != nil {
+		return err
+	}
+
+	return s.s.DeleteBucket(ctx, id)
+}
@@ -0,0 +1,156 @@
+package authorizer_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/authorizer"
+	platcontext "github.com/influxdata/platform/context"
+	"github.com/influxdata/platform/mock"
+	platformtesting "github.com/influxdata/platform/testing"
+)
+
+var (
+	orgOneID    = platformtesting.MustIDBase16("020f755c3c082000")
+	orgTwoID    = platformtesting.MustIDBase16("020f755c3c082001")
+	bucketOneID = platformtesting.MustIDBase16("020f755c3c082010")
+	bucketTwoID = platformtesting.MustIDBase16("020f755c3c082011")
+)
+
+func newBucketService() *mock.BucketService {
+	buckets := []*platform.Bucket{
+		{ID: bucketOneID, Name: "b1", OrganizationID: orgOneID},
+		{ID: bucketTwoID, Name: "b2", OrganizationID: orgTwoID},
+	}
+
+	s := mock.NewBucketService()
+	s.FindBucketByIDFn = func(ctx context.Context, id platform.ID) (*platform.Bucket, error) {
+		for _, b := range buckets {
+			if b.ID == id {
+				return b, nil
+			}
+		}
+		return nil, &platform.Error{Code: platform.ENotFound}
+	}
+	s.FindBucketsFn = func(ctx context.Context, filter platform.BucketFilter, opts ...platform.FindOptions) ([]*platform.Bucket, int, error) {
+		bs := append([]*platform.Bucket{}, buckets...)
+		return bs, len(bs), nil
+	}
+	return s
+}
+
+func withPermissions(ps ...platform.Permission) context.Context {
+	return platcontext.SetAuthorizer(context.Background(), &platform.Authorization{
+		Status:      platform.Active,
+		Permissions: ps,
+	})
+}
+
+func TestBucketService_FindBucketByID(t *testing.T) {
+	tests := []struct {
+		name        string
+		permissions []platform.Permission
+		id          platform.ID
+		wantErr     string
+	}{
+		{
+			name:        "authorized to read all buckets in the org",
+			permissions: []platform.Permission{platform.NewPermission(platform.ReadAction, platform.BucketResourceType, orgOneID)},
+			id:          bucketOneID,
+		},
+		{
+			name:        "authorized to read the bucket",
+			permissions: []platform.Permission{platform.ReadBucketPermission(bucketTwoID)},
+			id:          bucketTwoID,
+		},
+		{
+			name:        "bucket belongs to another org",
+			permissions: []platform.Permission{platform.NewPermission(platform.ReadAction, platform.BucketResourceType, orgOneID)},
+			id:          bucketTwoID,
+			wantErr:     platform.EForbidden,
+		},
+		{
+			name:        "write does not imply read",
+			permissions: []platform.Permission{platform.NewPermission(platform.WriteAction, platform.BucketResourceType, orgOneID)},
+			id:          bucketOneID,
+			wantErr:     platform.EForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := authorizer.NewBucketService(newBucketService(), &mock.OrganizationService{})
+
+			_, err := s.FindBucketByID(withPermissions(tt.permissions...), tt.id)
+			if code := platform.ErrorCode(err); code != tt.wantErr {
+				t.Errorf("FindBucketByID() error code = %q, want %q (%v)", code, tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestBucketService_FindBuckets(t *testing.T) {
+	tests := []struct {
+		name        string
+		permissions []platform.Permission
+		want        []platform.ID
+	}{
+		{
+			name:        "all buckets",
+			permissions: []platform.Permission{platform.NewTypePermission(platform.ReadAction, platform.BucketResourceType)},
+			want:        []platform.ID{bucketOneID, bucketTwoID},
+		},
+		{
+			name:        "buckets in one org",
+			permissions: []platform.Permission{platform.NewPermission(platform.ReadAction, platform.BucketResourceType, orgTwoID)},
+			want:        []platform.ID{bucketTwoID},
+		},
+		{
+			name: "no buckets",
+			want: []platform.ID{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := authorizer.NewBucketService(newBucketService(), &mock.OrganizationService{})
+
+			bs, n, err := s.FindBuckets(withPermissions(tt.permissions...), platform.BucketFilter{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			ids := []platform.ID{}
+			for _, b := range bs {
+				ids = append(ids, b.ID)
+			}
+			if !cmp.Equal(ids, tt.want) {
+				t.Errorf("FindBuckets() -got/+want\n%s", cmp.Diff(ids, tt.want))
+			}
+			if n != len(tt.want) {
+				t.Errorf("FindBuckets() count = %d, want %d", n, len(tt.want))
+			}
+		})
+	}
+}
+
+func TestBucketService_CreateBucket(t *testing.T) {
+	orgs := &mock.OrganizationService{}
+	orgs.FindOrganizationF = func(ctx context.Context, filter platform.OrganizationFilter) (*platform.Organization, error) {
+		return &platform.Organization{ID: orgOneID, Name: *filter.Name}, nil
+	}
+	s := authorizer.NewBucketService(newBucketService(), orgs)
+	ctx := withPermissions(platform.NewPermission(platform.CreateAction, platform.BucketResourceType, orgOneID))
+
+	if err := s.CreateBucket(ctx, &platform.Bucket{Name: "b3", Organization: "org1"}); err != nil {
+		t.Errorf("CreateBucket() in an authorized org by name returned error: %v", err)
+	}
+	if err := s.CreateBucket(ctx, &platform.Bucket{Name: "b3", OrganizationID: orgTwoID}); platform.ErrorCode(err) != platform.EForbidden {
+		t.Errorf("CreateBucket() in another org returned %v, want forbidden", err)
+	}
+	if err := s.CreateBucket(context.Background(), &platform.Bucket{Name: "b3", OrganizationID: orgOneID}); err == nil {
+		t.Error("CreateBucket() without an authorizer on context should fail")
+	}
+}
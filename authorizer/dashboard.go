@@ -0,0 +1,122 @@
+package authorizer
+
+import (
+	"context"
+
+	"github.com/influxdata/platform"
+)
+
+var _ platform.DashboardService = (*DashboardService)(nil)
+
+// DashboardService wraps a platform.DashboardService and authorizes actions
+// against it appropriately.
+type DashboardService struct {
+	s        platform.DashboardService
+	mappings platform.UserResourceMappingService
+}
+
+// NewDashboardService constructs an instance of an authorizing dashboard service.
+// The creators of dashboards are mapped as their owners in ms.
+func NewDashboardService(s platform.DashboardService, ms platform.UserResourceMappingService) *DashboardService {
+	return &DashboardService{
+		s:        s,
+		mappings: ms,
+	}
+}
+
+func authorizeDashboard(ctx context.Context, a platform.Action, id platform.ID) error {
+	return IsAllowed(ctx, platform.NewResourcePermission(id, a, platform.DashboardResourceType))
+}
+
+// FindDashboardByID checks to see if the authorizer on context has read access to the id provided.
+func (s *DashboardService) FindDashboardByID(ctx context.Context, id platform.ID) (*platform.Dashboard, error) {
+	if err := authorizeDashboard(ctx, platform.ReadAction, id); err != nil {
+		return nil, err
+	}
+
+	return s.s.FindDashboardByID(ctx, id)
+}
+
+// FindDashboards retrieves all dashboards that match the provided filter and then filters the list down to only the resources that are authorized.
+func (s *DashboardService) FindDashboards(ctx context.Context, filter platform.DashboardFilter, opts platform.FindOptions) ([]*platform.Dashboard, int, error) {
+	ds, _, err := s.s.FindDashboards(ctx, filter, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	dashboards := ds[:0]
+	for _, d := range ds {
+		if authorizeDashboard(ctx, platform.ReadAction, d.ID) == nil {
+			dashboards = append(dashboards, d)
+		}
+	}
+
+	return dashboards, len(dashboards), nil
+}
+
+// CreateDashboard checks to see if the authorizer on context has create access to dashboards.
+func (s *DashboardService) CreateDashboard(ctx context.Context, d *platform.Dashboard) error {
+	if err := IsAllowed(ctx, platform.NewTypePermission(platform.CreateAction, platform.DashboardResourceType)); err != nil {
+		return err
+	}
+
+	if err := s.s.CreateDashboard(ctx, d); err != nil {
+		return err
+	}
+
+	return grantOwnership(ctx, s.mappings, platform.DashboardResourceType, d.ID)
+}
+
+// UpdateDashboard checks to see if the authorizer on context has write access to the dashboard provided.
+func (s *DashboardService) UpdateDashboard(ctx context.Context, id platform.ID, upd platform.DashboardUpdate) (*platform.Dashboard, error) {
+	if err := authorizeDashboard(ctx, platform.WriteAction, id); err != nil {
+		return nil, err
+	}
+
+	return s.s.UpdateDashboard(ctx, id, upd)
+}
+
+// AddDashboardCell checks to see if the authorizer on context has write access to the dashboard provided.
+func (s *DashboardService) AddDashboardCell(ctx context.Context, id platform.ID, c *platform.Cell, opts platform.AddDashboardCellOptions) error {
+	if err := authorizeDashboard(ctx, platform.WriteAction, id); err != nil {
+		return err
+	}
+
+	return s.s.AddDashboardCell(ctx, id, c, opts)
+}
+
+// RemoveDashboardCell checks to see if the authorizer on context has write access to the dashboard provided.
+func (s *DashboardService) RemoveDashboardCell(ctx context.Context, dashboardID, cellID platform.ID) error {
+	if err := authorizeDashboard(ctx, platform.WriteAction, dashboardID); err != nil {
+		return err
+	}
+
+	return s.s.RemoveDashboardCell(ctx, dashboardID, cellID)
+}
+
+// UpdateDashboardCell checks to see if the authorizer on context has write access to the dashboard provided.
+func (s *DashboardService) UpdateDashboardCell(ctx context.Context, dashboardID, cellID platform.ID, upd platform.CellUpdate) (*platform.Cell, error) {
+	if err := authorizeDashboard(ctx, platform.WriteAction, dashboardID); err != nil {
+		return nil, err
+	}
+
+	return s.s.UpdateDashboardCell(ctx, dashboardID, cellID, upd)
+}
+
+// DeleteDashboard checks to see if the authorizer on context has delete access to the dashboard provided.
+func (s *DashboardService) DeleteDashboard(ctx context.Context, id platform.ID) error {
+	if err := authorizeDashboard(ctx, platform.DeleteAction, id); err != nil {
+		return err
+	}
+
+	return s.s.DeleteDashboard(ctx, id)
+}
+
+// ReplaceDashboardCells checks to see if the authorizer on context has write access to the dashboard provided.
+func (s *DashboardService) ReplaceDashboardCells(ctx context.Context, id platform.ID, cs []*platform.Cell) error {
+	if err := authorizeDashboard(ctx, platform.WriteAction, id); err != nil {
+		return err
+	}
+
+	return s.s.ReplaceDashboardCells(ctx, id, cs)
+}
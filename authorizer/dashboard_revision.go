@@ -0,0 +1,49 @@
+package authorizer
+
+import (
+	"context"
+
+	"github.com/influxdata/platform"
+)
+
+var _ platform.DashboardRevisionService = (*DashboardRevisionService)(nil)
+
+// DashboardRevisionService wraps a platform.DashboardRevisionService and authorizes actions
+// against it appropriately.
+type DashboardRevisionService struct {
+	s platform.DashboardRevisionService
+}
+
+// NewDashboardRevisionService constructs an instance of an authorizing dashboard revision service.
+func NewDashboardRevisionService(s platform.DashboardRevisionService) *DashboardRevisionService {
+	return &DashboardRevisionService{
+		s: s,
+	}
+}
+
+// FindDashboardRevisions checks to see if the authorizer on context has read access to the dashboard provided.
+func (s *DashboardRevisionService) FindDashboardRevisions(ctx context.Context, id platform.ID, opts platform.FindOptions) ([]*platform.DashboardRevision, int, error) {
+	if err := authorizeDashboard(ctx, platform.ReadAction, id); err != nil {
+		return nil, 0, err
+	}
+
+	return s.s.FindDashboardRevisions(ctx, id, opts)
+}
+
+// FindDashboardRevision checks to see if the authorizer on context has read access to the dashboard provided.
+func (s *DashboardRevisionService) FindDashboardRevision(ctx context.Context, id platform.ID, revision int) (*platform.DashboardRevision, error) {
+	if err := authorizeDashboard(ctx, platform.ReadAction, id); err != nil {
+		return nil, err
+	}
+
+	return s.s.FindDashboardRevision(ctx, id, revision)
+}
+
+// RestoreDashboardRevision checks to see if the authorizer on context has write access to the dashboard provided.
+func (s *DashboardRevisionService) RestoreDashboardRevision(ctx context.Context, id platform.ID, revision int) (*platform.Dashboard, error) {
+	if err := authorizeDashboard(ctx, platform.WriteAction, id); err != nil {
+		return nil, err
+	}
+
+	return s.s.RestoreDashboardRevision(ctx, id, revision)
+}
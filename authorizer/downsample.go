@@ -0,0 +1,95 @@
+package authorizer
+
+import (
+	"context"
+
+	"github.com/influxdata/platform"
+)
+
+var _ platform.DownsamplePolicyService = (*DownsamplePolicyService)(nil)
+
+// DownsamplePolicyService wraps a platform.DownsamplePolicyService and authorizes actions
+// against it appropriately. Policies are run as tasks of their organization,
+// so they are authorized as tasks of the organization.
+type DownsamplePolicyService struct {
+	s platform.DownsamplePolicyService
+}
+
+// NewDownsamplePolicyService constructs an instance of an authorizing downsample policy service.
+func NewDownsamplePolicyService(s platform.DownsamplePolicyService) *DownsamplePolicyService {
+	return &DownsamplePolicyService{
+		s: s,
+	}
+}
+
+func authorizeDownsamplePolicy(ctx context.Context, a platform.Action, p *platform.DownsamplePolicy) error {
+	return IsAllowed(ctx, platform.NewPermission(a, platform.TaskResourceType, p.OrganizationID))
+}
+
+// authorizeDownsamplePolicyID looks up the policy with the provided id and checks the authorizer on context against it.
+func (s *DownsamplePolicyService) authorizeDownsamplePolicyID(ctx context.Context, a platform.Action, id platform.ID) error {
+	p, err := s.s.FindDownsamplePolicyByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return authorizeDownsamplePolicy(ctx, a, p)
+}
+
+// FindDownsamplePolicyByID checks to see if the authorizer on context has read access to the tasks of the policy's organization.
+func (s *DownsamplePolicyService) FindDownsamplePolicyByID(ctx context.Context, id platform.ID) (*platform.DownsamplePolicy, error) {
+	p, err := s.s.FindDownsamplePolicyByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeDownsamplePolicy(ctx, platform.ReadAction, p); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// FindDownsamplePolicies retrieves all policies that match the provided filter and then filters the list down to only the resources that are authorized.
+func (s *DownsamplePolicyService) FindDownsamplePolicies(ctx context.Context, filter platform.DownsamplePolicyFilter) ([]*platform.DownsamplePolicy, int, error) {
+	ps, _, err := s.s.FindDownsamplePolicies(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	policies := ps[:0]
+	for _, p := range ps {
+		if authorizeDownsamplePolicy(ctx, platform.ReadAction, p) == nil {
+			policies = append(policies, p)
+		}
+	}
+
+	return policies, len(policies), nil
+}
+
+// CreateDownsamplePolicy checks to see if the authorizer on context has create access to tasks in the policy's organization.
+func (s *DownsamplePolicyService) CreateDownsamplePolicy(ctx context.Context, p *platform.DownsamplePolicy) error {
+	if err := authorizeDownsamplePolicy(ctx, platform.CreateAction, p); err != nil {
+		return err
+	}
+
+	return s.s.CreateDownsamplePolicy(ctx, p)
+}
+
+// UpdateDownsamplePolicy checks to see if the authorizer on context has write access to tasks in the policy's organization.
+func (s *DownsamplePolicyService) UpdateDownsamplePolicy(ctx context.Context, id platform.ID, upd platform.DownsamplePolicyUpdate) (*platform.DownsamplePolicy, error) {
+	if err := s.authorizeDownsamplePolicyID(ctx, platform.WriteAction, id); err != nil {
+		return nil, err
+	}
+
+	return s.s.UpdateDownsamplePolicy(ctx, id, upd)
+}
+
+// DeleteDownsamplePolicy checks to see if the authorizer on context has delete access to tasks in the policy's organization.
+func (s *DownsamplePolicyService) DeleteDownsamplePolicy(ctx context.Context, id platform.ID) error {
+	if err := s.authorizeDownsamplePolicyID(ctx, platform.DeleteAction, id); err != nil {
+		return err
+	}
+
+	return s.s.DeleteDownsamplePolicy(ctx, id)
+}
@@ -0,0 +1,49 @@
+package authorizer
+
+import (
+	"context"
+
+	"github.com/influxdata/platform"
+)
+
+var _ platform.LabelService = (*LabelService)(nil)
+
+// LabelService wraps a platform.LabelService and authorizes actions
+// against it appropriately.
+type LabelService struct {
+	s platform.LabelService
+}
+
+// NewLabelService constructs an instance of an authorizing label service.
+func NewLabelService(s platform.LabelService) *LabelService {
+	return &LabelService{
+		s: s,
+	}
+}
+
+// FindLabels checks to see if the authorizer on context has read access to labels.
+func (s *LabelService) FindLabels(ctx context.Context, filter platform.LabelFilter, opt ...platform.FindOptions) ([]*platform.Label, error) {
+	if err := IsAllowed(ctx, platform.NewTypePermission(platform.ReadAction, platform.LabelResourceType)); err != nil {
+		return nil, err
+	}
+
+	return s.s.FindLabels(ctx, filter, opt...)
+}
+
+// CreateLabel checks to see if the authorizer on context has create access to labels.
+func (s *LabelService) CreateLabel(ctx context.Context, l *platform.Label) error {
+	if err := IsAllowed(ctx, platform.NewTypePermission(platform.CreateAction, platform.LabelResourceType)); err != nil {
+		return err
+	}
+
+	return s.s.CreateLabel(ctx, l)
+}
+
+// DeleteLabel checks to see if the authorizer on context has delete access to labels.
+func (s *LabelService) DeleteLabel(ctx context.Context, l platform.Label) error {
+	if err := IsAllowed(ctx, platform.NewTypePermission(platform.DeleteAction, platform.LabelResourceType)); err != nil {
+		return err
+	}
+
+	return s.s.DeleteLabel(ctx, l)
+}
@@ -0,0 +1,36 @@
+package authorizer_test
+
+import (
+	"testing"
+
+	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/authorizer"
+	"github.com/influxdata/platform/inmem"
+)
+
+func TestLabelService_Owner(t *testing.T) {
+	s := authorizer.NewLabelService(inmem.NewService())
+
+	ctx := withPermissions(platform.OwnerPermissions(orgOneID)...)
+	l := &platform.Label{ResourceID: bucketOneID, Name: "production"}
+	if err := s.CreateLabel(ctx, l); err != nil {
+		t.Fatal(err)
+	}
+
+	ls, err := s.FindLabels(ctx, platform.LabelFilter{ResourceID: bucketOneID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ls) != 1 || ls[0].Name != "production" {
+		t.Fatalf("unexpected labels: %v", ls)
+	}
+
+	ctx = withPermissions(platform.MemberPermissions(orgOneID)...)
+	if _, err := s.FindLabels(ctx, platform.LabelFilter{ResourceID: bucketOneID}); err != nil {
+		t.Errorf("unexpected error listing labels as a member: %v", err)
+	}
+	err = s.DeleteLabel(ctx, *l)
+	if got := platform.ErrorCode(err); got != platform.EForbidden {
+		t.Errorf("unexpected error code deleting a label as a member: got %q, want %q", got, platform.EForbidden)
+	}
+}
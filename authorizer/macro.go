@@ -0,0 +1,95 @@
+package authorizer
+
+import (
+	"context"
+
+	"github.com/influxdata/platform"
+)
+
+var _ platform.MacroService = (*MacroService)(nil)
+
+// MacroService wraps a platform.MacroService and authorizes actions
+// against it appropriately.
+type MacroService struct {
+	s        platform.MacroService
+	mappings platform.UserResourceMappingService
+}
+
+// NewMacroService constructs an instance of an authorizing macro service.
+// The creators of macros are mapped as their owners in ms.
+func NewMacroService(s platform.MacroService, ms platform.UserResourceMappingService) *MacroService {
+	return &MacroService{
+		s:        s,
+		mappings: ms,
+	}
+}
+
+func authorizeMacro(ctx context.Context, a platform.Action, id platform.ID) error {
+	return IsAllowed(ctx, platform.NewResourcePermission(id, a, platform.MacroResourceType))
+}
+
+// FindMacroByID checks to see if the authorizer on context has read access to the id provided.
+func (s *MacroService) FindMacroByID(ctx context.Context, id platform.ID) (*platform.Macro, error) {
+	if err := authorizeMacro(ctx, platform.ReadAction, id); err != nil {
+		return nil, err
+	}
+
+	return s.s.FindMacroByID(ctx, id)
+}
+
+// FindMacros retrieves all macros and then filters the list down to only the resources that are authorized.
+func (s *MacroService) FindMacros(ctx context.Context) ([]*platform.Macro, error) {
+	ms, err := s.s.FindMacros(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	macros := ms[:0]
+	for _, m := range ms {
+		if authorizeMacro(ctx, platform.ReadAction, m.ID) == nil {
+			macros = append(macros, m)
+		}
+	}
+
+	return macros, nil
+}
+
+// CreateMacro checks to see if the authorizer on context has create access to macros.
+func (s *MacroService) CreateMacro(ctx context.Context, m *platform.Macro) error {
+	if err := IsAllowed(ctx, platform.NewTypePermission(platform.CreateAction, platform.MacroResourceType)); err != nil {
+		return err
+	}
+
+	if err := s.s.CreateMacro(ctx, m); err != nil {
+		return err
+	}
+
+	return grantOwnership(ctx, s.mappings, platform.MacroResourceType, m.ID)
+}
+
+// UpdateMacro checks to see if the authorizer on context has write access to the macro provided.
+func (s *MacroService) UpdateMacro(ctx context.Context, id platform.ID, upd *platform.MacroUpdate) (*platform.Macro, error) {
+	if err := authorizeMacro(ctx, platform.WriteAction, id); err != nil {
+		return nil, err
+	}
+
+	return s.s.UpdateMacro(ctx, id, upd)
+}
+
+// ReplaceMacro checks to see if the authorizer on context has write access to the macro provided.
+func (s *MacroService) ReplaceMacro(ctx context.Context, m *platform.Macro) error {
+	if err := authorizeMacro(ctx, platform.WriteAction, m.ID); err != nil {
+		return err
+	}
+
+	return s.s.ReplaceMacro(ctx, m)
+}
+
+// DeleteMacro checks to see if the authorizer on context has delete access to the macro provided.
+func (s *MacroService) DeleteMacro(ctx context.Context, id platform.ID) error {
+	if err := authorizeMacro(ctx, platform.DeleteAction, id); err != nil {
+		return err
+	}
+
+	return s.s.DeleteMacro(ctx, id)
+}
@@ -0,0 +1,93 @@
+package authorizer
+
+import (
+	"context"
+
+	"github.com/influxdata/platform"
+)
+
+var _ platform.OrganizationService = (*OrganizationService)(nil)
+
+// OrganizationService wraps a platform.OrganizationService and authorizes actions
+// against it appropriately.
+type OrganizationService struct {
+	s platform.OrganizationService
+}
+
+// NewOrganizationService constructs an instance of an authorizing organization service.
+func NewOrganizationService(s platform.OrganizationService) *OrganizationService {
+	return &OrganizationService{
+		s: s,
+	}
+}
+
+func authorizeOrg(ctx context.Context, a platform.Action, id platform.ID) error {
+	return IsAllowed(ctx, platform.NewPermissionAtID(id, a, platform.OrgResourceType, id))
+}
+
+// FindOrganizationByID checks to see if the authorizer on context has read access to the id provided.
+func (s *OrganizationService) FindOrganizationByID(ctx context.Context, id platform.ID) (*platform.Organization, error) {
+	if err := authorizeOrg(ctx, platform.ReadAction, id); err != nil {
+		return nil, err
+	}
+
+	return s.s.FindOrganizationByID(ctx, id)
+}
+
+// FindOrganization retrieves the organization and checks to see if the authorizer on context has read access to it.
+func (s *OrganizationService) FindOrganization(ctx context.Context, filter platform.OrganizationFilter) (*platform.Organization, error) {
+	o, err := s.s.FindOrganization(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeOrg(ctx, platform.ReadAction, o.ID); err != nil {
+		return nil, err
+	}
+
+	return o, nil
+}
+
+// FindOrganizations retrieves all organizations that match the provided filter and then filters the list down to only the resources that are authorized.
+func (s *OrganizationService) FindOrganizations(ctx context.Context, filter platform.OrganizationFilter, opt ...platform.FindOptions) ([]*platform.Organization, int, error) {
+	os, _, err := s.s.FindOrganizations(ctx, filter, opt...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	orgs := os[:0]
+	for _, o := range os {
+		if authorizeOrg(ctx, platform.ReadAction, o.ID) == nil {
+			orgs = append(orgs, o)
+		}
+	}
+
+	return orgs, len(orgs), nil
+}
+
+// CreateOrganization checks to see if the authorizer on context has create access to organizations.
+func (s *OrganizationService) CreateOrganization(ctx context.Context, o *platform.Organization) error {
+	if err := IsAllowed(ctx, platform.NewTypePermission(platform.CreateAction, platform.OrgResourceType)); err != nil {
+		return err
+	}
+
+	return s.s.CreateOrganization(ctx, o)
+}
+
+// UpdateOrganization checks to see if the authorizer on context has write access to the organization provided.
+func (s *OrganizationService) UpdateOrganization(ctx context.Context, id platform.ID, upd platform.OrganizationUpdate) (*platform.Organization, error) {
+	if err := authorizeOrg(ctx, platform.WriteAction, id); err != nil {
+		return nil, err
+	}
+
+	return s.s.UpdateOrganization(ctx, id, upd)
+}
+
+// DeleteOrganization checks to see if the authorizer on context has delete access to the organization provided.
+func (s *OrganizationService) DeleteOrganization(ctx context.Context, id platform.ID) error {
+	if err := authorizeOrg(ctx, platform.DeleteAction, id); err != nil {
+		return err
+	}
+
+	return s.s.DeleteOrganization(ctx, id)
+}
@@ -0,0 +1,164 @@
+package authorizer
+
+import (
+	"context"
+
+	"github.com/influxdata/platform"
+)
+
+var _ platform.ScraperTargetStoreService = (*ScraperTargetStoreService)(nil)
+
+// ScraperTargetStoreService wraps a platform.ScraperTargetStoreService and authorizes actions
+// against it appropriately.
+type ScraperTargetStoreService struct {
+	s    platform.ScraperTargetStoreService
+	orgs platform.OrganizationService
+}
+
+// NewScraperTargetStoreService constructs an instance of an authorizing scraper target service.
+// The organization service resolves the organization of targets that only name their organization.
+func NewScraperTargetStoreService(s platform.ScraperTargetStoreService, orgs platform.OrganizationService) *ScraperTargetStoreService {
+	return &ScraperTargetStoreService{
+		s:    s,
+		orgs: orgs,
+	}
+}
+
+// targetOrgIDs returns the organization of the target's secrets and the
+// organization it writes to, when those differ.
+func (s *ScraperTargetStoreService) targetOrgIDs(ctx context.Context, t *platform.ScraperTarget) ([]platform.ID, error) {
+	orgID, err := organizationID(ctx, s.orgs, t.OrgID, t.OrgName)
+	if err != nil {
+		return nil, err
+	}
+
+	if !t.OrgID.Valid() || t.OrgName == "" {
+		return []platform.ID{orgID}, nil
+	}
+
+	writeOrgID, err := organizationID(ctx, s.orgs, 0, t.OrgName)
+	if err != nil {
+		return nil, err
+	}
+	if writeOrgID == orgID {
+		return []platform.ID{orgID}, nil
+	}
+
+	return []platform.ID{orgID, writeOrgID}, nil
+}
+
+func (s *ScraperTargetStoreService) authorizeTarget(ctx context.Context, a platform.Action, t *platform.ScraperTarget) error {
+	orgIDs, err := s.targetOrgIDs(ctx, t)
+	if err != nil {
+		return err
+	}
+
+	for _, orgID := range orgIDs {
+		if err := IsAllowed(ctx, platform.NewPermissionAtID(t.ID, a, platform.ScraperResourceType, orgID)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// authorizeCredentials checks that the authorizer on context may read the
+// secret the target sends as its bearer token or basic auth password, since
+// scrapes disclose it to the target URL.
+func authorizeCredentials(ctx context.Context, t *platform.ScraperTarget) error {
+	if t.BearerTokenSecret == "" && t.BasicAuthPasswordSecret == "" {
+		return nil
+	}
+
+	return authorizeSecrets(ctx, platform.ReadAction, t.OrgID)
+}
+
+// ListTargets retrieves all scraper targets and then filters the list down to only the resources that are authorized.
+func (s *ScraperTargetStoreService) ListTargets(ctx context.Context) ([]platform.ScraperTarget, error) {
+	ts, err := s.s.ListTargets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	targets := ts[:0]
+	for i := range ts {
+		if s.authorizeTarget(ctx, platform.ReadAction, &ts[i]) == nil {
+			targets = append(targets, ts[i])
+		}
+	}
+
+	return targets, nil
+}
+
+// AddTarget checks to see if the authorizer on context has create access to scraper targets in the target's organizations,
+// and read access to the secret of its bearer token.
+func (s *ScraperTargetStoreService) AddTarget(ctx context.Context, t *platform.ScraperTarget) error {
+	orgIDs, err := s.targetOrgIDs(ctx, t)
+	if err != nil {
+		return err
+	}
+
+	for _, orgID := range orgIDs {
+		if err := IsAllowed(ctx, platform.NewPermission(platform.CreateAction, platform.ScraperResourceType, orgID)); err != nil {
+			return err
+		}
+	}
+
+	if err := authorizeCredentials(ctx, t); err != nil {
+		return err
+	}
+
+	return s.s.AddTarget(ctx, t)
+}
+
+// GetTargetByID checks to see if the authorizer on context has read access to the id provided.
+func (s *ScraperTargetStoreService) GetTargetByID(ctx context.Context, id platform.ID) (*platform.ScraperTarget, error) {
+	t, err := s.s.GetTargetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.authorizeTarget(ctx, platform.ReadAction, t); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// RemoveTarget checks to see if the authorizer on context has delete access to the target provided.
+func (s *ScraperTargetStoreService) RemoveTarget(ctx context.Context, id platform.ID) error {
+	t, err := s.s.GetTargetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.authorizeTarget(ctx, platform.DeleteAction, t); err != nil {
+		return err
+	}
+
+	return s.s.RemoveTarget(ctx, id)
+}
+
+// UpdateTarget checks to see if the authorizer on context has write access to the target provided,
+// both in its current organization and in the organization of the update, and read access to the
+// secret of the updated bearer token.
+func (s *ScraperTargetStoreService) UpdateTarget(ctx context.Context, upd *platform.ScraperTarget) (*platform.ScraperTarget, error) {
+	t, err := s.s.GetTargetByID(ctx, upd.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.authorizeTarget(ctx, platform.WriteAction, t); err != nil {
+		return nil, err
+	}
+
+	if err := s.authorizeTarget(ctx, platform.WriteAction, upd); err != nil {
+		return nil, err
+	}
+
+	if err := authorizeCredentials(ctx, upd); err != nil {
+		return nil, err
+	}
+
+	return s.s.UpdateTarget(ctx, upd)
+}
@@ -0,0 +1,78 @@
+package authorizer_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/authorizer"
+	"github.com/influxdata/platform/inmem"
+	"github.com/influxdata/platform/mock"
+	platformtesting "github.com/influxdata/platform/testing"
+)
+
+var targetOneID = platformtesting.MustIDBase16("020f755c3c082020")
+
+func TestScraperTargetStoreService_UpdateTarget(t *testing.T) {
+	orgs := &mock.OrganizationService{}
+	orgs.FindOrganizationF = func(ctx context.Context, filter platform.OrganizationFilter) (*platform.Organization, error) {
+		switch *filter.Name {
+		case "org1":
+			return &platform.Organization{ID: orgOneID, Name: "org1"}, nil
+		case "org2":
+			return &platform.Organization{ID: orgTwoID, Name: "org2"}, nil
+		}
+		return nil, &platform.Error{Code: platform.ENotFound}
+	}
+
+	tests := []struct {
+		name    string
+		upd     platform.ScraperTarget
+		wantErr string
+	}{
+		{
+			name: "bearer token from the same org",
+			upd:  platform.ScraperTarget{OrgName: "org1", OrgID: orgOneID, BearerTokenSecret: "token"},
+		},
+		{
+			name:    "moved to another org",
+			upd:     platform.ScraperTarget{OrgName: "org2"},
+			wantErr: platform.EForbidden,
+		},
+		{
+			name:    "bearer token from another org",
+			upd:     platform.ScraperTarget{OrgName: "org1", OrgID: orgTwoID, BearerTokenSecret: "token"},
+			wantErr: platform.EForbidden,
+		},
+		{
+			name: "basic auth password from the same org",
+			upd:  platform.ScraperTarget{OrgName: "org1", OrgID: orgOneID, BasicAuthUsername: "user", BasicAuthPasswordSecret: "password"},
+		},
+		{
+			name:    "basic auth password from another org",
+			upd:     platform.ScraperTarget{OrgName: "org1", OrgID: orgTwoID, BasicAuthUsername: "user", BasicAuthPasswordSecret: "password"},
+			wantErr: platform.EForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := inmem.NewService()
+			if err := svc.PutTarget(context.Background(), &platform.ScraperTarget{ID: targetOneID, OrgName: "org1"}); err != nil {
+				t.Fatal(err)
+			}
+			s := authorizer.NewScraperTargetStoreService(svc, orgs)
+			ctx := withPermissions(
+				platform.NewPermission(platform.WriteAction, platform.ScraperResourceType, orgOneID),
+				platform.NewPermission(platform.ReadAction, platform.SecretResourceType, orgOneID),
+			)
+
+			upd := tt.upd
+			upd.ID = targetOneID
+			_, err := s.UpdateTarget(ctx, &upd)
+			if code := platform.ErrorCode(err); code != tt.wantErr {
+				t.Errorf("UpdateTarget() error code = %q, want %q (%v)", code, tt.wantErr, err)
+			}
+		})
+	}
+}
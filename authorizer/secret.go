@@ -0,0 +1,80 @@
+package authorizer
+
+import (
+	"context"
+
+	"github.com/influxdata/platform"
+)
+
+var _ platform.SecretService = (*SecretService)(nil)
+
+// SecretService wraps a platform.SecretService and authorizes actions
+// against it appropriately.
+type SecretService struct {
+	s platform.SecretService
+}
+
+// NewSecretService constructs an instance of an authorizing secret service.
+func NewSecretService(s platform.SecretService) *SecretService {
+	return &SecretService{
+		s: s,
+	}
+}
+
+func authorizeSecrets(ctx context.Context, a platform.Action, orgID platform.ID) error {
+	return IsAllowed(ctx, platform.NewPermission(a, platform.SecretResourceType, orgID))
+}
+
+// LoadSecret checks to see if the authorizer on context has read access to the organization's secrets.
+func (s *SecretService) LoadSecret(ctx context.Context, orgID platform.ID, k string) (string, error) {
+	if err := authorizeSecrets(ctx, platform.ReadAction, orgID); err != nil {
+		return "", err
+	}
+
+	return s.s.LoadSecret(ctx, orgID, k)
+}
+
+// GetSecretKeys checks to see if the authorizer on context has read access to the organization's secrets.
+func (s *SecretService) GetSecretKeys(ctx context.Context, orgID platform.ID) ([]string, error) {
+	if err := authorizeSecrets(ctx, platform.ReadAction, orgID); err != nil {
+		return nil, err
+	}
+
+	return s.s.GetSecretKeys(ctx, orgID)
+}
+
+// PutSecret checks to see if the authorizer on context has write access to the organization's secrets.
+func (s *SecretService) PutSecret(ctx context.Context, orgID platform.ID, k string, v string) error {
+	if err := authorizeSecrets(ctx, platform.WriteAction, orgID); err != nil {
+		return err
+	}
+
+	return s.s.PutSecret(ctx, orgID, k, v)
+}
+
+// PutSecrets checks to see if the authorizer on context has write access to the organization's secrets.
+func (s *SecretService) PutSecrets(ctx context.Context, orgID platform.ID, m map[string]string) error {
+	if err := authorizeSecrets(ctx, platform.WriteAction, orgID); err != nil {
+		return err
+	}
+
+	return s.s.PutSecrets(ctx, orgID, m)
+}
+
+// PatchSecrets checks to see if the authorizer on context has write access to the organization's secrets.
+func (s *SecretService) PatchSecrets(ctx context.Context, orgID platform.ID, m map[string]string) error {
+	if err := authorizeSecrets(ctx, platform.WriteAction, orgID); err != nil {
+		return err
+	}
+
+	return s.s.PatchSecrets(ctx, orgID, m)
+}
+
+// DeleteSecret checks to see if the authorizer on context has delete access to the organization's secrets.
+func (s *SecretService) DeleteSecret(ctx context.Context, orgID platform.ID, ks ...string) error {
+	if err := authorizeSecrets(ctx, platform.DeleteAction, orgID); err != nil {
+		return err
+	}
+
+	return s.s.DeleteSecret(ctx, orgID, ks...)
+}
@@ -0,0 +1,108 @@
+package authorizer
+
+import (
+	"context"
+
+	"github.com/influxdata/platform"
+)
+
+var _ platform.SourceService = (*SourceService)(nil)
+
+// SourceService wraps a platform.SourceService and authorizes actions
+// against it appropriately.
+type SourceService struct {
+	s platform.SourceService
+}
+
+// NewSourceService constructs an instance of an authorizing source service.
+func NewSourceService(s platform.SourceService) *SourceService {
+	return &SourceService{
+		s: s,
+	}
+}
+
+func authorizeSource(ctx context.Context, a platform.Action, src *platform.Source) error {
+	return IsAllowed(ctx, platform.NewPermissionAtID(src.ID, a, platform.SourceResourceType, src.OrganizationID))
+}
+
+// DefaultSource checks to see if the authorizer on context has read access to the default source.
+func (s *SourceService) DefaultSource(ctx context.Context) (*platform.Source, error) {
+	src, err := s.s.DefaultSource(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeSource(ctx, platform.ReadAction, src); err != nil {
+		return nil, err
+	}
+
+	return src, nil
+}
+
+// FindSourceByID checks to see if the authorizer on context has read access to the id provided.
+func (s *SourceService) FindSourceByID(ctx context.Context, id platform.ID) (*platform.Source, error) {
+	src, err := s.s.FindSourceByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeSource(ctx, platform.ReadAction, src); err != nil {
+		return nil, err
+	}
+
+	return src, nil
+}
+
+// FindSources retrieves all sources and then filters the list down to only the resources that are authorized.
+func (s *SourceService) FindSources(ctx context.Context, opts platform.FindOptions) ([]*platform.Source, int, error) {
+	ss, _, err := s.s.FindSources(ctx, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	sources := ss[:0]
+	for _, src := range ss {
+		if authorizeSource(ctx, platform.ReadAction, src) == nil {
+			sources = append(sources, src)
+		}
+	}
+
+	return sources, len(sources), nil
+}
+
+// CreateSource checks to see if the authorizer on context has create access to sources in the source's organization.
+func (s *SourceService) CreateSource(ctx context.Context, src *platform.Source) error {
+	if err := IsAllowed(ctx, platform.NewPermission(platform.CreateAction, platform.SourceResourceType, src.OrganizationID)); err != nil {
+		return err
+	}
+
+	return s.s.CreateSource(ctx, src)
+}
+
+// UpdateSource checks to see if the authorizer on context has write access to the source provided.
+func (s *SourceService) UpdateSource(ctx context.Context, id platform.ID, upd platform.SourceUpdate) (*platform.Source, error) {
+	src, err := s.s.FindSourceByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeSource(ctx, platform.WriteAction, src); err != nil {
+		return nil, err
+	}
+
+	return s.s.UpdateSource(ctx, id, upd)
+}
+
+// DeleteSource checks to see if the authorizer on context has delete access to the source provided.
+func (s *SourceService) DeleteSource(ctx context.Context, id platform.ID) error {
+	src, err := s.s.FindSourceByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := authorizeSource(ctx, platform.DeleteAction, src); err != nil {
+		return err
+	}
+
+	return s.s.DeleteSource(ctx, id)
+}
@@ -0,0 +1,161 @@
+package authorizer
+
+import (
+	"context"
+
+	"github.com/influxdata/platform"
+)
+
+var _ platform.TaskService = (*TaskService)(nil)
+
+// TaskService wraps a platform.TaskService and authorizes actions
+// against it appropriately.
+type TaskService struct {
+	s platform.TaskService
+}
+
+// NewTaskService constructs an instance of an authorizing task service.
+func NewTaskService(s platform.TaskService) *TaskService {
+	return &TaskService{
+		s: s,
+	}
+}
+
+func authorizeTask(ctx context.Context, a platform.Action, t *platform.Task) error {
+	return IsAllowed(ctx, platform.NewPermissionAtID(t.ID, a, platform.TaskResourceType, t.Organization))
+}
+
+// authorizeTaskID looks up the task with the provided id and checks the authorizer on context against it.
+func (s *TaskService) authorizeTaskID(ctx context.Context, a platform.Action, id platform.ID) error {
+	t, err := s.s.FindTaskByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return authorizeTask(ctx, a, t)
+}
+
+// authorizeTaskFilter checks read access to the task a run or log filter selects,
+// or to all tasks in the filter's organization when no task is given.
+func (s *TaskService) authorizeTaskFilter(ctx context.Context, taskID, orgID *platform.ID) error {
+	switch {
+	case taskID != nil:
+		return s.authorizeTaskID(ctx, platform.ReadAction, *taskID)
+	case orgID != nil:
+		return IsAllowed(ctx, platform.NewPermission(platform.ReadAction, platform.TaskResourceType, *orgID))
+	default:
+		return IsAllowed(ctx, platform.NewTypePermission(platform.ReadAction, platform.TaskResourceType))
+	}
+}
+
+// FindTaskByID checks to see if the authorizer on context has read access to the id provided.
+func (s *TaskService) FindTaskByID(ctx context.Context, id platform.ID) (*platform.Task, error) {
+	t, err := s.s.FindTaskByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeTask(ctx, platform.ReadAction, t); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// FindTasks retrieves all tasks that match the provided filter and then filters the list down to only the resources that are authorized.
+func (s *TaskService) FindTasks(ctx context.Context, filter platform.TaskFilter) ([]*platform.Task, int, error) {
+	ts, _, err := s.s.FindTasks(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	tasks := ts[:0]
+	for _, t := range ts {
+		if authorizeTask(ctx, platform.ReadAction, t) == nil {
+			tasks = append(tasks, t)
+		}
+	}
+
+	return tasks, len(tasks), nil
+}
+
+// CreateTask checks to see if the authorizer on context has create access to tasks in the task's organization.
+func (s *TaskService) CreateTask(ctx context.Context, t *platform.Task) error {
+	if err := IsAllowed(ctx, platform.NewPermission(platform.CreateAction, platform.TaskResourceType, t.Organization)); err != nil {
+		return err
+	}
+
+	return s.s.CreateTask(ctx, t)
+}
+
+// UpdateTask checks to see if the authorizer on context has write access to the task provided.
+func (s *TaskService) UpdateTask(ctx context.Context, id platform.ID, upd platform.TaskUpdate) (*platform.Task, error) {
+	if err := s.authorizeTaskID(ctx, platform.WriteAction, id); err != nil {
+		return nil, err
+	}
+
+	return s.s.UpdateTask(ctx, id, upd)
+}
+
+// DeleteTask checks to see if the authorizer on context has delete access to the task provided.
+func (s *TaskService) DeleteTask(ctx context.Context, id platform.ID) error {
+	if err := s.authorizeTaskID(ctx, platform.DeleteAction, id); err != nil {
+		return err
+	}
+
+	return s.s.DeleteTask(ctx, id)
+}
+
+// FindLogs checks to see if the authorizer on context has read access to the task the logs belong to.
+func (s *TaskService) FindLogs(ctx context.Context, filter platform.LogFilter) ([]*platform.Log, int, error) {
+	if err := s.authorizeTaskFilter(ctx, filter.Task, filter.Org); err != nil {
+		return nil, 0, err
+	}
+
+	return s.s.FindLogs(ctx, filter)
+}
+
+// FindRuns checks to see if the authorizer on context has read access to the task the runs belong to.
+func (s *TaskService) FindRuns(ctx context.Context, filter platform.RunFilter) ([]*platform.Run, int, error) {
+	if err := s.authorizeTaskFilter(ctx, filter.Task, filter.Org); err != nil {
+		return nil, 0, err
+	}
+
+	return s.s.FindRuns(ctx, filter)
+}
+
+// FindRunByID checks to see if the authorizer on context has read access to the task provided.
+func (s *TaskService) FindRunByID(ctx context.Context, taskID, runID platform.ID) (*platform.Run, error) {
+	if err := s.authorizeTaskID(ctx, platform.ReadAction, taskID); err != nil {
+		return nil, err
+	}
+
+	return s.s.FindRunByID(ctx, taskID, runID)
+}
+
+// CancelRun checks to see if the authorizer on context has write access to the task provided.
+func (s *TaskService) CancelRun(ctx context.Context, taskID, runID platform.ID) error {
+	if err := s.authorizeTaskID(ctx, platform.WriteAction, taskID); err != nil {
+		return err
+	}
+
+	return s.s.CancelRun(ctx, taskID, runID)
+}
+
+// RetryRun checks to see if the authorizer on context has write access to the task provided.
+func (s *TaskService) RetryRun(ctx context.Context, taskID, runID platform.ID) (*platform.Run, error) {
+	if err := s.authorizeTaskID(ctx, platform.WriteAction, taskID); err != nil {
+		return nil, err
+	}
+
+	return s.s.RetryRun(ctx, taskID, runID)
+}
+
+// FindTaskDependencies checks to see if the authorizer on context has read access to the task provided.
+func (s *TaskService) FindTaskDependencies(ctx context.Context, id platform.ID) (*platform.TaskDependencies, error) {
+	if err := s.authorizeTaskID(ctx, platform.ReadAction, id); err != nil {
+		return nil, err
+	}
+
+	return s.s.FindTaskDependencies(ctx, id)
+}
@@ -0,0 +1,96 @@
+package authorizer
+
+import (
+	"context"
+	"time"
+
+	"github.com/influxdata/platform"
+)
+
+var _ platform.TelegrafConfigStore = (*TelegrafConfigService)(nil)
+
+// TelegrafConfigService wraps a platform.TelegrafConfigStore and authorizes actions
+// against it appropriately.
+type TelegrafConfigService struct {
+	platform.UserResourceMappingService
+	s platform.TelegrafConfigStore
+}
+
+// NewTelegrafConfigService constructs an instance of an authorizing telegraf config service.
+func NewTelegrafConfigService(s platform.TelegrafConfigStore) *TelegrafConfigService {
+	return &TelegrafConfigService{
+		UserResourceMappingService: s,
+		s:                          s,
+	}
+}
+
+func authorizeTelegraf(ctx context.Context, a platform.Action, id platform.ID) error {
+	return IsAllowed(ctx, platform.NewResourcePermission(id, a, platform.TelegrafResourceType))
+}
+
+// FindTelegrafConfigByID checks to see if the authorizer on context has read access to the id provided.
+func (s *TelegrafConfigService) FindTelegrafConfigByID(ctx context.Context, id platform.ID) (*platform.TelegrafConfig, error) {
+	if err := authorizeTelegraf(ctx, platform.ReadAction, id); err != nil {
+		return nil, err
+	}
+
+	return s.s.FindTelegrafConfigByID(ctx, id)
+}
+
+// FindTelegrafConfig retrieves the telegraf config and checks to see if the authorizer on context has read access to it.
+func (s *TelegrafConfigService) FindTelegrafConfig(ctx context.Context, filter platform.UserResourceMappingFilter) (*platform.TelegrafConfig, error) {
+	tc, err := s.s.FindTelegrafConfig(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeTelegraf(ctx, platform.ReadAction, tc.ID); err != nil {
+		return nil, err
+	}
+
+	return tc, nil
+}
+
+// FindTelegrafConfigs retrieves all telegraf configs that match the provided filter and then filters the list down to only the resources that are authorized.
+func (s *TelegrafConfigService) FindTelegrafConfigs(ctx context.Context, filter platform.UserResourceMappingFilter, opt ...platform.FindOptions) ([]*platform.TelegrafConfig, int, error) {
+	tcs, _, err := s.s.FindTelegrafConfigs(ctx, filter, opt...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	configs := tcs[:0]
+	for _, tc := range tcs {
+		if authorizeTelegraf(ctx, platform.ReadAction, tc.ID) == nil {
+			configs = append(configs, tc)
+		}
+	}
+
+	return configs, len(configs), nil
+}
+
+// CreateTelegrafConfig checks to see if the authorizer on context has create access to telegraf configs.
+func (s *TelegrafConfigService) CreateTelegrafConfig(ctx context.Context, tc *platform.TelegrafConfig, userID platform.ID, now time.Time) error {
+	if err := IsAllowed(ctx, platform.NewTypePermission(platform.CreateAction, platform.TelegrafResourceType)); err != nil {
+		return err
+	}
+
+	return s.s.CreateTelegrafConfig(ctx, tc, userID, now)
+}
+
+// UpdateTelegrafConfig checks to see if the authorizer on context has write access to the telegraf config provided.
+func (s *TelegrafConfigService) UpdateTelegrafConfig(ctx context.Context, id platform.ID, tc *platform.TelegrafConfig, userID platform.ID, now time.Time) (*platform.TelegrafConfig, error) {
+	if err := authorizeTelegraf(ctx, platform.WriteAction, id); err != nil {
+		return nil, err
+	}
+
+	return s.s.UpdateTelegrafConfig(ctx, id, tc, userID, now)
+}
+
+// DeleteTelegrafConfig checks to see if the authorizer on context has delete access to the telegraf config provided.
+func (s *TelegrafConfigService) DeleteTelegrafConfig(ctx context.Context, id platform.ID) error {
+	if err := authorizeTelegraf(ctx, platform.DeleteAction, id); err != nil {
+		return err
+	}
+
+	return s.s.DeleteTelegrafConfig(ctx, id)
+}
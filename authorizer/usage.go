@@ -0,0 +1,45 @@
+package authorizer
+
+import (
+	"context"
+
+	"github.com/influxdata/platform"
+)
+
+var _ platform.UsageService = (*UsageService)(nil)
+
+// UsageService wraps a platform.UsageService and authorizes actions
+// against it appropriately.
+type UsageService struct {
+	s platform.UsageService
+}
+
+// NewUsageService constructs an instance of an authorizing usage service.
+func NewUsageService(s platform.UsageService) *UsageService {
+	return &UsageService{
+		s: s,
+	}
+}
+
+// GetUsage checks to see if the authorizer on context has read access to the
+// bucket or organization of the filter. Usage across all organizations
+// requires read access to every organization.
+func (s *UsageService) GetUsage(ctx context.Context, filter platform.UsageFilter) (map[platform.UsageMetric]*platform.Usage, error) {
+	var p platform.Permission
+	switch {
+	case filter.BucketID != nil && filter.OrgID != nil:
+		p = platform.NewPermissionAtID(*filter.BucketID, platform.ReadAction, platform.BucketResourceType, *filter.OrgID)
+	case filter.BucketID != nil:
+		p = platform.NewResourcePermission(*filter.BucketID, platform.ReadAction, platform.BucketResourceType)
+	case filter.OrgID != nil:
+		p = platform.NewPermissionAtID(*filter.OrgID, platform.ReadAction, platform.OrgResourceType, *filter.OrgID)
+	default:
+		p = platform.NewTypePermission(platform.ReadAction, platform.OrgResourceType)
+	}
+
+	if err := IsAllowed(ctx, p); err != nil {
+		return nil, err
+	}
+
+	return s.s.GetUsage(ctx, filter)
+}
@@ -0,0 +1,108 @@
+package authorizer
+
+import (
+	"context"
+
+	"github.com/influxdata/platform"
+	platcontext "github.com/influxdata/platform/context"
+)
+
+var _ platform.UserService = (*UserService)(nil)
+
+// UserService wraps a platform.UserService and authorizes actions
+// against it appropriately.
+type UserService struct {
+	s platform.UserService
+}
+
+// NewUserService constructs an instance of an authorizing user service.
+func NewUserService(s platform.UserService) *UserService {
+	return &UserService{
+		s: s,
+	}
+}
+
+// authorizeUser allows the user of the authorizer on context to act on itself,
+// and otherwise checks the authorizer against the user with the provided id.
+func authorizeUser(ctx context.Context, a platform.Action, id platform.ID) error {
+	auth, err := platcontext.GetAuthorizer(ctx)
+	if err != nil {
+		return &platform.Error{
+			Code: platform.EInternal,
+			Err:  err,
+		}
+	}
+
+	if userID := auth.GetUserID(); userID.Valid() && userID == id {
+		return nil
+	}
+
+	return IsAllowed(ctx, platform.NewResourcePermission(id, a, platform.UserResourceType))
+}
+
+// FindUserByID checks to see if the authorizer on context has read access to the id provided.
+func (s *UserService) FindUserByID(ctx context.Context, id platform.ID) (*platform.User, error) {
+	if err := authorizeUser(ctx, platform.ReadAction, id); err != nil {
+		return nil, err
+	}
+
+	return s.s.FindUserByID(ctx, id)
+}
+
+// FindUser retrieves the user and checks to see if the authorizer on context has read access to it.
+func (s *UserService) FindUser(ctx context.Context, filter platform.UserFilter) (*platform.User, error) {
+	u, err := s.s.FindUser(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeUser(ctx, platform.ReadAction, u.ID); err != nil {
+		return nil, err
+	}
+
+	return u, nil
+}
+
+// FindUsers retrieves all users that match the provided filter and then filters the list down to only the resources that are authorized.
+func (s *UserService) FindUsers(ctx context.Context, filter platform.UserFilter, opt ...platform.FindOptions) ([]*platform.User, int, error) {
+	us, _, err := s.s.FindUsers(ctx, filter, opt...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	users := us[:0]
+	for _, u := range us {
+		if authorizeUser(ctx, platform.ReadAction, u.ID) == nil {
+			users = append(users, u)
+		}
+	}
+
+	return users, len(users), nil
+}
+
+// CreateUser checks to see if the authorizer on context has create access to users.
+func (s *UserService) CreateUser(ctx context.Context, u *platform.User) error {
+	if err := IsAllowed(ctx, platform.CreateUserPermission); err != nil {
+		return err
+	}
+
+	return s.s.CreateUser(ctx, u)
+}
+
+// UpdateUser checks to see if the authorizer on context has write access to the user provided.
+func (s *UserService) UpdateUser(ctx context.Context, id platform.ID, upd platform.UserUpdate) (*platform.User, error) {
+	if err := authorizeUser(ctx, platform.WriteAction, id); err != nil {
+		return nil, err
+	}
+
+	return s.s.UpdateUser(ctx, id, upd)
+}
+
+// DeleteUser checks to see if the authorizer on context has delete access to users.
+func (s *UserService) DeleteUser(ctx context.Context, id platform.ID) error {
+	if err := IsAllowed(ctx, platform.DeleteUserPermission); err != nil {
+		return err
+	}
+
+	return s.s.DeleteUser(ctx, id)
+}
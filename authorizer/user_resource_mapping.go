@@ -0,0 +1,95 @@
+package authorizer
+
+import (
+	"context"
+
+	"github.com/influxdata/platform"
+)
+
+var _ platform.UserResourceMappingService = (*UserResourceMappingService)(nil)
+
+// UserResourceMappingService wraps a platform.UserResourceMappingService and authorizes actions
+// against it appropriately.
+type UserResourceMappingService struct {
+	s       platform.UserResourceMappingService
+	buckets platform.BucketService
+	tasks   platform.TaskService
+}
+
+// NewUserResourceMappingService constructs an instance of an authorizing user resource mapping service.
+// The bucket and task services resolve the organization of mapped buckets and tasks.
+func NewUserResourceMappingService(s platform.UserResourceMappingService, buckets platform.BucketService, tasks platform.TaskService) *UserResourceMappingService {
+	return &UserResourceMappingService{
+		s:       s,
+		buckets: buckets,
+		tasks:   tasks,
+	}
+}
+
+// authorizeResource checks the authorizer on context against the resource a mapping refers to.
+func (s *UserResourceMappingService) authorizeResource(ctx context.Context, a platform.Action, rt platform.ResourceType, id platform.ID) error {
+	switch rt {
+	case platform.OrgResourceType:
+		return authorizeOrg(ctx, a, id)
+	case platform.BucketResourceType:
+		b, err := s.buckets.FindBucketByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		return authorizeBucket(ctx, a, b)
+	case platform.TaskResourceType:
+		t, err := s.tasks.FindTaskByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		return authorizeTask(ctx, a, t)
+	default:
+		return IsAllowed(ctx, platform.NewResourcePermission(id, a, rt))
+	}
+}
+
+// FindUserResourceMappings retrieves all mappings that match the provided filter and then filters the list down to
+// the mappings of resources the authorizer on context has read access to.
+func (s *UserResourceMappingService) FindUserResourceMappings(ctx context.Context, filter platform.UserResourceMappingFilter, opt ...platform.FindOptions) ([]*platform.UserResourceMapping, int, error) {
+	ms, _, err := s.s.FindUserResourceMappings(ctx, filter, opt...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	mappings := ms[:0]
+	for _, m := range ms {
+		if s.authorizeResource(ctx, platform.ReadAction, m.ResourceType, m.ResourceID) == nil {
+			mappings = append(mappings, m)
+		}
+	}
+
+	return mappings, len(mappings), nil
+}
+
+// CreateUserResourceMapping checks to see if the authorizer on context has write access to the mapped resource.
+func (s *UserResourceMappingService) CreateUserResourceMapping(ctx context.Context, m *platform.UserResourceMapping) error {
+	if err := s.authorizeResource(ctx, platform.WriteAction, m.ResourceType, m.ResourceID); err != nil {
+		return err
+	}
+
+	return s.s.CreateUserResourceMapping(ctx, m)
+}
+
+// DeleteUserResourceMapping checks to see if the authorizer on context has write access to the mapped resource.
+func (s *UserResourceMappingService) DeleteUserResourceMapping(ctx context.Context, resourceID, userID platform.ID) error {
+	ms, _, err := s.s.FindUserResourceMappings(ctx, platform.UserResourceMappingFilter{
+		ResourceID: resourceID,
+		UserID:     userID,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, m := range ms {
+		if err := s.authorizeResource(ctx, platform.WriteAction, m.ResourceType, m.ResourceID); err != nil {
+			return err
+		}
+	}
+
+	return s.s.DeleteUserResourceMapping(ctx, resourceID, userID)
+}
@@ -0,0 +1,66 @@
+package authorizer_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/authorizer"
+	"github.com/influxdata/platform/inmem"
+)
+
+func TestUserResourceMappingService_OrgOwner(t *testing.T) {
+	const userID = platform.ID(100)
+
+	svc := inmem.NewService()
+	s := authorizer.NewUserResourceMappingService(svc, svc, nil)
+	if err := svc.CreateUserResourceMapping(context.Background(), &platform.UserResourceMapping{
+		ResourceID:   orgOneID,
+		ResourceType: platform.OrgResourceType,
+		UserID:       userID,
+		UserType:     platform.Member,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	owner := &platform.UserResourceMapping{
+		ResourceID:   orgOneID,
+		ResourceType: platform.OrgResourceType,
+		UserID:       userID + 1,
+		UserType:     platform.Owner,
+	}
+
+	// A member of the organization cannot make anyone an owner of it,
+	// nor remove the mappings of others.
+	ctx := withPermissions(platform.MemberPermissions(orgOneID)...)
+	if err := s.CreateUserResourceMapping(ctx, owner); platform.ErrorCode(err) != platform.EForbidden {
+		t.Errorf("unexpected error creating an owner as a member: got %v, want %q", err, platform.EForbidden)
+	}
+	if err := s.DeleteUserResourceMapping(ctx, orgOneID, userID); platform.ErrorCode(err) != platform.EForbidden {
+		t.Errorf("unexpected error deleting a member as a member: got %v, want %q", err, platform.EForbidden)
+	}
+	ms, _, err := s.FindUserResourceMappings(ctx, platform.UserResourceMappingFilter{ResourceID: orgOneID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ms) != 1 {
+		t.Errorf("unexpected number of mappings listed as a member: got %d, want 1", len(ms))
+	}
+
+	// Members of another organization cannot see its mappings.
+	ctx = withPermissions(platform.MemberPermissions(orgTwoID)...)
+	ms, _, err = s.FindUserResourceMappings(ctx, platform.UserResourceMappingFilter{ResourceID: orgOneID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ms) != 0 {
+		t.Errorf("unexpected number of mappings listed from another organization: got %d, want 0", len(ms))
+	}
+
+	ctx = withPermissions(platform.OwnerPermissions(orgOneID)...)
+	if err := s.CreateUserResourceMapping(ctx, owner); err != nil {
+		t.Errorf("unexpected error creating an owner as an owner: %v", err)
+	}
+	if err := s.DeleteUserResourceMapping(ctx, orgOneID, userID); err != nil {
+		t.Errorf("unexpected error deleting a member as an owner: %v", err)
+	}
+}
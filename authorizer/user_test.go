@@ -0,0 +1,60 @@
+package authorizer_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/authorizer"
+	platcontext "github.com/influxdata/platform/context"
+	"github.com/influxdata/platform/inmem"
+)
+
+func TestUserService(t *testing.T) {
+	svc := inmem.NewService()
+	self := &platform.User{Name: "self"}
+	other := &platform.User{Name: "other"}
+	for _, u := range []*platform.User{self, other} {
+		if err := svc.CreateUser(context.Background(), u); err != nil {
+			t.Fatal(err)
+		}
+	}
+	s := authorizer.NewUserService(svc)
+
+	ctx := platcontext.SetAuthorizer(context.Background(), &platform.Authorization{
+		Status:      platform.Active,
+		UserID:      self.ID,
+		Permissions: platform.OwnerPermissions(orgOneID),
+	})
+
+	if _, err := s.FindUserByID(ctx, self.ID); err != nil {
+		t.Errorf("unexpected error finding self: %v", err)
+	}
+	if _, err := s.FindUserByID(ctx, other.ID); platform.ErrorCode(err) != platform.EForbidden {
+		t.Errorf("unexpected error finding another user: got %v, want %q", err, platform.EForbidden)
+	}
+	if us, _, err := s.FindUsers(ctx, platform.UserFilter{}); err != nil {
+		t.Fatal(err)
+	} else if len(us) != 1 || us[0].ID != self.ID {
+		t.Errorf("unexpected users: %v", us)
+	}
+
+	name := "renamed"
+	if _, err := s.UpdateUser(ctx, self.ID, platform.UserUpdate{Name: &name}); err != nil {
+		t.Errorf("unexpected error updating self: %v", err)
+	}
+	if _, err := s.UpdateUser(ctx, other.ID, platform.UserUpdate{Name: &name}); platform.ErrorCode(err) != platform.EForbidden {
+		t.Errorf("unexpected error updating another user: got %v, want %q", err, platform.EForbidden)
+	}
+	if err := s.CreateUser(ctx, &platform.User{Name: "new"}); platform.ErrorCode(err) != platform.EForbidden {
+		t.Errorf("unexpected error creating a user: got %v, want %q", err, platform.EForbidden)
+	}
+	if err := s.DeleteUser(ctx, other.ID); platform.ErrorCode(err) != platform.EForbidden {
+		t.Errorf("unexpected error deleting a user: got %v, want %q", err, platform.EForbidden)
+	}
+
+	ctx = withPermissions(platform.OperPermissions()...)
+	if err := s.DeleteUser(ctx, other.ID); err != nil {
+		t.Errorf("unexpected error deleting a user as an operator: %v", err)
+	}
+}
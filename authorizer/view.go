@@ -0,0 +1,107 @@
+package authorizer
+
+import (
+	"context"
+
+	"github.com/influxdata/platform"
+)
+
+var _ platform.ViewService = (*ViewService)(nil)
+
+// ViewService wraps a platform.ViewService and authorizes actions
+// against it appropriately. The views of the cells of a dashboard are also
+// authorized by access to the dashboard.
+type ViewService struct {
+	s          platform.ViewService
+	dashboards platform.DashboardService
+	mappings   platform.UserResourceMappingService
+}
+
+// NewViewService constructs an instance of an authorizing view service.
+// Views of cells are looked up in ds, and the creators of views are mapped as
+// their owners in ms.
+func NewViewService(s platform.ViewService, ds platform.DashboardService, ms platform.UserResourceMappingService) *ViewService {
+	return &ViewService{
+		s:          s,
+		dashboards: ds,
+		mappings:   ms,
+	}
+}
+
+// authorizeView checks access to the view, or else to a dashboard with a cell of the view.
+func (s *ViewService) authorizeView(ctx context.Context, a platform.Action, id platform.ID) error {
+	err := IsAllowed(ctx, platform.NewResourcePermission(id, a, platform.ViewResourceType))
+	if err == nil || platform.ErrorCode(err) != platform.EForbidden {
+		return err
+	}
+
+	ds, _, derr := s.dashboards.FindDashboards(ctx, platform.DashboardFilter{}, platform.DefaultDashboardFindOptions)
+	if derr != nil {
+		return derr
+	}
+	for _, d := range ds {
+		for _, c := range d.Cells {
+			if c.ViewID == id && authorizeDashboard(ctx, a, d.ID) == nil {
+				return nil
+			}
+		}
+	}
+	return err
+}
+
+// FindViewByID checks to see if the authorizer on context has read access to the id provided.
+func (s *ViewService) FindViewByID(ctx context.Context, id platform.ID) (*platform.View, error) {
+	if err := s.authorizeView(ctx, platform.ReadAction, id); err != nil {
+		return nil, err
+	}
+
+	return s.s.FindViewByID(ctx, id)
+}
+
+// FindViews retrieves all views that match the provided filter and then filters the list down to only the resources that are authorized.
+func (s *ViewService) FindViews(ctx context.Context, filter platform.ViewFilter) ([]*platform.View, int, error) {
+	vs, _, err := s.s.FindViews(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	views := vs[:0]
+	for _, v := range vs {
+		if s.authorizeView(ctx, platform.ReadAction, v.ID) == nil {
+			views = append(views, v)
+		}
+	}
+
+	return views, len(views), nil
+}
+
+// CreateView checks to see if the authorizer on context has create access to views.
+func (s *ViewService) CreateView(ctx context.Context, v *platform.View) error {
+	if err := IsAllowed(ctx, platform.NewTypePermission(platform.CreateAction, platform.ViewResourceType)); err != nil {
+		return err
+	}
+
+	if err := s.s.CreateView(ctx, v); err != nil {
+		return err
+	}
+
+	return grantOwnership(ctx, s.mappings, platform.ViewResourceType, v.ID)
+}
+
+// UpdateView checks to see if the authorizer on context has write access to the view provided.
+func (s *ViewService) UpdateView(ctx context.Context, id platform.ID, upd platform.ViewUpdate) (*platform.View, error) {
+	if err := s.authorizeView(ctx, platform.WriteAction, id); err != nil {
+		return nil, err
+	}
+
+	return s.s.UpdateView(ctx, id, upd)
+}
+
+// DeleteView checks to see if the authorizer on context has delete access to the view provided.
+func (s *ViewService) DeleteView(ctx context.Context, id platform.ID) error {
+	if err := s.authorizeView(ctx, platform.DeleteAction, id); err != nil {
+		return err
+	}
+
+	return s.s.DeleteView(ctx, id)
+}
@@ -1,8 +1,10 @@
 package platform
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 )
 
 var (
@@ -27,55 +29,352 @@ type Authorizer interface {
 
 func allowed(p Permission, ps []Permission) bool {
 	for _, perm := range ps {
-		if perm.Action == p.Action && perm.Resource == p.Resource {
+		if perm.Matches(p) {
 			return true
 		}
 	}
 	return false
 }
 
-type action string
+// Action is an operation a permission grants on a resource.
+type Action string
 
 const (
 	// ReadAction is the action for reading.
-	ReadAction action = "read"
+	ReadAction Action = "read"
 	// WriteAction is the action for writing.
-	WriteAction action = "write"
+	WriteAction Action = "write"
 	// CreateAction is the action for creating new resources.
-	CreateAction action = "create"
+	CreateAction Action = "create"
 	// DeleteAction is the action for deleting an existing resource.
-	DeleteAction action = "delete"
+	DeleteAction Action = "delete"
 )
 
-type resource string
+var actions = []Action{
+	ReadAction,
+	WriteAction,
+	CreateAction,
+	DeleteAction,
+}
+
+// Valid checks if the action is a member of the action enum.
+func (a Action) Valid() error {
+	for _, b := range actions {
+		if a == b {
+			return nil
+		}
+	}
+	return &Error{
+		Code: EInvalid,
+		Msg:  fmt.Sprintf("unknown action %q", string(a)),
+	}
+}
 
+// Resource types that permissions can be granted on, in addition to the user resource mapping types.
 const (
-	// UserResource represents the user resource actions can apply to.
-	UserResource = resource("user")
-	// OrganizationResource represents the org resource actions can apply to.
-	OrganizationResource = resource("org")
+	SourceResourceType  ResourceType = "source"
+	ScraperResourceType ResourceType = "scraper"
+	MacroResourceType   ResourceType = "macro"
+	SecretResourceType  ResourceType = "secret"
+	LabelResourceType   ResourceType = "label"
 )
 
+// AllResourceTypes is the list of all resource types permissions can be granted on.
+var AllResourceTypes = []ResourceType{
+	UserResourceType,
+	OrgResourceType,
+	BucketResourceType,
+	DashboardResourceType,
+	TaskResourceType,
+	SourceResourceType,
+	TelegrafResourceType,
+	ScraperResourceType,
+	MacroResourceType,
+	SecretResourceType,
+	LabelResourceType,
+	ViewResourceType,
+	TokenResourceType,
+	AuditResourceType,
+}
+
+// Resource is the set of resources a permission applies to.
+// A nil ID applies the permission to all resources of the type, and a nil OrgID
+// applies it across all organizations.
+type Resource struct {
+	Type  ResourceType `json:"type"`
+	ID    *ID          `json:"id,omitempty"`
+	OrgID *ID          `json:"orgID,omitempty"`
+}
+
+// Valid checks that the resource type is known and that any IDs are valid.
+func (r Resource) Valid() error {
+	known := false
+	for _, t := range AllResourceTypes {
+		if r.Type == t {
+			known = true
+			break
+		}
+	}
+	if !known {
+		return &Error{
+			Code: EInvalid,
+			Msg:  fmt.Sprintf("unknown resource type %q", string(r.Type)),
+		}
+	}
+	if r.ID != nil && !r.ID.Valid() {
+		return &Error{
+			Code: EInvalid,
+			Msg:  "resource id is invalid",
+		}
+	}
+	if r.OrgID != nil && !r.OrgID.Valid() {
+		return &Error{
+			Code: EInvalid,
+			Msg:  "resource orgID is invalid",
+		}
+	}
+	// Resources of other types are required without an organization, so an
+	// org scoped grant on them would never match.
+	if r.OrgID != nil && !r.Type.orgScoped() && r.Type != OrgResourceType {
+		return &Error{
+			Code: EInvalid,
+			Msg:  fmt.Sprintf("%s resources do not belong to an organization", r.Type),
+		}
+	}
+	return nil
+}
+
+// String renders the resource as [org/<orgID>/]<type>[/<id>].
+func (r Resource) String() string {
+	s := string(r.Type)
+	if r.OrgID != nil {
+		s = fmt.Sprintf("org/%s/%s", r.OrgID, s)
+	}
+	if r.ID != nil {
+		s = fmt.Sprintf("%s/%s", s, r.ID)
+	}
+	return s
+}
+
+// UnmarshalJSON decodes a resource object, and also accepts the
+// string form stored by authorizations created before resources were structured.
+func (r *Resource) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		res, err := parseResource(s)
+		if err != nil {
+			return err
+		}
+		*r = res
+		return nil
+	}
+
+	type resource Resource
+	var res resource
+	if err := json.Unmarshal(b, &res); err != nil {
+		return err
+	}
+	*r = Resource(res)
+	return nil
+}
+
+// parseResource parses a resource in the form produced by Resource.String.
+func parseResource(s string) (Resource, error) {
+	var r Resource
+	parts := strings.Split(s, "/")
+	if len(parts) >= 3 && parts[0] == string(OrgResourceType) {
+		var orgID ID
+		if err := orgID.DecodeFromString(parts[1]); err != nil {
+			return r, fmt.Errorf("invalid resource %q: %v", s, err)
+		}
+		r.OrgID = &orgID
+		parts = parts[2:]
+	}
+
+	switch len(parts) {
+	case 1:
+	case 2:
+		var id ID
+		if err := id.DecodeFromString(parts[1]); err != nil {
+			return r, fmt.Errorf("invalid resource %q: %v", s, err)
+		}
+		r.ID = &id
+	default:
+		return r, fmt.Errorf("invalid resource %q", s)
+	}
+	r.Type = ResourceType(parts[0])
+	return r, nil
+}
+
+// orgScoped reports whether resources of the type belong to an organization.
+func (t ResourceType) orgScoped() bool {
+	switch t {
+	case BucketResourceType, TaskResourceType, SourceResourceType, ScraperResourceType, SecretResourceType, AuditResourceType:
+		return true
+	}
+	return false
+}
+
+// UserResource represents the user resource actions can apply to.
+var UserResource = Resource{Type: UserResourceType}
+
+// OrganizationResource represents the org resource actions can apply to.
+var OrganizationResource = Resource{Type: OrgResourceType}
+
 // TaskResource represents the task resource scoped to an organization.
-func TaskResource(orgID ID) resource {
-	return resource(fmt.Sprintf("org/%s/task", orgID))
+func TaskResource(orgID ID) Resource {
+	return Resource{Type: TaskResourceType, OrgID: &orgID}
 }
 
 // BucketResource constructs a bucket resource.
-func BucketResource(id ID) resource {
-	return resource(fmt.Sprintf("bucket/%s", id))
+func BucketResource(id ID) Resource {
+	return Resource{Type: BucketResourceType, ID: &id}
 }
 
 // Permission defines an action and a resource.
 type Permission struct {
-	Action   action   `json:"action"`
-	Resource resource `json:"resource"`
+	Action   Action   `json:"action"`
+	Resource Resource `json:"resource"`
 }
 
 func (p Permission) String() string {
 	return fmt.Sprintf("%s:%s", p.Action, p.Resource)
 }
 
+// Valid checks that the action and the resource are valid.
+func (p Permission) Valid() error {
+	if err := p.Action.Valid(); err != nil {
+		return err
+	}
+	return p.Resource.Valid()
+}
+
+// Matches returns true if holding p grants the required permission.
+// An unset ID or OrgID on p matches any ID or organization. An org scoped
+// grant only matches permissions required in the same organization, so it
+// never matches resources of types that do not belong to an organization.
+func (p Permission) Matches(required Permission) bool {
+	if p.Action != required.Action || p.Resource.Type != required.Resource.Type {
+		return false
+	}
+
+	if p.Resource.ID != nil {
+		if required.Resource.ID == nil || *p.Resource.ID != *required.Resource.ID {
+			return false
+		}
+	}
+
+	if p.Resource.OrgID != nil {
+		if required.Resource.OrgID == nil || *p.Resource.OrgID != *required.Resource.OrgID {
+			return false
+		}
+	}
+
+	return true
+}
+
+// NewPermission returns a permission for the action on all resources of the type in the organization.
+func NewPermission(a Action, rt ResourceType, orgID ID) Permission {
+	return Permission{
+		Action: a,
+		Resource: Resource{
+			Type:  rt,
+			OrgID: &orgID,
+		},
+	}
+}
+
+// NewPermissionAtID returns a permission for the action on a single resource in the organization.
+func NewPermissionAtID(id ID, a Action, rt ResourceType, orgID ID) Permission {
+	p := NewPermission(a, rt, orgID)
+	p.Resource.ID = &id
+	return p
+}
+
+// NewResourcePermission returns a permission for the action on a single resource
+// of a type that does not belong to an organization.
+func NewResourcePermission(id ID, a Action, rt ResourceType) Permission {
+	return Permission{
+		Action: a,
+		Resource: Resource{
+			Type: rt,
+			ID:   &id,
+		},
+	}
+}
+
+// NewTypePermission returns a permission for the action on all resources of the type.
+func NewTypePermission(a Action, rt ResourceType) Permission {
+	return Permission{
+		Action:   a,
+		Resource: Resource{Type: rt},
+	}
+}
+
+// OperPermissions returns every action on every resource type across all organizations.
+func OperPermissions() []Permission {
+	ps := []Permission{}
+	for _, rt := range AllResourceTypes {
+		for _, a := range actions {
+			ps = append(ps, NewTypePermission(a, rt))
+		}
+	}
+	return ps
+}
+
+// unscopedOwnerResourceTypes are the resource types that do not belong to an
+// organization that the owners of an organization may create. Access to
+// existing resources of these types is granted by their own user resource
+// mappings.
+var unscopedOwnerResourceTypes = []ResourceType{
+	DashboardResourceType,
+	TelegrafResourceType,
+	MacroResourceType,
+	ViewResourceType,
+	TokenResourceType,
+}
+
+// labelOwnerActions are the actions the owners of an organization may take on
+// labels. Labels do not belong to an organization, and are only ever
+// authorized on all labels at once.
+var labelOwnerActions = []Action{ReadAction, CreateAction, DeleteAction}
+
+// OwnerPermissions returns every action on every resource type that belongs
+// to an organization within the organization, the creation of resources of
+// the types that do not, and the management of labels.
+func OwnerPermissions(orgID ID) []Permission {
+	ps := []Permission{}
+	for _, rt := range AllResourceTypes {
+		if !rt.orgScoped() {
+			continue
+		}
+		for _, a := range actions {
+			ps = append(ps, NewPermission(a, rt, orgID))
+		}
+	}
+	for _, rt := range unscopedOwnerResourceTypes {
+		ps = append(ps, NewTypePermission(CreateAction, rt))
+	}
+	for _, a := range labelOwnerActions {
+		ps = append(ps, NewTypePermission(a, LabelResourceType))
+	}
+	return append(ps, NewPermissionAtID(orgID, ReadAction, OrgResourceType, orgID), NewPermissionAtID(orgID, WriteAction, OrgResourceType, orgID))
+}
+
+// MemberPermissions returns read access to every resource type that belongs
+// to an organization within the organization, and to labels.
+func MemberPermissions(orgID ID) []Permission {
+	ps := []Permission{}
+	for _, rt := range AllResourceTypes {
+		if !rt.orgScoped() {
+			continue
+		}
+		ps = append(ps, NewPermission(ReadAction, rt, orgID))
+	}
+	ps = append(ps, NewTypePermission(ReadAction, LabelResourceType))
+	return append(ps, NewPermissionAtID(orgID, ReadAction, OrgResourceType, orgID))
+}
+
 var (
 	// CreateUserPermission is a permission for creating users.
 	CreateUserPermission = Permission{
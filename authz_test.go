@@ -0,0 +1,263 @@
+package platform_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/platform"
+	platformtesting "github.com/influxdata/platform/testing"
+)
+
+func TestPermission_Matches(t *testing.T) {
+	orgOne := platformtesting.MustIDBase16("020f755c3c082000")
+	orgTwo := platformtesting.MustIDBase16("020f755c3c082001")
+	bucketOne := platformtesting.MustIDBase16("020f755c3c082002")
+	bucketTwo := platformtesting.MustIDBase16("020f755c3c082003")
+	dashboard := platformtesting.MustIDBase16("020f755c3c082004")
+
+	tests := []struct {
+		name     string
+		granted  platform.Permission
+		required platform.Permission
+		want     bool
+	}{
+		{
+			name:     "all buckets in all orgs",
+			granted:  platform.NewTypePermission(platform.ReadAction, platform.BucketResourceType),
+			required: platform.NewPermissionAtID(bucketOne, platform.ReadAction, platform.BucketResourceType, orgOne),
+			want:     true,
+		},
+		{
+			name:     "all buckets in the org",
+			granted:  platform.NewPermission(platform.ReadAction, platform.BucketResourceType, orgOne),
+			required: platform.NewPermissionAtID(bucketOne, platform.ReadAction, platform.BucketResourceType, orgOne),
+			want:     true,
+		},
+		{
+			name:     "all buckets in another org",
+			granted:  platform.NewPermission(platform.ReadAction, platform.BucketResourceType, orgTwo),
+			required: platform.NewPermissionAtID(bucketOne, platform.ReadAction, platform.BucketResourceType, orgOne),
+		},
+		{
+			name:     "org scoped grant requires the org to be known",
+			granted:  platform.NewPermission(platform.ReadAction, platform.BucketResourceType, orgOne),
+			required: platform.ReadBucketPermission(bucketOne),
+		},
+		{
+			name:     "single bucket",
+			granted:  platform.ReadBucketPermission(bucketOne),
+			required: platform.NewPermissionAtID(bucketOne, platform.ReadAction, platform.BucketResourceType, orgOne),
+			want:     true,
+		},
+		{
+			name:     "different bucket",
+			granted:  platform.ReadBucketPermission(bucketTwo),
+			required: platform.NewPermissionAtID(bucketOne, platform.ReadAction, platform.BucketResourceType, orgOne),
+		},
+		{
+			name:     "single bucket does not grant creation",
+			granted:  platform.ReadBucketPermission(bucketOne),
+			required: platform.NewPermission(platform.ReadAction, platform.BucketResourceType, orgOne),
+		},
+		{
+			name:     "different action",
+			granted:  platform.NewPermission(platform.ReadAction, platform.BucketResourceType, orgOne),
+			required: platform.NewPermissionAtID(bucketOne, platform.WriteAction, platform.BucketResourceType, orgOne),
+		},
+		{
+			name:     "different type",
+			granted:  platform.NewPermission(platform.ReadAction, platform.BucketResourceType, orgOne),
+			required: platform.NewPermissionAtID(bucketOne, platform.ReadAction, platform.TaskResourceType, orgOne),
+		},
+		{
+			name:     "org scoped grant on a type without organizations",
+			granted:  platform.NewPermission(platform.WriteAction, platform.DashboardResourceType, orgOne),
+			required: platform.NewResourcePermission(dashboard, platform.WriteAction, platform.DashboardResourceType),
+		},
+		{
+			name:     "grant on a single resource of a type without organizations",
+			granted:  platform.NewResourcePermission(dashboard, platform.WriteAction, platform.DashboardResourceType),
+			required: platform.NewResourcePermission(dashboard, platform.WriteAction, platform.DashboardResourceType),
+			want:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.granted.Matches(tt.required); got != tt.want {
+				t.Errorf("%s.Matches(%s) = %v, want %v", tt.granted, tt.required, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOwnerPermissions(t *testing.T) {
+	orgOne := platformtesting.MustIDBase16("020f755c3c082000")
+	orgTwo := platformtesting.MustIDBase16("020f755c3c082001")
+	dashboard := platformtesting.MustIDBase16("020f755c3c082004")
+
+	a := &platform.Authorization{Status: platform.Active, Permissions: platform.OwnerPermissions(orgOne)}
+	tests := []struct {
+		name     string
+		required platform.Permission
+		want     bool
+	}{
+		{
+			name:     "buckets of the org",
+			required: platform.NewPermission(platform.WriteAction, platform.BucketResourceType, orgOne),
+			want:     true,
+		},
+		{
+			name:     "buckets of another org",
+			required: platform.NewPermission(platform.WriteAction, platform.BucketResourceType, orgTwo),
+		},
+		{
+			name:     "existing dashboard",
+			required: platform.NewResourcePermission(dashboard, platform.ReadAction, platform.DashboardResourceType),
+		},
+		{
+			name:     "dashboard creation",
+			required: platform.NewTypePermission(platform.CreateAction, platform.DashboardResourceType),
+			want:     true,
+		},
+		{
+			name:     "label deletion",
+			required: platform.NewTypePermission(platform.DeleteAction, platform.LabelResourceType),
+			want:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := a.Allowed(tt.required); got != tt.want {
+				t.Errorf("Allowed(%s) = %v, want %v", tt.required, got, tt.want)
+			}
+		})
+	}
+
+	m := &platform.Authorization{Status: platform.Active, Permissions: platform.MemberPermissions(orgOne)}
+	if m.Allowed(platform.NewResourcePermission(dashboard, platform.ReadAction, platform.DashboardResourceType)) {
+		t.Error("org members are allowed to read any dashboard")
+	}
+}
+
+func TestPermission_Valid(t *testing.T) {
+	orgID := platformtesting.MustIDBase16("020f755c3c082000")
+	invalidID := platform.ID(0)
+
+	tests := []struct {
+		name    string
+		p       platform.Permission
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			p:    platform.NewPermission(platform.ReadAction, platform.BucketResourceType, orgID),
+		},
+		{
+			name:    "unknown action",
+			p:       platform.NewPermission("exec", platform.BucketResourceType, orgID),
+			wantErr: true,
+		},
+		{
+			name:    "unknown resource type",
+			p:       platform.NewPermission(platform.ReadAction, "widgets", orgID),
+			wantErr: true,
+		},
+		{
+			name:    "org scoped dashboards",
+			p:       platform.NewPermission(platform.ReadAction, platform.DashboardResourceType, orgID),
+			wantErr: true,
+		},
+		{
+			name:    "org scoped labels",
+			p:       platform.NewPermission(platform.ReadAction, platform.LabelResourceType, orgID),
+			wantErr: true,
+		},
+		{
+			name: "org of the organization",
+			p:    platform.NewPermissionAtID(orgID, platform.ReadAction, platform.OrgResourceType, orgID),
+		},
+		{
+			name: "invalid id",
+			p: platform.Permission{
+				Action:   platform.ReadAction,
+				Resource: platform.Resource{Type: platform.BucketResourceType, ID: &invalidID},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.p.Valid(); (err != nil) != tt.wantErr {
+				t.Errorf("Valid() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestResource_UnmarshalJSON(t *testing.T) {
+	orgID := platformtesting.MustIDBase16("020f755c3c082000")
+	id := platformtesting.MustIDBase16("020f755c3c082001")
+
+	tests := []struct {
+		name    string
+		json    string
+		want    platform.Resource
+		wantErr bool
+	}{
+		{
+			name: "object",
+			json: `{"type":"bucket","id":"020f755c3c082001","orgID":"020f755c3c082000"}`,
+			want: platform.Resource{Type: platform.BucketResourceType, ID: &id, OrgID: &orgID},
+		},
+		{
+			name: "legacy type",
+			json: `"user"`,
+			want: platform.UserResource,
+		},
+		{
+			name: "legacy id",
+			json: `"bucket/020f755c3c082001"`,
+			want: platform.BucketResource(id),
+		},
+		{
+			name: "legacy org scope",
+			json: `"org/020f755c3c082000/task"`,
+			want: platform.TaskResource(orgID),
+		},
+		{
+			name:    "legacy invalid id",
+			json:    `"bucket/nope"`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var r platform.Resource
+			err := json.Unmarshal([]byte(tt.json), &r)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Unmarshal() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if !cmp.Equal(r, tt.want) {
+				t.Errorf("Unmarshal() -got/+want\n%s", cmp.Diff(r, tt.want))
+			}
+			b, err := json.Marshal(r)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var rt platform.Resource
+			if err := json.Unmarshal(b, &rt); err != nil {
+				t.Fatal(err)
+			}
+			if !cmp.Equal(rt, tt.want) {
+				t.Errorf("round trip -got/+want\n%s", cmp.Diff(rt, tt.want))
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package platform
+
+import (
+	"context"
+	"io"
+)
+
+// ops for backup error.
+var (
+	OpBackup = "Backup"
+)
+
+// BackupService streams snapshots of a running instance.
+type BackupService interface {
+	// Backup writes a tar archive of a consistent snapshot of the metadata
+	// store and of the storage engine data described by the filter to w.
+	Backup(ctx context.Context, w io.Writer, filter BackupFilter) error
+}
+
+// BackupFilter describes the storage engine data written by a BackupService.
+// An empty filter backs up the data of every bucket, and a filter with only
+// an organization the data of each of its buckets.
+type BackupFilter struct {
+	OrganizationID *ID `json:"orgID,omitempty"`
+	BucketID       *ID `json:"bucketID,omitempty"`
+}
+
+// Valid returns an error if the filter does not describe an organization or a bucket.
+func (f BackupFilter) Valid() error {
+	if f.OrganizationID != nil && !f.OrganizationID.Valid() {
+		return &Error{
+			Code: EInvalid,
+			Op:   OpBackup,
+			Msg:  "organization id is invalid",
+		}
+	}
+	if f.BucketID == nil {
+		return nil
+	}
+	if f.OrganizationID == nil {
+		return &Error{
+			Code: EInvalid,
+			Op:   OpBackup,
+			Msg:  "backup of a bucket requires its organization id",
+		}
+	}
+	if !f.BucketID.Valid() {
+		return &Error{
+			Code: EInvalid,
+			Op:   OpBackup,
+			Msg:  "bucket id is invalid",
+		}
+	}
+	return nil
+}
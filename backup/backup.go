@@ -0,0 +1,210 @@
+// Package backup writes backups of the metadata store and the storage engine
+// of a running influxd as tar archives, and restores data directories from them.
+//
+// Archives hold the bolt file as influxd.bolt, and the files of the storage
+// engine under the engine directory, in the layout of its data directory.
+package backup
+
+import (
+	"archive/tar"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	bolt "github.com/coreos/bbolt"
+	"github.com/influxdata/platform"
+	pbolt "github.com/influxdata/platform/bolt"
+	intar "github.com/influxdata/platform/pkg/tar"
+	"github.com/influxdata/platform/storage"
+	"go.uber.org/zap"
+)
+
+const (
+	// BoltFileName is the name of the bolt file in an archive.
+	BoltFileName = "influxd.bolt"
+
+	// EngineDirectoryName is the directory of the storage engine files in an archive.
+	EngineDirectoryName = "engine"
+)
+
+// Engine is a storage engine that writes its files to an archive.
+type Engine interface {
+	Backup(ctx context.Context, tw *tar.Writer, dir string, filter platform.BackupFilter) error
+}
+
+var _ platform.BackupService = (*Service)(nil)
+
+// Service writes backups of a bolt metadata store and a storage engine.
+type Service struct {
+	DB     *bolt.DB
+	Engine Engine
+	Logger *zap.Logger
+}
+
+// NewService constructs a Service backing up db and engine.
+func NewService(db *bolt.DB, engine Engine, logger *zap.Logger) *Service {
+	return &Service{
+		DB:     db,
+		Engine: engine,
+		Logger: logger,
+	}
+}
+
+// Backup writes an archive of the bolt file and of the storage engine data
+// described by the filter to w. The bolt file is copied in a read-only
+// transaction, before the storage engine is snapshotted, so that it has the
+// buckets of all of the data.
+func (s *Service) Backup(ctx context.Context, w io.Writer, filter platform.BackupFilter) error {
+	if err := filter.Valid(); err != nil {
+		return err
+	}
+
+	log := s.Logger.With(zap.String("op", platform.OpBackup))
+	started := time.Now()
+
+	tw := tar.NewWriter(w)
+	if err := s.DB.View(func(tx *bolt.Tx) error {
+		if err := tw.WriteHeader(&tar.Header{
+			Typeflag: tar.TypeReg,
+			Name:     BoltFileName,
+			Mode:     0600,
+			Size:     tx.Size(),
+			ModTime:  started,
+		}); err != nil {
+			return err
+		}
+		_, err := tx.WriteTo(tw)
+		return err
+	}); err != nil {
+		log.Info("Failed to write bolt file", zap.Error(err))
+		return err
+	}
+
+	if err := s.Engine.Backup(ctx, tw, EngineDirectoryName, filter); err != nil {
+		log.Info("Failed to write storage engine files", zap.Error(err))
+		return err
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+
+	log.Info("Backup written", zap.Duration("duration", time.Since(started)))
+	return nil
+}
+
+// Restorer rebuilds the data directory of influxd from an archive.
+// influxd must not be running.
+type Restorer struct {
+	// BoltPath is the path the bolt file is restored to.
+	BoltPath string
+
+	// EnginePath is the path of the storage engine the files are restored to,
+	// and EngineConfig its configuration.
+	EnginePath   string
+	EngineConfig storage.Config
+
+	Logger *zap.Logger
+}
+
+// NewRestorer constructs a Restorer to the bolt file and storage engine paths.
+func NewRestorer(boltPath, enginePath string) *Restorer {
+	return &Restorer{
+		BoltPath:     boltPath,
+		EnginePath:   enginePath,
+		EngineConfig: storage.NewConfig(),
+		Logger:       zap.NewNop(),
+	}
+}
+
+// Restore extracts the archive read from r. Only the storage engine data
+// described by the filter is kept; when it has a bucket but no organization,
+// the organization of the bucket is found in the restored bolt file.
+//
+// The index and series file of the storage engine are rebuilt when the data
+// is filtered, or when the archive does not have them. Neither the bolt file
+// nor the storage engine may already exist.
+func (r *Restorer) Restore(ctx context.Context, rd io.Reader, filter platform.BackupFilter) error {
+	if err := r.checkPaths(); err != nil {
+		return err
+	}
+
+	var hasBolt, hasIndex bool
+	enginePrefix := EngineDirectoryName + string(filepath.Separator)
+	indexPrefix := filepath.Join(EngineDirectoryName, storage.DefaultIndexDirectoryName) + string(filepath.Separator)
+	if err := intar.Restore(rd, func(name string) string {
+		switch {
+		case name == BoltFileName:
+			hasBolt = true
+			return r.BoltPath
+		case strings.HasPrefix(name, enginePrefix):
+			if strings.HasPrefix(name, indexPrefix) {
+				hasIndex = true
+			}
+			return r.EngineConfig.RestorePath(r.EnginePath, strings.TrimPrefix(name, enginePrefix))
+		default:
+			r.Logger.Info("Skipping unknown file", zap.String("name", name))
+			return ""
+		}
+	}); err != nil {
+		return err
+	}
+
+	if !hasBolt {
+		return &platform.Error{
+			Code: platform.EInvalid,
+			Msg:  "archive has no bolt file",
+		}
+	}
+
+	if filter.BucketID != nil && filter.OrganizationID == nil {
+		b, err := r.findBucket(ctx, *filter.BucketID)
+		if err != nil {
+			return err
+		}
+		filter.OrganizationID = &b.OrganizationID
+	}
+
+	if filter.OrganizationID == nil && hasIndex {
+		return nil
+	}
+	r.Logger.Info("Rebuilding index", zap.String("path", r.EnginePath))
+	return storage.RebuildIndex(r.EnginePath, r.EngineConfig, filter, r.Logger)
+}
+
+// checkPaths returns an error if the bolt file or the storage engine exists.
+func (r *Restorer) checkPaths() error {
+	if _, err := os.Stat(r.BoltPath); err == nil {
+		return fmt.Errorf("bolt file %q already exists", r.BoltPath)
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+
+	fis, err := ioutil.ReadDir(r.EnginePath)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if len(fis) > 0 {
+		return fmt.Errorf("storage engine path %q is not empty", r.EnginePath)
+	}
+	return nil
+}
+
+// findBucket finds the bucket in the restored bolt file. The file is opened
+// read-only, as the keys its secrets are encrypted with are not known.
+func (r *Restorer) findBucket(ctx context.Context, id platform.ID) (*platform.Bucket, error) {
+	c := pbolt.NewClient()
+	c.Path = r.BoltPath
+	c.WithLogger(r.Logger)
+	if err := c.OpenReadOnly(ctx); err != nil {
+		return nil, err
+	}
+	defer c.Close()
+
+	return c.FindBucketByID(ctx, id)
+}
@@ -0,0 +1,83 @@
+package backup_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/backup"
+	"github.com/influxdata/platform/bolt"
+	"go.uber.org/zap"
+)
+
+// emptyEngine is a storage engine without any files.
+type emptyEngine struct{}
+
+func (emptyEngine) Backup(ctx context.Context, tw *tar.Writer, dir string, filter platform.BackupFilter) error {
+	return nil
+}
+
+func TestRestorer_Restore_EncryptedSecrets(t *testing.T) {
+	dir, err := ioutil.TempDir("", "influxdata-platform-backup-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	ctx := context.Background()
+	key := bolt.SecretKey{Version: 1, Key: bytes.Repeat([]byte{1}, bolt.SecretKeySize)}
+
+	c := bolt.NewClient()
+	c.Path = filepath.Join(dir, "source.bolt")
+	if err := c.WithSecretKeys(key); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Open(ctx); err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	org := &platform.Organization{Name: "org"}
+	if err := c.CreateOrganization(ctx, org); err != nil {
+		t.Fatal(err)
+	}
+	b := &platform.Bucket{Name: "bucket", OrganizationID: org.ID}
+	if err := c.CreateBucket(ctx, b); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.PutSecret(ctx, org.ID, "token", "secret"); err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := backup.NewService(c.DB(), emptyEngine{}, zap.NewNop()).Backup(ctx, &buf, platform.BackupFilter{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// The organization of the bucket is found in the restored bolt file,
+	// whose secrets the restorer cannot decrypt.
+	r := backup.NewRestorer(filepath.Join(dir, "restored.bolt"), filepath.Join(dir, "engine"))
+	if err := r.Restore(ctx, &buf, platform.BackupFilter{BucketID: &b.ID}); err != nil {
+		t.Fatal(err)
+	}
+
+	restored := bolt.NewClient()
+	restored.Path = r.BoltPath
+	if err := restored.WithSecretKeys(key); err != nil {
+		t.Fatal(err)
+	}
+	if err := restored.Open(ctx); err != nil {
+		t.Fatal(err)
+	}
+	defer restored.Close()
+	if v, err := restored.LoadSecret(ctx, org.ID, "token"); err != nil {
+		t.Fatal(err)
+	} else if v != "secret" {
+		t.Errorf("unexpected secret: got %q, want %q", v, "secret")
+	}
+}
@@ -0,0 +1,141 @@
+package bolt
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"time"
+
+	bolt "github.com/coreos/bbolt"
+	"github.com/influxdata/platform"
+)
+
+var (
+	auditBucket = []byte("auditv1")
+)
+
+var _ platform.AuditLogService = (*Client)(nil)
+
+func (c *Client) initializeAudit(ctx context.Context, tx *bolt.Tx) error {
+	if _, err := tx.CreateBucketIfNotExists(auditBucket); err != nil {
+		return err
+	}
+	return nil
+}
+
+// encodeAuditKey orders events by time, using the event ID to keep keys unique.
+func encodeAuditKey(e *platform.AuditEvent) ([]byte, error) {
+	id, err := e.ID.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	key := make([]byte, 8, 8+len(id))
+	binary.BigEndian.PutUint64(key, uint64(e.Time.UnixNano()))
+	return append(key, id...), nil
+}
+
+// encodeAuditTime returns the prefix of the keys of the events at time t.
+func encodeAuditTime(t time.Time) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(t.UnixNano()))
+	return key
+}
+
+// RecordAuditEvent stores the event and sets its ID.
+func (c *Client) RecordAuditEvent(ctx context.Context, e *platform.AuditEvent) error {
+	op := getOp(platform.OpRecordAuditEvent)
+	err := c.db.Update(func(tx *bolt.Tx) error {
+		e.ID = c.IDGenerator.ID()
+		if e.Time.IsZero() {
+			e.Time = c.time()
+		}
+
+		key, err := encodeAuditKey(e)
+		if err != nil {
+			return err
+		}
+
+		v, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+
+		return tx.Bucket(auditBucket).Put(key, v)
+	})
+	if err != nil {
+		return &platform.Error{
+			Op:  op,
+			Err: err,
+		}
+	}
+	return nil
+}
+
+// FindAuditEvents returns the events that match the filter, ordered by time, and the total count of matching events.
+func (c *Client) FindAuditEvents(ctx context.Context, filter platform.AuditFilter, opt ...platform.FindOptions) ([]*platform.AuditEvent, int, error) {
+	op := getOp(platform.OpFindAuditEvents)
+	opts := platform.DefaultAuditFindOptions
+	if len(opt) > 0 {
+		opts = opt[0]
+	}
+
+	es := []*platform.AuditEvent{}
+	n := 0
+	err := c.db.View(func(tx *bolt.Tx) error {
+		cur := tx.Bucket(auditBucket).Cursor()
+
+		// Seek to the first event within Start and Stop, and stop at the last one.
+		var start, stop []byte
+		if !filter.Start.IsZero() {
+			start = encodeAuditTime(filter.Start)
+		}
+		if !filter.Stop.IsZero() {
+			stop = encodeAuditTime(filter.Stop)
+		}
+
+		first, next := cur.First, cur.Next
+		inRange := func(k []byte) bool { return stop == nil || bytes.Compare(k, stop) < 0 }
+		if start != nil {
+			first = func() ([]byte, []byte) { return cur.Seek(start) }
+		}
+		if opts.Descending {
+			first, next = cur.Last, cur.Prev
+			inRange = func(k []byte) bool { return start == nil || bytes.Compare(k, start) >= 0 }
+			if stop != nil {
+				first = func() ([]byte, []byte) {
+					// Step back from the first event at or after Stop.
+					if k, _ := cur.Seek(stop); k == nil {
+						return cur.Last()
+					}
+					return cur.Prev()
+				}
+			}
+		}
+
+		for k, v := first(); k != nil && inRange(k); k, v = next() {
+			e := &platform.AuditEvent{}
+			if err := json.Unmarshal(v, e); err != nil {
+				return err
+			}
+			if !filter.Matches(e) {
+				continue
+			}
+
+			if n >= opts.Offset && (opts.Limit <= 0 || len(es) < opts.Limit) {
+				es = append(es, e)
+			}
+			n++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, 0, &platform.Error{
+			Op:  op,
+			Err: err,
+		}
+	}
+
+	return es, n, nil
+}
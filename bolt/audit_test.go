@@ -0,0 +1,19 @@
+package bolt_test
+
+import (
+	"testing"
+
+	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/bolt"
+	platformtesting "github.com/influxdata/platform/testing"
+)
+
+func TestAuditLogService_FindAuditEvents(t *testing.T) {
+	platformtesting.FindAuditEvents(func(t *testing.T) (platform.AuditLogService, string, func()) {
+		c, closeFn, err := NewTestClient()
+		if err != nil {
+			t.Fatalf("failed to create new bolt client: %v", err)
+		}
+		return c, bolt.OpPrefix, closeFn
+	}, t)
+}
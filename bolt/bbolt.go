@@ -2,6 +2,7 @@ package bolt
 
 import (
 	"context"
+	"crypto/cipher"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -30,6 +31,10 @@ type Client struct {
 	IDGenerator    platform.IDGenerator
 	TokenGenerator platform.TokenGenerator
 	time           func() time.Time
+
+	// secretKeys are the keys secret values are encrypted with, by version.
+	secretKeys       map[uint32]cipher.AEAD
+	secretKeyVersion uint32
 }
 
 // NewClient returns an instance of a Client.
@@ -85,6 +90,20 @@ func (c *Client) Open(ctx context.Context) error {
 	return nil
 }
 
+// OpenReadOnly opens an existing boltDB file for reading. The file is not
+// initialized, so resources can be read from it without the secret keys its
+// secrets are encrypted with.
+func (c *Client) OpenReadOnly(ctx context.Context) error {
+	db, err := bolt.Open(c.Path, 0600, &bolt.Options{Timeout: 1 * time.Second, ReadOnly: true})
+	if err != nil {
+		return fmt.Errorf("unable to open boltdb: %v", err)
+	}
+	c.db = db
+
+	c.Logger.Info("Resources opened read-only", zap.String("path", c.Path))
+	return nil
+}
+
 // initialize creates Buckets that are missing
 func (c *Client) initialize(ctx context.Context) error {
 	if err := c.db.Update(func(tx *bolt.Tx) error {
@@ -103,6 +122,11 @@ func (c *Client) initialize(ctx context.Context) error {
 			return err
 		}
 
+		// Always create Dashboard Revisions bucket.
+		if err := c.initializeDashboardRevisions(ctx, tx); err != nil {
+			return err
+		}
+
 		// Always create User bucket.
 		if err := c.initializeUsers(ctx, tx); err != nil {
 			return err
@@ -168,6 +192,21 @@ func (c *Client) initialize(ctx context.Context) error {
 			return err
 		}
 
+		// Always create Audit bucket.
+		if err := c.initializeAudit(ctx, tx); err != nil {
+			return err
+		}
+
+		// Always create Downsample Policies bucket.
+		if err := c.initializeDownsamplePolicies(ctx, tx); err != nil {
+			return err
+		}
+
+		// Always create Usage bucket.
+		if err := c.initializeUsage(ctx, tx); err != nil {
+			return err
+		}
+
 		return nil
 	}); err != nil {
 		return err
@@ -1,6 +1,7 @@
 package bolt
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -14,6 +15,9 @@ import (
 
 var (
 	dashboardBucket = []byte("dashboardsv2")
+	// dashboardsByViewIndex is keyed by the ID of a view followed by the ID of
+	// a dashboard with a cell of the view.
+	dashboardsByViewIndex = []byte("dashboardsbyviewv1")
 )
 
 // TODO(desa): what do we want these to be?
@@ -25,6 +29,9 @@ const (
 	dashboardCellAddedEvent     = "Dashboard Cell Added"
 	dashboardCellRemovedEvent   = "Dashboard Cell Removed"
 	dashboardCellUpdatedEvent   = "Dashboard Cell Updated"
+
+	dashboardViewUpdatedEvent = "Dashboard View Updated"
+	dashboardRestoredEvent    = "Dashboard Restored"
 )
 
 var _ platform.DashboardService = (*Client)(nil)
@@ -34,6 +41,27 @@ func (c *Client) initializeDashboards(ctx context.Context, tx *bolt.Tx) error {
 	if _, err := tx.CreateBucketIfNotExists([]byte(dashboardBucket)); err != nil {
 		return err
 	}
+	if tx.Bucket(dashboardsByViewIndex) != nil {
+		return nil
+	}
+
+	// Build the index from the dashboards stored before it existed.
+	if _, err := tx.CreateBucket(dashboardsByViewIndex); err != nil {
+		return err
+	}
+	var ds []*platform.Dashboard
+	err := c.forEachDashboard(ctx, tx, func(d *platform.Dashboard) bool {
+		ds = append(ds, d)
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	for _, d := range ds {
+		if err := c.indexDashboardViews(ctx, tx, d.ID, nil, d.Cells); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -188,7 +216,11 @@ func (c *Client) CreateDashboard(ctx context.Context, d *platform.Dashboard) err
 		// TODO(desa): don't populate this here. use the first/last methods of the oplog to get meta fields.
 		d.Meta.CreatedAt = c.time()
 
-		return c.putDashboardWithMeta(ctx, tx, d)
+		if err := c.putDashboardWithMeta(ctx, tx, d); err != nil {
+			return err
+		}
+
+		return c.appendDashboardRevision(ctx, tx, d, dashboardCreatedEvent)
 	})
 }
 
@@ -257,7 +289,11 @@ func (c *Client) ReplaceDashboardCells(ctx context.Context, id platform.ID, cs [
 			return err
 		}
 
-		return c.putDashboardWithMeta(ctx, tx, d)
+		if err := c.putDashboardWithMeta(ctx, tx, d); err != nil {
+			return err
+		}
+
+		return c.appendDashboardRevision(ctx, tx, d, dashboardCellsReplacedEvent)
 	})
 }
 
@@ -279,7 +315,11 @@ func (c *Client) AddDashboardCell(ctx context.Context, id platform.ID, cell *pla
 			return err
 		}
 
-		return c.putDashboardWithMeta(ctx, tx, d)
+		if err := c.putDashboardWithMeta(ctx, tx, d); err != nil {
+			return err
+		}
+
+		return c.appendDashboardRevision(ctx, tx, d, dashboardCellAddedEvent)
 	})
 }
 
@@ -312,7 +352,11 @@ func (c *Client) RemoveDashboardCell(ctx context.Context, dashboardID, cellID pl
 			return err
 		}
 
-		return c.putDashboardWithMeta(ctx, tx, d)
+		if err := c.putDashboardWithMeta(ctx, tx, d); err != nil {
+			return err
+		}
+
+		return c.appendDashboardRevision(ctx, tx, d, dashboardCellRemovedEvent)
 	})
 }
 
@@ -350,7 +394,11 @@ func (c *Client) UpdateDashboardCell(ctx context.Context, dashboardID, cellID pl
 			return err
 		}
 
-		return c.putDashboardWithMeta(ctx, tx, d)
+		if err := c.putDashboardWithMeta(ctx, tx, d); err != nil {
+			return err
+		}
+
+		return c.appendDashboardRevision(ctx, tx, d, dashboardCellUpdatedEvent)
 	})
 
 	if err != nil {
@@ -376,12 +424,94 @@ func (c *Client) putDashboard(ctx context.Context, tx *bolt.Tx, d *platform.Dash
 	if err != nil {
 		return err
 	}
+
+	var old []*platform.Cell
+	if prev, err := c.findDashboardByID(ctx, tx, d.ID); err == nil {
+		old = prev.Cells
+	} else if err != platform.ErrDashboardNotFound {
+		return err
+	}
+	if err := c.indexDashboardViews(ctx, tx, d.ID, old, d.Cells); err != nil {
+		return err
+	}
+
 	if err := tx.Bucket(dashboardBucket).Put(encodedID, v); err != nil {
 		return err
 	}
 	return nil
 }
 
+// encodeDashboardByViewKey returns the key of the dashboardsByViewIndex entry
+// of a view and a dashboard.
+func encodeDashboardByViewKey(viewID, dashboardID platform.ID) ([]byte, error) {
+	v, err := viewID.Encode()
+	if err != nil {
+		return nil, err
+	}
+	d, err := dashboardID.Encode()
+	if err != nil {
+		return nil, err
+	}
+	return append(v, d...), nil
+}
+
+// indexDashboardViews updates the dashboardsByViewIndex of a dashboard whose
+// cells change from old to cells.
+func (c *Client) indexDashboardViews(ctx context.Context, tx *bolt.Tx, id platform.ID, old, cells []*platform.Cell) error {
+	b := tx.Bucket(dashboardsByViewIndex)
+
+	keep := make(map[platform.ID]bool, len(cells))
+	for _, cell := range cells {
+		keep[cell.ViewID] = true
+	}
+	for _, cell := range old {
+		if keep[cell.ViewID] {
+			continue
+		}
+		key, err := encodeDashboardByViewKey(cell.ViewID, id)
+		if err != nil {
+			return err
+		}
+		if err := b.Delete(key); err != nil {
+			return err
+		}
+	}
+
+	for viewID := range keep {
+		key, err := encodeDashboardByViewKey(viewID, id)
+		if err != nil {
+			return err
+		}
+		if err := b.Put(key, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// findDashboardsByView returns the dashboards with a cell of the view.
+func (c *Client) findDashboardsByView(ctx context.Context, tx *bolt.Tx, viewID platform.ID) ([]*platform.Dashboard, error) {
+	prefix, err := viewID.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	var ds []*platform.Dashboard
+	cur := tx.Bucket(dashboardsByViewIndex).Cursor()
+	for k, _ := cur.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, _ = cur.Next() {
+		var id platform.ID
+		if err := id.Decode(k[len(prefix):]); err != nil {
+			return nil, err
+		}
+		d, err := c.findDashboardByID(ctx, tx, id)
+		if err != nil {
+			return nil, err
+		}
+		ds = append(ds, d)
+	}
+	return ds, nil
+}
+
 func (c *Client) putDashboardWithMeta(ctx context.Context, tx *bolt.Tx, d *platform.Dashboard) error {
 	// TODO(desa): don't populate this here. use the first/last methods of the oplog to get meta fields.
 	d.Meta.UpdatedAt = c.time()
@@ -442,6 +572,10 @@ func (c *Client) updateDashboard(ctx context.Context, tx *bolt.Tx, id platform.I
 		return nil, err
 	}
 
+	if err := c.appendDashboardRevision(ctx, tx, d, dashboardUpdatedEvent); err != nil {
+		return nil, err
+	}
+
 	return d, nil
 }
 
@@ -469,12 +603,19 @@ func (c *Client) deleteDashboard(ctx context.Context, tx *bolt.Tx, id platform.I
 	if err := tx.Bucket(dashboardBucket).Delete(encodedID); err != nil {
 		return err
 	}
+	if err := c.indexDashboardViews(ctx, tx, id, d.Cells, nil); err != nil {
+		return err
+	}
 
 	err = c.deleteLabels(ctx, tx, platform.LabelFilter{ResourceID: id})
 	if err != nil {
 		return err
 	}
 
+	if err := c.deleteDashboardRevisions(ctx, tx, id); err != nil {
+		return err
+	}
+
 	// TODO(desa): add DeleteKeyValueLog method and use it here.
 	return c.deleteUserResourceMappings(ctx, tx, platform.UserResourceMappingFilter{
 		ResourceID:   id,
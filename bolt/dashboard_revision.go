@@ -0,0 +1,352 @@
+package bolt
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"math"
+
+	bolt "github.com/coreos/bbolt"
+	"github.com/influxdata/platform"
+	platformcontext "github.com/influxdata/platform/context"
+)
+
+var (
+	dashboardRevisionBucket = []byte("dashboardrevisionsv1")
+)
+
+// MaxDashboardRevisions is the number of revisions kept for a dashboard.
+// Recording a revision deletes the revisions older than the latest
+// MaxDashboardRevisions; the numbers of the revisions kept do not change.
+const MaxDashboardRevisions = 100
+
+var _ platform.DashboardRevisionService = (*Client)(nil)
+
+func (c *Client) initializeDashboardRevisions(ctx context.Context, tx *bolt.Tx) error {
+	if _, err := tx.CreateBucketIfNotExists(dashboardRevisionBucket); err != nil {
+		return err
+	}
+	return nil
+}
+
+// encodeDashboardRevisionKey returns the key of a revision, the dashboard ID
+// followed by the big endian revision so that revisions sort in order.
+func encodeDashboardRevisionKey(id platform.ID, revision uint64) ([]byte, error) {
+	buf, err := id.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	key := make([]byte, len(buf)+8)
+	copy(key, buf)
+	binary.BigEndian.PutUint64(key[len(buf):], revision)
+	return key, nil
+}
+
+// FindDashboardRevisions returns the revisions of a dashboard and their count.
+func (c *Client) FindDashboardRevisions(ctx context.Context, id platform.ID, opts platform.FindOptions) ([]*platform.DashboardRevision, int, error) {
+	rs := []*platform.DashboardRevision{}
+	var n int
+
+	err := c.db.View(func(tx *bolt.Tx) error {
+		if _, err := c.findDashboardByID(ctx, tx, id); err != nil {
+			return err
+		}
+
+		prefix, err := id.Encode()
+		if err != nil {
+			return err
+		}
+
+		var vs [][]byte
+		cur := tx.Bucket(dashboardRevisionBucket).Cursor()
+		for k, v := cur.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = cur.Next() {
+			vs = append(vs, v)
+		}
+		n = len(vs)
+
+		if opts.Descending {
+			for i, j := 0, len(vs)-1; i < j; i, j = i+1, j-1 {
+				vs[i], vs[j] = vs[j], vs[i]
+			}
+		}
+		if opts.Offset >= len(vs) {
+			return nil
+		}
+		vs = vs[opts.Offset:]
+		if opts.Limit > 0 && opts.Limit < len(vs) {
+			vs = vs[:opts.Limit]
+		}
+
+		for _, v := range vs {
+			r := &platform.DashboardRevision{}
+			if err := json.Unmarshal(v, r); err != nil {
+				return err
+			}
+			rs = append(rs, r)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return rs, n, nil
+}
+
+// FindDashboardRevision returns a single revision of a dashboard.
+func (c *Client) FindDashboardRevision(ctx context.Context, id platform.ID, revision int) (*platform.DashboardRevision, error) {
+	var r *platform.DashboardRevision
+
+	err := c.db.View(func(tx *bolt.Tx) error {
+		rev, err := c.findDashboardRevision(ctx, tx, id, revision)
+		if err != nil {
+			return err
+		}
+		r = rev
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (c *Client) findDashboardRevision(ctx context.Context, tx *bolt.Tx, id platform.ID, revision int) (*platform.DashboardRevision, error) {
+	if revision < 1 {
+		return nil, platform.ErrDashboardRevisionNotFound
+	}
+
+	key, err := encodeDashboardRevisionKey(id, uint64(revision))
+	if err != nil {
+		return nil, err
+	}
+
+	v := tx.Bucket(dashboardRevisionBucket).Get(key)
+	if len(v) == 0 {
+		return nil, platform.ErrDashboardRevisionNotFound
+	}
+
+	var r platform.DashboardRevision
+	if err := json.Unmarshal(v, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// RestoreDashboardRevision rolls a dashboard and the views of its cells back to
+// the revision. Views of cells the revision does not have are deleted, as
+// removing the cells would, and views it has are put back as they were.
+func (c *Client) RestoreDashboardRevision(ctx context.Context, id platform.ID, revision int) (*platform.Dashboard, error) {
+	var d *platform.Dashboard
+
+	err := c.db.Update(func(tx *bolt.Tx) error {
+		dash, err := c.findDashboardByID(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		r, err := c.findDashboardRevision(ctx, tx, id, revision)
+		if err != nil {
+			return err
+		}
+
+		keep := make(map[platform.ID]bool, len(r.Dashboard.Cells))
+		for _, cell := range r.Dashboard.Cells {
+			keep[cell.ViewID] = true
+		}
+		for _, cell := range dash.Cells {
+			if keep[cell.ViewID] {
+				continue
+			}
+			// Cells may share a view, so only delete it once.
+			keep[cell.ViewID] = true
+			if err := c.deleteView(ctx, tx, cell.ViewID); err != nil && err != platform.ErrViewNotFound {
+				return err
+			}
+		}
+
+		for _, v := range r.Views {
+			if err := c.putView(ctx, tx, v); err != nil {
+				return err
+			}
+		}
+
+		dash.Name = r.Dashboard.Name
+		dash.Description = r.Dashboard.Description
+		dash.Cells = r.Dashboard.Cells
+
+		if err := c.appendDashboardEventToLog(ctx, tx, dash.ID, dashboardRestoredEvent); err != nil {
+			return err
+		}
+
+		if err := c.putDashboardWithMeta(ctx, tx, dash); err != nil {
+			return err
+		}
+
+		d = dash
+		return c.appendDashboardRevision(ctx, tx, dash, dashboardRestoredEvent)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// appendDashboardRevision records the dashboard and the views of its cells as
+// its next revision.
+func (c *Client) appendDashboardRevision(ctx context.Context, tx *bolt.Tx, d *platform.Dashboard, s string) error {
+	last, err := c.lastDashboardRevision(ctx, tx, d.ID)
+	if err != nil {
+		return err
+	}
+
+	r := &platform.DashboardRevision{
+		Revision:    last + 1,
+		DashboardID: d.ID,
+		Description: s,
+		Time:        c.time(),
+		Dashboard:   d,
+		Views:       []*platform.View{},
+	}
+	// Add the user to the revision if you can, but don't error if its not there.
+	if a, err := platformcontext.GetAuthorizer(ctx); err == nil {
+		r.UserID = a.GetUserID()
+	}
+
+	seen := make(map[platform.ID]bool, len(d.Cells))
+	for _, cell := range d.Cells {
+		if seen[cell.ViewID] {
+			continue
+		}
+		seen[cell.ViewID] = true
+
+		v, err := c.findViewByID(ctx, tx, cell.ViewID)
+		if err == platform.ErrViewNotFound {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		r.Views = append(r.Views, v)
+	}
+
+	v, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+
+	key, err := encodeDashboardRevisionKey(d.ID, uint64(r.Revision))
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Bucket(dashboardRevisionBucket).Put(key, v); err != nil {
+		return err
+	}
+
+	return c.pruneDashboardRevisions(ctx, tx, d.ID, r.Revision)
+}
+
+// pruneDashboardRevisions deletes the revisions of a dashboard older than the
+// MaxDashboardRevisions up to and including last.
+func (c *Client) pruneDashboardRevisions(ctx context.Context, tx *bolt.Tx, id platform.ID, last int) error {
+	if last <= MaxDashboardRevisions {
+		return nil
+	}
+
+	prefix, err := id.Encode()
+	if err != nil {
+		return err
+	}
+	end, err := encodeDashboardRevisionKey(id, uint64(last-MaxDashboardRevisions+1))
+	if err != nil {
+		return err
+	}
+
+	b := tx.Bucket(dashboardRevisionBucket)
+	var keys [][]byte
+	cur := b.Cursor()
+	for k, _ := cur.Seek(prefix); k != nil && bytes.Compare(k, end) < 0; k, _ = cur.Next() {
+		keys = append(keys, k)
+	}
+
+	for _, k := range keys {
+		if err := b.Delete(k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// lastDashboardRevision returns the number of the latest revision of a
+// dashboard, or 0 if it has none.
+func (c *Client) lastDashboardRevision(ctx context.Context, tx *bolt.Tx, id platform.ID) (int, error) {
+	prefix, err := id.Encode()
+	if err != nil {
+		return 0, err
+	}
+	end, err := encodeDashboardRevisionKey(id, math.MaxUint64)
+	if err != nil {
+		return 0, err
+	}
+
+	// Seek past the revisions of the dashboard and step back to its last.
+	cur := tx.Bucket(dashboardRevisionBucket).Cursor()
+	k, _ := cur.Seek(end)
+	if k == nil {
+		k, _ = cur.Last()
+	} else {
+		k, _ = cur.Prev()
+	}
+
+	if k == nil || !bytes.HasPrefix(k, prefix) {
+		return 0, nil
+	}
+	return int(binary.BigEndian.Uint64(k[len(prefix):])), nil
+}
+
+// appendViewDashboardRevisions records a revision of each dashboard with a
+// cell of the view.
+func (c *Client) appendViewDashboardRevisions(ctx context.Context, tx *bolt.Tx, viewID platform.ID) error {
+	ds, err := c.findDashboardsByView(ctx, tx, viewID)
+	if err != nil {
+		return err
+	}
+
+	for _, d := range ds {
+		if err := c.appendDashboardRevision(ctx, tx, d, dashboardViewUpdatedEvent); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteDashboardRevisions deletes all revisions of a dashboard.
+func (c *Client) deleteDashboardRevisions(ctx context.Context, tx *bolt.Tx, id platform.ID) error {
+	prefix, err := id.Encode()
+	if err != nil {
+		return err
+	}
+
+	b := tx.Bucket(dashboardRevisionBucket)
+	var keys [][]byte
+	cur := b.Cursor()
+	for k, _ := cur.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, _ = cur.Next() {
+		keys = append(keys, k)
+	}
+
+	for _, k := range keys {
+		if err := b.Delete(k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,217 @@
+package bolt_test
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/bolt"
+)
+
+func TestClient_DashboardRevisions(t *testing.T) {
+	c, closeFn, err := NewTestClient()
+	if err != nil {
+		t.Fatalf("failed to create new bolt client: %v", err)
+	}
+	defer closeFn()
+
+	ctx := context.Background()
+	d := &platform.Dashboard{Name: "hosts"}
+	if err := c.CreateDashboard(ctx, d); err != nil {
+		t.Fatal(err)
+	}
+
+	cell := &platform.Cell{W: 4, H: 4}
+	if err := c.AddDashboardCell(ctx, d.ID, cell, platform.AddDashboardCellOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	name := "cpu"
+	properties := platform.XYViewProperties{Type: "xy", Geom: "line", Queries: []platform.DashboardQuery{{Text: "from(bucket: \"b\")"}}}
+	if _, err := c.UpdateView(ctx, cell.ViewID, platform.ViewUpdate{
+		ViewContentsUpdate: platform.ViewContentsUpdate{Name: &name},
+		Properties:         properties,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Removing the cell deletes its view.
+	if err := c.RemoveDashboardCell(ctx, d.ID, cell.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	rs, n, err := c.FindDashboardRevisions(ctx, d.ID, platform.DefaultDashboardRevisionFindOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 4 || len(rs) != 4 {
+		t.Fatalf("got %d of %d revisions, want 4", len(rs), n)
+	}
+	var got []string
+	for _, r := range rs {
+		got = append(got, r.Description)
+	}
+	want := []string{"Dashboard Cell Removed", "Dashboard View Updated", "Dashboard Cell Added", "Dashboard Created"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got revisions %v, want %v", got, want)
+	}
+	if rs[0].Revision != 4 || rs[3].Revision != 1 {
+		t.Errorf("got revisions %d to %d, want 4 to 1", rs[0].Revision, rs[3].Revision)
+	}
+
+	rs, n, err = c.FindDashboardRevisions(ctx, d.ID, platform.FindOptions{Offset: 1, Limit: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 4 || len(rs) != 2 || rs[0].Revision != 2 || rs[1].Revision != 3 {
+		t.Errorf("got page %+v of %d revisions, want revisions 2 and 3 of 4", rs, n)
+	}
+
+	r, err := c.FindDashboardRevision(ctx, d.ID, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Views) != 1 || r.Views[0].Name != name {
+		t.Fatalf("got views %+v, want the updated view", r.Views)
+	}
+
+	restored, err := c.RestoreDashboardRevision(ctx, d.ID, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(restored.Cells) != 1 || restored.Cells[0].ID != cell.ID {
+		t.Fatalf("got cells %+v, want the removed cell", restored.Cells)
+	}
+	v, err := c.FindViewByID(ctx, cell.ViewID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Name != name || !reflect.DeepEqual(v.Properties, properties) {
+		t.Errorf("got view %+v, want the view of the revision", v)
+	}
+
+	// Rolling back to the creation removes the cell again.
+	if _, err := c.RestoreDashboardRevision(ctx, d.ID, 1); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.FindViewByID(ctx, cell.ViewID); err != platform.ErrViewNotFound {
+		t.Errorf("got error %v finding the view of the removed cell, want %v", err, platform.ErrViewNotFound)
+	}
+
+	rs, _, err = c.FindDashboardRevisions(ctx, d.ID, platform.DefaultDashboardRevisionFindOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rs) != 6 || rs[0].Description != "Dashboard Restored" {
+		t.Errorf("got revisions %+v, want the restores recorded as revisions", rs)
+	}
+
+	if _, err := c.FindDashboardRevision(ctx, d.ID, 7); err != platform.ErrDashboardRevisionNotFound {
+		t.Errorf("got error %v finding a missing revision, want %v", err, platform.ErrDashboardRevisionNotFound)
+	}
+
+	if err := c.DeleteDashboard(ctx, d.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.FindDashboardRevision(ctx, d.ID, 1); err != platform.ErrDashboardRevisionNotFound {
+		t.Errorf("got error %v finding a revision of a deleted dashboard, want %v", err, platform.ErrDashboardRevisionNotFound)
+	}
+}
+
+func TestClient_DashboardRevisions_View(t *testing.T) {
+	c, closeFn, err := NewTestClient()
+	if err != nil {
+		t.Fatalf("failed to create new bolt client: %v", err)
+	}
+	defer closeFn()
+
+	ctx := context.Background()
+	d1 := &platform.Dashboard{Name: "hosts"}
+	if err := c.CreateDashboard(ctx, d1); err != nil {
+		t.Fatal(err)
+	}
+	d2 := &platform.Dashboard{Name: "services"}
+	if err := c.CreateDashboard(ctx, d2); err != nil {
+		t.Fatal(err)
+	}
+
+	cell := &platform.Cell{W: 4, H: 4}
+	if err := c.AddDashboardCell(ctx, d1.ID, cell, platform.AddDashboardCellOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	// Share the view of the cell with the second dashboard.
+	if err := c.AddDashboardCell(ctx, d2.ID, &platform.Cell{ViewID: cell.ViewID}, platform.AddDashboardCellOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	name := "cpu"
+	if _, err := c.UpdateView(ctx, cell.ViewID, platform.ViewUpdate{ViewContentsUpdate: platform.ViewContentsUpdate{Name: &name}}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Replacing the cells of the second dashboard drops it from the view.
+	if err := c.ReplaceDashboardCells(ctx, d2.ID, []*platform.Cell{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.UpdateView(ctx, cell.ViewID, platform.ViewUpdate{ViewContentsUpdate: platform.ViewContentsUpdate{Name: &name}}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		d    *platform.Dashboard
+		want []string
+	}{
+		{d: d1, want: []string{"Dashboard View Updated", "Dashboard View Updated", "Dashboard Cell Added", "Dashboard Created"}},
+		{d: d2, want: []string{"Dashboard Cells Replaced", "Dashboard View Updated", "Dashboard Cell Added", "Dashboard Created"}},
+	} {
+		rs, _, err := c.FindDashboardRevisions(ctx, tt.d.ID, platform.DefaultDashboardRevisionFindOptions)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []string
+		for _, r := range rs {
+			got = append(got, r.Description)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("got revisions %v of dashboard %q, want %v", got, tt.d.Name, tt.want)
+		}
+	}
+}
+
+func TestClient_DashboardRevisions_Max(t *testing.T) {
+	c, closeFn, err := NewTestClient()
+	if err != nil {
+		t.Fatalf("failed to create new bolt client: %v", err)
+	}
+	defer closeFn()
+
+	ctx := context.Background()
+	d := &platform.Dashboard{Name: "hosts"}
+	if err := c.CreateDashboard(ctx, d); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < bolt.MaxDashboardRevisions+4; i++ {
+		name := fmt.Sprintf("hosts %d", i)
+		if _, err := c.UpdateDashboard(ctx, d.ID, platform.DashboardUpdate{Name: &name}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	last := bolt.MaxDashboardRevisions + 5
+	rs, n, err := c.FindDashboardRevisions(ctx, d.ID, platform.FindOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != bolt.MaxDashboardRevisions {
+		t.Fatalf("got %d revisions, want %d", n, bolt.MaxDashboardRevisions)
+	}
+	if first := last - bolt.MaxDashboardRevisions + 1; rs[0].Revision != first || rs[n-1].Revision != last {
+		t.Errorf("got revisions %d to %d, want %d to %d", rs[0].Revision, rs[n-1].Revision, first, last)
+	}
+	if _, err := c.FindDashboardRevision(ctx, d.ID, 1); err != platform.ErrDashboardRevisionNotFound {
+		t.Errorf("got error %v finding a pruned revision, want %v", err, platform.ErrDashboardRevisionNotFound)
+	}
+}
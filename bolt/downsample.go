@@ -0,0 +1,190 @@
+package bolt
+
+import (
+	"context"
+	"encoding/json"
+
+	bolt "github.com/coreos/bbolt"
+	"github.com/influxdata/platform"
+)
+
+var (
+	downsamplePolicyBucket = []byte("downsamplepoliciesv1")
+)
+
+var _ platform.DownsamplePolicyService = (*Client)(nil)
+
+func (c *Client) initializeDownsamplePolicies(ctx context.Context, tx *bolt.Tx) error {
+	if _, err := tx.CreateBucketIfNotExists(downsamplePolicyBucket); err != nil {
+		return err
+	}
+	return nil
+}
+
+// FindDownsamplePolicyByID returns a single downsample policy by ID.
+func (c *Client) FindDownsamplePolicyByID(ctx context.Context, id platform.ID) (*platform.DownsamplePolicy, error) {
+	var p *platform.DownsamplePolicy
+	err := c.db.View(func(tx *bolt.Tx) error {
+		policy, pe := c.findDownsamplePolicyByID(ctx, tx, id)
+		if pe != nil {
+			return &platform.Error{
+				Op:  getOp(platform.OpFindDownsamplePolicyByID),
+				Err: pe,
+			}
+		}
+		p = policy
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (c *Client) findDownsamplePolicyByID(ctx context.Context, tx *bolt.Tx, id platform.ID) (*platform.DownsamplePolicy, *platform.Error) {
+	encID, err := id.Encode()
+	if err != nil {
+		return nil, &platform.Error{
+			Code: platform.EInvalid,
+			Err:  err,
+		}
+	}
+
+	v := tx.Bucket(downsamplePolicyBucket).Get(encID)
+	if len(v) == 0 {
+		return nil, &platform.Error{
+			Code: platform.ENotFound,
+			Msg:  "downsample policy not found",
+		}
+	}
+
+	p := &platform.DownsamplePolicy{}
+	if err := json.Unmarshal(v, p); err != nil {
+		return nil, &platform.Error{
+			Err: err,
+		}
+	}
+	return p, nil
+}
+
+// FindDownsamplePolicies returns the downsample policies that match filter.
+func (c *Client) FindDownsamplePolicies(ctx context.Context, filter platform.DownsamplePolicyFilter) ([]*platform.DownsamplePolicy, int, error) {
+	ps := []*platform.DownsamplePolicy{}
+	err := c.db.View(func(tx *bolt.Tx) error {
+		if filter.ID != nil {
+			p, pe := c.findDownsamplePolicyByID(ctx, tx, *filter.ID)
+			if pe != nil {
+				if pe.Code == platform.ENotFound {
+					return nil
+				}
+				return pe
+			}
+			if filter.Matches(p) {
+				ps = append(ps, p)
+			}
+			return nil
+		}
+
+		cur := tx.Bucket(downsamplePolicyBucket).Cursor()
+		for k, v := cur.First(); k != nil; k, v = cur.Next() {
+			p := &platform.DownsamplePolicy{}
+			if err := json.Unmarshal(v, p); err != nil {
+				return err
+			}
+			if filter.Matches(p) {
+				ps = append(ps, p)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, 0, &platform.Error{
+			Op:  getOp(platform.OpFindDownsamplePolicies),
+			Err: err,
+		}
+	}
+	return ps, len(ps), nil
+}
+
+// CreateDownsamplePolicy creates a new downsample policy and sets p.ID with the new identifier.
+func (c *Client) CreateDownsamplePolicy(ctx context.Context, p *platform.DownsamplePolicy) error {
+	err := c.db.Update(func(tx *bolt.Tx) error {
+		p.ID = c.IDGenerator.ID()
+		return c.putDownsamplePolicy(ctx, tx, p)
+	})
+	if err != nil {
+		return &platform.Error{
+			Op:  getOp(platform.OpCreateDownsamplePolicy),
+			Err: err,
+		}
+	}
+	return nil
+}
+
+// PutDownsamplePolicy will put a downsample policy without setting an ID.
+func (c *Client) PutDownsamplePolicy(ctx context.Context, p *platform.DownsamplePolicy) error {
+	return c.db.Update(func(tx *bolt.Tx) error {
+		return c.putDownsamplePolicy(ctx, tx, p)
+	})
+}
+
+func (c *Client) putDownsamplePolicy(ctx context.Context, tx *bolt.Tx, p *platform.DownsamplePolicy) error {
+	v, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+	encID, err := p.ID.Encode()
+	if err != nil {
+		return &platform.Error{
+			Code: platform.EInvalid,
+			Err:  err,
+		}
+	}
+	return tx.Bucket(downsamplePolicyBucket).Put(encID, v)
+}
+
+// UpdateDownsamplePolicy updates a single downsample policy with changeset.
+func (c *Client) UpdateDownsamplePolicy(ctx context.Context, id platform.ID, upd platform.DownsamplePolicyUpdate) (*platform.DownsamplePolicy, error) {
+	var p *platform.DownsamplePolicy
+	err := c.db.Update(func(tx *bolt.Tx) error {
+		policy, pe := c.findDownsamplePolicyByID(ctx, tx, id)
+		if pe != nil {
+			return pe
+		}
+
+		upd.Apply(policy)
+		p = policy
+		return c.putDownsamplePolicy(ctx, tx, p)
+	})
+	if err != nil {
+		return nil, &platform.Error{
+			Op:  getOp(platform.OpUpdateDownsamplePolicy),
+			Err: err,
+		}
+	}
+	return p, nil
+}
+
+// DeleteDownsamplePolicy removes a downsample policy by ID.
+func (c *Client) DeleteDownsamplePolicy(ctx context.Context, id platform.ID) error {
+	err := c.db.Update(func(tx *bolt.Tx) error {
+		if _, pe := c.findDownsamplePolicyByID(ctx, tx, id); pe != nil {
+			return pe
+		}
+		encID, err := id.Encode()
+		if err != nil {
+			return &platform.Error{
+				Code: platform.EInvalid,
+				Err:  err,
+			}
+		}
+		return tx.Bucket(downsamplePolicyBucket).Delete(encID)
+	})
+	if err != nil {
+		return &platform.Error{
+			Op:  getOp(platform.OpDeleteDownsamplePolicy),
+			Err: err,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package bolt_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/bolt"
+	platformtesting "github.com/influxdata/platform/testing"
+)
+
+func initDownsamplePolicyService(f platformtesting.DownsamplePolicyFields, t *testing.T) (platform.DownsamplePolicyService, string, func()) {
+	c, closeFn, err := NewTestClient()
+	if err != nil {
+		t.Fatalf("failed to create new bolt test client: %v", err)
+	}
+
+	c.IDGenerator = f.IDGenerator
+	ctx := context.Background()
+
+	for _, p := range f.DownsamplePolicies {
+		if err := c.PutDownsamplePolicy(ctx, p); err != nil {
+			t.Fatalf("failed to populate test downsample policies: %v", err)
+		}
+	}
+
+	return c, bolt.OpPrefix, closeFn
+}
+
+func TestDownsamplePolicyService(t *testing.T) {
+	platformtesting.DownsamplePolicyService(initDownsamplePolicyService, t)
+}
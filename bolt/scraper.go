@@ -9,15 +9,20 @@ import (
 )
 
 var (
-	scraperBucket = []byte("scraperv2")
+	scraperBucket       = []byte("scraperv2")
+	scraperStatusBucket = []byte("scraperstatusv1")
 )
 
 var _ platform.ScraperTargetStoreService = (*Client)(nil)
+var _ platform.ScraperTargetStatusService = (*Client)(nil)
 
 func (c *Client) initializeScraperTargets(ctx context.Context, tx *bolt.Tx) error {
 	if _, err := tx.CreateBucketIfNotExists([]byte(scraperBucket)); err != nil {
 		return err
 	}
+	if _, err := tx.CreateBucketIfNotExists(scraperStatusBucket); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -73,6 +78,9 @@ func (c *Client) RemoveTarget(ctx context.Context, id platform.ID) error {
 				Err:  err,
 			}
 		}
+		if err := tx.Bucket(scraperStatusBucket).Delete(encID); err != nil {
+			return err
+		}
 		return tx.Bucket(scraperBucket).Delete(encID)
 	})
 	if err != nil {
@@ -175,3 +183,82 @@ func (c *Client) PutTarget(ctx context.Context, target *platform.ScraperTarget)
 		return c.putTarget(ctx, tx, target)
 	})
 }
+
+// GetTargetStatus returns the status of a scraper target.
+func (c *Client) GetTargetStatus(ctx context.Context, id platform.ID) (status *platform.ScraperTargetStatus, err error) {
+	var pe *platform.Error
+	err = c.db.View(func(tx *bolt.Tx) error {
+		status, pe = c.findTargetStatus(ctx, tx, id)
+		if pe != nil {
+			return pe
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, &platform.Error{
+			Op:  getOp(platform.OpGetTargetStatus),
+			Err: err,
+		}
+	}
+	return status, nil
+}
+
+// RecordScrape updates the status of a scraper target with the result of a scrape.
+func (c *Client) RecordScrape(ctx context.Context, id platform.ID, r platform.ScrapeResult) (status *platform.ScraperTargetStatus, err error) {
+	err = c.db.Update(func(tx *bolt.Tx) error {
+		var pe *platform.Error
+		status, pe = c.findTargetStatus(ctx, tx, id)
+		if pe != nil {
+			if pe.Code != platform.ENotFound {
+				return pe
+			}
+			status = &platform.ScraperTargetStatus{TargetID: id}
+		}
+		status.Apply(r)
+
+		v, err := json.Marshal(status)
+		if err != nil {
+			return err
+		}
+		encID, err := id.Encode()
+		if err != nil {
+			return &platform.Error{
+				Code: platform.EInvalid,
+				Err:  err,
+			}
+		}
+		return tx.Bucket(scraperStatusBucket).Put(encID, v)
+	})
+	if err != nil {
+		return nil, &platform.Error{
+			Op:  getOp(platform.OpRecordScrape),
+			Err: err,
+		}
+	}
+	return status, nil
+}
+
+func (c *Client) findTargetStatus(ctx context.Context, tx *bolt.Tx, id platform.ID) (*platform.ScraperTargetStatus, *platform.Error) {
+	encID, err := id.Encode()
+	if err != nil {
+		return nil, &platform.Error{
+			Code: platform.EInvalid,
+			Err:  err,
+		}
+	}
+	v := tx.Bucket(scraperStatusBucket).Get(encID)
+	if len(v) == 0 {
+		return nil, &platform.Error{
+			Code: platform.ENotFound,
+			Msg:  "scraper target status is not found",
+		}
+	}
+
+	status := new(platform.ScraperTargetStatus)
+	if err := json.Unmarshal(v, status); err != nil {
+		return nil, &platform.Error{
+			Err: err,
+		}
+	}
+	return status, nil
+}
@@ -50,3 +50,13 @@ func TestScraperTargetStoreService_UpdateTarget(t *testing.T) {
 func TestScraperTargetStoreService_GetTargetByID(t *testing.T) {
 	platformtesting.GetTargetByID(initScraperTargetStoreService, t)
 }
+
+func TestScraperTargetStatusService_RecordScrape(t *testing.T) {
+	platformtesting.RecordScrape(func(t *testing.T) (platform.ScraperTargetStatusService, string, func()) {
+		c, closeFn, err := NewTestClient()
+		if err != nil {
+			t.Fatalf("failed to create new bolt client: %v", err)
+		}
+		return c, bolt.OpPrefix, closeFn
+	}, t)
+}
@@ -20,7 +20,7 @@ func (c *Client) initializeSecretService(ctx context.Context, tx *bolt.Tx) error
 	if _, err := tx.CreateBucketIfNotExists([]byte(secretBucket)); err != nil {
 		return err
 	}
-	return nil
+	return c.reencryptSecrets(tx)
 }
 
 // LoadSecret retrieves the secret value v found at key k for organization orgID.
@@ -54,7 +54,7 @@ func (c *Client) loadSecret(ctx context.Context, tx *bolt.Tx, orgID platform.ID,
 		return "", fmt.Errorf("secret not found")
 	}
 
-	v, err := decodeSecretValue(val)
+	v, err := c.decodeSecretValue(key, val)
 	if err != nil {
 		return "", err
 	}
@@ -138,7 +138,10 @@ func (c *Client) putSecret(ctx context.Context, tx *bolt.Tx, orgID platform.ID,
 		return err
 	}
 
-	val := encodeSecretValue(v)
+	val, err := c.encodeSecretValue(key, v)
+	if err != nil {
+		return err
+	}
 
 	if err := tx.Bucket(secretBucket).Put(key, val); err != nil {
 		return err
@@ -178,11 +181,12 @@ func decodeSecretKey(key []byte) (platform.ID, string, error) {
 func decodeSecretValue(val []byte) (string, error) {
 	// store the secret value base64 encoded so that it's marginally better than plaintext
 	v := make([]byte, base64.StdEncoding.DecodedLen(len(val)))
-	if _, err := base64.StdEncoding.Decode(v, val); err != nil {
+	n, err := base64.StdEncoding.Decode(v, val)
+	if err != nil {
 		return "", err
 	}
 
-	return string(v), nil
+	return string(v[:n]), nil
 }
 
 func encodeSecretValue(v string) []byte {
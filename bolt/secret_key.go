@@ -0,0 +1,199 @@
+package bolt
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	bolt "github.com/coreos/bbolt"
+	"go.uber.org/zap"
+)
+
+// SecretKeySize is the size of the keys secret values are encrypted with (AES-256).
+const SecretKeySize = 32
+
+// encryptedSecretMarker begins encrypted secret values. Values stored before
+// secrets were encrypted are base64 encoded, and never begin with it.
+const encryptedSecretMarker = 0x00
+
+// SecretKey is a versioned key used to encrypt secret values at rest.
+//
+// Keys are rotated by configuring a key with a higher version alongside the
+// previous ones: when the client is opened, values encrypted with a previous
+// key are re-encrypted with the key of the highest version, after which the
+// previous keys may be removed.
+type SecretKey struct {
+	Version uint32
+	Key     []byte
+}
+
+// ParseSecretKeys parses keys formatted as "<version>:<base64 key>", separated
+// by commas or white space.
+func ParseSecretKeys(s string) ([]SecretKey, error) {
+	fields := strings.FieldsFunc(s, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\t' || r == '\n' || r == '\r'
+	})
+
+	keys := make([]SecretKey, 0, len(fields))
+	for _, f := range fields {
+		i := strings.IndexByte(f, ':')
+		if i < 0 {
+			return nil, fmt.Errorf("secret key must be formatted as <version>:<base64 key>")
+		}
+
+		version, err := strconv.ParseUint(f[:i], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid secret key version %q", f[:i])
+		}
+
+		key, err := base64.StdEncoding.DecodeString(f[i+1:])
+		if err != nil {
+			return nil, fmt.Errorf("secret key version %d is not base64 encoded: %v", version, err)
+		}
+
+		keys = append(keys, SecretKey{Version: uint32(version), Key: key})
+	}
+	return keys, nil
+}
+
+// WithSecretKeys sets the keys secret values are encrypted with. Values are
+// encrypted with the key of the highest version. It should not be called after
+// the client has been opened.
+//
+// Without keys, secret values are stored unencrypted, and the client fails to
+// open if encrypted values are stored.
+func (c *Client) WithSecretKeys(keys ...SecretKey) error {
+	aeads := make(map[uint32]cipher.AEAD, len(keys))
+	var current uint32
+	for _, k := range keys {
+		if len(k.Key) != SecretKeySize {
+			return fmt.Errorf("secret key version %d must be %d bytes, got %d", k.Version, SecretKeySize, len(k.Key))
+		}
+		if _, ok := aeads[k.Version]; ok {
+			return fmt.Errorf("secret key version %d is configured more than once", k.Version)
+		}
+
+		block, err := aes.NewCipher(k.Key)
+		if err != nil {
+			return err
+		}
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return err
+		}
+		aeads[k.Version] = aead
+
+		if k.Version > current {
+			current = k.Version
+		}
+	}
+
+	c.secretKeys = aeads
+	c.secretKeyVersion = current
+	return nil
+}
+
+// encodeSecretValue encodes the value of the secret stored at key, encrypting
+// it if a secret key is configured. The value is authenticated with its key, so
+// that it cannot be moved to another organization or secret.
+func (c *Client) encodeSecretValue(key []byte, v string) ([]byte, error) {
+	aead, ok := c.secretKeys[c.secretKeyVersion]
+	if !ok {
+		return encodeSecretValue(v), nil
+	}
+
+	// marker | version | nonce | ciphertext
+	headerLen := 1 + 4 + aead.NonceSize()
+	val := make([]byte, headerLen, headerLen+len(v)+aead.Overhead())
+	val[0] = encryptedSecretMarker
+	binary.BigEndian.PutUint32(val[1:5], c.secretKeyVersion)
+	nonce := val[5:headerLen]
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+
+	return aead.Seal(val, nonce, []byte(v), key), nil
+}
+
+// decodeSecretValue decodes the value of the secret stored at key.
+func (c *Client) decodeSecretValue(key, val []byte) (string, error) {
+	version, ok := secretKeyVersion(val)
+	if !ok {
+		return decodeSecretValue(val)
+	}
+
+	aead, ok := c.secretKeys[version]
+	if !ok {
+		return "", fmt.Errorf("secret is encrypted with key version %d, which is not configured", version)
+	}
+
+	headerLen := 1 + 4 + aead.NonceSize()
+	if len(val) < headerLen {
+		return "", fmt.Errorf("encrypted secret is truncated")
+	}
+
+	v, err := aead.Open(nil, val[5:headerLen], val[headerLen:], key)
+	if err != nil {
+		return "", fmt.Errorf("unable to decrypt secret: %v", err)
+	}
+	return string(v), nil
+}
+
+// secretKeyVersion returns the version of the key an encrypted value is
+// encrypted with, and false if the value is not encrypted.
+func secretKeyVersion(val []byte) (uint32, bool) {
+	if len(val) < 5 || val[0] != encryptedSecretMarker {
+		return 0, false
+	}
+	return binary.BigEndian.Uint32(val[1:5]), true
+}
+
+// reencryptSecrets re-encrypts the secret values that are not encrypted with
+// the current key. It returns an error if values are encrypted but no key is
+// configured to decrypt them.
+func (c *Client) reencryptSecrets(tx *bolt.Tx) error {
+	b := tx.Bucket(secretBucket)
+
+	type secret struct {
+		key, val []byte
+	}
+	var stale []secret
+	cur := b.Cursor()
+	for k, v := cur.First(); k != nil; k, v = cur.Next() {
+		version, encrypted := secretKeyVersion(v)
+		if encrypted && len(c.secretKeys) == 0 {
+			return fmt.Errorf("secrets are encrypted but no secret key is configured")
+		}
+		if len(c.secretKeys) == 0 || (encrypted && version == c.secretKeyVersion) {
+			continue
+		}
+		// Keys and values are only valid for the life of the transaction,
+		// and the bucket cannot be modified while iterating.
+		stale = append(stale, secret{key: append([]byte(nil), k...), val: append([]byte(nil), v...)})
+	}
+
+	for _, s := range stale {
+		v, err := c.decodeSecretValue(s.key, s.val)
+		if err != nil {
+			return err
+		}
+		val, err := c.encodeSecretValue(s.key, v)
+		if err != nil {
+			return err
+		}
+		if err := b.Put(s.key, val); err != nil {
+			return err
+		}
+	}
+
+	if len(stale) > 0 {
+		c.Logger.Info("Re-encrypted secrets", zap.Int("count", len(stale)), zap.Uint32("key_version", c.secretKeyVersion))
+	}
+	return nil
+}
@@ -1,10 +1,14 @@
 package bolt_test
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
 	"testing"
 
+	bbolt "github.com/coreos/bbolt"
 	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/bolt"
 	platformtesting "github.com/influxdata/platform/testing"
 )
 
@@ -29,3 +33,139 @@ func initSecretService(f platformtesting.SecretServiceFields, t *testing.T) (pla
 func TestSecretService(t *testing.T) {
 	platformtesting.SecretService(initSecretService, t)
 }
+
+func secretKey(version uint32, b byte) bolt.SecretKey {
+	return bolt.SecretKey{Version: version, Key: bytes.Repeat([]byte{b}, bolt.SecretKeySize)}
+}
+
+func initEncryptedSecretService(f platformtesting.SecretServiceFields, t *testing.T) (platform.SecretService, func()) {
+	c, closeFn, err := NewTestClient()
+	if err != nil {
+		t.Fatalf("failed to create new bolt client: %v", err)
+	}
+	if err := c.WithSecretKeys(secretKey(1, 1)); err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.TODO()
+	for _, s := range f.Secrets {
+		for k, v := range s.Env {
+			if err := c.PutSecret(ctx, s.OrganizationID, k, v); err != nil {
+				t.Fatalf("failed to populate secrets")
+			}
+		}
+	}
+	return c, func() {
+		defer closeFn()
+	}
+}
+
+func TestSecretService_Encrypted(t *testing.T) {
+	platformtesting.SecretService(initEncryptedSecretService, t)
+}
+
+func TestSecretService_KeyRotation(t *testing.T) {
+	ctx := context.Background()
+	orgID := platform.ID(1)
+
+	c, closeFn, err := NewTestClient()
+	if err != nil {
+		t.Fatalf("failed to create new bolt client: %v", err)
+	}
+	defer closeFn()
+
+	// Stored before encryption was configured.
+	if err := c.PutSecret(ctx, orgID, "plain", "value1"); err != nil {
+		t.Fatal(err)
+	}
+
+	reopen := func(keys ...bolt.SecretKey) error {
+		c.Close()
+		if err := c.WithSecretKeys(keys...); err != nil {
+			t.Fatal(err)
+		}
+		return c.Open(ctx)
+	}
+	mustLoad := func(k, want string) {
+		t.Helper()
+		v, err := c.LoadSecret(ctx, orgID, k)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v != want {
+			t.Fatalf("got secret %q, want %q", v, want)
+		}
+	}
+	raw := func(k string) []byte {
+		var val []byte
+		c.DB().View(func(tx *bbolt.Tx) error {
+			key := append(mustEncode(t, orgID), k...)
+			val = append(val, tx.Bucket([]byte("secretsv1")).Get(key)...)
+			return nil
+		})
+		return val
+	}
+
+	if err := reopen(secretKey(1, 1)); err != nil {
+		t.Fatal(err)
+	}
+	mustLoad("plain", "value1")
+	if bytes.Contains(raw("plain"), []byte("value1")) || bytes.Contains(raw("plain"), []byte("dmFsdWUx")) {
+		t.Fatal("secret was not encrypted when the key was configured")
+	}
+	if err := c.PutSecret(ctx, orgID, "new", "value2"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := reopen(); err == nil {
+		t.Fatal("expected opening without a key to fail when secrets are encrypted")
+	}
+	if err := reopen(secretKey(2, 2)); err == nil {
+		t.Fatal("expected opening without the previous key to fail")
+	}
+
+	before := raw("new")
+	if err := reopen(secretKey(1, 1), secretKey(2, 2)); err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(raw("new"), before) {
+		t.Fatal("secret was not re-encrypted with the new key")
+	}
+
+	// The previous key is no longer needed.
+	if err := reopen(secretKey(2, 2)); err != nil {
+		t.Fatal(err)
+	}
+	mustLoad("plain", "value1")
+	mustLoad("new", "value2")
+}
+
+func mustEncode(t *testing.T, id platform.ID) []byte {
+	t.Helper()
+	b, err := id.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestParseSecretKeys(t *testing.T) {
+	k1 := base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, bolt.SecretKeySize))
+	keys, err := bolt.ParseSecretKeys("1:" + k1 + ",\n2:" + k1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 2 || keys[0].Version != 1 || keys[1].Version != 2 {
+		t.Fatalf("unexpected keys %+v", keys)
+	}
+
+	for _, s := range []string{k1, "x:" + k1, "1:not base64!"} {
+		if _, err := bolt.ParseSecretKeys(s); err == nil {
+			t.Errorf("expected error parsing %q", s)
+		}
+	}
+
+	c := bolt.NewClient()
+	if err := c.WithSecretKeys(bolt.SecretKey{Version: 1, Key: []byte("short")}); err == nil {
+		t.Error("expected error configuring a key of the wrong size")
+	}
+}
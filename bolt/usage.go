@@ -0,0 +1,141 @@
+package bolt
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"math"
+	"time"
+
+	bolt "github.com/coreos/bbolt"
+	"github.com/influxdata/platform"
+)
+
+var (
+	usageBucket = []byte("usagev1")
+)
+
+var _ platform.UsageService = (*Client)(nil)
+var _ platform.UsageRecorder = (*Client)(nil)
+
+func (c *Client) initializeUsage(ctx context.Context, tx *bolt.Tx) error {
+	if _, err := tx.CreateBucketIfNotExists(usageBucket); err != nil {
+		return err
+	}
+	return nil
+}
+
+// usageKeyPrefixSize is the size of the organization and bucket IDs of a usage key.
+const usageKeyPrefixSize = 16
+
+// encodeUsageKey orders counters by organization, bucket and time. The bucket
+// ID of counters of an organization is zero.
+func encodeUsageKey(orgID, bucketID platform.ID, t time.Time, metric platform.UsageMetric) []byte {
+	key := make([]byte, usageKeyPrefixSize+8, usageKeyPrefixSize+8+len(metric))
+	binary.BigEndian.PutUint64(key, uint64(orgID))
+	binary.BigEndian.PutUint64(key[8:], uint64(bucketID))
+	binary.BigEndian.PutUint64(key[16:], uint64(t.UnixNano()))
+	return append(key, metric...)
+}
+
+func decodeUsageKey(key []byte) (orgID, bucketID platform.ID, t time.Time, metric platform.UsageMetric) {
+	orgID = platform.ID(binary.BigEndian.Uint64(key))
+	bucketID = platform.ID(binary.BigEndian.Uint64(key[8:]))
+	t = time.Unix(0, int64(binary.BigEndian.Uint64(key[16:])))
+	metric = platform.UsageMetric(key[usageKeyPrefixSize+8:])
+	return orgID, bucketID, t, metric
+}
+
+func encodeUsageValue(v float64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, math.Float64bits(v))
+	return b
+}
+
+func decodeUsageValue(b []byte) float64 {
+	return math.Float64frombits(binary.BigEndian.Uint64(b))
+}
+
+// RecordUsage adds the values of the usages to the counters at time t.
+// Usages without an organization are ignored.
+func (c *Client) RecordUsage(ctx context.Context, t time.Time, usages ...platform.Usage) error {
+	err := c.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(usageBucket)
+		for _, u := range usages {
+			if u.OrganizationID == nil {
+				continue
+			}
+
+			var bucketID platform.ID
+			if u.BucketID != nil {
+				bucketID = *u.BucketID
+			}
+
+			key := encodeUsageKey(*u.OrganizationID, bucketID, t, u.Type)
+			v := u.Value
+			if prev := b.Get(key); prev != nil {
+				v += decodeUsageValue(prev)
+			}
+			if err := b.Put(key, encodeUsageValue(v)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return &platform.Error{
+			Op:  getOp(platform.OpRecordUsage),
+			Err: err,
+		}
+	}
+	return nil
+}
+
+// GetUsage returns the sums of the counters of the filter's bucket, or of its
+// organization and all of its buckets if it has no bucket, recorded within
+// its range. Only the metrics that were recorded are returned.
+func (c *Client) GetUsage(ctx context.Context, filter platform.UsageFilter) (map[platform.UsageMetric]*platform.Usage, error) {
+	var prefix []byte
+	if filter.OrgID != nil {
+		prefix = make([]byte, 8, usageKeyPrefixSize)
+		binary.BigEndian.PutUint64(prefix, uint64(*filter.OrgID))
+		if filter.BucketID != nil {
+			prefix = prefix[:usageKeyPrefixSize]
+			binary.BigEndian.PutUint64(prefix[8:], uint64(*filter.BucketID))
+		}
+	}
+
+	usages := make(map[platform.UsageMetric]*platform.Usage)
+	err := c.db.View(func(tx *bolt.Tx) error {
+		cur := tx.Bucket(usageBucket).Cursor()
+		for k, v := cur.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = cur.Next() {
+			_, bucketID, t, metric := decodeUsageKey(k)
+			if filter.BucketID != nil && bucketID != *filter.BucketID {
+				continue
+			}
+			if filter.Range != nil && (t.Before(filter.Range.Start) || !t.Before(filter.Range.Stop)) {
+				continue
+			}
+
+			u, ok := usages[metric]
+			if !ok {
+				u = &platform.Usage{
+					OrganizationID: filter.OrgID,
+					BucketID:       filter.BucketID,
+					Type:           metric,
+				}
+				usages[metric] = u
+			}
+			u.Value += decodeUsageValue(v)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, &platform.Error{
+			Op:  getOp(platform.OpGetUsage),
+			Err: err,
+		}
+	}
+
+	return usages, nil
+}
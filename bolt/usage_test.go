@@ -0,0 +1,119 @@
+package bolt_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/influxdata/platform"
+	platformtesting "github.com/influxdata/platform/testing"
+)
+
+func TestClient_GetUsage(t *testing.T) {
+	c, closeFn, err := NewTestClient()
+	if err != nil {
+		t.Fatalf("failed to create new bolt client: %v", err)
+	}
+	defer closeFn()
+
+	ctx := context.Background()
+	org1 := platformtesting.MustIDBase16("020f755c3c082000")
+	org2 := platformtesting.MustIDBase16("020f755c3c082001")
+	bucket1 := platformtesting.MustIDBase16("020f755c3c082002")
+	bucket2 := platformtesting.MustIDBase16("020f755c3c082003")
+	t1 := time.Date(2018, 11, 1, 10, 0, 0, 0, time.UTC)
+	t2 := t1.Add(time.Hour)
+
+	record := func(t0 time.Time, usages ...platform.Usage) {
+		t.Helper()
+		if err := c.RecordUsage(ctx, t0, usages...); err != nil {
+			t.Fatal(err)
+		}
+	}
+	record(t1,
+		platform.Usage{OrganizationID: &org1, BucketID: &bucket1, Type: platform.UsageValues, Value: 1},
+		platform.Usage{OrganizationID: &org1, BucketID: &bucket2, Type: platform.UsageValues, Value: 2},
+		platform.Usage{OrganizationID: &org1, Type: platform.UsageQueryRequestCount, Value: 1},
+		platform.Usage{OrganizationID: &org2, Type: platform.UsageQueryRequestCount, Value: 8},
+	)
+	record(t1, platform.Usage{OrganizationID: &org1, BucketID: &bucket1, Type: platform.UsageValues, Value: 4})
+	record(t2, platform.Usage{OrganizationID: &org1, BucketID: &bucket1, Type: platform.UsageValues, Value: 16})
+
+	tests := []struct {
+		name   string
+		filter platform.UsageFilter
+		want   map[platform.UsageMetric]float64
+	}{
+		{
+			name: "all",
+			want: map[platform.UsageMetric]float64{
+				platform.UsageValues:            23,
+				platform.UsageQueryRequestCount: 9,
+			},
+		},
+		{
+			name:   "organization",
+			filter: platform.UsageFilter{OrgID: &org1},
+			want: map[platform.UsageMetric]float64{
+				platform.UsageValues:            23,
+				platform.UsageQueryRequestCount: 1,
+			},
+		},
+		{
+			name:   "bucket",
+			filter: platform.UsageFilter{OrgID: &org1, BucketID: &bucket1},
+			want: map[platform.UsageMetric]float64{
+				platform.UsageValues: 21,
+			},
+		},
+		{
+			name:   "bucket without organization",
+			filter: platform.UsageFilter{BucketID: &bucket2},
+			want: map[platform.UsageMetric]float64{
+				platform.UsageValues: 2,
+			},
+		},
+		{
+			name: "range",
+			filter: platform.UsageFilter{
+				OrgID:    &org1,
+				BucketID: &bucket1,
+				Range:    &platform.Timespan{Start: t1, Stop: t2},
+			},
+			want: map[platform.UsageMetric]float64{
+				platform.UsageValues: 5,
+			},
+		},
+		{
+			name: "empty range",
+			filter: platform.UsageFilter{
+				Range: &platform.Timespan{Start: t2.Add(time.Hour), Stop: t2.Add(2 * time.Hour)},
+			},
+			want: map[platform.UsageMetric]float64{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			usages, err := c.GetUsage(ctx, tt.filter)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := make(map[platform.UsageMetric]float64, len(usages))
+			for m, u := range usages {
+				if u.Type != m {
+					t.Errorf("got type %q for metric %q", u.Type, m)
+				}
+				got[m] = u.Value
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+			for m, v := range tt.want {
+				if got[m] != v {
+					t.Errorf("got %v for %q, want %v", got[m], m, v)
+				}
+			}
+		})
+	}
+}
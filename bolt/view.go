@@ -259,6 +259,10 @@ func (c *Client) updateView(ctx context.Context, tx *bolt.Tx, id platform.ID, up
 		return nil, err
 	}
 
+	if err := c.appendViewDashboardRevisions(ctx, tx, d.ID); err != nil {
+		return nil, err
+	}
+
 	return d, nil
 }
 
@@ -25,6 +25,38 @@ type Bucket struct {
 	Name                string        `json:"name"`
 	RetentionPolicyName string        `json:"rp,omitempty"` // This to support v1 sources
 	RetentionPeriod     time.Duration `json:"retentionPeriod"`
+
+	// ShardGroupDuration is the time span covered by each group of data files
+	// the storage engine keeps for the bucket. Expired data is dropped a whole
+	// group at a time. A zero value selects a default based on RetentionPeriod.
+	ShardGroupDuration time.Duration `json:"shardGroupDuration,omitempty"`
+
+	// Schema optionally restricts the measurements and fields written to the bucket.
+	Schema *BucketSchema `json:"schema,omitempty"`
+}
+
+// ShardGroupDurationOrDefault returns the bucket's shard group duration, or the
+// default for its retention period when none has been set.
+func (b *Bucket) ShardGroupDurationOrDefault() time.Duration {
+	if b.ShardGroupDuration > 0 {
+		return b.ShardGroupDuration
+	}
+	return DefaultShardGroupDuration(b.RetentionPeriod)
+}
+
+// DefaultShardGroupDuration returns the shard group duration used for a bucket
+// with the retention period rp, following the rules of InfluxDB 1.x.
+func DefaultShardGroupDuration(rp time.Duration) time.Duration {
+	switch {
+	case rp == InfiniteRetention:
+		return 7 * 24 * time.Hour
+	case rp < 2*24*time.Hour:
+		return time.Hour
+	case rp < 180*24*time.Hour:
+		return 24 * time.Hour
+	default:
+		return 7 * 24 * time.Hour
+	}
 }
 
 // ops for buckets error and buckets op logs.
@@ -63,8 +95,11 @@ type BucketService interface {
 // BucketUpdate represents updates to a bucket.
 // Only fields which are set are updated.
 type BucketUpdate struct {
-	Name            *string        `json:"name,omitempty"`
-	RetentionPeriod *time.Duration `json:"retentionPeriod,omitempty"`
+	Name               *string        `json:"name,omitempty"`
+	RetentionPeriod    *time.Duration `json:"retentionPeriod,omitempty"`
+	ShardGroupDuration *time.Duration `json:"shardGroupDuration,omitempty"`
+	// Schema replaces the bucket's schema. An empty schema removes it.
+	Schema *BucketSchema `json:"schema,omitempty"`
 }
 
 // BucketFilter represents a set of filter that restrict the returned results.
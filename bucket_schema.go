@@ -0,0 +1,139 @@
+package platform
+
+import "fmt"
+
+// SchemaMode determines how strictly a bucket schema is enforced.
+type SchemaMode string
+
+const (
+	// SchemaModeImplicit accepts measurements and fields that are not in the
+	// schema, and enforces the schema of the ones that are.
+	SchemaModeImplicit SchemaMode = "implicit"
+	// SchemaModeExplicit only accepts the measurements and fields declared in the schema.
+	SchemaModeExplicit SchemaMode = "explicit"
+)
+
+// SchemaFieldType is the type of a field declared in a bucket schema.
+type SchemaFieldType string
+
+// Field types that can be declared in a bucket schema.
+const (
+	SchemaFieldTypeFloat    SchemaFieldType = "float"
+	SchemaFieldTypeInteger  SchemaFieldType = "integer"
+	SchemaFieldTypeUnsigned SchemaFieldType = "unsigned"
+	SchemaFieldTypeString   SchemaFieldType = "string"
+	SchemaFieldTypeBoolean  SchemaFieldType = "boolean"
+)
+
+// BucketSchema restricts the data that can be written to a bucket.
+// Points that do not conform are dropped by the storage engine and reported
+// as a partial write.
+type BucketSchema struct {
+	// Mode defaults to SchemaModeImplicit.
+	Mode         SchemaMode          `json:"mode,omitempty"`
+	Measurements []MeasurementSchema `json:"measurements,omitempty"`
+}
+
+// MeasurementSchema declares a measurement of a bucket schema.
+type MeasurementSchema struct {
+	Name string `json:"name"`
+	// RequiredTags are the tags every point of the measurement must have.
+	RequiredTags []string      `json:"requiredTags,omitempty"`
+	Fields       []FieldSchema `json:"fields,omitempty"`
+}
+
+// FieldSchema declares the type of a field.
+type FieldSchema struct {
+	Name string          `json:"name"`
+	Type SchemaFieldType `json:"type"`
+}
+
+// Explicit returns true if the schema only accepts declared measurements and fields.
+func (s *BucketSchema) Explicit() bool {
+	return s.Mode == SchemaModeExplicit
+}
+
+// Empty returns true if the schema enforces nothing. Updating a bucket with an
+// empty schema removes the bucket's schema.
+func (s *BucketSchema) Empty() bool {
+	return !s.Explicit() && len(s.Measurements) == 0
+}
+
+// Measurement returns the declared measurement with the name, or nil.
+func (s *BucketSchema) Measurement(name string) *MeasurementSchema {
+	for i := range s.Measurements {
+		if s.Measurements[i].Name == name {
+			return &s.Measurements[i]
+		}
+	}
+	return nil
+}
+
+// Valid returns an error if the schema has an unknown mode or field type,
+// or declares a measurement, tag or field more than once.
+func (s *BucketSchema) Valid() error {
+	switch s.Mode {
+	case "", SchemaModeImplicit, SchemaModeExplicit:
+	default:
+		return &Error{
+			Code: EInvalid,
+			Msg:  fmt.Sprintf("unknown schema mode %q", s.Mode),
+		}
+	}
+
+	measurements := make(map[string]bool, len(s.Measurements))
+	for _, m := range s.Measurements {
+		if m.Name == "" {
+			return &Error{
+				Code: EInvalid,
+				Msg:  "schema measurement name is empty",
+			}
+		}
+		if measurements[m.Name] {
+			return &Error{
+				Code: EInvalid,
+				Msg:  fmt.Sprintf("schema declares measurement %q more than once", m.Name),
+			}
+		}
+		measurements[m.Name] = true
+
+		if err := m.valid(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *MeasurementSchema) valid() error {
+	tags := make(map[string]bool, len(m.RequiredTags))
+	for _, t := range m.RequiredTags {
+		if t == "" || tags[t] {
+			return &Error{
+				Code: EInvalid,
+				Msg:  fmt.Sprintf("invalid required tag %q on measurement %q", t, m.Name),
+			}
+		}
+		tags[t] = true
+	}
+
+	fields := make(map[string]bool, len(m.Fields))
+	for _, f := range m.Fields {
+		if f.Name == "" || fields[f.Name] {
+			return &Error{
+				Code: EInvalid,
+				Msg:  fmt.Sprintf("invalid field %q on measurement %q", f.Name, m.Name),
+			}
+		}
+		fields[f.Name] = true
+
+		switch f.Type {
+		case SchemaFieldTypeFloat, SchemaFieldTypeInteger, SchemaFieldTypeUnsigned, SchemaFieldTypeString, SchemaFieldTypeBoolean:
+		default:
+			return &Error{
+				Code: EInvalid,
+				Msg:  fmt.Sprintf("unknown type %q of field %q on measurement %q", f.Type, f.Name, m.Name),
+			}
+		}
+	}
+	return nil
+}
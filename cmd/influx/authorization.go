@@ -24,13 +24,17 @@ var authorizationCmd = &cobra.Command{
 
 // AuthorizationCreateFlags are command line args used when creating a authorization
 type AuthorizationCreateFlags struct {
-	user string
+	user  string
+	orgID string
 
 	createUserPermission bool
 	deleteUserPermission bool
 
 	readBucketPermissions  []string
 	writeBucketPermissions []string
+
+	readBucketsPermission  bool
+	writeBucketsPermission bool
 }
 
 var authorizationCreateFlags AuthorizationCreateFlags
@@ -51,6 +55,10 @@ func init() {
 	authorizationCreateCmd.Flags().StringArrayVarP(&authorizationCreateFlags.readBucketPermissions, "read-bucket", "", []string{}, "bucket id")
 	authorizationCreateCmd.Flags().StringArrayVarP(&authorizationCreateFlags.writeBucketPermissions, "write-bucket", "", []string{}, "bucket id")
 
+	authorizationCreateCmd.Flags().StringVarP(&authorizationCreateFlags.orgID, "org-id", "o", "", "organization id the read-buckets and write-buckets permissions are scoped to")
+	authorizationCreateCmd.Flags().BoolVarP(&authorizationCreateFlags.readBucketsPermission, "read-buckets", "", false, "grants the permission to read all buckets in the organization")
+	authorizationCreateCmd.Flags().BoolVarP(&authorizationCreateFlags.writeBucketsPermission, "write-buckets", "", false, "grants the permission to write to all buckets in the organization")
+
 	authorizationCmd.AddCommand(authorizationCreateCmd)
 }
 
@@ -80,6 +88,20 @@ func authorizationCreateF(cmd *cobra.Command, args []string) {
 		permissions = append(permissions, platform.ReadBucketPermission(id))
 	}
 
+	if authorizationCreateFlags.readBucketsPermission || authorizationCreateFlags.writeBucketsPermission {
+		var orgID platform.ID
+		if err := orgID.DecodeFromString(authorizationCreateFlags.orgID); err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		if authorizationCreateFlags.readBucketsPermission {
+			permissions = append(permissions, platform.NewPermission(platform.ReadAction, platform.BucketResourceType, orgID))
+		}
+		if authorizationCreateFlags.writeBucketsPermission {
+			permissions = append(permissions, platform.NewPermission(platform.WriteAction, platform.BucketResourceType, orgID))
+		}
+	}
+
 	authorization := &platform.Authorization{
 		User:        authorizationCreateFlags.user,
 		Permissions: permissions,
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/bolt"
+	"github.com/influxdata/platform/http"
+	"github.com/spf13/cobra"
+)
+
+var backupCmd = &cobra.Command{
+	Use:   "backup",
+	Short: "Backup the data of a running influxdb",
+	Long: `Write a tar archive of a consistent snapshot of the metadata store
+		and of the storage engine, optionally restricted to the data of a
+		single organization or bucket`,
+	Args: cobra.NoArgs,
+	RunE: backupF,
+}
+
+var backupFlags struct {
+	Path     string
+	OrgID    string
+	Org      string
+	BucketID string
+	Bucket   string
+}
+
+func init() {
+	backupCmd.Flags().StringVarP(&backupFlags.Path, "path", "p", "", "path of the archive to write")
+	backupCmd.MarkFlagRequired("path")
+	backupCmd.Flags().StringVar(&backupFlags.OrgID, "org-id", "", "id of the organization to backup the data of")
+	backupCmd.Flags().StringVarP(&backupFlags.Org, "org", "o", "", "name of the organization to backup the data of")
+	backupCmd.Flags().StringVar(&backupFlags.BucketID, "bucket-id", "", "id of the bucket to backup the data of")
+	backupCmd.Flags().StringVarP(&backupFlags.Bucket, "bucket", "b", "", "name of the bucket to backup the data of")
+}
+
+func backupF(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	if backupFlags.Org != "" && backupFlags.OrgID != "" {
+		cmd.Usage()
+		return fmt.Errorf("please specify one of org or org-id")
+	}
+
+	if backupFlags.Bucket != "" && backupFlags.BucketID != "" {
+		cmd.Usage()
+		return fmt.Errorf("please specify one of bucket or bucket-id")
+	}
+
+	filter, err := backupFilter(ctx)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(backupFlags.Path, os.O_CREATE|os.O_WRONLY|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	s := &http.BackupService{
+		Addr:  flags.host,
+		Token: flags.token,
+	}
+
+	if err := s.Backup(ctx, f, filter); err != nil {
+		f.Close()
+		os.Remove(backupFlags.Path)
+		return err
+	}
+	return f.Close()
+}
+
+// backupFilter finds the organization and bucket of the flags.
+func backupFilter(ctx context.Context) (platform.BackupFilter, error) {
+	var filter platform.BackupFilter
+
+	if backupFlags.Bucket != "" || backupFlags.BucketID != "" {
+		bs := &http.BucketService{
+			Addr:  flags.host,
+			Token: flags.token,
+		}
+
+		bf := platform.BucketFilter{}
+		if backupFlags.BucketID != "" {
+			id, err := platform.IDFromString(backupFlags.BucketID)
+			if err != nil {
+				return filter, err
+			}
+			bf.ID = id
+		}
+		if backupFlags.Bucket != "" {
+			bf.Name = &backupFlags.Bucket
+		}
+		if backupFlags.OrgID != "" {
+			id, err := platform.IDFromString(backupFlags.OrgID)
+			if err != nil {
+				return filter, err
+			}
+			bf.OrganizationID = id
+		}
+		if backupFlags.Org != "" {
+			bf.Organization = &backupFlags.Org
+		}
+
+		buckets, n, err := bs.FindBuckets(ctx, bf)
+		if err != nil {
+			return filter, err
+		}
+		if n == 0 {
+			return filter, fmt.Errorf("bucket does not exist")
+		}
+
+		filter.OrganizationID = &buckets[0].OrganizationID
+		filter.BucketID = &buckets[0].ID
+		return filter, nil
+	}
+
+	if backupFlags.OrgID != "" {
+		id, err := platform.IDFromString(backupFlags.OrgID)
+		if err != nil {
+			return filter, err
+		}
+		filter.OrganizationID = id
+	}
+
+	if backupFlags.Org != "" {
+		orgSvc := &http.OrganizationService{
+			Addr:     flags.host,
+			Token:    flags.token,
+			OpPrefix: bolt.OpPrefix,
+		}
+
+		o, err := orgSvc.FindOrganization(ctx, platform.OrganizationFilter{Name: &backupFlags.Org})
+		if err != nil {
+			return filter, err
+		}
+		filter.OrganizationID = &o.ID
+	}
+
+	return filter, nil
+}
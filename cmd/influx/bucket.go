@@ -31,6 +31,8 @@ type BucketCreateFlags struct {
 	org       string
 	orgID     string
 	retention time.Duration
+
+	shardGroupDuration time.Duration
 }
 
 var bucketCreateFlags BucketCreateFlags
@@ -44,6 +46,7 @@ func init() {
 
 	bucketCreateCmd.Flags().StringVarP(&bucketCreateFlags.name, "name", "n", "", "name of bucket that will be created")
 	bucketCreateCmd.Flags().DurationVarP(&bucketCreateFlags.retention, "retention", "r", 0, "duration in nanoseconds data will live in bucket")
+	bucketCreateCmd.Flags().DurationVar(&bucketCreateFlags.shardGroupDuration, "shard-group-duration", 0, "time span covered by each group of stored data; defaults based on retention")
 	bucketCreateCmd.Flags().StringVarP(&bucketCreateFlags.org, "org", "o", "", "name of the organization that owns the bucket")
 	bucketCreateCmd.Flags().StringVarP(&bucketCreateFlags.orgID, "org-id", "", "", "id of the organization that owns the bucket")
 	bucketCreateCmd.MarkFlagRequired("name")
@@ -86,8 +89,9 @@ func bucketCreateF(cmd *cobra.Command, args []string) {
 	}
 
 	b := &platform.Bucket{
-		Name:            bucketCreateFlags.name,
-		RetentionPeriod: bucketCreateFlags.retention,
+		Name:               bucketCreateFlags.name,
+		RetentionPeriod:    bucketCreateFlags.retention,
+		ShardGroupDuration: bucketCreateFlags.shardGroupDuration,
 	}
 
 	if bucketCreateFlags.org != "" {
@@ -113,15 +117,17 @@ func bucketCreateF(cmd *cobra.Command, args []string) {
 		"ID",
 		"Name",
 		"Retention",
+		"ShardGroupDuration",
 		"Organization",
 		"OrganizationID",
 	)
 	w.Write(map[string]interface{}{
-		"ID":             b.ID.String(),
-		"Name":           b.Name,
-		"Retention":      b.RetentionPeriod,
-		"Organization":   b.Organization,
-		"OrganizationID": b.OrganizationID.String(),
+		"ID":                 b.ID.String(),
+		"Name":               b.Name,
+		"Retention":          b.RetentionPeriod,
+		"ShardGroupDuration": b.ShardGroupDurationOrDefault(),
+		"Organization":       b.Organization,
+		"OrganizationID":     b.OrganizationID.String(),
 	})
 	w.Flush()
 }
@@ -202,16 +208,18 @@ func bucketFindF(cmd *cobra.Command, args []string) {
 		"ID",
 		"Name",
 		"Retention",
+		"ShardGroupDuration",
 		"Organization",
 		"OrganizationID",
 	)
 	for _, b := range buckets {
 		w.Write(map[string]interface{}{
-			"ID":             b.ID.String(),
-			"Name":           b.Name,
-			"Retention":      b.RetentionPeriod,
-			"Organization":   b.Organization,
-			"OrganizationID": b.OrganizationID.String(),
+			"ID":                 b.ID.String(),
+			"Name":               b.Name,
+			"Retention":          b.RetentionPeriod,
+			"ShardGroupDuration": b.ShardGroupDurationOrDefault(),
+			"Organization":       b.Organization,
+			"OrganizationID":     b.OrganizationID.String(),
 		})
 	}
 	w.Flush()
@@ -222,6 +230,8 @@ type BucketUpdateFlags struct {
 	id        string
 	name      string
 	retention time.Duration
+
+	shardGroupDuration time.Duration
 }
 
 var bucketUpdateFlags BucketUpdateFlags
@@ -236,6 +246,7 @@ func init() {
 	bucketUpdateCmd.Flags().StringVarP(&bucketUpdateFlags.id, "id", "i", "", "bucket ID (required)")
 	bucketUpdateCmd.Flags().StringVarP(&bucketUpdateFlags.name, "name", "n", "", "new bucket name")
 	bucketUpdateCmd.Flags().DurationVarP(&bucketUpdateFlags.retention, "retention", "r", 0, "new duration data will live in bucket")
+	bucketUpdateCmd.Flags().DurationVar(&bucketUpdateFlags.shardGroupDuration, "shard-group-duration", 0, "new time span covered by each group of stored data")
 	bucketUpdateCmd.MarkFlagRequired("id")
 
 	bucketCmd.AddCommand(bucketUpdateCmd)
@@ -261,6 +272,9 @@ func bucketUpdateF(cmd *cobra.Command, args []string) {
 	if bucketUpdateFlags.retention != 0 {
 		update.RetentionPeriod = &bucketUpdateFlags.retention
 	}
+	if bucketUpdateFlags.shardGroupDuration != 0 {
+		update.ShardGroupDuration = &bucketUpdateFlags.shardGroupDuration
+	}
 
 	b, err := s.UpdateBucket(context.Background(), id, update)
 	if err != nil {
@@ -273,15 +287,17 @@ func bucketUpdateF(cmd *cobra.Command, args []string) {
 		"ID",
 		"Name",
 		"Retention",
+		"ShardGroupDuration",
 		"Organization",
 		"OrganizationID",
 	)
 	w.Write(map[string]interface{}{
-		"ID":             b.ID.String(),
-		"Name":           b.Name,
-		"Retention":      b.RetentionPeriod,
-		"Organization":   b.Organization,
-		"OrganizationID": b.OrganizationID.String(),
+		"ID":                 b.ID.String(),
+		"Name":               b.Name,
+		"Retention":          b.RetentionPeriod,
+		"ShardGroupDuration": b.ShardGroupDurationOrDefault(),
+		"Organization":       b.Organization,
+		"OrganizationID":     b.OrganizationID.String(),
 	})
 	w.Flush()
 }
@@ -323,17 +339,19 @@ func bucketDeleteF(cmd *cobra.Command, args []string) {
 		"ID",
 		"Name",
 		"Retention",
+		"ShardGroupDuration",
 		"Organization",
 		"OrganizationID",
 		"Deleted",
 	)
 	w.Write(map[string]interface{}{
-		"ID":             b.ID.String(),
-		"Name":           b.Name,
-		"Retention":      b.RetentionPeriod,
-		"Organization":   b.Organization,
-		"OrganizationID": b.OrganizationID.String(),
-		"Deleted":        true,
+		"ID":                 b.ID.String(),
+		"Name":               b.Name,
+		"Retention":          b.RetentionPeriod,
+		"ShardGroupDuration": b.ShardGroupDurationOrDefault(),
+		"Organization":       b.Organization,
+		"OrganizationID":     b.OrganizationID.String(),
+		"Deleted":            true,
 	})
 	w.Flush()
 }
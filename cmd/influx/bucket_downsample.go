@@ -0,0 +1,353 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/cmd/influx/internal"
+	"github.com/influxdata/platform/http"
+	"github.com/spf13/cobra"
+)
+
+// Bucket Downsample Command
+var bucketDownsampleCmd = &cobra.Command{
+	Use:   "downsample",
+	Short: "downsample policy related commands",
+	Run:   bucketDownsampleF,
+}
+
+func bucketDownsampleF(cmd *cobra.Command, args []string) {
+	if flags.local {
+		fmt.Println("Local flag not supported for downsample command")
+		os.Exit(1)
+	}
+
+	cmd.Usage()
+}
+
+func init() {
+	bucketCmd.AddCommand(bucketDownsampleCmd)
+}
+
+func newDownsamplePolicyService(f Flags) (platform.DownsamplePolicyService, error) {
+	if f.local {
+		return nil, fmt.Errorf("local flag not supported for downsample command")
+	}
+	return &http.DownsamplePolicyService{
+		Addr:  f.host,
+		Token: f.token,
+	}, nil
+}
+
+// parseFieldOverrides parses overrides of the form field=fn1,fn2.
+func parseFieldOverrides(overrides []string) (map[string][]string, error) {
+	if len(overrides) == 0 {
+		return nil, nil
+	}
+
+	m := make(map[string][]string, len(overrides))
+	for _, o := range overrides {
+		parts := strings.SplitN(o, "=", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("invalid field override %q; expected field=fn1,fn2", o)
+		}
+		m[parts[0]] = strings.Split(parts[1], ",")
+	}
+	return m, nil
+}
+
+func formatFieldOverrides(overrides map[string][]string) string {
+	fields := make([]string, 0, len(overrides))
+	for field := range overrides {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	parts := make([]string, len(fields))
+	for i, field := range fields {
+		parts[i] = field + "=" + strings.Join(overrides[field], ",")
+	}
+	return strings.Join(parts, " ")
+}
+
+func writeDownsamplePolicies(ps ...*platform.DownsamplePolicy) {
+	w := internal.NewTabWriter(os.Stdout)
+	w.WriteHeaders(
+		"ID",
+		"Name",
+		"OrganizationID",
+		"SourceBucketID",
+		"DestinationBucketID",
+		"Window",
+		"Functions",
+		"FieldOverrides",
+	)
+	for _, p := range ps {
+		w.Write(map[string]interface{}{
+			"ID":                  p.ID.String(),
+			"Name":                p.Name,
+			"OrganizationID":      p.OrganizationID.String(),
+			"SourceBucketID":      p.SourceBucketID.String(),
+			"DestinationBucketID": p.DestinationBucketID.String(),
+			"Window":              p.Window,
+			"Functions":           strings.Join(p.Functions, ","),
+			"FieldOverrides":      formatFieldOverrides(p.FieldOverrides),
+		})
+	}
+	w.Flush()
+}
+
+// BucketDownsampleCreateFlags define the Create Command
+type BucketDownsampleCreateFlags struct {
+	name           string
+	orgID          string
+	sourceBucketID string
+	destBucketID   string
+	window         time.Duration
+	functions      []string
+	overrides      []string
+}
+
+var bucketDownsampleCreateFlags BucketDownsampleCreateFlags
+
+func init() {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create downsample policy",
+		Run:   bucketDownsampleCreateF,
+	}
+
+	cmd.Flags().StringVarP(&bucketDownsampleCreateFlags.name, "name", "n", "", "name of the downsample policy (required)")
+	cmd.Flags().StringVarP(&bucketDownsampleCreateFlags.orgID, "org-id", "", "", "id of the organization that owns the policy (required)")
+	cmd.Flags().StringVarP(&bucketDownsampleCreateFlags.sourceBucketID, "source-bucket-id", "", "", "id of the bucket to downsample (required)")
+	cmd.Flags().StringVarP(&bucketDownsampleCreateFlags.destBucketID, "dest-bucket-id", "", "", "id of the bucket downsampled data is written to (required)")
+	cmd.Flags().DurationVarP(&bucketDownsampleCreateFlags.window, "window", "w", 0, "width of the windows data is aggregated over (required)")
+	cmd.Flags().StringSliceVarP(&bucketDownsampleCreateFlags.functions, "functions", "f", nil, "aggregate functions applied to every field (required)")
+	cmd.Flags().StringArrayVarP(&bucketDownsampleCreateFlags.overrides, "override", "", nil, "aggregate functions for a single field, as field=fn1,fn2")
+	cmd.MarkFlagRequired("name")
+	cmd.MarkFlagRequired("org-id")
+	cmd.MarkFlagRequired("source-bucket-id")
+	cmd.MarkFlagRequired("dest-bucket-id")
+	cmd.MarkFlagRequired("window")
+	cmd.MarkFlagRequired("functions")
+
+	bucketDownsampleCmd.AddCommand(cmd)
+}
+
+func bucketDownsampleCreateF(cmd *cobra.Command, args []string) {
+	s, err := newDownsamplePolicyService(flags)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	p := &platform.DownsamplePolicy{
+		Name:      bucketDownsampleCreateFlags.name,
+		Window:    bucketDownsampleCreateFlags.window,
+		Functions: bucketDownsampleCreateFlags.functions,
+	}
+
+	for _, f := range []struct {
+		name string
+		s    string
+		id   *platform.ID
+	}{
+		{"organization", bucketDownsampleCreateFlags.orgID, &p.OrganizationID},
+		{"source bucket", bucketDownsampleCreateFlags.sourceBucketID, &p.SourceBucketID},
+		{"destination bucket", bucketDownsampleCreateFlags.destBucketID, &p.DestinationBucketID},
+	} {
+		if err := f.id.DecodeFromString(f.s); err != nil {
+			fmt.Printf("error parsing %s id: %v\n", f.name, err)
+			os.Exit(1)
+		}
+	}
+
+	if p.FieldOverrides, err = parseFieldOverrides(bucketDownsampleCreateFlags.overrides); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	if err := s.CreateDownsamplePolicy(context.Background(), p); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	writeDownsamplePolicies(p)
+}
+
+// BucketDownsampleFindFlags define the Find Command
+type BucketDownsampleFindFlags struct {
+	id             string
+	orgID          string
+	sourceBucketID string
+}
+
+var bucketDownsampleFindFlags BucketDownsampleFindFlags
+
+func init() {
+	cmd := &cobra.Command{
+		Use:   "find",
+		Short: "Find downsample policies",
+		Run:   bucketDownsampleFindF,
+	}
+
+	cmd.Flags().StringVarP(&bucketDownsampleFindFlags.id, "id", "i", "", "downsample policy ID")
+	cmd.Flags().StringVarP(&bucketDownsampleFindFlags.orgID, "org-id", "", "", "downsample policy organization ID")
+	cmd.Flags().StringVarP(&bucketDownsampleFindFlags.sourceBucketID, "source-bucket-id", "", "", "ID of the bucket being downsampled")
+
+	bucketDownsampleCmd.AddCommand(cmd)
+}
+
+func bucketDownsampleFindF(cmd *cobra.Command, args []string) {
+	s, err := newDownsamplePolicyService(flags)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	filter := platform.DownsamplePolicyFilter{}
+	for _, f := range []struct {
+		s  string
+		id **platform.ID
+	}{
+		{bucketDownsampleFindFlags.id, &filter.ID},
+		{bucketDownsampleFindFlags.orgID, &filter.OrganizationID},
+		{bucketDownsampleFindFlags.sourceBucketID, &filter.SourceBucketID},
+	} {
+		if f.s == "" {
+			continue
+		}
+		id, err := platform.IDFromString(f.s)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		*f.id = id
+	}
+
+	ps, _, err := s.FindDownsamplePolicies(context.Background(), filter)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	writeDownsamplePolicies(ps...)
+}
+
+// BucketDownsampleUpdateFlags define the Update Command
+type BucketDownsampleUpdateFlags struct {
+	id        string
+	name      string
+	window    time.Duration
+	functions []string
+	overrides []string
+}
+
+var bucketDownsampleUpdateFlags BucketDownsampleUpdateFlags
+
+func init() {
+	cmd := &cobra.Command{
+		Use:   "update",
+		Short: "Update downsample policy",
+		Run:   bucketDownsampleUpdateF,
+	}
+
+	cmd.Flags().StringVarP(&bucketDownsampleUpdateFlags.id, "id", "i", "", "downsample policy ID (required)")
+	cmd.Flags().StringVarP(&bucketDownsampleUpdateFlags.name, "name", "n", "", "new downsample policy name")
+	cmd.Flags().DurationVarP(&bucketDownsampleUpdateFlags.window, "window", "w", 0, "new width of the windows data is aggregated over")
+	cmd.Flags().StringSliceVarP(&bucketDownsampleUpdateFlags.functions, "functions", "f", nil, "new aggregate functions applied to every field")
+	cmd.Flags().StringArrayVarP(&bucketDownsampleUpdateFlags.overrides, "override", "", nil, "new aggregate functions for a single field, as field=fn1,fn2; replaces all overrides")
+	cmd.MarkFlagRequired("id")
+
+	bucketDownsampleCmd.AddCommand(cmd)
+}
+
+func bucketDownsampleUpdateF(cmd *cobra.Command, args []string) {
+	s, err := newDownsamplePolicyService(flags)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	var id platform.ID
+	if err := id.DecodeFromString(bucketDownsampleUpdateFlags.id); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	update := platform.DownsamplePolicyUpdate{}
+	if bucketDownsampleUpdateFlags.name != "" {
+		update.Name = &bucketDownsampleUpdateFlags.name
+	}
+	if bucketDownsampleUpdateFlags.window != 0 {
+		update.Window = &bucketDownsampleUpdateFlags.window
+	}
+	if len(bucketDownsampleUpdateFlags.functions) > 0 {
+		update.Functions = bucketDownsampleUpdateFlags.functions
+	}
+	if update.FieldOverrides, err = parseFieldOverrides(bucketDownsampleUpdateFlags.overrides); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	p, err := s.UpdateDownsamplePolicy(context.Background(), id, update)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	writeDownsamplePolicies(p)
+}
+
+// BucketDownsampleDeleteFlags define the Delete command
+type BucketDownsampleDeleteFlags struct {
+	id string
+}
+
+var bucketDownsampleDeleteFlags BucketDownsampleDeleteFlags
+
+func init() {
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete downsample policy and its tasks",
+		Run:   bucketDownsampleDeleteF,
+	}
+
+	cmd.Flags().StringVarP(&bucketDownsampleDeleteFlags.id, "id", "i", "", "downsample policy id (required)")
+	cmd.MarkFlagRequired("id")
+
+	bucketDownsampleCmd.AddCommand(cmd)
+}
+
+func bucketDownsampleDeleteF(cmd *cobra.Command, args []string) {
+	s, err := newDownsamplePolicyService(flags)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	var id platform.ID
+	if err := id.DecodeFromString(bucketDownsampleDeleteFlags.id); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	ctx := context.Background()
+	p, err := s.FindDownsamplePolicyByID(ctx, id)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	if err := s.DeleteDownsamplePolicy(ctx, id); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	writeDownsamplePolicies(p)
+}
@@ -0,0 +1,233 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/cmd/influx/internal"
+	"github.com/influxdata/platform/http"
+	"github.com/spf13/cobra"
+)
+
+// Dashboard Command
+var dashboardCmd = &cobra.Command{
+	Use:   "dashboard",
+	Short: "dashboard related commands",
+	Run:   dashboardF,
+}
+
+func dashboardF(cmd *cobra.Command, args []string) {
+	cmd.Usage()
+}
+
+// DashboardExportFlags define the Export Command
+type DashboardExportFlags struct {
+	id   string
+	path string
+}
+
+var dashboardExportFlags DashboardExportFlags
+
+func init() {
+	dashboardExportCmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export a dashboard, its views, macros and labels as a JSON template",
+		Run:   dashboardExportF,
+	}
+
+	dashboardExportCmd.Flags().StringVarP(&dashboardExportFlags.id, "id", "i", "", "id of the dashboard to export")
+	dashboardExportCmd.Flags().StringVarP(&dashboardExportFlags.path, "path", "p", "", "path of the template to write; defaults to stdout")
+	dashboardExportCmd.MarkFlagRequired("id")
+
+	dashboardCmd.AddCommand(dashboardExportCmd)
+}
+
+func newDashboardTemplateService(f Flags) platform.DashboardTemplateService {
+	return &http.DashboardTemplateService{
+		Addr:  f.host,
+		Token: f.token,
+	}
+}
+
+func dashboardExportF(cmd *cobra.Command, args []string) {
+	id, err := platform.IDFromString(dashboardExportFlags.id)
+	if err != nil {
+		fmt.Printf("error parsing dashboard id: %v\n", err)
+		os.Exit(1)
+	}
+
+	s := newDashboardTemplateService(flags)
+	t, err := s.ExportDashboard(context.Background(), *id)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	var w io.Writer = os.Stdout
+	if dashboardExportFlags.path != "" {
+		f, err := os.OpenFile(dashboardExportFlags.path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		defer f.Close()
+		w = f
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(t); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
+
+// DashboardImportFlags define the Import Command
+type DashboardImportFlags struct {
+	path string
+}
+
+var dashboardImportFlags DashboardImportFlags
+
+func init() {
+	dashboardImportCmd := &cobra.Command{
+		Use:   "import",
+		Short: "Create a dashboard from a JSON template",
+		Run:   dashboardImportF,
+	}
+
+	dashboardImportCmd.Flags().StringVarP(&dashboardImportFlags.path, "path", "p", "", "path of the template to import; defaults to stdin")
+
+	dashboardCmd.AddCommand(dashboardImportCmd)
+}
+
+func dashboardImportF(cmd *cobra.Command, args []string) {
+	var r io.Reader = os.Stdin
+	if dashboardImportFlags.path != "" {
+		f, err := os.Open(dashboardImportFlags.path)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		defer f.Close()
+		r = f
+	}
+
+	var t platform.DashboardTemplate
+	if err := json.NewDecoder(r).Decode(&t); err != nil {
+		fmt.Printf("error decoding dashboard template: %v\n", err)
+		os.Exit(1)
+	}
+
+	s := newDashboardTemplateService(flags)
+	d, err := s.ImportDashboard(context.Background(), &t)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	w := internal.NewTabWriter(os.Stdout)
+	w.WriteHeaders(
+		"ID",
+		"Name",
+		"Cells",
+	)
+	w.Write(map[string]interface{}{
+		"ID":    d.ID.String(),
+		"Name":  d.Name,
+		"Cells": len(d.Cells),
+	})
+	w.Flush()
+}
+
+func init() {
+	dashboardTemplatesCmd := &cobra.Command{
+		Use:   "templates",
+		Short: "List the canned layouts dashboards can be created from",
+		Run:   dashboardTemplatesF,
+	}
+
+	dashboardCmd.AddCommand(dashboardTemplatesCmd)
+}
+
+func newDashboardLayoutService(f Flags) platform.DashboardLayoutService {
+	return &http.DashboardLayoutService{
+		Addr:  f.host,
+		Token: f.token,
+	}
+}
+
+func dashboardTemplatesF(cmd *cobra.Command, args []string) {
+	s := newDashboardLayoutService(flags)
+	ls, err := s.FindDashboardLayouts(context.Background())
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	w := internal.NewTabWriter(os.Stdout)
+	w.WriteHeaders(
+		"ID",
+		"App",
+		"Measurement",
+		"Cells",
+	)
+	for _, l := range ls {
+		w.Write(map[string]interface{}{
+			"ID":          l.ID,
+			"App":         l.Application,
+			"Measurement": l.Measurement,
+			"Cells":       l.Cells,
+		})
+	}
+	w.Flush()
+}
+
+// DashboardInstantiateFlags define the Instantiate Command
+type DashboardInstantiateFlags struct {
+	id     string
+	bucket string
+}
+
+var dashboardInstantiateFlags DashboardInstantiateFlags
+
+func init() {
+	dashboardInstantiateCmd := &cobra.Command{
+		Use:   "instantiate",
+		Short: "Create a dashboard from a canned layout querying a bucket",
+		Run:   dashboardInstantiateF,
+	}
+
+	dashboardInstantiateCmd.Flags().StringVarP(&dashboardInstantiateFlags.id, "id", "i", "", "id of the layout")
+	dashboardInstantiateCmd.Flags().StringVarP(&dashboardInstantiateFlags.bucket, "bucket", "b", "", "name of the bucket queried by the dashboard")
+	dashboardInstantiateCmd.MarkFlagRequired("id")
+	dashboardInstantiateCmd.MarkFlagRequired("bucket")
+
+	dashboardCmd.AddCommand(dashboardInstantiateCmd)
+}
+
+func dashboardInstantiateF(cmd *cobra.Command, args []string) {
+	s := newDashboardLayoutService(flags)
+	d, err := s.CreateDashboardFromLayout(context.Background(), dashboardInstantiateFlags.id, dashboardInstantiateFlags.bucket)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	w := internal.NewTabWriter(os.Stdout)
+	w.WriteHeaders(
+		"ID",
+		"Name",
+		"Cells",
+	)
+	w.Write(map[string]interface{}{
+		"ID":    d.ID.String(),
+		"Name":  d.Name,
+		"Cells": len(d.Cells),
+	})
+	w.Flush()
+}
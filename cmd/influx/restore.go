@@ -0,0 +1,78 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/backup"
+	"github.com/influxdata/platform/internal/fs"
+	"github.com/influxdata/platform/logger"
+	"github.com/spf13/cobra"
+)
+
+var restoreCmd = &cobra.Command{
+	Use:   "restore",
+	Short: "Restore the data directory of influxdb from a backup",
+	Long: `Rebuild the bolt file and the storage engine of influxd from an
+		archive written by influx backup, optionally restricted to the data
+		of a single organization or bucket. influxd must not be running,
+		and neither the bolt file nor the storage engine may exist`,
+	Args: cobra.NoArgs,
+	RunE: restoreF,
+}
+
+var restoreFlags struct {
+	Path       string
+	BoltPath   string
+	EnginePath string
+	OrgID      string
+	BucketID   string
+}
+
+func init() {
+	dir, err := fs.InfluxDir()
+	if err != nil {
+		dir = "."
+	}
+
+	restoreCmd.Flags().StringVarP(&restoreFlags.Path, "path", "p", "", "path of the archive to restore")
+	restoreCmd.MarkFlagRequired("path")
+	restoreCmd.Flags().StringVar(&restoreFlags.BoltPath, "bolt-path", filepath.Join(dir, "influxd.bolt"), "path the boltdb database is restored to")
+	restoreCmd.Flags().StringVar(&restoreFlags.EnginePath, "engine-path", filepath.Join(dir, "engine"), "path the engine files are restored to")
+	restoreCmd.Flags().StringVar(&restoreFlags.OrgID, "org-id", "", "id of the organization to restore the data of")
+	restoreCmd.Flags().StringVar(&restoreFlags.BucketID, "bucket-id", "", "id of the bucket to restore the data of")
+}
+
+func restoreF(cmd *cobra.Command, args []string) error {
+	var filter platform.BackupFilter
+	if restoreFlags.OrgID != "" {
+		id, err := platform.IDFromString(restoreFlags.OrgID)
+		if err != nil {
+			return err
+		}
+		filter.OrganizationID = id
+	}
+	if restoreFlags.BucketID != "" {
+		id, err := platform.IDFromString(restoreFlags.BucketID)
+		if err != nil {
+			return err
+		}
+		filter.BucketID = id
+	}
+
+	f, err := os.Open(restoreFlags.Path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	r := backup.NewRestorer(restoreFlags.BoltPath, restoreFlags.EnginePath)
+	r.Logger = logger.New(os.Stderr)
+	if err := r.Restore(context.Background(), f, filter); err != nil {
+		return fmt.Errorf("failed to restore %q: %v", restoreFlags.Path, err)
+	}
+	return nil
+}
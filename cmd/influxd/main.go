@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"net"
 	nethttp "net/http"
 	_ "net/http/pprof"
@@ -15,8 +16,13 @@ import (
 	"github.com/influxdata/flux/control"
 	"github.com/influxdata/flux/execute"
 	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/authorizer"
+	"github.com/influxdata/platform/backup"
 	"github.com/influxdata/platform/bolt"
+	"github.com/influxdata/platform/chronograf"
+	"github.com/influxdata/platform/chronograf/canned"
 	"github.com/influxdata/platform/chronograf/server"
+	"github.com/influxdata/platform/downsample"
 	"github.com/influxdata/platform/gather"
 	"github.com/influxdata/platform/http"
 	"github.com/influxdata/platform/internal/fs"
@@ -31,6 +37,7 @@ import (
 	"github.com/influxdata/platform/snowflake"
 	"github.com/influxdata/platform/source"
 	"github.com/influxdata/platform/storage"
+	"github.com/influxdata/platform/storage/queue"
 	"github.com/influxdata/platform/storage/readservice"
 	"github.com/influxdata/platform/task"
 	taskbackend "github.com/influxdata/platform/task/backend"
@@ -39,6 +46,7 @@ import (
 	taskexecutor "github.com/influxdata/platform/task/backend/executor"
 	_ "github.com/influxdata/platform/tsdb/tsi1"
 	_ "github.com/influxdata/platform/tsdb/tsm1"
+	"github.com/influxdata/platform/usage"
 	pzap "github.com/influxdata/platform/zap"
 	"github.com/opentracing/opentracing-go"
 	"github.com/prometheus/client_golang/prometheus"
@@ -76,12 +84,19 @@ type Main struct {
 	logLevel        string
 	httpBindAddress string
 	boltPath        string
+	secretKeyPath   string
+	secretKeys      string
 	natsPath        string
 	developerMode   bool
 	enginePath      string
+	maxWriteBody    int
+	writeQueue      bool
+	maxBucketSeries int
+	maxOrgSeries    int
 
-	boltClient *bolt.Client
-	engine     *storage.Engine
+	boltClient   *bolt.Client
+	engine       *storage.Engine
+	usageService *usage.Service
 
 	queryController *pcontrol.Controller
 
@@ -89,6 +104,8 @@ type Main struct {
 	httpServer *nethttp.Server
 
 	natsServer *nats.Server
+	// natsClients are the connections to the NATS server, closed before it.
+	natsClients []io.Closer
 
 	scheduler *taskbackend.TickScheduler
 
@@ -121,8 +138,18 @@ func (m *Main) Shutdown(ctx context.Context) {
 	m.scheduler.Stop()
 
 	m.logger.Info("Stopping", zap.String("service", "nats"))
+	for _, c := range m.natsClients {
+		if err := c.Close(); err != nil {
+			m.logger.Info("Failed closing nats connection", zap.Error(err))
+		}
+	}
 	m.natsServer.Close()
 
+	m.logger.Info("Stopping", zap.String("service", "usage"))
+	if err := m.usageService.Close(); err != nil {
+		m.logger.Info("Failed closing usage service", zap.Error(err))
+	}
+
 	m.logger.Info("Stopping", zap.String("service", "bolt"))
 	if err := m.boltClient.Close(); err != nil {
 		m.logger.Info("failed closing bolt", zap.Error(err))
@@ -175,6 +202,18 @@ func (m *Main) Run(ctx context.Context, args ...string) error {
 				Default: filepath.Join(dir, "influxd.bolt"),
 				Desc:    "path to boltdb database",
 			},
+			{
+				DestP:   &m.secretKeyPath,
+				Flag:    "secret-key-path",
+				Default: "",
+				Desc:    "path to a file of <version>:<base64 key> secret encryption keys; secrets are encrypted with the highest version",
+			},
+			{
+				DestP:   &m.secretKeys,
+				Flag:    "secret-keys",
+				Default: "",
+				Desc:    "comma separated <version>:<base64 key> secret encryption keys, usually set with the INFLUXD_SECRET_KEYS environment variable",
+			},
 			{
 				DestP:   &m.developerMode,
 				Flag:    "developer-mode",
@@ -193,6 +232,30 @@ func (m *Main) Run(ctx context.Context, args ...string) error {
 				Default: filepath.Join(dir, "engine"),
 				Desc:    "path to persistent engine files",
 			},
+			{
+				DestP:   &m.maxWriteBody,
+				Flag:    "http-max-write-body-size",
+				Default: 0,
+				Desc:    "maximum size in bytes of a write request body; 0 means no limit",
+			},
+			{
+				DestP:   &m.writeQueue,
+				Flag:    "write-queue",
+				Default: false,
+				Desc:    "queue writes in the NATS streaming server and write them to the engine asynchronously; points rejected by the engine are logged rather than reported to writers",
+			},
+			{
+				DestP:   &m.maxBucketSeries,
+				Flag:    "storage-max-series-per-bucket",
+				Default: 0,
+				Desc:    "maximum number of series in a bucket; 0 means no limit",
+			},
+			{
+				DestP:   &m.maxOrgSeries,
+				Flag:    "storage-max-series-per-org",
+				Default: 0,
+				Desc:    "maximum number of series in an organization; 0 means no limit",
+			},
 		},
 	}
 
@@ -201,6 +264,28 @@ func (m *Main) Run(ctx context.Context, args ...string) error {
 	return cmd.Execute()
 }
 
+// loadSecretKeys returns the secret encryption keys of the key file and of the
+// secret-keys option.
+func (m *Main) loadSecretKeys() ([]bolt.SecretKey, error) {
+	keys, err := bolt.ParseSecretKeys(m.secretKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	if m.secretKeyPath != "" {
+		b, err := ioutil.ReadFile(m.secretKeyPath)
+		if err != nil {
+			return nil, err
+		}
+		fileKeys, err := bolt.ParseSecretKeys(string(b))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", m.secretKeyPath, err)
+		}
+		keys = append(keys, fileKeys...)
+	}
+	return keys, nil
+}
+
 func (m *Main) run(ctx context.Context) (err error) {
 	m.running = true
 	ctx, m.cancel = context.WithCancel(ctx)
@@ -234,6 +319,16 @@ func (m *Main) run(ctx context.Context) (err error) {
 	m.boltClient.Path = m.boltPath
 	m.boltClient.WithLogger(m.logger.With(zap.String("service", "bolt")))
 
+	secretKeys, err := m.loadSecretKeys()
+	if err != nil {
+		m.logger.Error("failed loading secret keys", zap.Error(err))
+		return err
+	}
+	if err := m.boltClient.WithSecretKeys(secretKeys...); err != nil {
+		m.logger.Error("invalid secret keys", zap.Error(err))
+		return err
+	}
+
 	if err := m.boltClient.Open(ctx); err != nil {
 		m.logger.Error("failed opening bolt", zap.Error(err))
 		return err
@@ -251,14 +346,18 @@ func (m *Main) run(ctx context.Context) (err error) {
 		basicAuthSvc     platform.BasicAuthService                = m.boltClient
 		dashboardSvc     platform.DashboardService                = m.boltClient
 		dashboardLogSvc  platform.DashboardOperationLogService    = m.boltClient
+		dashboardRevSvc  platform.DashboardRevisionService        = m.boltClient
 		userLogSvc       platform.UserOperationLogService         = m.boltClient
 		bucketLogSvc     platform.BucketOperationLogService       = m.boltClient
 		orgLogSvc        platform.OrganizationOperationLogService = m.boltClient
 		onboardingSvc    platform.OnboardingService               = m.boltClient
 		scraperTargetSvc platform.ScraperTargetStoreService       = m.boltClient
+		secretSvc        platform.SecretService                   = m.boltClient
+		scraperStatusSvc platform.ScraperTargetStatusService      = m.boltClient
 		telegrafSvc      platform.TelegrafConfigStore             = m.boltClient
 		userResourceSvc  platform.UserResourceMappingService      = m.boltClient
 		labelSvc         platform.LabelService                    = m.boltClient
+		auditSvc         platform.AuditLogService                 = m.boltClient
 	)
 
 	chronografSvc, err := server.NewServiceV2(ctx, m.boltClient.DB())
@@ -269,7 +368,14 @@ func (m *Main) run(ctx context.Context) (err error) {
 
 	var pointsWriter storage.PointsWriter
 	{
-		m.engine = storage.NewEngine(m.enginePath, storage.NewConfig(), storage.WithRetentionEnforcer(bucketSvc))
+		config := storage.NewConfig()
+		config.MaxSeriesPerBucket = m.maxBucketSeries
+		config.MaxSeriesPerOrg = m.maxOrgSeries
+		m.engine = storage.NewEngine(m.enginePath, config,
+			storage.WithTimeGroups(bucketSvc),
+			storage.WithBucketSchemas(bucketSvc),
+			storage.WithRetentionEnforcer(bucketSvc),
+		)
 		m.engine.WithLogger(m.logger)
 
 		if err := m.engine.Open(); err != nil {
@@ -280,6 +386,8 @@ func (m *Main) run(ctx context.Context) (err error) {
 		reg.MustRegister(m.engine.PrometheusCollectors()...)
 
 		pointsWriter = m.engine
+		// Apply schema changes to writes as soon as buckets are updated.
+		bucketSvc = storage.NewBucketSchemaService(bucketSvc, m.engine)
 
 		const (
 			concurrencyQuota = 10
@@ -301,9 +409,16 @@ func (m *Main) run(ctx context.Context) (err error) {
 		}
 
 		m.queryController = pcontrol.New(cc)
+		m.queryController.SecretService = secretSvc
 		reg.MustRegister(m.queryController.PrometheusCollectors()...)
 	}
 
+	m.usageService = usage.NewService(m.boltClient, m.engine, m.logger.With(zap.String("service", "usage")))
+	if err := m.usageService.Open(); err != nil {
+		m.logger.Error("failed to open usage service", zap.Error(err))
+		return err
+	}
+
 	var storageQueryService query.ProxyQueryService = readservice.NewProxyQueryService(m.queryController)
 	var taskSvc platform.TaskService
 	{
@@ -313,9 +428,9 @@ func (m *Main) run(ctx context.Context) (err error) {
 			return err
 		}
 
-		executor := taskexecutor.NewAsyncQueryServiceExecutor(m.logger.With(zap.String("service", "task-executor")), m.queryController, boltStore)
+		executor := taskexecutor.NewAsyncQueryServiceExecutor(m.logger.With(zap.String("service", "task-executor")), m.queryController, boltStore, taskexecutor.WithSecretService(secretSvc))
 
-		lw := taskbackend.NewPointLogWriter(pointsWriter)
+		lw := taskbackend.NewRedactingLogWriter(taskbackend.NewPointLogWriter(pointsWriter), secretSvc)
 		m.scheduler = taskbackend.NewScheduler(boltStore, executor, lw, time.Now().UTC().Unix(), taskbackend.WithTicker(ctx, 100*time.Millisecond), taskbackend.WithLogger(m.logger))
 		m.scheduler.Start(ctx)
 		reg.MustRegister(m.scheduler.PrometheusCollectors()...)
@@ -326,6 +441,8 @@ func (m *Main) run(ctx context.Context) (err error) {
 		taskSvc = task.NewValidator(taskSvc, bucketSvc)
 	}
 
+	var downsampleSvc platform.DownsamplePolicyService = downsample.NewService(m.boltClient, authorizer.NewTaskService(taskSvc), bucketSvc)
+
 	// NATS streaming server
 	m.natsServer = nats.NewServer(nats.Config{FilestoreDir: m.natsPath})
 	if err := m.natsServer.Open(); err != nil {
@@ -338,6 +455,7 @@ func (m *Main) run(ctx context.Context) (err error) {
 		m.logger.Error("failed to connect to streaming server", zap.Error(err))
 		return err
 	}
+	m.natsClients = append(m.natsClients, publisher)
 
 	// TODO(jm): this is an example of using a subscriber to consume from the channel. It should be removed.
 	subscriber := nats.NewQueueSubscriber("nats-subscriber")
@@ -345,8 +463,35 @@ func (m *Main) run(ctx context.Context) (err error) {
 		m.logger.Error("failed to connect to streaming server", zap.Error(err))
 		return err
 	}
+	m.natsClients = append(m.natsClients, subscriber)
+
+	writePointsWriter := pointsWriter
+	if m.writeQueue {
+		wqPublisher := nats.NewSyncPublisher("write-queue-publisher")
+		if err := wqPublisher.Open(); err != nil {
+			m.logger.Error("failed to connect to streaming server", zap.Error(err))
+			return err
+		}
+		m.natsClients = append(m.natsClients, wqPublisher)
+
+		// Writes are consumed one at a time so that they are written in order.
+		wqSubscriber := nats.NewQueueSubscriber("write-queue-subscriber")
+		wqSubscriber.MaxInflight = 1
+		if err := wqSubscriber.Open(); err != nil {
+			m.logger.Error("failed to connect to streaming server", zap.Error(err))
+			return err
+		}
+		m.natsClients = append(m.natsClients, wqSubscriber)
+
+		wq := queue.NewWriter(wqPublisher, wqSubscriber, pointsWriter, m.logger.With(zap.String("service", "write-queue")))
+		if err := wq.Open(); err != nil {
+			m.logger.Error("failed to start write queue", zap.Error(err))
+			return err
+		}
+		writePointsWriter = wq
+	}
 
-	scraperScheduler, err := gather.NewScheduler(10, m.logger, scraperTargetSvc, publisher, subscriber, 0, 0)
+	scraperScheduler, err := gather.NewScheduler(10, m.logger, scraperTargetSvc, secretSvc, scraperStatusSvc, bucketSvc, writePointsWriter, publisher, subscriber, nil, 0, 0)
 	if err != nil {
 		m.logger.Error("failed to create scraper subscriber", zap.Error(err))
 		return err
@@ -370,29 +515,40 @@ func (m *Main) run(ctx context.Context) (err error) {
 		Logger:                          m.logger,
 		NewBucketService:                source.NewBucketService,
 		NewQueryService:                 source.NewQueryService,
-		PointsWriter:                    pointsWriter,
+		PointsWriter:                    writePointsWriter,
+		MaxWriteBodySize:                int64(m.maxWriteBody),
+		DeleteService:                   m.engine,
+		BackupService:                   backup.NewService(m.boltClient.DB(), m.engine, m.logger.With(zap.String("service", "backup"))),
 		AuthorizationService:            authSvc,
 		BucketService:                   bucketSvc,
 		SessionService:                  sessionSvc,
 		UserService:                     userSvc,
 		OrganizationService:             orgSvc,
+		SecretService:                   secretSvc,
 		UserResourceMappingService:      userResourceSvc,
 		LabelService:                    labelSvc,
 		DashboardService:                dashboardSvc,
 		DashboardOperationLogService:    dashboardLogSvc,
+		DashboardRevisionService:        dashboardRevSvc,
 		BucketOperationLogService:       bucketLogSvc,
 		UserOperationLogService:         userLogSvc,
 		OrganizationOperationLogService: orgLogSvc,
 		ViewService:                     viewSvc,
 		SourceService:                   sourceSvc,
 		MacroService:                    macroSvc,
+		DownsamplePolicyService:         downsampleSvc,
 		BasicAuthService:                basicAuthSvc,
 		OnboardingService:               onboardingSvc,
 		ProxyQueryService:               storageQueryService,
 		TaskService:                     taskSvc,
 		TelegrafService:                 telegrafSvc,
 		ScraperTargetStoreService:       scraperTargetSvc,
+		ScraperTargetStatusService:      scraperStatusSvc,
+		UsageService:                    m.usageService,
+		UsageRecorder:                   m.usageService,
+		AuditLogService:                 auditSvc,
 		ChronografService:               chronografSvc,
+		DashboardLayoutsStore:           &canned.BinLayoutsStore{Logger: &chronograf.NoopLogger{}},
 	}
 
 	// HTTP server
@@ -7,10 +7,12 @@ import (
 	"io"
 	"io/ioutil"
 	nethttp "net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/influxdata/platform"
@@ -77,6 +79,53 @@ func TestMain_WriteAndQuery(t *testing.T) {
 	}
 }
 
+func TestMain_ScrapeMetrics(t *testing.T) {
+	m := RunMainOrFail(t, ctx)
+	m.SetupOrFail(t)
+	defer m.ShutdownOrFail(t, ctx)
+
+	ts := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		fmt.Fprintln(w, "# TYPE go_goroutines gauge")
+		fmt.Fprintln(w, "go_goroutines 36")
+	}))
+	defer ts.Close()
+
+	target := &platform.ScraperTarget{
+		Name:       "target",
+		Type:       platform.PrometheusScraperType,
+		URL:        ts.URL,
+		OrgName:    m.Org.Name,
+		BucketName: m.Bucket.Name,
+		Interval:   time.Second,
+	}
+	svc := &http.ScraperService{Addr: m.URL(), Token: m.Auth.Token}
+	if err := svc.AddTarget(ctx, target); err != nil {
+		t.Fatal(err)
+	}
+
+	// The scraped metrics, and the up metric describing the scrape,
+	// are written to the bucket of the target.
+	qs := `from(bucket:"BUCKET") |> range(start:-1h) |> filter(fn: (r) => r._measurement == "up" or r._measurement == "go_goroutines") |> last()`
+	req := (http.QueryRequest{Query: qs, Org: m.Org}).WithDefaults()
+	preq, err := req.ProxyRequest()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got string
+	for deadline := time.Now().Add(10 * time.Second); time.Now().Before(deadline); time.Sleep(100 * time.Millisecond) {
+		var buf bytes.Buffer
+		if _, err := m.FluxService().Query(ctx, &buf, preq); err != nil {
+			t.Fatal(err)
+		}
+		got = buf.String()
+		if strings.Contains(got, ",36,gauge,go_goroutines") && strings.Contains(got, ",1,gauge,up,") {
+			return
+		}
+	}
+	t.Fatalf("scraped metrics not found in bucket:\n%s", got)
+}
+
 // Main is a test wrapper for main.Main.
 type Main struct {
 	*main.Main
@@ -0,0 +1,52 @@
+package platform
+
+import (
+	"context"
+	"time"
+)
+
+// ErrDashboardRevisionNotFound is the error for a missing dashboard revision.
+const ErrDashboardRevisionNotFound = ChronografError("dashboard revision not found")
+
+// ops for dashboard revision errors.
+const (
+	OpFindDashboardRevisions   = "FindDashboardRevisions"
+	OpFindDashboardRevision    = "FindDashboardRevision"
+	OpRestoreDashboardRevision = "RestoreDashboardRevision"
+)
+
+// DashboardRevisionService is the history of the states of dashboards. A
+// revision is recorded by every mutation of a dashboard, its cells or the
+// views of its cells. Implementations may prune old revisions.
+type DashboardRevisionService interface {
+	// FindDashboardRevisions returns the revisions of the dashboard and their
+	// count. Additional options provide pagination and ordering.
+	FindDashboardRevisions(ctx context.Context, id ID, opts FindOptions) ([]*DashboardRevision, int, error)
+
+	// FindDashboardRevision returns a single revision of the dashboard.
+	FindDashboardRevision(ctx context.Context, id ID, revision int) (*DashboardRevision, error)
+
+	// RestoreDashboardRevision rolls the dashboard and the views of its cells
+	// back to the revision, recording the restored state as a new revision.
+	RestoreDashboardRevision(ctx context.Context, id ID, revision int) (*Dashboard, error)
+}
+
+// DashboardRevision is the state of a dashboard and the views of its cells
+// after a mutation.
+type DashboardRevision struct {
+	// Revision numbers the revisions of a dashboard from 1.
+	Revision    int        `json:"revision"`
+	DashboardID ID         `json:"dashboardID"`
+	Description string     `json:"description"`
+	UserID      ID         `json:"userID,omitempty"`
+	Time        time.Time  `json:"time"`
+	Dashboard   *Dashboard `json:"dashboard"`
+	Views       []*View    `json:"views"`
+}
+
+// DefaultDashboardRevisionFindOptions are the default options for listing
+// dashboard revisions, the latest first.
+var DefaultDashboardRevisionFindOptions = FindOptions{
+	Descending: true,
+	Limit:      100,
+}
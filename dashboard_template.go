@@ -0,0 +1,112 @@
+package platform
+
+import (
+	"context"
+	"fmt"
+)
+
+// ops for dashboard template errors.
+const (
+	OpExportDashboard = "ExportDashboard"
+	OpImportDashboard = "ImportDashboard"
+)
+
+// DashboardTemplateVersion is the version of the dashboard templates written by
+// ExportDashboard. Templates of other versions are not imported.
+const DashboardTemplateVersion = 1
+
+// DashboardTemplateService exports dashboards as portable templates and imports
+// them as new dashboards.
+type DashboardTemplateService interface {
+	// ExportDashboard returns a template of the dashboard, its views, the macros
+	// referenced by their queries and its labels.
+	ExportDashboard(ctx context.Context, id ID) (*DashboardTemplate, error)
+
+	// ImportDashboard creates a dashboard from the template. The dashboard, its
+	// cells and views get new IDs, and macros are created unless a macro of
+	// the same name exists.
+	ImportDashboard(ctx context.Context, t *DashboardTemplate) (*Dashboard, error)
+}
+
+// DashboardTemplate is a portable document of a dashboard. The IDs in the
+// template only relate its cells to their views; they are rewritten on import.
+type DashboardTemplate struct {
+	Version   int        `json:"version"`
+	Dashboard *Dashboard `json:"dashboard"`
+	Views     []*View    `json:"views"`
+	Macros    []*Macro   `json:"macros"`
+	Labels    []string   `json:"labels"`
+}
+
+// Valid returns an error if the template cannot be imported.
+func (t *DashboardTemplate) Valid() error {
+	if t.Version != DashboardTemplateVersion {
+		return &Error{
+			Code: EInvalid,
+			Msg:  fmt.Sprintf("unsupported dashboard template version %d", t.Version),
+		}
+	}
+
+	if t.Dashboard == nil {
+		return &Error{
+			Code: EInvalid,
+			Msg:  "dashboard template has no dashboard",
+		}
+	}
+
+	views := make(map[ID]bool, len(t.Views))
+	for _, v := range t.Views {
+		views[v.ID] = true
+	}
+	for _, c := range t.Dashboard.Cells {
+		if !views[c.ViewID] {
+			return &Error{
+				Code: EInvalid,
+				Msg:  fmt.Sprintf("view %s of cell %s is not in the dashboard template", c.ViewID, c.ID),
+			}
+		}
+	}
+
+	for _, m := range t.Macros {
+		if m.Arguments == nil {
+			return &Error{
+				Code: EInvalid,
+				Msg:  fmt.Sprintf("macro %q has no arguments", m.Name),
+			}
+		}
+		if err := m.Valid(); err != nil {
+			return &Error{
+				Code: EInvalid,
+				Msg:  fmt.Sprintf("invalid macro %q", m.Name),
+				Err:  err,
+			}
+		}
+	}
+	return nil
+}
+
+// ops for dashboard layout errors.
+const (
+	OpFindDashboardLayouts      = "FindDashboardLayouts"
+	OpCreateDashboardFromLayout = "CreateDashboardFromLayout"
+)
+
+// DashboardLayoutService lists the canned layouts of applications and creates
+// dashboards from them.
+type DashboardLayoutService interface {
+	// FindDashboardLayouts returns the layouts dashboards can be created from.
+	FindDashboardLayouts(ctx context.Context) ([]*DashboardLayout, error)
+
+	// CreateDashboardFromLayout creates a dashboard from the layout whose
+	// views query the bucket.
+	CreateDashboardFromLayout(ctx context.Context, id string, bucket string) (*Dashboard, error)
+}
+
+// DashboardLayout is a canned layout of the metrics an application writes to
+// a measurement.
+type DashboardLayout struct {
+	ID          string `json:"id"`
+	Application string `json:"app"`
+	Measurement string `json:"measurement"`
+	Cells       int    `json:"cells"`
+}
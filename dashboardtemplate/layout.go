@@ -0,0 +1,420 @@
+package dashboardtemplate
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/influxdata/influxql"
+	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/chronograf"
+)
+
+const (
+	// layoutRange is the time range queried by the views of converted layouts.
+	layoutRange = "-1h"
+
+	// layoutWindow is the window aggregates are computed over, matching the
+	// window the query builder uses for layoutRange.
+	layoutWindow = "1m"
+)
+
+// layoutAggregates are the InfluxQL aggregate functions of layout queries with
+// their Flux equivalents.
+var layoutAggregates = map[string]string{
+	"count":  "count()",
+	"first":  "first()",
+	"last":   "last()",
+	"max":    "max()",
+	"mean":   "mean()",
+	"median": "median()",
+	"min":    "min()",
+	"spread": "spread()",
+	"stddev": "stddev()",
+	"sum":    "sum()",
+}
+
+// layoutSelectors are the functions that return an existing row of each
+// window, retaining its timestamp, rather than computing a new value.
+var layoutSelectors = map[string]bool{
+	"first": true,
+	"last":  true,
+	"max":   true,
+	"min":   true,
+}
+
+// layoutGeoms maps chronograf cell types to the geometry of XY views.
+var layoutGeoms = map[string]string{
+	"line-stepplot": "step",
+	"line-stacked":  "stacked",
+	"bar":           "bar",
+}
+
+// FromLayout converts a chronograf layout into a dashboard template with an
+// XY view for each of its cells, whose InfluxQL queries are rewritten to Flux
+// querying bucket. Fields of queries that cannot be rewritten are left out,
+// as are cells left without queries; it is an error if no cell is left.
+func FromLayout(l chronograf.Layout, bucket string) (*platform.DashboardTemplate, error) {
+	t := &platform.DashboardTemplate{
+		Version: platform.DashboardTemplateVersion,
+		Dashboard: &platform.Dashboard{
+			Name:        l.Application,
+			Description: fmt.Sprintf("%s metrics of the %s measurement", l.Application, l.Measurement),
+			Cells:       []*platform.Cell{},
+		},
+		Views:  []*platform.View{},
+		Macros: []*platform.Macro{},
+		Labels: []string{},
+	}
+
+	for _, c := range l.Cells {
+		properties := layoutCellProperties(c, bucket)
+		if len(properties.Queries) == 0 {
+			continue
+		}
+
+		// IDs of the template only relate cells to their views.
+		id := platform.ID(len(t.Views) + 1)
+		t.Views = append(t.Views, &platform.View{
+			ViewContents: platform.ViewContents{ID: id, Name: c.Name},
+			Properties:   properties,
+		})
+		t.Dashboard.Cells = append(t.Dashboard.Cells, &platform.Cell{
+			ID:     id,
+			X:      c.X,
+			Y:      c.Y,
+			W:      c.W,
+			H:      c.H,
+			ViewID: id,
+		})
+	}
+
+	if len(t.Views) == 0 {
+		return nil, &platform.Error{
+			Code: platform.EInvalid,
+			Msg:  fmt.Sprintf("no queries of layout %s can be converted to flux", l.ID),
+		}
+	}
+	return t, nil
+}
+
+// layoutCellProperties returns the properties of the XY view of the cell.
+func layoutCellProperties(c chronograf.Cell, bucket string) platform.XYViewProperties {
+	geom, ok := layoutGeoms[c.Type]
+	if !ok {
+		geom = "line"
+	}
+
+	p := platform.XYViewProperties{
+		Type:       "xy",
+		Geom:       geom,
+		Queries:    []platform.DashboardQuery{},
+		Axes:       make(map[string]platform.Axis, len(c.Axes)),
+		ViewColors: make([]platform.ViewColor, 0, len(c.CellColors)),
+	}
+	for name, a := range c.Axes {
+		p.Axes[name] = platform.Axis(a)
+	}
+	for _, cc := range c.CellColors {
+		v, _ := strconv.ParseFloat(cc.Value, 64)
+		p.ViewColors = append(p.ViewColors, platform.ViewColor{
+			ID:    cc.ID,
+			Type:  cc.Type,
+			Hex:   cc.Hex,
+			Name:  cc.Name,
+			Value: v,
+		})
+	}
+
+	for _, q := range c.Queries {
+		text, err := LayoutQueryToFlux(q, bucket)
+		if err != nil {
+			continue
+		}
+		p.Queries = append(p.Queries, platform.DashboardQuery{
+			Text:     text,
+			Type:     "flux",
+			EditMode: "advanced",
+			Name:     q.Label,
+		})
+		if q.Label != "" {
+			if a, ok := p.Axes["y"]; ok && a.Label == "" {
+				a.Label = q.Label
+				p.Axes["y"] = a
+			} else if !ok {
+				p.Axes["y"] = platform.Axis{Label: q.Label}
+			}
+		}
+	}
+	return p
+}
+
+// LayoutQueryToFlux rewrites the InfluxQL query of a layout to Flux querying
+// bucket, with a statement yielding each field of the query. The database and
+// retention policy of the query are replaced by the bucket.
+//
+// Fields must apply an aggregate to a field, optionally followed by
+// derivative or non_negative_derivative and arithmetic with constants.
+// Fields that are not supported are left out; it is an error if none is left.
+func LayoutQueryToFlux(q chronograf.Query, bucket string) (string, error) {
+	stmt, err := influxql.ParseStatement(q.Command)
+	if err != nil {
+		return "", err
+	}
+	s, ok := stmt.(*influxql.SelectStatement)
+	if !ok {
+		return "", fmt.Errorf("not a select statement: %s", q.Command)
+	}
+	if len(s.Sources) != 1 {
+		return "", fmt.Errorf("query must select from one measurement: %s", q.Command)
+	}
+	m, ok := s.Sources[0].(*influxql.Measurement)
+	if !ok || m.Name == "" {
+		return "", fmt.Errorf("query must select from one measurement: %s", q.Command)
+	}
+
+	var predicates []string
+	conds := []influxql.Expr{}
+	if s.Condition != nil {
+		conds = append(conds, s.Condition)
+	}
+	for _, w := range q.Wheres {
+		expr, err := influxql.ParseExpr(w)
+		if err != nil {
+			return "", err
+		}
+		conds = append(conds, expr)
+	}
+	for _, cond := range conds {
+		p, err := fluxPredicate(cond)
+		if err != nil {
+			return "", err
+		}
+		predicates = append(predicates, p)
+	}
+
+	columns := []string{"_measurement", "_field"}
+	for _, d := range s.Dimensions {
+		if ref, ok := d.Expr.(*influxql.VarRef); ok {
+			columns = append(columns, ref.Val)
+		}
+	}
+	for _, g := range q.GroupBys {
+		expr, err := influxql.ParseExpr(g)
+		if err != nil {
+			return "", err
+		}
+		if ref, ok := expr.(*influxql.VarRef); ok {
+			columns = append(columns, ref.Val)
+		}
+	}
+	quoted := make([]string, len(columns))
+	for i, c := range columns {
+		quoted[i] = fluxString(c)
+	}
+
+	var statements []string
+	names := make(map[string]bool)
+	for _, f := range s.Fields {
+		field, fns, err := fieldPipeline(f.Expr)
+		if err != nil {
+			continue
+		}
+
+		name := f.Name()
+		for i := 1; names[name]; i++ {
+			name = fmt.Sprintf("%s_%d", f.Name(), i)
+		}
+		names[name] = true
+
+		var b strings.Builder
+		fmt.Fprintf(&b, "from(bucket: %s)\n", fluxString(bucket))
+		fmt.Fprintf(&b, "  |> range(start: %s)\n", layoutRange)
+		fmt.Fprintf(&b, "  |> filter(fn: (r) => r._measurement == %s and r._field == %s)\n", fluxString(m.Name), fluxString(field))
+		for _, p := range predicates {
+			fmt.Fprintf(&b, "  |> filter(fn: (r) => %s)\n", p)
+		}
+		fmt.Fprintf(&b, "  |> group(columns: [%s])\n", strings.Join(quoted, ", "))
+		for _, fn := range fns {
+			fmt.Fprintf(&b, "  |> %s\n", fn)
+		}
+		fmt.Fprintf(&b, "  |> yield(name: %s)", fluxString(name))
+		statements = append(statements, b.String())
+	}
+
+	if len(statements) == 0 {
+		return "", fmt.Errorf("no fields of the query can be converted to flux: %s", q.Command)
+	}
+	return strings.Join(statements, "\n\n"), nil
+}
+
+// fieldPipeline returns the field selected by expr and the Flux functions
+// computing expr from the field's values.
+func fieldPipeline(expr influxql.Expr) (string, []string, error) {
+	switch expr := expr.(type) {
+	case *influxql.ParenExpr:
+		return fieldPipeline(expr.Expr)
+	case *influxql.VarRef:
+		return expr.Val, nil, nil
+	case *influxql.Call:
+		return callPipeline(expr)
+	case *influxql.BinaryExpr:
+		field, fns, err := fieldPipeline(expr.LHS)
+		if err != nil {
+			return "", nil, err
+		}
+		v, ok := numberLiteral(expr.RHS)
+		if !ok {
+			return "", nil, fmt.Errorf("unsupported expression: %s", expr)
+		}
+		switch expr.Op {
+		case influxql.ADD, influxql.SUB, influxql.MUL, influxql.DIV:
+		default:
+			return "", nil, fmt.Errorf("unsupported operator: %s", expr.Op)
+		}
+		fn := fmt.Sprintf("map(fn: (r) => ({_time: r._time, _value: r._value %s %s}))", expr.Op, v)
+		return field, append(fns, fn), nil
+	default:
+		return "", nil, fmt.Errorf("unsupported expression: %s", expr)
+	}
+}
+
+// callPipeline returns the field and the Flux functions of an aggregate or
+// derivative call.
+func callPipeline(call *influxql.Call) (string, []string, error) {
+	switch call.Name {
+	case "derivative", "non_negative_derivative":
+		if len(call.Args) == 0 || len(call.Args) > 2 {
+			return "", nil, fmt.Errorf("invalid number of arguments to %s", call.Name)
+		}
+		inner, ok := call.Args[0].(*influxql.Call)
+		if !ok {
+			return "", nil, fmt.Errorf("%s requires an aggregate", call.Name)
+		}
+		field, fns, err := callPipeline(inner)
+		if err != nil {
+			return "", nil, err
+		}
+
+		unit := time.Second
+		if len(call.Args) == 2 {
+			d, ok := call.Args[1].(*influxql.DurationLiteral)
+			if !ok {
+				return "", nil, fmt.Errorf("invalid unit of %s", call.Name)
+			}
+			unit = d.Val
+		}
+
+		fn := fmt.Sprintf("derivative(unit: %s", influxql.FormatDuration(unit))
+		if call.Name == "non_negative_derivative" {
+			fn += ", nonNegative: true"
+		}
+		return field, append(fns, fn+")"), nil
+	}
+
+	if len(call.Args) == 0 {
+		return "", nil, fmt.Errorf("invalid number of arguments to %s", call.Name)
+	}
+	ref, ok := call.Args[0].(*influxql.VarRef)
+	if !ok {
+		return "", nil, fmt.Errorf("%s requires a field", call.Name)
+	}
+
+	var fn string
+	switch {
+	case call.Name == "percentile" && len(call.Args) == 2:
+		v, ok := call.Args[1].(*influxql.NumberLiteral)
+		if !ok {
+			if i, ok := call.Args[1].(*influxql.IntegerLiteral); ok {
+				v = &influxql.NumberLiteral{Val: float64(i.Val)}
+			} else {
+				return "", nil, fmt.Errorf("invalid percentile")
+			}
+		}
+		fn = fmt.Sprintf("percentile(percentile: %s)", strconv.FormatFloat(v.Val/100, 'f', -1, 64))
+	case len(call.Args) == 1 && layoutAggregates[call.Name] != "":
+		fn = layoutAggregates[call.Name]
+	default:
+		return "", nil, fmt.Errorf("unsupported function: %s", call.Name)
+	}
+
+	fns := []string{fmt.Sprintf("window(every: %s)", layoutWindow), fn}
+	if !layoutSelectors[call.Name] {
+		fns = append(fns, `duplicate(column: "_stop", as: "_time")`)
+	}
+	fns = append(fns, "window(every: inf)")
+	return ref.Val, fns, nil
+}
+
+// numberLiteral returns the Flux float literal of a numeric literal.
+func numberLiteral(expr influxql.Expr) (string, bool) {
+	switch expr := expr.(type) {
+	case *influxql.NumberLiteral:
+		return strconv.FormatFloat(expr.Val, 'f', -1, 64), true
+	case *influxql.IntegerLiteral:
+		return strconv.FormatInt(expr.Val, 10) + ".0", true
+	default:
+		return "", false
+	}
+}
+
+// identifier matches tag keys that can be referenced as members of a record.
+var identifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// fluxPredicate converts a condition on tags into a Flux predicate of r.
+func fluxPredicate(expr influxql.Expr) (string, error) {
+	switch expr := expr.(type) {
+	case *influxql.ParenExpr:
+		p, err := fluxPredicate(expr.Expr)
+		if err != nil {
+			return "", err
+		}
+		return "(" + p + ")", nil
+	case *influxql.BinaryExpr:
+		switch expr.Op {
+		case influxql.AND, influxql.OR:
+			lhs, err := fluxPredicate(expr.LHS)
+			if err != nil {
+				return "", err
+			}
+			rhs, err := fluxPredicate(expr.RHS)
+			if err != nil {
+				return "", err
+			}
+			op := "and"
+			if expr.Op == influxql.OR {
+				op = "or"
+			}
+			return fmt.Sprintf("%s %s %s", lhs, op, rhs), nil
+		}
+
+		ref, ok := expr.LHS.(*influxql.VarRef)
+		if !ok || !identifier.MatchString(ref.Val) {
+			return "", fmt.Errorf("unsupported condition: %s", expr)
+		}
+		switch rhs := expr.RHS.(type) {
+		case *influxql.StringLiteral:
+			switch expr.Op {
+			case influxql.EQ:
+				return fmt.Sprintf("r.%s == %s", ref.Val, fluxString(rhs.Val)), nil
+			case influxql.NEQ:
+				return fmt.Sprintf("r.%s != %s", ref.Val, fluxString(rhs.Val)), nil
+			}
+		case *influxql.RegexLiteral:
+			switch expr.Op {
+			case influxql.EQREGEX:
+				return fmt.Sprintf("r.%s =~ %s", ref.Val, rhs), nil
+			case influxql.NEQREGEX:
+				return fmt.Sprintf("r.%s !~ %s", ref.Val, rhs), nil
+			}
+		}
+	}
+	return "", fmt.Errorf("unsupported condition: %s", expr)
+}
+
+// fluxString returns s as a Flux string literal.
+func fluxString(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
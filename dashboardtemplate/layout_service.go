@@ -0,0 +1,93 @@
+package dashboardtemplate
+
+import (
+	"context"
+	"sort"
+
+	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/chronograf"
+)
+
+var _ platform.DashboardLayoutService = (*LayoutService)(nil)
+
+// LayoutService is a platform.DashboardLayoutService that converts the layouts
+// of a chronograf.LayoutsStore into templates and imports them as dashboards.
+type LayoutService struct {
+	LayoutsStore             chronograf.LayoutsStore
+	DashboardTemplateService platform.DashboardTemplateService
+}
+
+// NewLayoutService returns a LayoutService creating dashboards from the
+// layouts of ls through ts.
+func NewLayoutService(ls chronograf.LayoutsStore, ts platform.DashboardTemplateService) *LayoutService {
+	return &LayoutService{
+		LayoutsStore:             ls,
+		DashboardTemplateService: ts,
+	}
+}
+
+// FindDashboardLayouts returns the layouts of the store ordered by application
+// and measurement.
+func (s *LayoutService) FindDashboardLayouts(ctx context.Context) ([]*platform.DashboardLayout, error) {
+	layouts, err := s.LayoutsStore.All(ctx)
+	if err != nil {
+		return nil, &platform.Error{
+			Op:  platform.OpFindDashboardLayouts,
+			Err: err,
+		}
+	}
+
+	ls := make([]*platform.DashboardLayout, 0, len(layouts))
+	for _, l := range layouts {
+		ls = append(ls, &platform.DashboardLayout{
+			ID:          l.ID,
+			Application: l.Application,
+			Measurement: l.Measurement,
+			Cells:       len(l.Cells),
+		})
+	}
+	sort.Slice(ls, func(i, j int) bool {
+		if ls[i].Application != ls[j].Application {
+			return ls[i].Application < ls[j].Application
+		}
+		return ls[i].Measurement < ls[j].Measurement
+	})
+	return ls, nil
+}
+
+// CreateDashboardFromLayout converts the layout into a template whose queries
+// read the bucket, and imports it as a new dashboard.
+func (s *LayoutService) CreateDashboardFromLayout(ctx context.Context, id string, bucket string) (*platform.Dashboard, error) {
+	op := platform.OpCreateDashboardFromLayout
+	if bucket == "" {
+		return nil, &platform.Error{
+			Code: platform.EInvalid,
+			Op:   op,
+			Msg:  "bucket is required",
+		}
+	}
+
+	l, err := s.LayoutsStore.Get(ctx, id)
+	if err == chronograf.ErrLayoutNotFound {
+		return nil, &platform.Error{
+			Code: platform.ENotFound,
+			Op:   op,
+			Msg:  "layout not found",
+		}
+	}
+	if err != nil {
+		return nil, &platform.Error{
+			Op:  op,
+			Err: err,
+		}
+	}
+
+	t, err := FromLayout(l, bucket)
+	if err != nil {
+		return nil, &platform.Error{
+			Op:  op,
+			Err: err,
+		}
+	}
+	return s.DashboardTemplateService.ImportDashboard(ctx, t)
+}
@@ -0,0 +1,210 @@
+package dashboardtemplate_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/chronograf"
+	"github.com/influxdata/platform/dashboardtemplate"
+	"github.com/influxdata/platform/inmem"
+)
+
+func TestLayoutQueryToFlux(t *testing.T) {
+	tests := []struct {
+		name  string
+		query chronograf.Query
+		want  string
+	}{
+		{
+			name: "selector",
+			query: chronograf.Query{
+				Command:  `SELECT max("used_percent") AS "used" FROM ":db:".":rp:"."mem"`,
+				GroupBys: []string{`"host"`},
+			},
+			want: `from(bucket: "telegraf")
+  |> range(start: -1h)
+  |> filter(fn: (r) => r._measurement == "mem" and r._field == "used_percent")
+  |> group(columns: ["_measurement", "_field", "host"])
+  |> window(every: 1m)
+  |> max()
+  |> window(every: inf)
+  |> yield(name: "used")`,
+		},
+		{
+			name: "derivative of aggregate with constant",
+			query: chronograf.Query{
+				Command: `SELECT non_negative_derivative(mean("bytes"), 10s) / 1024 AS "kb" FROM ":db:".":rp:"."net"`,
+				Wheres:  []string{`"interface" = 'eth0' OR "interface" =~ /^en/`},
+			},
+			want: `from(bucket: "telegraf")
+  |> range(start: -1h)
+  |> filter(fn: (r) => r._measurement == "net" and r._field == "bytes")
+  |> filter(fn: (r) => r.interface == "eth0" or r.interface =~ /^en/)
+  |> group(columns: ["_measurement", "_field"])
+  |> window(every: 1m)
+  |> mean()
+  |> duplicate(column: "_stop", as: "_time")
+  |> window(every: inf)
+  |> derivative(unit: 10s, nonNegative: true)
+  |> map(fn: (r) => ({_time: r._time, _value: r._value / 1024.0}))
+  |> yield(name: "kb")`,
+		},
+		{
+			name: "unsupported fields are left out",
+			query: chronograf.Query{
+				Command: `SELECT percentile("latency", 99), mean("a") / mean("b") FROM ":db:".":rp:"."http"`,
+			},
+			want: `from(bucket: "telegraf")
+  |> range(start: -1h)
+  |> filter(fn: (r) => r._measurement == "http" and r._field == "latency")
+  |> group(columns: ["_measurement", "_field"])
+  |> window(every: 1m)
+  |> percentile(percentile: 0.99)
+  |> duplicate(column: "_stop", as: "_time")
+  |> window(every: inf)
+  |> yield(name: "percentile")`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := dashboardtemplate.LayoutQueryToFlux(tt.query, "telegraf")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("got flux\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := dashboardtemplate.LayoutQueryToFlux(chronograf.Query{Command: `SELECT mean("a") / mean("b") FROM "m"`}, "telegraf"); err == nil {
+		t.Error("expected an error converting a query without supported fields")
+	}
+}
+
+var apacheLayout = chronograf.Layout{
+	ID:          "apache",
+	Application: "apache",
+	Measurement: "apache",
+	Cells: []chronograf.Cell{
+		{
+			X: 0, Y: 0, W: 4, H: 4,
+			Name: "Apache Bytes/Second",
+			Type: "line-stepplot",
+			Queries: []chronograf.Query{{
+				Command:  `SELECT non_negative_derivative(max("BytesPerSec")) AS "bytes_per_sec" FROM ":db:".":rp:"."apache"`,
+				Label:    "bytes/s",
+				GroupBys: []string{`"server"`},
+			}},
+			CellColors: []chronograf.CellColor{{ID: "c", Type: "scale", Hex: "#31C0F6", Name: "Nineteen Eighty Four", Value: "0"}},
+		},
+		{
+			X: 4, Y: 0, W: 4, H: 4,
+			Name: "Apache Ratio",
+			Queries: []chronograf.Query{{
+				Command: `SELECT max("a") / max("b") FROM ":db:".":rp:"."apache"`,
+			}},
+		},
+	},
+}
+
+func TestFromLayout(t *testing.T) {
+	tmpl, err := dashboardtemplate.FromLayout(apacheLayout, "telegraf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tmpl.Valid(); err != nil {
+		t.Fatal(err)
+	}
+	if tmpl.Dashboard.Name != "apache" {
+		t.Errorf("got dashboard name %q, want %q", tmpl.Dashboard.Name, "apache")
+	}
+	if got, want := len(tmpl.Dashboard.Cells), 1; got != want {
+		t.Fatalf("got %d cells, want %d without the cell that cannot be converted", got, want)
+	}
+	if c := tmpl.Dashboard.Cells[0]; c.W != 4 || c.H != 4 {
+		t.Errorf("got cell %+v, want the size of the layout cell", c)
+	}
+
+	v := tmpl.Views[0]
+	if v.Name != "Apache Bytes/Second" {
+		t.Errorf("got view name %q", v.Name)
+	}
+	p, ok := v.Properties.(platform.XYViewProperties)
+	if !ok {
+		t.Fatalf("got properties %T, want XY view properties", v.Properties)
+	}
+	if p.Geom != "step" {
+		t.Errorf("got geom %q, want %q", p.Geom, "step")
+	}
+	if len(p.Queries) != 1 || p.Queries[0].Type != "flux" {
+		t.Errorf("got queries %+v, want a flux query", p.Queries)
+	}
+	if p.Axes["y"].Label != "bytes/s" {
+		t.Errorf("got y axis %+v, want the label of the query", p.Axes["y"])
+	}
+	if len(p.ViewColors) != 1 || p.ViewColors[0].Hex != "#31C0F6" {
+		t.Errorf("got colors %+v, want the colors of the cell", p.ViewColors)
+	}
+
+	empty := chronograf.Layout{ID: "empty", Cells: apacheLayout.Cells[1:]}
+	if _, err := dashboardtemplate.FromLayout(empty, "telegraf"); platform.ErrorCode(err) != platform.EInvalid {
+		t.Errorf("got error %v converting a layout without supported queries, want an invalid error", err)
+	}
+}
+
+// layoutsStore is a chronograf.LayoutsStore of fixed layouts.
+type layoutsStore struct {
+	chronograf.LayoutsStore
+	layouts []chronograf.Layout
+}
+
+func (s *layoutsStore) All(ctx context.Context) ([]chronograf.Layout, error) {
+	return s.layouts, nil
+}
+
+func (s *layoutsStore) Get(ctx context.Context, id string) (chronograf.Layout, error) {
+	for _, l := range s.layouts {
+		if l.ID == id {
+			return l, nil
+		}
+	}
+	return chronograf.Layout{}, chronograf.ErrLayoutNotFound
+}
+
+func TestLayoutService(t *testing.T) {
+	ctx := context.Background()
+	svc := inmem.NewService()
+	store := &layoutsStore{layouts: []chronograf.Layout{
+		{ID: "redis", Application: "redis", Measurement: "redis"},
+		apacheLayout,
+	}}
+	s := dashboardtemplate.NewLayoutService(store, newService(svc))
+
+	ls, err := s.FindDashboardLayouts(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ls) != 2 || ls[0].ID != "apache" || ls[0].Cells != 2 {
+		t.Fatalf("got layouts %+v, want the layouts ordered by application", ls)
+	}
+
+	d, err := s.CreateDashboardFromLayout(ctx, "apache", "telegraf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(d.Cells) != 1 {
+		t.Fatalf("got %d cells, want %d", len(d.Cells), 1)
+	}
+	if _, err := svc.FindViewByID(ctx, d.Cells[0].ViewID); err != nil {
+		t.Errorf("view of the cell was not created: %v", err)
+	}
+
+	if _, err := s.CreateDashboardFromLayout(ctx, "missing", "telegraf"); platform.ErrorCode(err) != platform.ENotFound {
+		t.Errorf("got error %v creating a dashboard from a missing layout, want a not found error", err)
+	}
+	if _, err := s.CreateDashboardFromLayout(ctx, "apache", ""); platform.ErrorCode(err) != platform.EInvalid {
+		t.Errorf("got error %v creating a dashboard without a bucket, want an invalid error", err)
+	}
+}
@@ -0,0 +1,245 @@
+// Package dashboardtemplate exports dashboards as portable templates, imports
+// templates as new dashboards, and converts chronograf layouts into templates.
+package dashboardtemplate
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/influxdata/platform"
+)
+
+var _ platform.DashboardTemplateService = (*Service)(nil)
+
+// Service is a platform.DashboardTemplateService that reads and creates the
+// parts of dashboards through the services that manage them.
+type Service struct {
+	DashboardService platform.DashboardService
+	ViewService      platform.ViewService
+	MacroService     platform.MacroService
+	LabelService     platform.LabelService
+}
+
+// NewService returns a Service managing dashboards through ds, their views
+// through vs, macros through ms and labels through ls.
+func NewService(ds platform.DashboardService, vs platform.ViewService, ms platform.MacroService, ls platform.LabelService) *Service {
+	return &Service{
+		DashboardService: ds,
+		ViewService:      vs,
+		MacroService:     ms,
+		LabelService:     ls,
+	}
+}
+
+// ExportDashboard returns a template of the dashboard, the views of its cells,
+// the macros referenced by their queries and its labels.
+func (s *Service) ExportDashboard(ctx context.Context, id platform.ID) (*platform.DashboardTemplate, error) {
+	op := platform.OpExportDashboard
+	d, err := s.DashboardService.FindDashboardByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &platform.DashboardTemplate{
+		Version:   platform.DashboardTemplateVersion,
+		Dashboard: d,
+		Views:     []*platform.View{},
+		Macros:    []*platform.Macro{},
+		Labels:    []string{},
+	}
+
+	seen := make(map[platform.ID]bool)
+	for _, c := range d.Cells {
+		if seen[c.ViewID] {
+			continue
+		}
+		seen[c.ViewID] = true
+
+		v, err := s.ViewService.FindViewByID(ctx, c.ViewID)
+		if err != nil {
+			return nil, &platform.Error{
+				Op:  op,
+				Err: err,
+			}
+		}
+		t.Views = append(t.Views, v)
+	}
+
+	macros, err := s.MacroService.FindMacros(ctx)
+	if err != nil {
+		return nil, &platform.Error{
+			Op:  op,
+			Err: err,
+		}
+	}
+	t.Macros = append(t.Macros, ReferencedMacros(t.Views, macros)...)
+
+	labels, err := s.LabelService.FindLabels(ctx, platform.LabelFilter{ResourceID: id})
+	if err != nil {
+		return nil, &platform.Error{
+			Op:  op,
+			Err: err,
+		}
+	}
+	for _, l := range labels {
+		t.Labels = append(t.Labels, l.Name)
+	}
+
+	return t, nil
+}
+
+// ImportDashboard creates the views of the template, then a dashboard with
+// cells referencing them, and labels it. Macros of the template are created
+// unless a macro of the same name exists, since queries refer to macros by
+// name. If any part fails to be created, the views and the dashboard created
+// so far are removed.
+func (s *Service) ImportDashboard(ctx context.Context, t *platform.DashboardTemplate) (*platform.Dashboard, error) {
+	op := platform.OpImportDashboard
+	if err := t.Valid(); err != nil {
+		return nil, err
+	}
+
+	var created []platform.ID
+	undo := func() {
+		for _, id := range created {
+			s.ViewService.DeleteView(ctx, id)
+		}
+	}
+
+	views := make(map[platform.ID]platform.ID, len(t.Views))
+	for _, v := range t.Views {
+		nv := &platform.View{
+			ViewContents: platform.ViewContents{Name: v.Name},
+			Properties:   v.Properties,
+		}
+		if nv.Properties == nil {
+			nv.Properties = platform.EmptyViewProperties{}
+		}
+		if err := s.ViewService.CreateView(ctx, nv); err != nil {
+			undo()
+			return nil, &platform.Error{
+				Op:  op,
+				Err: err,
+			}
+		}
+		created = append(created, nv.ID)
+		views[v.ID] = nv.ID
+	}
+
+	d := &platform.Dashboard{
+		Name:        t.Dashboard.Name,
+		Description: t.Dashboard.Description,
+		Cells:       make([]*platform.Cell, 0, len(t.Dashboard.Cells)),
+	}
+	for _, c := range t.Dashboard.Cells {
+		d.Cells = append(d.Cells, &platform.Cell{
+			X:      c.X,
+			Y:      c.Y,
+			W:      c.W,
+			H:      c.H,
+			ViewID: views[c.ViewID],
+		})
+	}
+	if err := s.DashboardService.CreateDashboard(ctx, d); err != nil {
+		undo()
+		return nil, &platform.Error{
+			Op:  op,
+			Err: err,
+		}
+	}
+
+	if err := s.importMacros(ctx, t.Macros); err != nil {
+		s.DashboardService.DeleteDashboard(ctx, d.ID)
+		undo()
+		return nil, &platform.Error{
+			Op:  op,
+			Err: err,
+		}
+	}
+
+	for _, name := range t.Labels {
+		if err := s.LabelService.CreateLabel(ctx, &platform.Label{ResourceID: d.ID, Name: name}); err != nil {
+			s.DashboardService.DeleteDashboard(ctx, d.ID)
+			undo()
+			return nil, &platform.Error{
+				Op:  op,
+				Err: err,
+			}
+		}
+	}
+
+	return d, nil
+}
+
+// importMacros creates the macros whose names are not used by existing macros.
+func (s *Service) importMacros(ctx context.Context, macros []*platform.Macro) error {
+	if len(macros) == 0 {
+		return nil
+	}
+
+	existing, err := s.MacroService.FindMacros(ctx)
+	if err != nil {
+		return err
+	}
+	names := make(map[string]bool, len(existing))
+	for _, m := range existing {
+		names[m.Name] = true
+	}
+
+	for _, m := range macros {
+		if names[m.Name] {
+			continue
+		}
+		nm := &platform.Macro{
+			Name:      m.Name,
+			Selected:  m.Selected,
+			Arguments: m.Arguments,
+		}
+		if err := s.MacroService.CreateMacro(ctx, nm); err != nil {
+			return err
+		}
+		names[m.Name] = true
+	}
+	return nil
+}
+
+// ReferencedMacros returns the macros that the queries of the views refer to,
+// either as v.name in Flux or as :name: in InfluxQL.
+func ReferencedMacros(views []*platform.View, macros []*platform.Macro) []*platform.Macro {
+	var texts []string
+	for _, v := range views {
+		for _, q := range queries(v.Properties) {
+			texts = append(texts, q.Text)
+		}
+	}
+
+	var refs []*platform.Macro
+	for _, m := range macros {
+		re := regexp.MustCompile(`\bv\.` + regexp.QuoteMeta(m.Name) + `\b|:` + regexp.QuoteMeta(m.Name) + `:`)
+		for _, text := range texts {
+			if re.MatchString(text) {
+				refs = append(refs, m)
+				break
+			}
+		}
+	}
+	return refs
+}
+
+// queries returns the queries of the view properties.
+func queries(p platform.ViewProperties) []platform.DashboardQuery {
+	switch p := p.(type) {
+	case platform.XYViewProperties:
+		return p.Queries
+	case platform.LinePlusSingleStatProperties:
+		return p.Queries
+	case platform.SingleStatViewProperties:
+		return p.Queries
+	case platform.GaugeViewProperties:
+		return p.Queries
+	case platform.TableViewProperties:
+		return p.Queries
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,220 @@
+package dashboardtemplate_test
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/dashboardtemplate"
+	"github.com/influxdata/platform/inmem"
+)
+
+func newService(svc *inmem.Service) *dashboardtemplate.Service {
+	return dashboardtemplate.NewService(svc, svc, svc, svc)
+}
+
+func newMacro(name string) *platform.Macro {
+	return &platform.Macro{
+		Name:     name,
+		Selected: []string{"a"},
+		Arguments: &platform.MacroArguments{
+			Type:   "constant",
+			Values: platform.MacroConstantValues{"a", "b"},
+		},
+	}
+}
+
+func TestService_ExportImportDashboard(t *testing.T) {
+	ctx := context.Background()
+	src := inmem.NewService()
+
+	for _, m := range []*platform.Macro{newMacro("host"), newMacro("unused")} {
+		if err := src.CreateMacro(ctx, m); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	properties := platform.XYViewProperties{
+		Type: "xy",
+		Queries: []platform.DashboardQuery{
+			{Text: `from(bucket: "b") |> range(start: -1h) |> filter(fn: (r) => r.host == v.host)`},
+		},
+		Geom: "line",
+	}
+	view := &platform.View{
+		ViewContents: platform.ViewContents{Name: "cpu"},
+		Properties:   properties,
+	}
+	if err := src.CreateView(ctx, view); err != nil {
+		t.Fatal(err)
+	}
+
+	d := &platform.Dashboard{
+		Name:        "hosts",
+		Description: "host metrics",
+		Cells: []*platform.Cell{
+			{ID: 1, X: 1, Y: 2, W: 3, H: 4, ViewID: view.ID},
+			{ID: 2, X: 5, Y: 6, W: 7, H: 8, ViewID: view.ID},
+		},
+	}
+	if err := src.CreateDashboard(ctx, d); err != nil {
+		t.Fatal(err)
+	}
+	if err := src.CreateLabel(ctx, &platform.Label{ResourceID: d.ID, Name: "prod"}); err != nil {
+		t.Fatal(err)
+	}
+
+	tmpl, err := newService(src).ExportDashboard(ctx, d.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(tmpl.Views), 1; got != want {
+		t.Fatalf("got %d views, want %d", got, want)
+	}
+	if len(tmpl.Macros) != 1 || tmpl.Macros[0].Name != "host" {
+		t.Fatalf("got macros %v, want only the referenced macro", tmpl.Macros)
+	}
+	if got, want := tmpl.Labels, []string{"prod"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got labels %v, want %v", got, want)
+	}
+
+	// The template is imported from its JSON document.
+	b, err := json.Marshal(tmpl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var imported platform.DashboardTemplate
+	if err := json.Unmarshal(b, &imported); err != nil {
+		t.Fatal(err)
+	}
+
+	dst := inmem.NewService()
+	dst.IDGenerator = &idGenerator{next: 100}
+	if err := dst.CreateMacro(ctx, newMacro("host")); err != nil {
+		t.Fatal(err)
+	}
+
+	nd, err := newService(dst).ImportDashboard(ctx, &imported)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nd.ID == d.ID || nd.Name != d.Name || nd.Description != d.Description {
+		t.Fatalf("got dashboard %+v, want a new dashboard named %q", nd, d.Name)
+	}
+	if got, want := len(nd.Cells), 2; got != want {
+		t.Fatalf("got %d cells, want %d", got, want)
+	}
+	for i, c := range nd.Cells {
+		if c.X != d.Cells[i].X || c.Y != d.Cells[i].Y || c.W != d.Cells[i].W || c.H != d.Cells[i].H {
+			t.Errorf("got cell %+v, want the layout of %+v", c, d.Cells[i])
+		}
+		if c.ViewID == view.ID {
+			t.Errorf("cell %d references the exported view ID", i)
+		}
+		v, err := dst.FindViewByID(ctx, c.ViewID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v.Name != view.Name || !reflect.DeepEqual(v.Properties, view.Properties) {
+			t.Errorf("got view %+v, want a copy of %+v", v, view)
+		}
+	}
+	if nd.Cells[0].ViewID != nd.Cells[1].ViewID {
+		t.Error("cells sharing a view were given different views")
+	}
+
+	macros, err := dst.FindMacros(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(macros), 1; got != want {
+		t.Errorf("got %d macros, want the existing macro to be reused", got)
+	}
+
+	labels, err := dst.FindLabels(ctx, platform.LabelFilter{ResourceID: nd.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(labels) != 1 || labels[0].Name != "prod" {
+		t.Errorf("got labels %v, want the label of the template", labels)
+	}
+}
+
+func TestService_ImportDashboard_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		tmpl *platform.DashboardTemplate
+	}{
+		{
+			name: "unsupported version",
+			tmpl: &platform.DashboardTemplate{Version: 2, Dashboard: &platform.Dashboard{Name: "d"}},
+		},
+		{
+			name: "missing view",
+			tmpl: &platform.DashboardTemplate{
+				Version:   platform.DashboardTemplateVersion,
+				Dashboard: &platform.Dashboard{Name: "d", Cells: []*platform.Cell{{ID: 1, ViewID: 2}}},
+			},
+		},
+		{
+			name: "macro without arguments",
+			tmpl: &platform.DashboardTemplate{
+				Version:   platform.DashboardTemplateVersion,
+				Dashboard: &platform.Dashboard{Name: "d"},
+				Macros:    []*platform.Macro{{Name: "m", Selected: []string{"a"}}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := inmem.NewService()
+			_, err := newService(svc).ImportDashboard(context.Background(), tt.tmpl)
+			if platform.ErrorCode(err) != platform.EInvalid {
+				t.Fatalf("got error %v, want an invalid error", err)
+			}
+
+			ds, _, err := svc.FindDashboards(context.Background(), platform.DashboardFilter{}, platform.DefaultDashboardFindOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(ds) != 0 {
+				t.Errorf("got %d dashboards, want none", len(ds))
+			}
+		})
+	}
+}
+
+func TestReferencedMacros(t *testing.T) {
+	views := []*platform.View{
+		{Properties: platform.TableViewProperties{Queries: []platform.DashboardQuery{{Text: `SELECT * FROM cpu WHERE host = :host: AND region = :region:`}}}},
+		{Properties: platform.GaugeViewProperties{Queries: []platform.DashboardQuery{{Text: `r.dc == v.dc`}}}},
+		{Properties: platform.MarkdownViewProperties{Note: "v.note"}},
+	}
+	macros := []*platform.Macro{
+		{Name: "host"},
+		{Name: "dc"},
+		{Name: "d"},
+		{Name: "note"},
+		{Name: "region"},
+	}
+
+	var got []string
+	for _, m := range dashboardtemplate.ReferencedMacros(views, macros) {
+		got = append(got, m.Name)
+	}
+	if want := []string{"host", "dc", "region"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got macros %v, want %v", got, want)
+	}
+}
+
+// idGenerator generates sequential IDs.
+type idGenerator struct {
+	next platform.ID
+}
+
+func (g *idGenerator) ID() platform.ID {
+	g.next++
+	return g.next
+}
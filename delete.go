@@ -0,0 +1,59 @@
+package platform
+
+import (
+	"context"
+)
+
+// ops for delete error.
+var (
+	OpDeleteBucketRangePredicate = "DeleteBucketRangePredicate"
+)
+
+// DeleteService removes time series data from a bucket.
+type DeleteService interface {
+	// DeleteBucketRangePredicate deletes all data in the bucket described by
+	// the filter that falls within the filter's time range and belongs to a
+	// series matching the filter's predicate.
+	DeleteBucketRangePredicate(ctx context.Context, filter DeleteFilter) error
+}
+
+// DeleteFilter describes the data to be removed by a DeleteService.
+type DeleteFilter struct {
+	OrganizationID ID       `json:"orgID"`
+	BucketID       ID       `json:"bucketID"`
+	Range          Timespan `json:"range"`
+
+	// Predicate is an expression over tag keys, e.g. `host = 'a' AND region =~ /us-.*/`.
+	// The special keys _measurement and _field may also be referenced.
+	// An empty predicate matches every series in the bucket.
+	Predicate string `json:"predicate,omitempty"`
+}
+
+// Valid returns an error if the filter does not describe a deletable range.
+func (f DeleteFilter) Valid() error {
+	if !f.OrganizationID.Valid() {
+		return &Error{
+			Code: EInvalid,
+			Msg:  "organization id is invalid",
+		}
+	}
+	if !f.BucketID.Valid() {
+		return &Error{
+			Code: EInvalid,
+			Msg:  "bucket id is invalid",
+		}
+	}
+	if f.Range.Start.IsZero() || f.Range.Stop.IsZero() {
+		return &Error{
+			Code: EInvalid,
+			Msg:  "start and stop are required",
+		}
+	}
+	if f.Range.Stop.Before(f.Range.Start) {
+		return &Error{
+			Code: EInvalid,
+			Msg:  "stop must not be before start",
+		}
+	}
+	return nil
+}
@@ -0,0 +1,224 @@
+package platform
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// ops for downsample policy errors and op logs.
+const (
+	OpFindDownsamplePolicyByID = "FindDownsamplePolicyByID"
+	OpFindDownsamplePolicies   = "FindDownsamplePolicies"
+	OpCreateDownsamplePolicy   = "CreateDownsamplePolicy"
+	OpUpdateDownsamplePolicy   = "UpdateDownsamplePolicy"
+	OpDeleteDownsamplePolicy   = "DeleteDownsamplePolicy"
+)
+
+// DownsamplePolicyService manages the policies that continuously downsample
+// the data of one bucket into another.
+type DownsamplePolicyService interface {
+	// FindDownsamplePolicyByID returns a single downsample policy by ID.
+	FindDownsamplePolicyByID(ctx context.Context, id ID) (*DownsamplePolicy, error)
+
+	// FindDownsamplePolicies returns the downsample policies that match filter
+	// and the total count of matching policies.
+	FindDownsamplePolicies(ctx context.Context, filter DownsamplePolicyFilter) ([]*DownsamplePolicy, int, error)
+
+	// CreateDownsamplePolicy creates a new downsample policy and sets p.ID with the new identifier.
+	CreateDownsamplePolicy(ctx context.Context, p *DownsamplePolicy) error
+
+	// UpdateDownsamplePolicy updates a single downsample policy with changeset.
+	// Returns the new policy state after update.
+	UpdateDownsamplePolicy(ctx context.Context, id ID, upd DownsamplePolicyUpdate) (*DownsamplePolicy, error)
+
+	// DeleteDownsamplePolicy removes a downsample policy by ID.
+	DeleteDownsamplePolicy(ctx context.Context, id ID) error
+}
+
+// Aggregate functions a downsample policy may apply to each window of data.
+const (
+	DownsampleCount  = "count"
+	DownsampleFirst  = "first"
+	DownsampleLast   = "last"
+	DownsampleMax    = "max"
+	DownsampleMean   = "mean"
+	DownsampleMin    = "min"
+	DownsampleSpread = "spread"
+	DownsampleStddev = "stddev"
+	DownsampleSum    = "sum"
+)
+
+var downsampleFunctions = map[string]bool{
+	DownsampleCount:  true,
+	DownsampleFirst:  true,
+	DownsampleLast:   true,
+	DownsampleMax:    true,
+	DownsampleMean:   true,
+	DownsampleMin:    true,
+	DownsampleSpread: true,
+	DownsampleStddev: true,
+	DownsampleSum:    true,
+}
+
+// DownsamplePolicy aggregates the data written to a source bucket into
+// windows and writes the results to a destination bucket.
+type DownsamplePolicy struct {
+	ID                  ID            `json:"id,omitempty"`
+	OrganizationID      ID            `json:"orgID"`
+	Name                string        `json:"name"`
+	SourceBucketID      ID            `json:"sourceBucketID"`
+	DestinationBucketID ID            `json:"destinationBucketID"`
+	Window              time.Duration `json:"window"`
+
+	// Functions are the aggregates applied to every field without an override.
+	Functions []string `json:"functions"`
+	// FieldOverrides maps a field key to the aggregates applied to that field
+	// in place of Functions.
+	FieldOverrides map[string][]string `json:"fieldOverrides,omitempty"`
+
+	// TaskIDs maps each aggregate function to the task that computes it.
+	// The tasks are owned and maintained by the DownsamplePolicyService.
+	TaskIDs map[string]ID `json:"taskIDs,omitempty"`
+}
+
+// Valid returns an error if the policy cannot be used to downsample data.
+func (p *DownsamplePolicy) Valid() error {
+	if p.Name == "" {
+		return &Error{
+			Code: EInvalid,
+			Msg:  "downsample policy name is empty",
+		}
+	}
+	if !p.OrganizationID.Valid() {
+		return &Error{
+			Code: EInvalid,
+			Msg:  "organization id is invalid",
+		}
+	}
+	if !p.SourceBucketID.Valid() || !p.DestinationBucketID.Valid() {
+		return &Error{
+			Code: EInvalid,
+			Msg:  "source and destination bucket ids must be valid",
+		}
+	}
+	if p.SourceBucketID == p.DestinationBucketID {
+		return &Error{
+			Code: EInvalid,
+			Msg:  "source and destination buckets must differ",
+		}
+	}
+	if p.Window < time.Second {
+		return &Error{
+			Code: EInvalid,
+			Msg:  "window must be at least one second",
+		}
+	}
+	if len(p.Functions) == 0 && len(p.FieldOverrides) == 0 {
+		return &Error{
+			Code: EInvalid,
+			Msg:  "at least one aggregate function is required",
+		}
+	}
+	if err := validDownsampleFunctions(p.Functions); err != nil {
+		return err
+	}
+	for field, fns := range p.FieldOverrides {
+		if field == "" || len(fns) == 0 {
+			return &Error{
+				Code: EInvalid,
+				Msg:  "field overrides require a field and at least one aggregate function",
+			}
+		}
+		if err := validDownsampleFunctions(fns); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validDownsampleFunctions(fns []string) error {
+	for _, fn := range fns {
+		if !downsampleFunctions[fn] {
+			return &Error{
+				Code: EInvalid,
+				Msg:  fmt.Sprintf("unsupported aggregate function %q", fn),
+			}
+		}
+	}
+	return nil
+}
+
+// AggregateFunctions returns the sorted set of every aggregate function used by the policy.
+func (p *DownsamplePolicy) AggregateFunctions() []string {
+	set := make(map[string]bool)
+	for _, fn := range p.Functions {
+		set[fn] = true
+	}
+	for _, fns := range p.FieldOverrides {
+		for _, fn := range fns {
+			set[fn] = true
+		}
+	}
+
+	fns := make([]string, 0, len(set))
+	for fn := range set {
+		fns = append(fns, fn)
+	}
+	sort.Strings(fns)
+	return fns
+}
+
+// DownsamplePolicyUpdate represents updates to a downsample policy.
+// Only fields which are set are updated.
+type DownsamplePolicyUpdate struct {
+	Name           *string             `json:"name,omitempty"`
+	Window         *time.Duration      `json:"window,omitempty"`
+	Functions      []string            `json:"functions,omitempty"`
+	FieldOverrides map[string][]string `json:"fieldOverrides,omitempty"`
+
+	// TaskIDs replaces the policy's tasks. It is only set by the
+	// DownsamplePolicyService itself.
+	TaskIDs map[string]ID `json:"-"`
+}
+
+// Apply applies the set fields of the update to p.
+func (u DownsamplePolicyUpdate) Apply(p *DownsamplePolicy) {
+	if u.Name != nil {
+		p.Name = *u.Name
+	}
+	if u.Window != nil {
+		p.Window = *u.Window
+	}
+	if u.Functions != nil {
+		p.Functions = u.Functions
+	}
+	if u.FieldOverrides != nil {
+		p.FieldOverrides = u.FieldOverrides
+	}
+	if u.TaskIDs != nil {
+		p.TaskIDs = u.TaskIDs
+	}
+}
+
+// DownsamplePolicyFilter represents a set of filters that restrict the returned results.
+type DownsamplePolicyFilter struct {
+	ID             *ID
+	OrganizationID *ID
+	SourceBucketID *ID
+}
+
+// Matches returns true if the policy satisfies every set field of the filter.
+func (f DownsamplePolicyFilter) Matches(p *DownsamplePolicy) bool {
+	if f.ID != nil && *f.ID != p.ID {
+		return false
+	}
+	if f.OrganizationID != nil && *f.OrganizationID != p.OrganizationID {
+		return false
+	}
+	if f.SourceBucketID != nil && *f.SourceBucketID != p.SourceBucketID {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,126 @@
+package downsample
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/influxdata/platform"
+)
+
+// AggregateTagKey is the tag added to downsampled data to identify the
+// aggregate function that produced it.
+const AggregateTagKey = "aggregate"
+
+// selectors are the functions that return an existing row of each window,
+// retaining its timestamp, rather than computing a new value.
+var selectors = map[string]bool{
+	platform.DownsampleFirst: true,
+	platform.DownsampleLast:  true,
+	platform.DownsampleMax:   true,
+	platform.DownsampleMin:   true,
+}
+
+// Script returns the Flux script of the task that applies the aggregate
+// function fn to every field of the policy's source bucket that fn applies to,
+// writing the results to the policy's destination bucket.
+func Script(p *platform.DownsamplePolicy, fn string) string {
+	every := fluxDuration(p.Window)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "option task = {name: %s, every: %s}\n\n", fluxString(taskName(p, fn)), every)
+	fmt.Fprintf(&b, "from(bucketID: %s)\n", fluxString(p.SourceBucketID.String()))
+	fmt.Fprintf(&b, "\t|> range(start: -%s)\n", every)
+	if pred := fieldPredicate(p, fn); pred != "" {
+		fmt.Fprintf(&b, "\t|> filter(fn: (r) => %s)\n", pred)
+	}
+	fmt.Fprintf(&b, "\t|> window(every: %s)\n", every)
+	fmt.Fprintf(&b, "\t|> %s()\n", fn)
+	if !selectors[fn] {
+		b.WriteString("\t|> duplicate(column: \"_stop\", as: \"_time\")\n")
+	}
+	b.WriteString("\t|> window(every: inf)\n")
+	fmt.Fprintf(&b, "\t|> set(key: %s, value: %s)\n", fluxString(AggregateTagKey), fluxString(fn))
+	fmt.Fprintf(&b, "\t|> to(bucketID: %s, orgID: %s)\n", fluxString(p.DestinationBucketID.String()), fluxString(p.OrganizationID.String()))
+	return b.String()
+}
+
+func taskName(p *platform.DownsamplePolicy, fn string) string {
+	return fmt.Sprintf("downsample %s (%s)", p.Name, fn)
+}
+
+// fieldPredicate returns a Flux predicate selecting the fields that fn is
+// applied to, or an empty string if fn is applied to every field.
+func fieldPredicate(p *platform.DownsamplePolicy, fn string) string {
+	var overridden, included []string
+	for field, fns := range p.FieldOverrides {
+		overridden = append(overridden, field)
+		if contains(fns, fn) {
+			included = append(included, field)
+		}
+	}
+	sort.Strings(overridden)
+	sort.Strings(included)
+
+	var terms []string
+	if contains(p.Functions, fn) {
+		if len(overridden) == 0 {
+			return ""
+		}
+
+		excluded := make([]string, len(overridden))
+		for i, field := range overridden {
+			excluded[i] = "r._field != " + fluxString(field)
+		}
+		terms = append(terms, "("+strings.Join(excluded, " and ")+")")
+	}
+	for _, field := range included {
+		terms = append(terms, "r._field == "+fluxString(field))
+	}
+	return strings.Join(terms, " or ")
+}
+
+func contains(a []string, s string) bool {
+	for _, v := range a {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// fluxString returns s as a Flux string literal.
+func fluxString(s string) string {
+	s = strings.Replace(s, `\`, `\\`, -1)
+	s = strings.Replace(s, `"`, `\"`, -1)
+	return `"` + s + `"`
+}
+
+// fluxDuration returns d as a Flux duration literal, such as 1h30m.
+func fluxDuration(d time.Duration) string {
+	if d == 0 {
+		return "0s"
+	}
+
+	units := []struct {
+		unit string
+		d    time.Duration
+	}{
+		{"h", time.Hour},
+		{"m", time.Minute},
+		{"s", time.Second},
+		{"ms", time.Millisecond},
+		{"us", time.Microsecond},
+		{"ns", time.Nanosecond},
+	}
+
+	var b strings.Builder
+	for _, u := range units {
+		if n := d / u.d; n > 0 {
+			fmt.Fprintf(&b, "%d%s", n, u.unit)
+			d -= n * u.d
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,260 @@
+// Package downsample maintains the tasks that implement downsample policies.
+package downsample
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/influxdata/platform"
+	pcontext "github.com/influxdata/platform/context"
+)
+
+var _ platform.DownsamplePolicyService = (*Service)(nil)
+
+// Service is a platform.DownsamplePolicyService that generates a task for
+// each aggregate function of a policy, and keeps those tasks in sync as the
+// policy is updated and deleted. Policies are persisted by Store.
+type Service struct {
+	Store         platform.DownsamplePolicyService
+	TaskService   platform.TaskService
+	BucketService platform.BucketService
+}
+
+// NewService returns a Service persisting policies in store and running them
+// as tasks through ts.
+func NewService(store platform.DownsamplePolicyService, ts platform.TaskService, bs platform.BucketService) *Service {
+	return &Service{
+		Store:         store,
+		TaskService:   ts,
+		BucketService: bs,
+	}
+}
+
+// FindDownsamplePolicyByID returns a single downsample policy by ID.
+func (s *Service) FindDownsamplePolicyByID(ctx context.Context, id platform.ID) (*platform.DownsamplePolicy, error) {
+	return s.Store.FindDownsamplePolicyByID(ctx, id)
+}
+
+// FindDownsamplePolicies returns the downsample policies that match filter.
+func (s *Service) FindDownsamplePolicies(ctx context.Context, filter platform.DownsamplePolicyFilter) ([]*platform.DownsamplePolicy, int, error) {
+	return s.Store.FindDownsamplePolicies(ctx, filter)
+}
+
+// CreateDownsamplePolicy validates p, creates its tasks and stores it.
+func (s *Service) CreateDownsamplePolicy(ctx context.Context, p *platform.DownsamplePolicy) error {
+	op := platform.OpCreateDownsamplePolicy
+	if err := s.validate(ctx, p); err != nil {
+		return &platform.Error{
+			Op:  op,
+			Err: err,
+		}
+	}
+
+	p.TaskIDs = make(map[string]platform.ID)
+	for _, fn := range p.AggregateFunctions() {
+		id, err := s.createTask(ctx, p, fn)
+		if err != nil {
+			s.deleteTasks(ctx, p.TaskIDs)
+			return &platform.Error{
+				Op:  op,
+				Err: err,
+			}
+		}
+		p.TaskIDs[fn] = id
+	}
+
+	if err := s.Store.CreateDownsamplePolicy(ctx, p); err != nil {
+		s.deleteTasks(ctx, p.TaskIDs)
+		return err
+	}
+	return nil
+}
+
+// UpdateDownsamplePolicy applies upd to the policy, brings its tasks in line
+// with the updated policy and stores it. If the tasks or the policy cannot be
+// updated, the tasks are returned to their previous state.
+func (s *Service) UpdateDownsamplePolicy(ctx context.Context, id platform.ID, upd platform.DownsamplePolicyUpdate) (*platform.DownsamplePolicy, error) {
+	op := platform.OpUpdateDownsamplePolicy
+	old, err := s.Store.FindDownsamplePolicyByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	p := *old
+	upd.TaskIDs = nil // Tasks are only managed by the service.
+	upd.Apply(&p)
+	if err := s.validate(ctx, &p); err != nil {
+		return nil, &platform.Error{
+			Op:  op,
+			Err: err,
+		}
+	}
+
+	// created and updated hold the tasks to roll back if the update fails.
+	created := make(map[string]platform.ID)
+	var updated []string
+	rollback := func() {
+		s.deleteTasks(ctx, created)
+		for _, fn := range updated {
+			script := Script(old, fn)
+			s.TaskService.UpdateTask(ctx, old.TaskIDs[fn], platform.TaskUpdate{Flux: &script})
+		}
+	}
+
+	upd.TaskIDs = make(map[string]platform.ID)
+	for _, fn := range p.AggregateFunctions() {
+		taskID, ok := old.TaskIDs[fn]
+		if !ok {
+			if taskID, err = s.createTask(ctx, &p, fn); err != nil {
+				rollback()
+				return nil, &platform.Error{
+					Op:  op,
+					Err: err,
+				}
+			}
+			created[fn] = taskID
+			upd.TaskIDs[fn] = taskID
+			continue
+		}
+
+		script := Script(&p, fn)
+		if _, err := s.TaskService.UpdateTask(ctx, taskID, platform.TaskUpdate{Flux: &script}); err != nil {
+			rollback()
+			return nil, &platform.Error{
+				Op:  op,
+				Msg: fmt.Sprintf("unable to update %s task", fn),
+				Err: err,
+			}
+		}
+		updated = append(updated, fn)
+		upd.TaskIDs[fn] = taskID
+	}
+
+	np, err := s.Store.UpdateDownsamplePolicy(ctx, id, upd)
+	if err != nil {
+		rollback()
+		return nil, err
+	}
+
+	// Remove the tasks of functions the policy no longer uses. Tasks that
+	// cannot be deleted are kept with the policy, so that a later update or
+	// delete removes them.
+	unused := make(map[string]platform.ID)
+	for fn, taskID := range old.TaskIDs {
+		if _, ok := upd.TaskIDs[fn]; !ok {
+			unused[fn] = taskID
+		}
+	}
+	if remaining, err := s.deleteTasks(ctx, unused); err != nil {
+		for fn, taskID := range remaining {
+			upd.TaskIDs[fn] = taskID
+		}
+		s.Store.UpdateDownsamplePolicy(ctx, id, platform.DownsamplePolicyUpdate{TaskIDs: upd.TaskIDs})
+		return nil, &platform.Error{
+			Op:  op,
+			Err: err,
+		}
+	}
+
+	return np, nil
+}
+
+// DeleteDownsamplePolicy removes the policy and its tasks. If some tasks
+// cannot be deleted, the policy is kept with only those tasks, so that
+// deleting it again removes them.
+func (s *Service) DeleteDownsamplePolicy(ctx context.Context, id platform.ID) error {
+	op := platform.OpDeleteDownsamplePolicy
+	p, err := s.Store.FindDownsamplePolicyByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if remaining, err := s.deleteTasks(ctx, p.TaskIDs); err != nil {
+		s.Store.UpdateDownsamplePolicy(ctx, id, platform.DownsamplePolicyUpdate{TaskIDs: remaining})
+		return &platform.Error{
+			Op:  op,
+			Err: err,
+		}
+	}
+	return s.Store.DeleteDownsamplePolicy(ctx, id)
+}
+
+// validate checks that the policy is valid, that both of its buckets belong
+// to its organization, and that the authorizer on the context may read the
+// source bucket and write the destination bucket, as the policy's tasks do.
+func (s *Service) validate(ctx context.Context, p *platform.DownsamplePolicy) error {
+	if err := p.Valid(); err != nil {
+		return err
+	}
+
+	a, err := pcontext.GetAuthorizer(ctx)
+	if err != nil {
+		return err
+	}
+
+	buckets := []struct {
+		id     platform.ID
+		action platform.Action
+	}{
+		{id: p.SourceBucketID, action: platform.ReadAction},
+		{id: p.DestinationBucketID, action: platform.WriteAction},
+	}
+	for _, bucket := range buckets {
+		b, err := s.BucketService.FindBucketByID(ctx, bucket.id)
+		if err != nil {
+			return err
+		}
+		if b.OrganizationID != p.OrganizationID {
+			return &platform.Error{
+				Code: platform.EInvalid,
+				Msg:  fmt.Sprintf("bucket %s does not belong to organization %s", bucket.id, p.OrganizationID),
+			}
+		}
+		perm := platform.NewPermissionAtID(b.ID, bucket.action, platform.BucketResourceType, b.OrganizationID)
+		if !a.Allowed(perm) {
+			return &platform.Error{
+				Code: platform.EForbidden,
+				Msg:  fmt.Sprintf("not authorized to %s", perm),
+			}
+		}
+	}
+	return nil
+}
+
+// createTask creates the task applying fn for the policy, owned by the user
+// making the request.
+func (s *Service) createTask(ctx context.Context, p *platform.DownsamplePolicy, fn string) (platform.ID, error) {
+	a, err := pcontext.GetAuthorizer(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	t := &platform.Task{
+		Organization: p.OrganizationID,
+		Owner:        platform.User{ID: a.GetUserID()},
+		Flux:         Script(p, fn),
+	}
+	if err := s.TaskService.CreateTask(ctx, t); err != nil {
+		return 0, &platform.Error{
+			Msg: fmt.Sprintf("unable to create %s task", fn),
+			Err: err,
+		}
+	}
+	return t.ID, nil
+}
+
+// deleteTasks deletes every task in ids, returning the tasks that could not
+// be deleted and the first error encountered.
+func (s *Service) deleteTasks(ctx context.Context, ids map[string]platform.ID) (map[string]platform.ID, error) {
+	remaining := make(map[string]platform.ID)
+	var firstErr error
+	for fn, id := range ids {
+		if err := s.TaskService.DeleteTask(ctx, id); err != nil {
+			remaining[fn] = id
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+	return remaining, firstErr
+}
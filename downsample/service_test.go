@@ -0,0 +1,416 @@
+package downsample_test
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/influxdata/platform"
+	pcontext "github.com/influxdata/platform/context"
+	"github.com/influxdata/platform/downsample"
+	"github.com/influxdata/platform/inmem"
+	"github.com/influxdata/platform/mock"
+	_ "github.com/influxdata/platform/query/builtin"
+	"github.com/influxdata/platform/task/options"
+	platformtesting "github.com/influxdata/platform/testing"
+)
+
+var (
+	orgID    = platformtesting.MustIDBase16("020f755c3c082000")
+	userID   = platformtesting.MustIDBase16("020f755c3c082001")
+	sourceID = platformtesting.MustIDBase16("020f755c3c082002")
+	destID   = platformtesting.MustIDBase16("020f755c3c082003")
+	otherID  = platformtesting.MustIDBase16("020f755c3c082004")
+)
+
+// taskService is an in-memory TaskService keeping just enough state to
+// check the tasks created for policies.
+type taskService struct {
+	mock.TaskService
+	next  platform.ID
+	tasks map[platform.ID]*platform.Task
+}
+
+func newTaskService() *taskService {
+	s := &taskService{
+		next:  platformtesting.MustIDBase16("020f755c3c083000"),
+		tasks: make(map[platform.ID]*platform.Task),
+	}
+	s.CreateTaskFn = func(ctx context.Context, t *platform.Task) error {
+		t.ID = s.next
+		s.next++
+		s.tasks[t.ID] = t
+		return nil
+	}
+	s.UpdateTaskFn = func(ctx context.Context, id platform.ID, upd platform.TaskUpdate) (*platform.Task, error) {
+		t, ok := s.tasks[id]
+		if !ok {
+			return nil, &platform.Error{Code: platform.ENotFound, Msg: "task not found"}
+		}
+		if upd.Flux != nil {
+			t.Flux = *upd.Flux
+		}
+		return t, nil
+	}
+	s.DeleteTaskFn = func(ctx context.Context, id platform.ID) error {
+		if _, ok := s.tasks[id]; !ok {
+			return &platform.Error{Code: platform.ENotFound, Msg: "task not found"}
+		}
+		delete(s.tasks, id)
+		return nil
+	}
+	return s
+}
+
+func newBucketService() *mock.BucketService {
+	bs := mock.NewBucketService()
+	bs.FindBucketByIDFn = func(ctx context.Context, id platform.ID) (*platform.Bucket, error) {
+		switch id {
+		case sourceID, destID:
+			return &platform.Bucket{ID: id, OrganizationID: orgID}, nil
+		case otherID:
+			return &platform.Bucket{ID: id, OrganizationID: otherID}, nil
+		}
+		return nil, &platform.Error{Code: platform.ENotFound, Msg: "bucket not found"}
+	}
+	return bs
+}
+
+func newService() (*downsample.Service, *taskService) {
+	ts := newTaskService()
+	return downsample.NewService(inmem.NewService(), ts, newBucketService()), ts
+}
+
+// newContext returns a context authorized to read the source bucket and to
+// write the destination bucket.
+func newContext() context.Context {
+	return pcontext.SetAuthorizer(context.Background(), &platform.Authorization{
+		UserID: userID,
+		Status: platform.Active,
+		Permissions: []platform.Permission{
+			platform.NewPermissionAtID(sourceID, platform.ReadAction, platform.BucketResourceType, orgID),
+			platform.NewPermissionAtID(destID, platform.WriteAction, platform.BucketResourceType, orgID),
+		},
+	})
+}
+
+func newPolicy() *platform.DownsamplePolicy {
+	return &platform.DownsamplePolicy{
+		OrganizationID:      orgID,
+		Name:                "cpu",
+		SourceBucketID:      sourceID,
+		DestinationBucketID: destID,
+		Window:              5 * time.Minute,
+		Functions:           []string{platform.DownsampleMean, platform.DownsampleMax},
+		FieldOverrides: map[string][]string{
+			"count": {platform.DownsampleSum},
+		},
+	}
+}
+
+// checkTasks verifies that the tasks of p are exactly the tasks in ts, and
+// that each runs the script of its function.
+func checkTasks(t *testing.T, p *platform.DownsamplePolicy, ts *taskService) {
+	t.Helper()
+
+	if len(p.TaskIDs) != len(ts.tasks) {
+		t.Fatalf("unexpected number of tasks: got %d, want %d", len(ts.tasks), len(p.TaskIDs))
+	}
+
+	var fns []string
+	for fn, id := range p.TaskIDs {
+		fns = append(fns, fn)
+
+		task, ok := ts.tasks[id]
+		if !ok {
+			t.Fatalf("task %s for %s does not exist", id, fn)
+		}
+		if task.Organization != p.OrganizationID {
+			t.Errorf("unexpected task organization: got %s, want %s", task.Organization, p.OrganizationID)
+		}
+		if task.Owner.ID != userID {
+			t.Errorf("unexpected task owner: got %s, want %s", task.Owner.ID, userID)
+		}
+		if want := downsample.Script(p, fn); task.Flux != want {
+			t.Errorf("unexpected %s task script:\ngot:\n%s\nwant:\n%s", fn, task.Flux, want)
+		}
+	}
+	sort.Strings(fns)
+
+	if got, want := strings.Join(fns, ","), strings.Join(p.AggregateFunctions(), ","); got != want {
+		t.Errorf("unexpected task functions: got %s, want %s", got, want)
+	}
+}
+
+func TestService_CreateDownsamplePolicy(t *testing.T) {
+	s, ts := newService()
+	ctx := newContext()
+
+	p := newPolicy()
+	if err := s.CreateDownsamplePolicy(ctx, p); err != nil {
+		t.Fatal(err)
+	}
+	checkTasks(t, p, ts)
+
+	stored, err := s.FindDownsamplePolicyByID(ctx, p.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkTasks(t, stored, ts)
+}
+
+func TestService_CreateDownsamplePolicy_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func(p *platform.DownsamplePolicy)
+		code string
+	}{
+		{
+			name: "unknown function",
+			fn: func(p *platform.DownsamplePolicy) {
+				p.Functions = []string{"median"}
+			},
+		},
+		{
+			name: "bucket of another organization",
+			fn: func(p *platform.DownsamplePolicy) {
+				p.DestinationBucketID = otherID
+			},
+		},
+		{
+			name: "unreadable source",
+			fn: func(p *platform.DownsamplePolicy) {
+				p.SourceBucketID, p.DestinationBucketID = p.DestinationBucketID, p.SourceBucketID
+			},
+			code: platform.EForbidden,
+		},
+		{
+			name: "same source and destination",
+			fn: func(p *platform.DownsamplePolicy) {
+				p.DestinationBucketID = p.SourceBucketID
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, ts := newService()
+
+			p := newPolicy()
+			tt.fn(p)
+			err := s.CreateDownsamplePolicy(newContext(), p)
+			code := tt.code
+			if code == "" {
+				code = platform.EInvalid
+			}
+			if got := platform.ErrorCode(err); got != code {
+				t.Fatalf("unexpected error code: got %q, want %q (%v)", got, code, err)
+			}
+			if len(ts.tasks) != 0 {
+				t.Errorf("tasks were created for an invalid policy")
+			}
+		})
+	}
+}
+
+func TestService_UpdateDownsamplePolicy(t *testing.T) {
+	s, ts := newService()
+	ctx := newContext()
+
+	p := newPolicy()
+	if err := s.CreateDownsamplePolicy(ctx, p); err != nil {
+		t.Fatal(err)
+	}
+	meanTaskID := p.TaskIDs[platform.DownsampleMean]
+
+	window := time.Hour
+	p, err := s.UpdateDownsamplePolicy(ctx, p.ID, platform.DownsamplePolicyUpdate{
+		Window:         &window,
+		Functions:      []string{platform.DownsampleMean, platform.DownsampleLast},
+		FieldOverrides: map[string][]string{},
+		TaskIDs:        map[string]platform.ID{"ignored": orgID},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkTasks(t, p, ts)
+
+	if got := p.TaskIDs[platform.DownsampleMean]; got != meanTaskID {
+		t.Errorf("task of a retained function was replaced: got %s, want %s", got, meanTaskID)
+	}
+
+	stored, err := s.FindDownsamplePolicyByID(ctx, p.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkTasks(t, stored, ts)
+}
+
+func TestService_UpdateDownsamplePolicy_Unauthorized(t *testing.T) {
+	tests := []struct {
+		name string
+		perm platform.Permission
+	}{
+		{
+			name: "unreadable source",
+			perm: platform.NewPermissionAtID(destID, platform.WriteAction, platform.BucketResourceType, orgID),
+		},
+		{
+			name: "unwritable destination",
+			perm: platform.NewPermissionAtID(sourceID, platform.ReadAction, platform.BucketResourceType, orgID),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, ts := newService()
+
+			p := newPolicy()
+			if err := s.CreateDownsamplePolicy(newContext(), p); err != nil {
+				t.Fatal(err)
+			}
+
+			// The tasks run with the buckets of the policy, so a user updating
+			// it must be allowed to access them too.
+			ctx := pcontext.SetAuthorizer(context.Background(), &platform.Authorization{
+				UserID:      userID,
+				Status:      platform.Active,
+				Permissions: []platform.Permission{tt.perm},
+			})
+			window := time.Hour
+			_, err := s.UpdateDownsamplePolicy(ctx, p.ID, platform.DownsamplePolicyUpdate{Window: &window})
+			if got := platform.ErrorCode(err); got != platform.EForbidden {
+				t.Fatalf("unexpected error code: got %q, want %q (%v)", got, platform.EForbidden, err)
+			}
+
+			stored, err := s.FindDownsamplePolicyByID(ctx, p.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			checkTasks(t, p, ts)
+			checkTasks(t, stored, ts)
+		})
+	}
+}
+
+func TestService_UpdateDownsamplePolicy_Rollback(t *testing.T) {
+	s, ts := newService()
+	ctx := newContext()
+
+	p := newPolicy()
+	if err := s.CreateDownsamplePolicy(ctx, p); err != nil {
+		t.Fatal(err)
+	}
+
+	// The mean task is updated before creating the min task fails.
+	ts.CreateTaskFn = func(ctx context.Context, t *platform.Task) error {
+		return &platform.Error{Code: platform.EInternal, Msg: "unable to create task"}
+	}
+	window := time.Hour
+	if _, err := s.UpdateDownsamplePolicy(ctx, p.ID, platform.DownsamplePolicyUpdate{
+		Window:    &window,
+		Functions: []string{platform.DownsampleMean, platform.DownsampleMin},
+	}); err == nil {
+		t.Fatal("expected error creating a task")
+	}
+
+	stored, err := s.FindDownsamplePolicyByID(ctx, p.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkTasks(t, p, ts)
+	checkTasks(t, stored, ts)
+}
+
+func TestService_DeleteDownsamplePolicy(t *testing.T) {
+	s, ts := newService()
+	ctx := newContext()
+
+	p := newPolicy()
+	if err := s.CreateDownsamplePolicy(ctx, p); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := s.DeleteDownsamplePolicy(ctx, p.ID); err != nil {
+		t.Fatal(err)
+	}
+	if len(ts.tasks) != 0 {
+		t.Errorf("unexpected tasks remaining after delete: %d", len(ts.tasks))
+	}
+	if _, err := s.FindDownsamplePolicyByID(ctx, p.ID); platform.ErrorCode(err) != platform.ENotFound {
+		t.Errorf("expected policy to be deleted, got %v", err)
+	}
+}
+
+func TestService_DeleteDownsamplePolicy_Partial(t *testing.T) {
+	s, ts := newService()
+	ctx := newContext()
+
+	p := newPolicy()
+	if err := s.CreateDownsamplePolicy(ctx, p); err != nil {
+		t.Fatal(err)
+	}
+
+	// The policy keeps the tasks that could not be deleted.
+	maxTaskID := p.TaskIDs[platform.DownsampleMax]
+	deleteTask := ts.DeleteTaskFn
+	ts.DeleteTaskFn = func(ctx context.Context, id platform.ID) error {
+		if id == maxTaskID {
+			return &platform.Error{Code: platform.EInternal, Msg: "unable to delete task"}
+		}
+		return deleteTask(ctx, id)
+	}
+	if err := s.DeleteDownsamplePolicy(ctx, p.ID); err == nil {
+		t.Fatal("expected error deleting a task")
+	}
+
+	stored, err := s.FindDownsamplePolicyByID(ctx, p.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stored.TaskIDs) != 1 || stored.TaskIDs[platform.DownsampleMax] != maxTaskID || len(ts.tasks) != 1 {
+		t.Errorf("unexpected tasks after partial delete: policy %v, tasks %d", stored.TaskIDs, len(ts.tasks))
+	}
+
+	ts.DeleteTaskFn = deleteTask
+	if err := s.DeleteDownsamplePolicy(ctx, p.ID); err != nil {
+		t.Fatal(err)
+	}
+	if len(ts.tasks) != 0 {
+		t.Errorf("unexpected tasks remaining after delete: %d", len(ts.tasks))
+	}
+}
+
+func TestScript(t *testing.T) {
+	p := newPolicy()
+	p.ID = platformtesting.MustIDBase16("020f755c3c082005")
+
+	want := `option task = {name: "downsample cpu (mean)", every: 5m}
+
+from(bucketID: "020f755c3c082002")
+	|> range(start: -5m)
+	|> filter(fn: (r) => (r._field != "count"))
+	|> window(every: 5m)
+	|> mean()
+	|> duplicate(column: "_stop", as: "_time")
+	|> window(every: inf)
+	|> set(key: "aggregate", value: "mean")
+	|> to(bucketID: "020f755c3c082003", orgID: "020f755c3c082000")
+`
+	if got := downsample.Script(p, platform.DownsampleMean); got != want {
+		t.Errorf("unexpected script:\ngot:\n%s\nwant:\n%s", got, want)
+	}
+
+	for _, fn := range p.AggregateFunctions() {
+		script := downsample.Script(p, fn)
+		opts, err := options.FromScript(script)
+		if err != nil {
+			t.Fatalf("invalid %s script: %v\n%s", fn, err, script)
+		}
+		if opts.Every != p.Window {
+			t.Errorf("unexpected %s task interval: got %v, want %v", fn, opts.Every, p.Window)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package gather
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/influxdata/platform"
+)
+
+// clients caches an http client for each distinct TLS configuration of the scraper targets,
+// so that scrapes of targets sharing a configuration reuse connections.
+var clients = struct {
+	sync.Mutex
+	m map[platform.ScraperTLSConfig]*http.Client
+}{
+	m: make(map[platform.ScraperTLSConfig]*http.Client),
+}
+
+// httpClient returns the http client to scrape targets with the TLS configuration c.
+func httpClient(c *platform.ScraperTLSConfig) (*http.Client, error) {
+	if c == nil || *c == (platform.ScraperTLSConfig{}) {
+		return http.DefaultClient, nil
+	}
+
+	clients.Lock()
+	defer clients.Unlock()
+
+	if hc, ok := clients.m[*c]; ok {
+		return hc, nil
+	}
+
+	tc := &tls.Config{
+		InsecureSkipVerify: c.InsecureSkipVerify,
+	}
+	if c.CA != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(c.CA)) {
+			return nil, fmt.Errorf("tls ca contains no valid PEM certificates")
+		}
+		tc.RootCAs = pool
+	}
+
+	hc := &http.Client{
+		Transport: &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: tc,
+		},
+	}
+	clients.m[*c] = hc
+	return hc, nil
+}
+
+// retainClients removes the http clients of the TLS configurations that none of the targets use,
+// closing their idle connections. Scrapes in progress with a removed client are not interrupted.
+func retainClients(targets []platform.ScraperTarget) {
+	used := make(map[platform.ScraperTLSConfig]bool, len(targets))
+	for _, t := range targets {
+		if t.TLS != nil {
+			used[*t.TLS] = true
+		}
+	}
+
+	clients.Lock()
+	defer clients.Unlock()
+
+	for c, hc := range clients.m {
+		if used[c] {
+			continue
+		}
+		if tr, ok := hc.Transport.(*http.Transport); ok {
+			tr.CloseIdleConnections()
+		}
+		delete(clients.m, c)
+	}
+}
+
+// httpGet requests the target's url with the target's headers and TLS configuration,
+// and returns the response if its status is 200 OK.
+func httpGet(ctx context.Context, target platform.ScraperTarget) (*http.Response, error) {
+	hc, err := httpClient(target.TLS)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", target.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range target.Headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := hc.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("unexpected status %s from %s", resp.Status, target.URL)
+	}
+	return resp, nil
+}
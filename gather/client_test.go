@@ -0,0 +1,176 @@
+package gather
+
+import (
+	"context"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/mock"
+)
+
+func TestHTTPGet(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" || r.Header.Get("X-Scope") != "metrics" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	ca := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw}))
+	headers := map[string]string{
+		"Authorization": "Bearer secret",
+		"X-Scope":       "metrics",
+	}
+
+	for _, c := range []struct {
+		name   string
+		target platform.ScraperTarget
+		hasErr bool
+	}{
+		{
+			name:   "unknown certificate authority",
+			target: platform.ScraperTarget{URL: ts.URL, Headers: headers},
+			hasErr: true,
+		},
+		{
+			name:   "custom certificate authority",
+			target: platform.ScraperTarget{URL: ts.URL, Headers: headers, TLS: &platform.ScraperTLSConfig{CA: ca}},
+		},
+		{
+			name:   "insecure skip verify",
+			target: platform.ScraperTarget{URL: ts.URL, Headers: headers, TLS: &platform.ScraperTLSConfig{InsecureSkipVerify: true}},
+		},
+		{
+			name:   "missing headers",
+			target: platform.ScraperTarget{URL: ts.URL, TLS: &platform.ScraperTLSConfig{CA: ca}},
+			hasErr: true,
+		},
+		{
+			name:   "invalid certificate authority",
+			target: platform.ScraperTarget{URL: ts.URL, Headers: headers, TLS: &platform.ScraperTLSConfig{CA: "not a certificate"}},
+			hasErr: true,
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			resp, err := httpGet(context.Background(), c.target)
+			if err == nil {
+				resp.Body.Close()
+			}
+			if (err != nil) != c.hasErr {
+				t.Fatalf("httpGet() error = %v, hasErr %v", err, c.hasErr)
+			}
+		})
+	}
+}
+
+func TestHandler_Authorize(t *testing.T) {
+	secrets := mock.NewSecretService()
+	secrets.LoadSecretFn = func(ctx context.Context, orgID platform.ID, k string) (string, error) {
+		if orgID != 1 || k != "exporter-token" {
+			return "", fmt.Errorf("secret %q not found", k)
+		}
+		return "secret", nil
+	}
+	h := &handler{
+		Secrets: secrets,
+	}
+
+	target := &platform.ScraperTarget{
+		OrgID:             1,
+		BearerTokenSecret: "exporter-token",
+		Headers:           map[string]string{"X-Scope": "metrics"},
+	}
+	original := target.Headers
+	if err := h.authorize(context.Background(), target); err != nil {
+		t.Fatal(err)
+	}
+	if got := target.Headers["Authorization"]; got != "Bearer secret" {
+		t.Fatalf("expected bearer token header, got %q", got)
+	}
+	if got := target.Headers["X-Scope"]; got != "metrics" {
+		t.Fatalf("expected target headers to be kept, got %q", got)
+	}
+	if _, ok := original["Authorization"]; ok {
+		t.Fatal("expected the target's headers not to be modified in place")
+	}
+
+	target = &platform.ScraperTarget{OrgID: 2, BearerTokenSecret: "exporter-token"}
+	if err := h.authorize(context.Background(), target); err == nil {
+		t.Fatal("expected error loading a secret of another organization")
+	}
+
+	h.Secrets = nil
+	target = &platform.ScraperTarget{OrgID: 1, BearerTokenSecret: "exporter-token"}
+	if err := h.authorize(context.Background(), target); err == nil {
+		t.Fatal("expected error without a secret service")
+	}
+
+	target = &platform.ScraperTarget{}
+	if err := h.authorize(context.Background(), target); err != nil {
+		t.Fatalf("unexpected error for target without bearer token: %v", err)
+	}
+}
+
+func TestHandler_Authorize_BasicAuth(t *testing.T) {
+	secrets := mock.NewSecretService()
+	secrets.LoadSecretFn = func(ctx context.Context, orgID platform.ID, k string) (string, error) {
+		if orgID != 1 || k != "exporter-password" {
+			return "", fmt.Errorf("secret %q not found", k)
+		}
+		return "bar", nil
+	}
+	h := &handler{
+		Secrets: secrets,
+	}
+
+	target := &platform.ScraperTarget{
+		OrgID:                   1,
+		BasicAuthUsername:       "foo",
+		BasicAuthPasswordSecret: "exporter-password",
+	}
+	if err := h.authorize(context.Background(), target); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := target.Headers["Authorization"], "Basic Zm9vOmJhcg=="; got != want {
+		t.Fatalf("unexpected basic auth header: got %q, want %q", got, want)
+	}
+
+	target = &platform.ScraperTarget{OrgID: 2, BasicAuthUsername: "foo", BasicAuthPasswordSecret: "exporter-password"}
+	if err := h.authorize(context.Background(), target); err == nil {
+		t.Fatal("expected error loading a secret of another organization")
+	}
+}
+
+func TestRetainClients(t *testing.T) {
+	kept := &platform.ScraperTLSConfig{InsecureSkipVerify: true}
+	removed := &platform.ScraperTLSConfig{CA: "removed"}
+
+	keptClient, err := httpClient(kept)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clients.Lock()
+	clients.m[*removed] = &http.Client{Transport: &http.Transport{}}
+	clients.Unlock()
+
+	retainClients([]platform.ScraperTarget{{TLS: kept}, {}})
+
+	clients.Lock()
+	_, hasRemoved := clients.m[*removed]
+	n := len(clients.m)
+	clients.Unlock()
+	if hasRemoved || n != 1 {
+		t.Fatalf("expected only the client of the used configuration to be kept, got %d clients", n)
+	}
+	if hc, err := httpClient(kept); err != nil {
+		t.Fatal(err)
+	} else if hc != keptClient {
+		t.Fatal("expected the client of a used configuration to be reused")
+	}
+}
@@ -1,24 +1,38 @@
 package gather
 
 import (
-	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"fmt"
+	"time"
 
 	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/models"
 	"github.com/influxdata/platform/nats"
+	"github.com/influxdata/platform/storage"
+	"github.com/influxdata/platform/tsdb"
 	"go.uber.org/zap"
 )
 
 // handler implents nats Handler interface.
 type handler struct {
-	Scraper   Scraper
-	Publisher nats.Publisher
-	Logger    *zap.Logger
+	Scraper Scraper
+	// Buckets resolves the bucket the metrics of scraper targets are written to.
+	Buckets platform.BucketService
+	// PointsWriter writes the gathered metrics to storage.
+	PointsWriter storage.PointsWriter
+	// Secrets resolves the bearer tokens of scraper targets.
+	Secrets platform.SecretService
+	// Status records the outcome of every scrape, if set.
+	Status platform.ScraperTargetStatusService
+	// Timeout bounds the scrape of targets without a timeout of their own.
+	Timeout time.Duration
+	Logger  *zap.Logger
 }
 
 // Process consumes scraper target from scraper target queue,
-// call the scraper to gather, and publish to metrics queue.
+// call the scraper to gather, and writes the metrics to the target's bucket.
 func (h *handler) Process(s nats.Subscription, m nats.Message) {
 	defer m.Ack()
 
@@ -29,22 +43,146 @@ func (h *handler) Process(s nats.Subscription, m nats.Message) {
 		return
 	}
 
-	ms, err := h.Scraper.Gather(context.TODO(), *req)
+	timeout := req.Timeout
+	if timeout == 0 {
+		timeout = h.Timeout
+	}
+	ctx := context.Background()
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	ms, err := h.gather(ctx, req)
 	if err != nil {
 		h.Logger.Error("unable to gather", zap.Error(err))
-		return
 	}
+	h.recordScrape(req, start, time.Since(start), len(ms), err)
+	ms = append(ms, scrapeMetrics(*req, start, time.Since(start), len(ms), err)...)
 
-	// send metrics to storage queue
-	buf := new(bytes.Buffer)
-	if err := json.NewEncoder(buf).Encode(ms); err != nil {
-		h.Logger.Error("unable to marshal json", zap.Error(err))
-		return
+	if err := h.write(context.Background(), req, ms); err != nil {
+		h.Logger.Error("unable to write scraper metrics", zap.Error(err))
 	}
+}
 
-	if err := h.Publisher.Publish(MetricsSubject, buf); err != nil {
-		h.Logger.Error("unable to publish scraper metrics", zap.Error(err))
+// write writes the metrics to the bucket of target.
+func (h *handler) write(ctx context.Context, target *platform.ScraperTarget, ms []Metrics) error {
+	filter := platform.BucketFilter{Name: &target.BucketName}
+	if target.OrgID.Valid() {
+		filter.OrganizationID = &target.OrgID
+	} else {
+		filter.Organization = &target.OrgName
+	}
+	b, err := h.Buckets.FindBucket(ctx, filter)
+	if err != nil {
+		return fmt.Errorf("unable to find bucket %q of scraper target %s: %v", target.BucketName, target.ID, err)
+	}
+
+	points := make([]models.Point, 0, len(ms))
+	for _, m := range ms {
+		pt, err := models.NewPoint(m.Name, models.NewTags(m.Tags), m.Fields, time.Unix(0, m.Timestamp))
+		if err != nil {
+			return err
+		}
+		points = append(points, pt)
+	}
+
+	exploded, err := tsdb.ExplodePoints(b.OrganizationID, b.ID, points)
+	if err != nil {
+		return err
+	}
+	return h.PointsWriter.WritePoints(exploded)
+}
+
+func (h *handler) gather(ctx context.Context, target *platform.ScraperTarget) ([]Metrics, error) {
+	if err := h.authorize(ctx, target); err != nil {
+		return nil, fmt.Errorf("unable to load credentials: %v", err)
+	}
+	return h.Scraper.Gather(ctx, *target)
+}
+
+// recordScrape updates the target's status with the outcome of a scrape.
+func (h *handler) recordScrape(target *platform.ScraperTarget, start time.Time, d time.Duration, samples int, err error) {
+	if h.Status == nil {
 		return
 	}
 
+	r := platform.ScrapeResult{
+		Time:        start,
+		Duration:    d,
+		SampleCount: samples,
+	}
+	if err != nil {
+		r.Err = err.Error()
+	}
+	if _, err := h.Status.RecordScrape(context.Background(), target.ID, r); err != nil {
+		h.Logger.Error("unable to record scrape status", zap.Error(err))
+	}
+}
+
+// scrapeMetrics returns the metrics describing a scrape of target, like the ones prometheus adds to every scrape:
+// up is 1 if the scrape succeeded and 0 otherwise, and the scrape's duration and number of samples.
+// The metrics are tagged with the target's ID rather than its URL,
+// which may carry credentials and would add a series whenever it changes.
+func scrapeMetrics(target platform.ScraperTarget, start time.Time, d time.Duration, samples int, err error) []Metrics {
+	up := float64(1)
+	if err != nil {
+		up = 0
+	}
+
+	tags := map[string]string{
+		"target": target.ID.String(),
+	}
+	gauge := func(name string, v float64) Metrics {
+		return Metrics{
+			Name:      name,
+			Tags:      tags,
+			Fields:    map[string]interface{}{"gauge": v},
+			Timestamp: start.UnixNano(),
+			Type:      MetricTypeGauge,
+		}
+	}
+	return []Metrics{
+		gauge("up", up),
+		gauge("scrape_duration_seconds", d.Seconds()),
+		gauge("scrape_samples_scraped", float64(samples)),
+	}
+}
+
+// authorize adds the Authorization header carrying the bearer token or basic
+// auth credentials of target, if it has any. The secrets are loaded from the
+// target organization's secrets on every scrape, so that they are never
+// published to the scrape queue.
+func (h *handler) authorize(ctx context.Context, target *platform.ScraperTarget) error {
+	var secret string
+	switch {
+	case target.BearerTokenSecret != "":
+		secret = target.BearerTokenSecret
+	case target.BasicAuthPasswordSecret != "":
+		secret = target.BasicAuthPasswordSecret
+	default:
+		return nil
+	}
+	if h.Secrets == nil {
+		return fmt.Errorf("no secret service to load credentials of scraper target %s", target.ID)
+	}
+
+	v, err := h.Secrets.LoadSecret(ctx, target.OrgID, secret)
+	if err != nil {
+		return err
+	}
+
+	headers := make(map[string]string, len(target.Headers)+1)
+	for k, v := range target.Headers {
+		headers[k] = v
+	}
+	if target.BearerTokenSecret != "" {
+		headers["Authorization"] = "Bearer " + v
+	} else {
+		headers["Authorization"] = "Basic " + base64.StdEncoding.EncodeToString([]byte(target.BasicAuthUsername+":"+v))
+	}
+	target.Headers = headers
+	return nil
 }
@@ -0,0 +1,115 @@
+package gather
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/influxdata/platform"
+)
+
+// jsonScraper maps values of a JSON document to metrics,
+// as configured by the target's JSON options.
+// implements Scraper interfaces.
+type jsonScraper struct{}
+
+// Gather parse metrics from a scraper target url.
+func (j *jsonScraper) Gather(ctx context.Context, target platform.ScraperTarget) (ms []Metrics, err error) {
+	if target.JSON == nil {
+		return nil, fmt.Errorf("json scraper target %s has no json options", target.ID)
+	}
+
+	resp, err := httpGet(ctx, target)
+	if err != nil {
+		return ms, err
+	}
+	defer resp.Body.Close()
+
+	return j.parse(resp.Body, *target.JSON, time.Now())
+}
+
+func (j *jsonScraper) parse(r io.Reader, opts platform.JSONScraperOptions, now time.Time) ([]Metrics, error) {
+	var doc interface{}
+	if err := json.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("reading json failed: %s", err)
+	}
+
+	records := []interface{}{doc}
+	if opts.Records != "" {
+		p, err := parseJSONPath(opts.Records)
+		if err != nil {
+			return nil, err
+		}
+		v, ok := p.lookup(doc)
+		if !ok {
+			return nil, fmt.Errorf("records path %q not found", opts.Records)
+		}
+		if records, ok = v.([]interface{}); !ok {
+			return nil, fmt.Errorf("records path %q does not select an array", opts.Records)
+		}
+	}
+
+	fieldPaths, err := parseJSONPaths(opts.Fields)
+	if err != nil {
+		return nil, err
+	}
+	tagPaths, err := parseJSONPaths(opts.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	ms := make([]Metrics, 0, len(records))
+	for _, record := range records {
+		fields := make(map[string]interface{}, len(fieldPaths))
+		for k, p := range fieldPaths {
+			v, ok := p.lookup(record)
+			if !ok {
+				continue
+			}
+			switch v := v.(type) {
+			case float64, string, bool:
+				fields[k] = v
+			}
+		}
+		if len(fields) == 0 {
+			continue
+		}
+
+		tags := make(map[string]string, len(tagPaths))
+		for k, p := range tagPaths {
+			v, ok := p.lookup(record)
+			if !ok {
+				continue
+			}
+			switch v := v.(type) {
+			case string:
+				tags[k] = v
+			case float64, bool:
+				tags[k] = fmt.Sprint(v)
+			}
+		}
+
+		ms = append(ms, Metrics{
+			Name:      opts.Measurement,
+			Tags:      tags,
+			Fields:    fields,
+			Timestamp: now.UnixNano(),
+			Type:      MetricTypeUntyped,
+		})
+	}
+	return ms, nil
+}
+
+func parseJSONPaths(m map[string]string) (map[string]jsonPath, error) {
+	ps := make(map[string]jsonPath, len(m))
+	for k, s := range m {
+		p, err := parseJSONPath(s)
+		if err != nil {
+			return nil, err
+		}
+		ps[k] = p
+	}
+	return ps, nil
+}
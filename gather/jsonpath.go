@@ -0,0 +1,87 @@
+package gather
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// jsonPath is a parsed JSONPath expression.
+// Only the subset needed to select a single value is supported:
+// the root $, child names as .name or ['name'], and array indexes as [0].
+type jsonPath []jsonPathStep
+
+type jsonPathStep struct {
+	key   string
+	index int
+	// isIndex is true if the step selects an array element rather than an object member.
+	isIndex bool
+}
+
+// parseJSONPath parses a JSONPath expression such as $.nodes[0]['cpu.load'].
+func parseJSONPath(s string) (jsonPath, error) {
+	if !strings.HasPrefix(s, "$") {
+		return nil, fmt.Errorf("json path %q must start with $", s)
+	}
+
+	var p jsonPath
+	for rest := s[1:]; len(rest) > 0; {
+		switch rest[0] {
+		case '.':
+			end := strings.IndexAny(rest[1:], ".[")
+			if end < 0 {
+				end = len(rest) - 1
+			}
+			key := rest[1 : end+1]
+			if key == "" {
+				return nil, fmt.Errorf("json path %q has an empty name", s)
+			}
+			p = append(p, jsonPathStep{key: key})
+			rest = rest[end+1:]
+		case '[':
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("json path %q has an unterminated [", s)
+			}
+			sel := rest[1:end]
+			rest = rest[end+1:]
+
+			if n := len(sel); n >= 2 && (sel[0] == '\'' || sel[0] == '"') && sel[n-1] == sel[0] {
+				p = append(p, jsonPathStep{key: sel[1 : n-1]})
+				continue
+			}
+			i, err := strconv.Atoi(sel)
+			if err != nil || i < 0 {
+				return nil, fmt.Errorf("json path %q has an invalid index %q", s, sel)
+			}
+			p = append(p, jsonPathStep{index: i, isIndex: true})
+		default:
+			return nil, fmt.Errorf("json path %q has an unexpected character %q", s, rest[0])
+		}
+	}
+	return p, nil
+}
+
+// lookup returns the value selected by p in v, a document decoded by encoding/json.
+// It returns false if the value does not exist.
+func (p jsonPath) lookup(v interface{}) (interface{}, bool) {
+	for _, step := range p {
+		if step.isIndex {
+			a, ok := v.([]interface{})
+			if !ok || step.index >= len(a) {
+				return nil, false
+			}
+			v = a[step.index]
+			continue
+		}
+
+		o, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if v, ok = o[step.key]; !ok {
+			return nil, false
+		}
+	}
+	return v, true
+}
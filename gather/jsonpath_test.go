@@ -0,0 +1,55 @@
+package gather
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestJSONPath(t *testing.T) {
+	const doc = `{"a": {"b.c": [10, {"d": "x"}]}, "e": true}`
+	var v interface{}
+	if err := json.Unmarshal([]byte(doc), &v); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, c := range []struct {
+		path     string
+		want     interface{}
+		found    bool
+		parseErr bool
+	}{
+		{path: "$", want: v, found: true},
+		{path: "$.e", want: true, found: true},
+		{path: "$.a['b.c'][0]", want: float64(10), found: true},
+		{path: `$.a["b.c"][1].d`, want: "x", found: true},
+		{path: "$.a['b.c'][2]", found: false},
+		{path: "$.e.f", found: false},
+		{path: "$.missing", found: false},
+		{path: "a.b", parseErr: true},
+		{path: "$.", parseErr: true},
+		{path: "$.a[", parseErr: true},
+		{path: "$.a[-1]", parseErr: true},
+		{path: "$a", parseErr: true},
+	} {
+		p, err := parseJSONPath(c.path)
+		if c.parseErr {
+			if err == nil {
+				t.Errorf("expected parse error for %q", c.path)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("unexpected parse error for %q: %v", c.path, err)
+			continue
+		}
+
+		got, found := p.lookup(v)
+		if found != c.found {
+			t.Errorf("lookup %q: expected found %v, got %v", c.path, c.found, found)
+			continue
+		}
+		if found && c.path != "$" && got != c.want {
+			t.Errorf("lookup %q: expected %v, got %v", c.path, c.want, got)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package gather
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"time"
+
+	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/models"
+)
+
+// lineProtocolScraper handles parsing InfluxDB line protocol metrics.
+// implements Scraper interfaces.
+type lineProtocolScraper struct{}
+
+// Gather parse metrics from a scraper target url.
+func (l *lineProtocolScraper) Gather(ctx context.Context, target platform.ScraperTarget) (ms []Metrics, err error) {
+	resp, err := httpGet(ctx, target)
+	if err != nil {
+		return ms, err
+	}
+	defer resp.Body.Close()
+
+	return l.parse(resp.Body, time.Now())
+}
+
+func (l *lineProtocolScraper) parse(r io.Reader, now time.Time) ([]Metrics, error) {
+	buf, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	points, err := models.ParsePointsWithPrecision(buf, now, "n")
+	if err != nil {
+		return nil, fmt.Errorf("reading line protocol failed: %s", err)
+	}
+
+	ms := make([]Metrics, 0, len(points))
+	for _, p := range points {
+		fields, err := p.Fields()
+		if err != nil {
+			return nil, fmt.Errorf("reading line protocol failed: %s", err)
+		}
+
+		tags := make(map[string]string, len(p.Tags()))
+		for _, t := range p.Tags() {
+			tags[string(t.Key)] = string(t.Value)
+		}
+
+		ms = append(ms, Metrics{
+			Name:      string(p.Name()),
+			Tags:      tags,
+			Fields:    fields,
+			Timestamp: p.UnixNano(),
+			Type:      MetricTypeUntyped,
+		})
+	}
+	return ms, nil
+}
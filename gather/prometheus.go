@@ -21,7 +21,7 @@ type prometheusScraper struct{}
 
 // Gather parse metrics from a scraper target url.
 func (p *prometheusScraper) Gather(ctx context.Context, target platform.ScraperTarget) (ms []Metrics, err error) {
-	resp, err := http.Get(target.URL)
+	resp, err := httpGet(ctx, target)
 	if err != nil {
 		return ms, err
 	}
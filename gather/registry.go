@@ -0,0 +1,93 @@
+package gather
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/influxdata/platform"
+)
+
+// Registry maps each scraper type to the Scraper that gathers its targets
+// and the nats subject its scrape requests are published to.
+type Registry struct {
+	mu       sync.RWMutex
+	scrapers map[platform.ScraperType]registration
+}
+
+type registration struct {
+	subject string
+	scraper Scraper
+}
+
+// NewRegistry returns a Registry with the built-in scrapers registered.
+func NewRegistry() *Registry {
+	r := &Registry{
+		scrapers: make(map[platform.ScraperType]registration),
+	}
+	r.mustRegister(platform.PrometheusScraperType, promTargetSubject, new(prometheusScraper))
+	r.mustRegister(platform.LineProtocolScraperType, lineProtocolTargetSubject, new(lineProtocolScraper))
+	r.mustRegister(platform.JSONScraperType, jsonTargetSubject, new(jsonScraper))
+	return r
+}
+
+// Register adds a scraper for targets of type t, whose scrape requests are published to subject.
+// Each type and each subject can only be registered once.
+func (r *Registry) Register(t platform.ScraperType, subject string, s Scraper) error {
+	if subject == "" || subject == MetricsSubject {
+		return fmt.Errorf("invalid subject %q for scraper type %s", subject, t)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.scrapers[t]; ok {
+		return fmt.Errorf("scraper type %s is already registered", t)
+	}
+	for typ, reg := range r.scrapers {
+		if reg.subject == subject {
+			return fmt.Errorf("subject %q is already used by scraper type %s", subject, typ)
+		}
+	}
+	r.scrapers[t] = registration{subject: subject, scraper: s}
+	return nil
+}
+
+func (r *Registry) mustRegister(t platform.ScraperType, subject string, s Scraper) {
+	if err := r.Register(t, subject, s); err != nil {
+		panic(err)
+	}
+}
+
+// Subject returns the subject that scrape requests for targets of type t are published to.
+func (r *Registry) Subject(t platform.ScraperType) (string, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	reg, ok := r.scrapers[t]
+	if !ok {
+		return "", fmt.Errorf("unsupported target scrape type: %s", t)
+	}
+	return reg.subject, nil
+}
+
+// Types returns the registered scraper types in sorted order.
+func (r *Registry) Types() []platform.ScraperType {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	ts := make([]platform.ScraperType, 0, len(r.scrapers))
+	for t := range r.scrapers {
+		ts = append(ts, t)
+	}
+	sort.Slice(ts, func(i, j int) bool { return ts[i] < ts[j] })
+	return ts
+}
+
+func (r *Registry) lookup(t platform.ScraperType) (registration, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	reg, ok := r.scrapers[t]
+	return reg, ok
+}
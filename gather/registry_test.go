@@ -0,0 +1,45 @@
+package gather
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/platform"
+)
+
+func TestRegistry(t *testing.T) {
+	r := NewRegistry()
+
+	want := []platform.ScraperType{
+		platform.JSONScraperType,
+		platform.LineProtocolScraperType,
+		platform.PrometheusScraperType,
+	}
+	if diff := cmp.Diff(r.Types(), want); diff != "" {
+		t.Fatalf("unexpected built-in scraper types -got/+want\n%s", diff)
+	}
+
+	if s, err := r.Subject(platform.PrometheusScraperType); err != nil || s != promTargetSubject {
+		t.Fatalf("expected prometheus subject %q, got %q, %v", promTargetSubject, s, err)
+	}
+	if _, err := r.Subject("custom"); err == nil {
+		t.Fatal("expected error for unregistered scraper type")
+	}
+
+	if err := r.Register("custom", "customTarget", new(jsonScraper)); err != nil {
+		t.Fatal(err)
+	}
+	if s, err := r.Subject("custom"); err != nil || s != "customTarget" {
+		t.Fatalf("expected custom subject %q, got %q, %v", "customTarget", s, err)
+	}
+
+	if err := r.Register("custom", "otherTarget", new(jsonScraper)); err == nil {
+		t.Fatal("expected error registering a scraper type twice")
+	}
+	if err := r.Register("other", promTargetSubject, new(jsonScraper)); err == nil {
+		t.Fatal("expected error registering a subject twice")
+	}
+	if err := r.Register("other", MetricsSubject, new(jsonScraper)); err == nil {
+		t.Fatal("expected error registering the metrics subject")
+	}
+}
@@ -4,43 +4,63 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
-	"fmt"
 	"time"
 
 	"github.com/influxdata/platform"
 	"github.com/influxdata/platform/nats"
+	"github.com/influxdata/platform/storage"
 	"go.uber.org/zap"
 )
 
 // nats subjects
 const (
-	MetricsSubject    = "metrics"
-	promTargetSubject = "promTarget"
+	MetricsSubject            = "metrics"
+	promTargetSubject         = "promTarget"
+	lineProtocolTargetSubject = "lineProtocolTarget"
+	jsonTargetSubject         = "jsonTarget"
 )
 
+// scheduleResolution is the longest time between checks for targets that are due to be scraped.
+const scheduleResolution = time.Second
+
 // Scheduler is struct to run scrape jobs.
 type Scheduler struct {
 	Targets platform.ScraperTargetStoreService
-	// Interval is between each metrics gathering event.
+	// Interval is between each metrics gathering event of targets without an interval of their own.
 	Interval time.Duration
 	// Timeout is the maxisium time duration allowed by each TCP request
+	// of targets without a timeout of their own.
 	Timeout time.Duration
 
-	// Publisher will send the gather requests and gathered metrics to the queue.
+	// Publisher will send the gather requests to the queue.
 	Publisher nats.Publisher
 
+	// Registry determines the subject each target's gather request is sent to.
+	Registry *Registry
+
 	Logger *zap.Logger
 
 	gather chan struct{}
+
+	// lastScraped holds the time each target's scrape was last requested.
+	lastScraped map[platform.ID]time.Time
 }
 
 // NewScheduler creates a new Scheduler and subscriptions for scraper jobs.
+// numScrapers subscriptions are created for each scraper type in the registry;
+// if registry is nil, the built-in scrapers are used.
+// The gathered metrics are written with w to the buckets of the targets.
 func NewScheduler(
 	numScrapers int,
 	l *zap.Logger,
 	targets platform.ScraperTargetStoreService,
+	secrets platform.SecretService,
+	status platform.ScraperTargetStatusService,
+	buckets platform.BucketService,
+	w storage.PointsWriter,
 	p nats.Publisher,
 	s nats.Subscriber,
+	registry *Registry,
 	interval time.Duration,
 	timeout time.Duration,
 ) (*Scheduler, error) {
@@ -50,23 +70,36 @@ func NewScheduler(
 	if timeout == 0 {
 		timeout = 30 * time.Second
 	}
+	if registry == nil {
+		registry = NewRegistry()
+	}
 	scheduler := &Scheduler{
 		Targets:   targets,
 		Interval:  interval,
 		Timeout:   timeout,
 		Publisher: p,
+		Registry:  registry,
 		Logger:    l,
 		gather:    make(chan struct{}, 100),
+
+		lastScraped: make(map[platform.ID]time.Time),
 	}
 
-	for i := 0; i < numScrapers; i++ {
-		err := s.Subscribe(promTargetSubject, "", &handler{
-			Scraper:   new(prometheusScraper),
-			Publisher: p,
-			Logger:    l,
-		})
-		if err != nil {
-			return nil, err
+	for _, typ := range registry.Types() {
+		reg, _ := registry.lookup(typ)
+		for i := 0; i < numScrapers; i++ {
+			err := s.Subscribe(reg.subject, "", &handler{
+				Scraper:      reg.scraper,
+				Buckets:      buckets,
+				PointsWriter: w,
+				Secrets:      secrets,
+				Status:       status,
+				Timeout:      timeout,
+				Logger:       l,
+			})
+			if err != nil {
+				return nil, err
+			}
 		}
 	}
 
@@ -74,14 +107,20 @@ func NewScheduler(
 }
 
 // Run will retrieve scraper targets from the target storage,
-// and publish them to nats job queue for gather.
+// and publish the ones that are due to nats job queue for gather.
 func (s *Scheduler) Run(ctx context.Context) error {
+	resolution := scheduleResolution
+	if s.Interval < resolution {
+		resolution = s.Interval
+	}
 	go func(s *Scheduler, ctx context.Context) {
+		ticker := time.NewTicker(resolution)
+		defer ticker.Stop()
 		for {
 			select {
 			case <-ctx.Done():
 				return
-			case <-time.After(s.Interval): // TODO: change to ticker because of garbage collection
+			case <-ticker.C:
 				s.gather <- struct{}{}
 			}
 		}
@@ -95,31 +134,56 @@ func (s *Scheduler) run(ctx context.Context) error {
 		case <-ctx.Done():
 			return nil
 		case <-s.gather:
-			ctx, cancel := context.WithTimeout(ctx, s.Timeout)
-			defer cancel()
-			targets, err := s.Targets.ListTargets(ctx)
-			if err != nil {
-				s.Logger.Error("cannot list targets", zap.Error(err))
-				continue
-			}
-			for _, target := range targets {
-				if err := requestScrape(target, s.Publisher); err != nil {
-					s.Logger.Error("json encoding error", zap.Error(err))
-				}
-			}
+			s.scrapeDue(ctx, time.Now())
 		}
 	}
 }
 
-func requestScrape(t platform.ScraperTarget, publisher nats.Publisher) error {
-	buf := new(bytes.Buffer)
-	err := json.NewEncoder(buf).Encode(t)
+// scrapeDue requests a scrape of every target whose interval has passed since its last scrape.
+func (s *Scheduler) scrapeDue(ctx context.Context, now time.Time) {
+	ctx, cancel := context.WithTimeout(ctx, s.Timeout)
+	defer cancel()
+	targets, err := s.Targets.ListTargets(ctx)
+	if err != nil {
+		s.Logger.Error("cannot list targets", zap.Error(err))
+		return
+	}
+
+	current := make(map[platform.ID]struct{}, len(targets))
+	for _, target := range targets {
+		current[target.ID] = struct{}{}
+
+		interval := target.Interval
+		if interval == 0 {
+			interval = s.Interval
+		}
+		if last, ok := s.lastScraped[target.ID]; ok && now.Sub(last) < interval {
+			continue
+		}
+
+		s.lastScraped[target.ID] = now
+		if err := s.requestScrape(target); err != nil {
+			s.Logger.Error("cannot request scrape", zap.Error(err))
+		}
+	}
+
+	// Forget targets that were removed, and the clients of TLS configurations no target uses.
+	for id := range s.lastScraped {
+		if _, ok := current[id]; !ok {
+			delete(s.lastScraped, id)
+		}
+	}
+	retainClients(targets)
+}
+
+func (s *Scheduler) requestScrape(t platform.ScraperTarget) error {
+	subject, err := s.Registry.Subject(t.Type)
 	if err != nil {
 		return err
 	}
-	switch t.Type {
-	case platform.PrometheusScraperType:
-		return publisher.Publish(promTargetSubject, buf)
+	buf := new(bytes.Buffer)
+	if err := json.NewEncoder(buf).Encode(t); err != nil {
+		return err
 	}
-	return fmt.Errorf("unsupported target scrape type: %s", t.Type)
+	return s.Publisher.Publish(subject, buf)
 }
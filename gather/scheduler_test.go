@@ -2,6 +2,7 @@ package gather
 
 import (
 	"context"
+	"io"
 	"net/http/httptest"
 	"os"
 	"testing"
@@ -9,9 +10,12 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/inmem"
 	influxlogger "github.com/influxdata/platform/logger"
 	"github.com/influxdata/platform/mock"
+	"github.com/influxdata/platform/models"
 	platformtesting "github.com/influxdata/platform/testing"
+	"github.com/influxdata/platform/tsdb"
 )
 
 func TestScheduler(t *testing.T) {
@@ -28,25 +32,35 @@ func TestScheduler(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
+	targetID := platformtesting.MustIDBase16("3a0d0a6365646120")
+	orgID := platformtesting.MustIDBase16("3a0d0a6365646121")
+	bucketID := platformtesting.MustIDBase16("3a0d0a6365646122")
+	status := inmem.NewService()
 	storage := &mockStorage{
-		Metrics: make(map[int64]Metrics),
 		Targets: []platform.ScraperTarget{
 			{
-				ID:   platformtesting.MustIDBase16("3a0d0a6365646120"),
-				Type: platform.PrometheusScraperType,
-				URL:  ts.URL + "/metrics",
+				ID:         targetID,
+				Type:       platform.PrometheusScraperType,
+				URL:        ts.URL + "/metrics",
+				OrgName:    "org",
+				BucketName: "bucket",
 			},
 		},
-		TotalGatherJobs: make(chan struct{}, totalGatherJobs),
 	}
-
-	subscriber.Subscribe(MetricsSubject, "", &StorageHandler{
-		Logger:  logger,
-		Storage: storage,
-	})
+	buckets := mock.NewBucketService()
+	buckets.FindBucketFn = func(_ context.Context, filter platform.BucketFilter) (*platform.Bucket, error) {
+		if filter.Organization == nil || *filter.Organization != "org" || filter.Name == nil || *filter.Name != "bucket" {
+			return nil, &platform.Error{Code: platform.ENotFound, Msg: "bucket not found"}
+		}
+		return &platform.Bucket{ID: bucketID, OrganizationID: orgID, Name: "bucket"}, nil
+	}
+	w := &recordingPointsWriter{written: make(chan []models.Point, totalGatherJobs)}
 
 	scheduler, err := NewScheduler(10, logger,
-		storage, publisher, subscriber, time.Millisecond, time.Microsecond)
+		storage, nil, status, buckets, w, publisher, subscriber, nil, time.Millisecond, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
 
 	go func() {
 		err = scheduler.run(ctx)
@@ -65,29 +79,115 @@ func TestScheduler(t *testing.T) {
 	}(scheduler)
 
 	// make sure all jobs are done
+	var points []models.Point
 	for i := 0; i < totalGatherJobs; i++ {
-		<-storage.TotalGatherJobs
+		points = append(points, <-w.written...)
 	}
 
-	want := Metrics{
-		Name: "go_goroutines",
-		Type: MetricTypeGauge,
-		Tags: map[string]string{},
-		Fields: map[string]interface{}{
-			"gauge": float64(36),
-		},
+	// The metrics are written to the target's bucket, with the scrape
+	// metrics tagged with the target.
+	name := tsdb.EncodeName(orgID, bucketID)
+	values := make(map[string]interface{})
+	for _, pt := range points {
+		if string(pt.Name()) != string(name[:]) {
+			t.Fatalf("metric written to another bucket: %s", pt)
+		}
+		m := string(pt.Tags().Get(tsdb.MeasurementTagKeyBytes))
+		if m == "up" && string(pt.Tags().Get([]byte("target"))) != targetID.String() {
+			t.Fatalf("unexpected tags of up metric: %s", pt)
+		}
+		fields, err := pt.Fields()
+		if err != nil {
+			t.Fatal(err)
+		}
+		values[m] = fields["gauge"]
+	}
+	if diff := cmp.Diff(values, map[string]interface{}{
+		"go_goroutines":           float64(36),
+		"up":                      float64(1),
+		"scrape_duration_seconds": values["scrape_duration_seconds"],
+		"scrape_samples_scraped":  float64(1),
+	}); diff != "" {
+		t.Fatalf("unexpected metrics written: %s", diff)
+	}
+
+	st, err := status.GetTargetStatus(ctx, targetID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !st.Up() || st.SampleCount != 1 || st.LastScrape.IsZero() {
+		t.Fatalf("unexpected target status %+v", st)
+	}
+	ts.Close()
+}
+
+// recordingPointsWriter sends the points of every write to written.
+type recordingPointsWriter struct {
+	written chan []models.Point
+}
+
+func (w *recordingPointsWriter) WritePoints(points []models.Point) error {
+	w.written <- points
+	return nil
+}
+
+func TestScheduler_TargetIntervals(t *testing.T) {
+	publisher := &recordingPublisher{}
+	fast := platform.ScraperTarget{
+		ID:       platformtesting.MustIDBase16("3a0d0a6365646120"),
+		Type:     platform.PrometheusScraperType,
+		URL:      "http://localhost:9100/metrics",
+		Interval: 10 * time.Second,
+	}
+	slow := platform.ScraperTarget{
+		ID:   platformtesting.MustIDBase16("3a0d0a6365646121"),
+		Type: platform.PrometheusScraperType,
+		URL:  "http://localhost:9101/metrics",
+	}
+	storage := &mockStorage{
+		Targets: []platform.ScraperTarget{fast, slow},
 	}
 
-	if len(storage.Metrics) < totalGatherJobs {
-		t.Fatalf("metrics stored less than expected, got len %d", len(storage.Metrics))
+	_, subscriber := mock.NewNats()
+	scheduler, err := NewScheduler(1, influxlogger.New(os.Stdout),
+		storage, nil, nil, nil, nil, publisher, subscriber, nil, time.Minute, time.Second)
+	if err != nil {
+		t.Fatal(err)
 	}
 
-	for _, v := range storage.Metrics {
-		if diff := cmp.Diff(v, want, metricsCmpOption); diff != "" {
-			t.Fatalf("scraper parse metrics want %v, got %v", want, v)
+	start := time.Unix(1000, 0)
+	for _, c := range []struct {
+		offset time.Duration
+		want   int
+	}{
+		{offset: 0, want: 2},
+		{offset: 5 * time.Second, want: 0},
+		{offset: 10 * time.Second, want: 1},
+		{offset: 20 * time.Second, want: 1},
+		{offset: time.Minute, want: 2},
+	} {
+		publisher.published = 0
+		scheduler.scrapeDue(context.Background(), start.Add(c.offset))
+		if publisher.published != c.want {
+			t.Fatalf("at %s: expected %d scrapes requested, got %d", c.offset, c.want, publisher.published)
 		}
 	}
-	ts.Close()
+
+	storage.Targets = []platform.ScraperTarget{fast}
+	scheduler.scrapeDue(context.Background(), start.Add(2*time.Minute))
+	if _, ok := scheduler.lastScraped[slow.ID]; ok {
+		t.Fatal("expected removed target to be forgotten")
+	}
+}
+
+// recordingPublisher counts the messages published to it.
+type recordingPublisher struct {
+	published int
+}
+
+func (p *recordingPublisher) Publish(subject string, r io.Reader) error {
+	p.published++
+	return nil
 }
 
 const sampleRespSmall = `
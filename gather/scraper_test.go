@@ -149,6 +149,233 @@ func TestPrometheusScraper(t *testing.T) {
 	}
 }
 
+func TestLineProtocolScraper(t *testing.T) {
+	cases := []struct {
+		name    string
+		ms      []Metrics
+		handler *mockHTTPHandler
+		hasErr  bool
+	}{
+		{
+			name:   "bad request",
+			hasErr: true,
+		},
+		{
+			name: "not found",
+			handler: &mockHTTPHandler{
+				responseMap: map[string]string{},
+			},
+			hasErr: true,
+		},
+		{
+			name: "invalid line protocol",
+			handler: &mockHTTPHandler{
+				responseMap: map[string]string{
+					"/metrics": "cpu,host=a",
+				},
+			},
+			hasErr: true,
+		},
+		{
+			name: "regular metrics",
+			handler: &mockHTTPHandler{
+				responseMap: map[string]string{
+					"/metrics": "cpu,host=a usage_idle=99.5,count=3i 1000000000\nmem free=1024i,ok=true\n",
+				},
+			},
+			ms: []Metrics{
+				{
+					Name: "cpu",
+					Type: MetricTypeUntyped,
+					Tags: map[string]string{"host": "a"},
+					Fields: map[string]interface{}{
+						"usage_idle": 99.5,
+						"count":      int64(3),
+					},
+				},
+				{
+					Name: "mem",
+					Type: MetricTypeUntyped,
+					Tags: map[string]string{},
+					Fields: map[string]interface{}{
+						"free": int64(1024),
+						"ok":   true,
+					},
+				},
+			},
+		},
+	}
+	for _, c := range cases {
+		scraper := new(lineProtocolScraper)
+		var url string
+		if c.handler != nil {
+			ts := httptest.NewServer(c.handler)
+			defer ts.Close()
+			url = ts.URL
+		}
+		results, err := scraper.Gather(context.Background(), platform.ScraperTarget{
+			Type: platform.LineProtocolScraperType,
+			URL:  url + "/metrics",
+		})
+		if err != nil && !c.hasErr {
+			t.Fatalf("scraper parse err in testing %s: %v", c.name, err)
+		}
+		if err == nil && c.hasErr {
+			t.Fatalf("expected error in testing %s", c.name)
+		}
+		if diff := cmp.Diff(results, c.ms, metricsCmpOption); diff != "" {
+			t.Fatalf("scraper parse metrics in testing %s: -got/+want\n%s", c.name, diff)
+		}
+		if c.name == "regular metrics" && results[0].Timestamp != 1000000000 {
+			t.Fatalf("expected timestamp to be read from line protocol, got %d", results[0].Timestamp)
+		}
+	}
+}
+
+func TestJSONScraper(t *testing.T) {
+	cases := []struct {
+		name    string
+		opts    *platform.JSONScraperOptions
+		ms      []Metrics
+		handler *mockHTTPHandler
+		hasErr  bool
+	}{
+		{
+			name: "missing options",
+			handler: &mockHTTPHandler{
+				responseMap: map[string]string{
+					"/stats": sampleJSONResp,
+				},
+			},
+			hasErr: true,
+		},
+		{
+			name: "invalid json",
+			opts: &platform.JSONScraperOptions{
+				Measurement: "server",
+				Fields:      map[string]string{"uptime": "$.uptime"},
+			},
+			handler: &mockHTTPHandler{
+				responseMap: map[string]string{
+					"/stats": "{",
+				},
+			},
+			hasErr: true,
+		},
+		{
+			name: "single record",
+			opts: &platform.JSONScraperOptions{
+				Measurement: "server",
+				Fields: map[string]string{
+					"uptime":  "$.uptime",
+					"healthy": "$.status['healthy']",
+					"missing": "$.nope",
+				},
+				Tags: map[string]string{
+					"version": "$.version",
+				},
+			},
+			handler: &mockHTTPHandler{
+				responseMap: map[string]string{
+					"/stats": sampleJSONResp,
+				},
+			},
+			ms: []Metrics{
+				{
+					Name: "server",
+					Type: MetricTypeUntyped,
+					Tags: map[string]string{"version": "1.2.3"},
+					Fields: map[string]interface{}{
+						"uptime":  float64(3600),
+						"healthy": true,
+					},
+				},
+			},
+		},
+		{
+			name: "records",
+			opts: &platform.JSONScraperOptions{
+				Measurement: "node",
+				Records:     "$.nodes",
+				Fields: map[string]string{
+					"load": "$.cpu.load[0]",
+				},
+				Tags: map[string]string{
+					"name": "$.name",
+					"rack": "$.rack",
+				},
+			},
+			handler: &mockHTTPHandler{
+				responseMap: map[string]string{
+					"/stats": sampleJSONResp,
+				},
+			},
+			ms: []Metrics{
+				{
+					Name:   "node",
+					Type:   MetricTypeUntyped,
+					Tags:   map[string]string{"name": "a", "rack": "1"},
+					Fields: map[string]interface{}{"load": 0.5},
+				},
+				{
+					Name:   "node",
+					Type:   MetricTypeUntyped,
+					Tags:   map[string]string{"name": "b", "rack": "2"},
+					Fields: map[string]interface{}{"load": 1.5},
+				},
+			},
+		},
+		{
+			name: "records path is not an array",
+			opts: &platform.JSONScraperOptions{
+				Measurement: "node",
+				Records:     "$.uptime",
+				Fields:      map[string]string{"load": "$.load"},
+			},
+			handler: &mockHTTPHandler{
+				responseMap: map[string]string{
+					"/stats": sampleJSONResp,
+				},
+			},
+			hasErr: true,
+		},
+	}
+	for _, c := range cases {
+		scraper := new(jsonScraper)
+		var url string
+		if c.handler != nil {
+			ts := httptest.NewServer(c.handler)
+			defer ts.Close()
+			url = ts.URL
+		}
+		results, err := scraper.Gather(context.Background(), platform.ScraperTarget{
+			Type: platform.JSONScraperType,
+			URL:  url + "/stats",
+			JSON: c.opts,
+		})
+		if err != nil && !c.hasErr {
+			t.Fatalf("scraper parse err in testing %s: %v", c.name, err)
+		}
+		if err == nil && c.hasErr {
+			t.Fatalf("expected error in testing %s", c.name)
+		}
+		if diff := cmp.Diff(results, c.ms, metricsCmpOption); diff != "" {
+			t.Fatalf("scraper parse metrics in testing %s: -got/+want\n%s", c.name, diff)
+		}
+	}
+}
+
+const sampleJSONResp = `{
+	"version": "1.2.3",
+	"uptime": 3600,
+	"status": {"healthy": true},
+	"nodes": [
+		{"name": "a", "rack": 1, "cpu": {"load": [0.5, 0.4]}},
+		{"name": "b", "rack": 2, "cpu": {"load": [1.5, 1.4]}},
+		{"name": "c", "rack": 3}
+	]
+}`
+
 const sampleResp = `
 # 	HELP go_gc_duration_seconds A summary of the GC invocation durations.
 # TYPE go_gc_duration_seconds summary
@@ -258,7 +485,7 @@ func (s *mockStorage) UpdateTarget(ctx context.Context, update *platform.Scraper
 	defer s.Unlock()
 
 	for k, v := range s.Targets {
-		if v.ID.String() == string(update.ID) {
+		if v.ID == update.ID {
 			s.Targets[k] = *update
 			break
 		}
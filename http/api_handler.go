@@ -5,7 +5,11 @@ import (
 	"strings"
 
 	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/audit"
+	"github.com/influxdata/platform/authorizer"
+	"github.com/influxdata/platform/chronograf"
 	"github.com/influxdata/platform/chronograf/server"
+	"github.com/influxdata/platform/dashboardtemplate"
 	"github.com/influxdata/platform/query"
 	"github.com/influxdata/platform/storage"
 	"go.uber.org/zap"
@@ -13,22 +17,30 @@ import (
 
 // APIHandler is a collection of all the service handlers.
 type APIHandler struct {
-	BucketHandler        *BucketHandler
-	UserHandler          *UserHandler
-	OrgHandler           *OrgHandler
-	AuthorizationHandler *AuthorizationHandler
-	DashboardHandler     *DashboardHandler
-	AssetHandler         *AssetHandler
-	ChronografHandler    *ChronografHandler
-	ViewHandler          *ViewHandler
-	SourceHandler        *SourceHandler
-	MacroHandler         *MacroHandler
-	TaskHandler          *TaskHandler
-	TelegrafHandler      *TelegrafHandler
-	QueryHandler         *FluxHandler
-	WriteHandler         *WriteHandler
-	SetupHandler         *SetupHandler
-	SessionHandler       *SessionHandler
+	BucketHandler            *BucketHandler
+	UserHandler              *UserHandler
+	OrgHandler               *OrgHandler
+	AuthorizationHandler     *AuthorizationHandler
+	DashboardHandler         *DashboardHandler
+	DashboardTemplateHandler *DashboardTemplateHandler
+	DashboardLayoutHandler   *DashboardLayoutHandler
+	AssetHandler             *AssetHandler
+	ChronografHandler        *ChronografHandler
+	ViewHandler              *ViewHandler
+	SourceHandler            *SourceHandler
+	MacroHandler             *MacroHandler
+	DownsampleHandler        *DownsamplePolicyHandler
+	TaskHandler              *TaskHandler
+	TelegrafHandler          *TelegrafHandler
+	ScraperHandler           *ScraperHandler
+	AuditHandler             *AuditHandler
+	UsageHandler             *UsageHandler
+	QueryHandler             *FluxHandler
+	WriteHandler             *WriteHandler
+	DeleteHandler            *DeleteHandler
+	BackupHandler            *BackupHandler
+	SetupHandler             *SetupHandler
+	SessionHandler           *SessionHandler
 }
 
 // APIBackend is all services and associated parameters required to construct
@@ -40,101 +52,156 @@ type APIBackend struct {
 	NewQueryService  func(*platform.Source) (query.ProxyQueryService, error)
 
 	PointsWriter                    storage.PointsWriter
+	MaxWriteBodySize                int64
+	DeleteService                   platform.DeleteService
+	BackupService                   platform.BackupService
 	AuthorizationService            platform.AuthorizationService
 	BucketService                   platform.BucketService
 	SessionService                  platform.SessionService
 	UserService                     platform.UserService
 	OrganizationService             platform.OrganizationService
+	SecretService                   platform.SecretService
 	UserResourceMappingService      platform.UserResourceMappingService
 	LabelService                    platform.LabelService
 	DashboardService                platform.DashboardService
 	DashboardOperationLogService    platform.DashboardOperationLogService
+	DashboardRevisionService        platform.DashboardRevisionService
 	BucketOperationLogService       platform.BucketOperationLogService
 	UserOperationLogService         platform.UserOperationLogService
 	OrganizationOperationLogService platform.OrganizationOperationLogService
 	ViewService                     platform.ViewService
 	SourceService                   platform.SourceService
 	MacroService                    platform.MacroService
+	DownsamplePolicyService         platform.DownsamplePolicyService
 	BasicAuthService                platform.BasicAuthService
 	OnboardingService               platform.OnboardingService
 	ProxyQueryService               query.ProxyQueryService
 	TaskService                     platform.TaskService
 	TelegrafService                 platform.TelegrafConfigStore
 	ScraperTargetStoreService       platform.ScraperTargetStoreService
+	ScraperTargetStatusService      platform.ScraperTargetStatusService
+	AuditLogService                 platform.AuditLogService
+	UsageService                    platform.UsageService
+	UsageRecorder                   platform.UsageRecorder
 	ChronografService               *server.Service
+	DashboardLayoutsStore           chronograf.LayoutsStore
 }
 
-// NewAPIHandler constructs all api handlers beneath it and returns an APIHandler
+// NewAPIHandler constructs all api handlers beneath it and returns an APIHandler.
+// Resource services are wrapped so that every request is checked against the
+// permissions of the authorizer on its context, and every mutation is recorded
+// in the audit log.
 func NewAPIHandler(b *APIBackend) *APIHandler {
 	h := &APIHandler{}
+	labelService := authorizer.NewLabelService(b.LabelService)
+	recorder := audit.NewRecorder(b.AuditLogService, b.Logger.With(zap.String("service", "audit")))
+	bucketService := authorizer.NewBucketService(audit.NewBucketService(b.BucketService, recorder), b.OrganizationService)
+	mappingService := authorizer.NewUserResourceMappingService(b.UserResourceMappingService, b.BucketService, b.TaskService)
+
 	h.SessionHandler = NewSessionHandler()
 	h.SessionHandler.BasicAuthService = b.BasicAuthService
 	h.SessionHandler.SessionService = b.SessionService
 	h.SessionHandler.Logger = b.Logger.With(zap.String("handler", "basicAuth"))
 
-	h.BucketHandler = NewBucketHandler(b.UserResourceMappingService, b.LabelService)
-	h.BucketHandler.BucketService = b.BucketService
+	h.BucketHandler = NewBucketHandler(mappingService, labelService)
+	h.BucketHandler.BucketService = bucketService
 	h.BucketHandler.BucketOperationLogService = b.BucketOperationLogService
 	h.BucketHandler.UserService = b.UserService
 
-	h.OrgHandler = NewOrgHandler(b.UserResourceMappingService, b.LabelService)
-	h.OrgHandler.OrganizationService = b.OrganizationService
-	h.OrgHandler.BucketService = b.BucketService
+	h.OrgHandler = NewOrgHandler(mappingService, labelService)
+	h.OrgHandler.OrganizationService = authorizer.NewOrganizationService(audit.NewOrganizationService(b.OrganizationService, recorder))
+	h.OrgHandler.SecretService = authorizer.NewSecretService(audit.NewSecretService(b.SecretService, recorder))
+	h.OrgHandler.BucketService = bucketService
 	h.OrgHandler.OrganizationOperationLogService = b.OrganizationOperationLogService
 	h.OrgHandler.UserService = b.UserService
 
 	h.UserHandler = NewUserHandler()
-	h.UserHandler.UserService = b.UserService
+	h.UserHandler.UserService = authorizer.NewUserService(audit.NewUserService(b.UserService, recorder))
 	h.UserHandler.BasicAuthService = b.BasicAuthService
 	h.UserHandler.UserOperationLogService = b.UserOperationLogService
 
-	h.DashboardHandler = NewDashboardHandler(b.UserResourceMappingService, b.LabelService)
-	h.DashboardHandler.DashboardService = b.DashboardService
+	dashboardService := authorizer.NewDashboardService(audit.NewDashboardService(b.DashboardService, recorder), b.UserResourceMappingService)
+	h.DashboardHandler = NewDashboardHandler(mappingService, labelService)
+	h.DashboardHandler.DashboardService = dashboardService
 	h.DashboardHandler.DashboardOperationLogService = b.DashboardOperationLogService
+	h.DashboardHandler.DashboardRevisionService = authorizer.NewDashboardRevisionService(audit.NewDashboardRevisionService(b.DashboardRevisionService, recorder))
 	h.DashboardHandler.UserService = b.UserService
 
-	h.ViewHandler = NewViewHandler(b.UserResourceMappingService, b.LabelService)
-	h.ViewHandler.ViewService = b.ViewService
+	h.ViewHandler = NewViewHandler(mappingService, labelService)
+	h.ViewHandler.ViewService = authorizer.NewViewService(b.ViewService, b.DashboardService, b.UserResourceMappingService)
 	h.ViewHandler.UserService = b.UserService
 
+	macroService := authorizer.NewMacroService(audit.NewMacroService(b.MacroService, recorder), b.UserResourceMappingService)
 	h.MacroHandler = NewMacroHandler()
-	h.MacroHandler.MacroService = b.MacroService
+	h.MacroHandler.MacroService = macroService
+
+	dashboardTemplateService := dashboardtemplate.NewService(dashboardService, b.ViewService, macroService, labelService)
+	h.DashboardTemplateHandler = NewDashboardTemplateHandler(dashboardTemplateService)
+	h.DashboardTemplateHandler.Logger = b.Logger.With(zap.String("handler", "dashboardTemplate"))
+
+	h.DashboardLayoutHandler = NewDashboardLayoutHandler(dashboardtemplate.NewLayoutService(b.DashboardLayoutsStore, dashboardTemplateService))
+	h.DashboardLayoutHandler.Logger = b.Logger.With(zap.String("handler", "dashboardLayout"))
+
+	h.DownsampleHandler = NewDownsamplePolicyHandler()
+	h.DownsampleHandler.DownsamplePolicyService = authorizer.NewDownsamplePolicyService(b.DownsamplePolicyService)
 
 	h.AuthorizationHandler = NewAuthorizationHandler()
-	h.AuthorizationHandler.AuthorizationService = b.AuthorizationService
+	h.AuthorizationHandler.AuthorizationService = authorizer.NewAuthorizationService(audit.NewAuthorizationService(b.AuthorizationService, recorder))
 	h.AuthorizationHandler.Logger = b.Logger.With(zap.String("handler", "auth"))
 
 	h.SourceHandler = NewSourceHandler()
-	h.SourceHandler.SourceService = b.SourceService
+	h.SourceHandler.SourceService = authorizer.NewSourceService(audit.NewSourceService(b.SourceService, recorder))
 	h.SourceHandler.NewBucketService = b.NewBucketService
 	h.SourceHandler.NewQueryService = b.NewQueryService
 
 	h.SetupHandler = NewSetupHandler()
 	h.SetupHandler.OnboardingService = b.OnboardingService
 
-	h.TaskHandler = NewTaskHandler(b.UserResourceMappingService, b.LabelService, b.Logger)
-	h.TaskHandler.TaskService = b.TaskService
+	h.TaskHandler = NewTaskHandler(mappingService, labelService, b.Logger)
+	h.TaskHandler.TaskService = authorizer.NewTaskService(audit.NewTaskService(b.TaskService, recorder))
 	h.TaskHandler.AuthorizationService = b.AuthorizationService
-	h.TaskHandler.UserResourceMappingService = b.UserResourceMappingService
+	h.TaskHandler.UserResourceMappingService = mappingService
 	h.TaskHandler.UserService = b.UserService
 
 	h.TelegrafHandler = NewTelegrafHandler(
 		b.Logger.With(zap.String("handler", "telegraf")),
-		b.UserResourceMappingService,
-		b.LabelService,
-		b.TelegrafService,
+		mappingService,
+		labelService,
+		authorizer.NewTelegrafConfigService(audit.NewTelegrafConfigService(b.TelegrafService, recorder)),
 	)
 	h.TelegrafHandler.UserService = b.UserService
 
+	h.ScraperHandler = NewScraperHandler()
+	h.ScraperHandler.ScraperStorageService = authorizer.NewScraperTargetStoreService(audit.NewScraperTargetStoreService(b.ScraperTargetStoreService, recorder), b.OrganizationService)
+	h.ScraperHandler.ScraperTargetStatusService = b.ScraperTargetStatusService
+
+	h.AuditHandler = NewAuditHandler()
+	h.AuditHandler.AuditLogService = authorizer.NewAuditLogService(b.AuditLogService)
+
+	h.UsageHandler = NewUsageHandler()
+	h.UsageHandler.UsageService = authorizer.NewUsageService(b.UsageService)
+
 	h.WriteHandler = NewWriteHandler(b.PointsWriter)
 	h.WriteHandler.OrganizationService = b.OrganizationService
 	h.WriteHandler.BucketService = b.BucketService
+	h.WriteHandler.MaxBodySize = b.MaxWriteBodySize
+	h.WriteHandler.UsageRecorder = b.UsageRecorder
 	h.WriteHandler.Logger = b.Logger.With(zap.String("handler", "write"))
 
+	h.DeleteHandler = NewDeleteHandler(b.DeleteService)
+	h.DeleteHandler.OrganizationService = b.OrganizationService
+	h.DeleteHandler.BucketService = b.BucketService
+	h.DeleteHandler.Logger = b.Logger.With(zap.String("handler", "delete"))
+
+	h.BackupHandler = NewBackupHandler(authorizer.NewBackupService(b.BackupService))
+	h.BackupHandler.Logger = b.Logger.With(zap.String("handler", "backup"))
+
 	h.QueryHandler = NewFluxHandler()
 	h.QueryHandler.OrganizationService = b.OrganizationService
+	h.QueryHandler.BucketService = b.BucketService
 	h.QueryHandler.Logger = b.Logger.With(zap.String("handler", "query"))
 	h.QueryHandler.ProxyQueryService = b.ProxyQueryService
+	h.QueryHandler.UsageRecorder = b.UsageRecorder
 
 	h.ChronografHandler = NewChronografHandler(b.ChronografService)
 
@@ -142,21 +209,26 @@ func NewAPIHandler(b *APIBackend) *APIHandler {
 }
 
 var apiLinks = map[string]interface{}{
-	"signin":         "/api/v2/signin",
-	"signout":        "/api/v2/signout",
-	"setup":          "/api/v2/setup",
-	"sources":        "/api/v2/sources",
-	"dashboards":     "/api/v2/dashboards",
-	"views":          "/api/v2/views",
-	"write":          "/api/v2/write",
-	"orgs":           "/api/v2/orgs",
-	"authorizations": "/api/v2/authorizations",
-	"buckets":        "/api/v2/buckets",
-	"users":          "/api/v2/users",
-	"me":             "/api/v2/me",
-	"tasks":          "/api/v2/tasks",
-	"macros":         "/api/v2/macros",
-	"telegrafs":      "/api/v2/telegrafs",
+	"signin":             "/api/v2/signin",
+	"signout":            "/api/v2/signout",
+	"setup":              "/api/v2/setup",
+	"sources":            "/api/v2/sources",
+	"dashboards":         "/api/v2/dashboards",
+	"views":              "/api/v2/views",
+	"write":              "/api/v2/write",
+	"delete":             "/api/v2/delete",
+	"orgs":               "/api/v2/orgs",
+	"authorizations":     "/api/v2/authorizations",
+	"buckets":            "/api/v2/buckets",
+	"users":              "/api/v2/users",
+	"me":                 "/api/v2/me",
+	"tasks":              "/api/v2/tasks",
+	"macros":             "/api/v2/macros",
+	"downsamplePolicies": "/api/v2/downsamplepolicies",
+	"telegrafs":          "/api/v2/telegrafs",
+	"scrapertargets":     "/api/v2/scrapertargets",
+	"audit":              "/api/v2/audit",
+	"usage":              "/api/v2/usage",
 	"query": map[string]string{
 		"self":        "/api/v2/query",
 		"ast":         "/api/v2/query/ast",
@@ -210,6 +282,16 @@ func (h *APIHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if strings.HasPrefix(r.URL.Path, "/api/v2/delete") {
+		h.DeleteHandler.ServeHTTP(w, r)
+		return
+	}
+
+	if strings.HasPrefix(r.URL.Path, "/api/v2/backup") {
+		h.BackupHandler.ServeHTTP(w, r)
+		return
+	}
+
 	if strings.HasPrefix(r.URL.Path, "/api/v2/query") {
 		h.QueryHandler.ServeHTTP(w, r)
 		return
@@ -240,6 +322,16 @@ func (h *APIHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if isDashboardLayoutPath(r.URL.Path) {
+		h.DashboardLayoutHandler.ServeHTTP(w, r)
+		return
+	}
+
+	if isDashboardTemplatePath(r.URL.Path) {
+		h.DashboardTemplateHandler.ServeHTTP(w, r)
+		return
+	}
+
 	if strings.HasPrefix(r.URL.Path, "/api/v2/dashboards") {
 		h.DashboardHandler.ServeHTTP(w, r)
 		return
@@ -260,6 +352,21 @@ func (h *APIHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if strings.HasPrefix(r.URL.Path, "/api/v2/scrapertargets") {
+		h.ScraperHandler.ServeHTTP(w, r)
+		return
+	}
+
+	if strings.HasPrefix(r.URL.Path, "/api/v2/usage") {
+		h.UsageHandler.ServeHTTP(w, r)
+		return
+	}
+
+	if strings.HasPrefix(r.URL.Path, "/api/v2/audit") {
+		h.AuditHandler.ServeHTTP(w, r)
+		return
+	}
+
 	if strings.HasPrefix(r.URL.Path, "/api/v2/views") {
 		h.ViewHandler.ServeHTTP(w, r)
 		return
@@ -270,6 +377,11 @@ func (h *APIHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if strings.HasPrefix(r.URL.Path, "/api/v2/downsamplepolicies") {
+		h.DownsampleHandler.ServeHTTP(w, r)
+		return
+	}
+
 	if strings.HasPrefix(r.URL.Path, "/chronograf/") {
 		h.ChronografHandler.ServeHTTP(w, r)
 		return
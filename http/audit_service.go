@@ -0,0 +1,151 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/influxdata/platform"
+	"github.com/julienschmidt/httprouter"
+)
+
+const (
+	auditPath = "/api/v2/audit"
+)
+
+// AuditHandler is the handler for the audit log.
+type AuditHandler struct {
+	*httprouter.Router
+
+	AuditLogService platform.AuditLogService
+}
+
+// NewAuditHandler creates a new AuditHandler.
+func NewAuditHandler() *AuditHandler {
+	h := &AuditHandler{
+		Router: NewRouter(),
+	}
+
+	h.HandlerFunc("GET", auditPath, h.handleGetAuditEvents)
+
+	return h
+}
+
+type auditEventsResponse struct {
+	Links  map[string]string      `json:"links"`
+	Events []*platform.AuditEvent `json:"events"`
+	Count  int                    `json:"count"`
+}
+
+// handleGetAuditEvents is the HTTP handler for the GET /api/v2/audit route.
+func (h *AuditHandler) handleGetAuditEvents(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	req, err := decodeGetAuditEventsRequest(ctx, r)
+	if err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	es, n, err := h.AuditLogService.FindAuditEvents(ctx, req.filter, req.opts)
+	if err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	res := auditEventsResponse{
+		Links: map[string]string{
+			"self": auditPath,
+		},
+		Events: es,
+		Count:  n,
+	}
+	if err := encodeResponse(ctx, w, http.StatusOK, res); err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+}
+
+type getAuditEventsRequest struct {
+	filter platform.AuditFilter
+	opts   platform.FindOptions
+}
+
+func decodeGetAuditEventsRequest(ctx context.Context, r *http.Request) (*getAuditEventsRequest, error) {
+	req := &getAuditEventsRequest{
+		opts: platform.DefaultAuditFindOptions,
+	}
+	qp := r.URL.Query()
+
+	if v := qp.Get("orgID"); v != "" {
+		id, err := platform.IDFromString(v)
+		if err != nil {
+			return nil, invalidAuditParam("orgID", err)
+		}
+		req.filter.OrgID = id
+	}
+	if v := qp.Get("actorID"); v != "" {
+		id, err := platform.IDFromString(v)
+		if err != nil {
+			return nil, invalidAuditParam("actorID", err)
+		}
+		req.filter.ActorID = id
+	}
+	if v := qp.Get("resourceType"); v != "" {
+		rt := platform.ResourceType(v)
+		req.filter.ResourceType = &rt
+	}
+	if v := qp.Get("start"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return nil, invalidAuditParam("start", err)
+		}
+		req.filter.Start = t
+	}
+	if v := qp.Get("stop"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return nil, invalidAuditParam("stop", err)
+		}
+		req.filter.Stop = t
+	}
+
+	if v := qp.Get("descending"); v != "" {
+		desc, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, invalidAuditParam("descending", err)
+		}
+		req.opts.Descending = desc
+	}
+	if v := qp.Get("limit"); v != "" {
+		l, err := strconv.Atoi(v)
+		if err != nil || l < 1 || l > platform.MaxPageSize {
+			return nil, &platform.Error{
+				Code: platform.EInvalid,
+				Msg:  "limit must be between 1 and " + strconv.Itoa(platform.MaxPageSize),
+			}
+		}
+		req.opts.Limit = l
+	}
+	if v := qp.Get("offset"); v != "" {
+		o, err := strconv.Atoi(v)
+		if err != nil || o < 0 {
+			return nil, &platform.Error{
+				Code: platform.EInvalid,
+				Msg:  "offset must be a non-negative integer",
+			}
+		}
+		req.opts.Offset = o
+	}
+
+	return req, nil
+}
+
+func invalidAuditParam(name string, err error) error {
+	return &platform.Error{
+		Code: platform.EInvalid,
+		Msg:  "invalid " + name + " parameter",
+		Err:  err,
+	}
+}
@@ -0,0 +1,123 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/inmem"
+	platformtesting "github.com/influxdata/platform/testing"
+)
+
+func TestAuditHandler_handleGetAuditEvents(t *testing.T) {
+	orgOne := platformtesting.MustIDBase16("020f755c3c082000")
+	orgTwo := platformtesting.MustIDBase16("020f755c3c082001")
+	actor := platformtesting.MustIDBase16("020f755c3c082010")
+	now := time.Date(2018, 12, 1, 17, 0, 0, 0, time.UTC)
+
+	svc := inmem.NewService()
+	ctx := context.Background()
+	for _, e := range []*platform.AuditEvent{
+		{Time: now, Action: platform.CreateAction, ResourceType: platform.BucketResourceType, OrgID: orgOne, ActorID: actor},
+		{Time: now.Add(time.Minute), Action: platform.WriteAction, ResourceType: platform.TaskResourceType, OrgID: orgOne},
+		{Time: now.Add(2 * time.Minute), Action: platform.DeleteAction, ResourceType: platform.BucketResourceType, OrgID: orgTwo, ActorID: actor},
+	} {
+		if err := svc.RecordAuditEvent(ctx, e); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		query   string
+		status  int
+		actions []platform.Action
+		count   int
+	}{
+		{
+			name:    "most recent first by default",
+			status:  http.StatusOK,
+			actions: []platform.Action{platform.DeleteAction, platform.WriteAction, platform.CreateAction},
+			count:   3,
+		},
+		{
+			name:    "filter by org and resource type",
+			query:   "?orgID=020f755c3c082000&resourceType=bucket",
+			status:  http.StatusOK,
+			actions: []platform.Action{platform.CreateAction},
+			count:   1,
+		},
+		{
+			name:    "filter by actor in ascending order",
+			query:   "?actorID=020f755c3c082010&descending=false",
+			status:  http.StatusOK,
+			actions: []platform.Action{platform.CreateAction, platform.DeleteAction},
+			count:   2,
+		},
+		{
+			name:    "filter by time range with a limit",
+			query:   "?start=2018-12-01T17:01:00Z&stop=2018-12-01T18:00:00Z&limit=1",
+			status:  http.StatusOK,
+			actions: []platform.Action{platform.DeleteAction},
+			count:   2,
+		},
+		{
+			name:   "invalid org id",
+			query:  "?orgID=nope",
+			status: http.StatusBadRequest,
+		},
+		{
+			name:   "invalid start time",
+			query:  "?start=yesterday",
+			status: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewAuditHandler()
+			h.AuditLogService = svc
+
+			r := httptest.NewRequest("GET", "http://any.url/api/v2/audit"+tt.query, nil)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+
+			res := w.Result()
+			body, _ := ioutil.ReadAll(res.Body)
+			if res.StatusCode != tt.status {
+				t.Fatalf("handleGetAuditEvents() = %v, want %v: %s", res.StatusCode, tt.status, body)
+			}
+			if tt.status != http.StatusOK {
+				return
+			}
+
+			var resp auditEventsResponse
+			if err := json.Unmarshal(body, &resp); err != nil {
+				t.Fatal(err)
+			}
+			actions := []platform.Action{}
+			for _, e := range resp.Events {
+				actions = append(actions, e.Action)
+			}
+			if len(actions) != len(tt.actions) {
+				t.Fatalf("got actions %v, want %v", actions, tt.actions)
+			}
+			for i := range actions {
+				if actions[i] != tt.actions[i] {
+					t.Fatalf("got actions %v, want %v", actions, tt.actions)
+				}
+			}
+			if resp.Count != tt.count {
+				t.Errorf("got count %d, want %d", resp.Count, tt.count)
+			}
+			if resp.Links["self"] != "/api/v2/audit" {
+				t.Errorf("got self link %q", resp.Links["self"])
+			}
+		})
+	}
+}
@@ -108,6 +108,12 @@ func decodePostAuthorizationRequest(ctx context.Context, r *http.Request) (*post
 		return nil, err
 	}
 
+	for _, p := range a.Permissions {
+		if err := p.Valid(); err != nil {
+			return nil, err
+		}
+	}
+
 	return &postAuthorizationRequest{
 		Authorization: a,
 	}, nil
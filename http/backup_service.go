@@ -0,0 +1,148 @@
+package http
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/influxdata/platform"
+	"github.com/julienschmidt/httprouter"
+	"go.uber.org/zap"
+)
+
+// BackupHandler streams backups of the metadata store and the storage engine.
+type BackupHandler struct {
+	*httprouter.Router
+
+	Logger *zap.Logger
+
+	BackupService platform.BackupService
+}
+
+const (
+	backupPath = "/api/v2/backup"
+)
+
+// NewBackupHandler creates a new handler at /api/v2/backup to stream backups.
+func NewBackupHandler(s platform.BackupService) *BackupHandler {
+	h := &BackupHandler{
+		Router:        NewRouter(),
+		Logger:        zap.NewNop(),
+		BackupService: s,
+	}
+
+	h.HandlerFunc("GET", backupPath, h.handleGetBackup)
+	return h
+}
+
+// handleGetBackup is the HTTP handler for the GET /api/v2/backup route.
+func (h *BackupHandler) handleGetBackup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	filter, err := decodeGetBackupRequest(ctx, r)
+	if err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	bw := &backupWriter{w: w}
+	if err := h.BackupService.Backup(ctx, bw, filter); err != nil {
+		if !bw.started {
+			EncodeError(ctx, err, w)
+			return
+		}
+		// The status was already sent, so the client is left with a
+		// truncated archive that it fails to read.
+		h.Logger.Info("Failed to stream backup", zap.Error(err))
+	}
+}
+
+func decodeGetBackupRequest(ctx context.Context, r *http.Request) (platform.BackupFilter, error) {
+	var filter platform.BackupFilter
+	qp := r.URL.Query()
+
+	if orgID := qp.Get("orgID"); orgID != "" {
+		var id platform.ID
+		if err := (&id).DecodeFromString(orgID); err != nil {
+			return filter, err
+		}
+		filter.OrganizationID = &id
+	}
+
+	if bucketID := qp.Get("bucketID"); bucketID != "" {
+		var id platform.ID
+		if err := (&id).DecodeFromString(bucketID); err != nil {
+			return filter, err
+		}
+		filter.BucketID = &id
+	}
+
+	return filter, filter.Valid()
+}
+
+// backupWriter sends the headers of the archive on the first write, so that
+// errors returned before the backup starts are encoded as usual.
+type backupWriter struct {
+	w       http.ResponseWriter
+	started bool
+}
+
+func (w *backupWriter) Write(p []byte) (int, error) {
+	if !w.started {
+		w.w.Header().Set("Content-Type", "application/x-tar")
+		w.w.WriteHeader(http.StatusOK)
+		w.started = true
+	}
+	return w.w.Write(p)
+}
+
+// BackupService streams backups from influxdb over HTTP.
+type BackupService struct {
+	Addr               string
+	Token              string
+	InsecureSkipVerify bool
+}
+
+var _ platform.BackupService = (*BackupService)(nil)
+
+// Backup writes the archive of the backup described by the filter to w.
+func (s *BackupService) Backup(ctx context.Context, w io.Writer, filter platform.BackupFilter) error {
+	if err := filter.Valid(); err != nil {
+		return err
+	}
+
+	u, err := newURL(s.Addr, backupPath)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return err
+	}
+	SetToken(s.Token, req)
+
+	params := req.URL.Query()
+	if filter.OrganizationID != nil {
+		params.Set("orgID", filter.OrganizationID.String())
+	}
+	if filter.BucketID != nil {
+		params.Set("bucketID", filter.BucketID.String())
+	}
+	req.URL.RawQuery = params.Encode()
+
+	hc := newClient(u.Scheme, s.InsecureSkipVerify)
+
+	resp, err := hc.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := CheckError(resp, true); err != nil {
+		return err
+	}
+
+	_, err = io.Copy(w, resp.Body)
+	return err
+}
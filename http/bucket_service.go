@@ -76,6 +76,11 @@ type bucket struct {
 	Name                string          `json:"name"`
 	RetentionPolicyName string          `json:"rp,omitempty"` // This to support v1 sources
 	RetentionRules      []retentionRule `json:"retentionRules"`
+
+	// ShardGroupDurationSeconds is the time span of each storage group; zero selects the default.
+	ShardGroupDurationSeconds int64 `json:"shardGroupDurationSeconds,omitempty"`
+
+	Schema *platform.BucketSchema `json:"schema,omitempty"`
 }
 
 // retentionRule is the retention rule action for a bucket.
@@ -99,6 +104,17 @@ func (b *bucket) toPlatform() (*platform.Bucket, error) {
 		}
 	}
 
+	sgd, err := shardGroupDurationFromSeconds(b.ShardGroupDurationSeconds)
+	if err != nil {
+		return nil, err
+	}
+
+	if b.Schema != nil {
+		if err := b.Schema.Valid(); err != nil {
+			return nil, err
+		}
+	}
+
 	return &platform.Bucket{
 		ID:                  b.ID,
 		OrganizationID:      b.OrganizationID,
@@ -106,6 +122,8 @@ func (b *bucket) toPlatform() (*platform.Bucket, error) {
 		Name:                b.Name,
 		RetentionPolicyName: b.RetentionPolicyName,
 		RetentionPeriod:     d,
+		ShardGroupDuration:  sgd,
+		Schema:              b.Schema,
 	}, nil
 }
 
@@ -130,13 +148,31 @@ func newBucket(pb *platform.Bucket) *bucket {
 		Name:                pb.Name,
 		RetentionPolicyName: pb.RetentionPolicyName,
 		RetentionRules:      rules,
+
+		ShardGroupDurationSeconds: int64(pb.ShardGroupDuration.Round(time.Second) / time.Second),
+		Schema:                    pb.Schema,
+	}
+}
+
+// shardGroupDurationFromSeconds converts a shard group duration in seconds,
+// where zero means the default should be used.
+func shardGroupDurationFromSeconds(secs int64) (time.Duration, error) {
+	if secs < 0 {
+		return 0, errors.InvalidDataf("shard group duration seconds must not be negative")
 	}
+	return time.Duration(secs) * time.Second, nil
 }
 
 // bucketUpdate is used for serialization/deserialization with retention rules.
 type bucketUpdate struct {
 	Name           *string         `json:"name,omitempty"`
 	RetentionRules []retentionRule `json:"retentionRules,omitempty"`
+
+	ShardGroupDurationSeconds *int64 `json:"shardGroupDurationSeconds,omitempty"`
+
+	// Schema replaces the bucket's schema. An implicit schema without
+	// measurements removes it.
+	Schema *platform.BucketSchema `json:"schema,omitempty"`
 }
 
 func (b *bucketUpdate) toPlatform() (*platform.BucketUpdate, error) {
@@ -153,10 +189,27 @@ func (b *bucketUpdate) toPlatform() (*platform.BucketUpdate, error) {
 		}
 	}
 
-	return &platform.BucketUpdate{
+	upd := &platform.BucketUpdate{
 		Name:            b.Name,
 		RetentionPeriod: &d,
-	}, nil
+	}
+
+	if b.ShardGroupDurationSeconds != nil {
+		sgd, err := shardGroupDurationFromSeconds(*b.ShardGroupDurationSeconds)
+		if err != nil {
+			return nil, err
+		}
+		upd.ShardGroupDuration = &sgd
+	}
+
+	if b.Schema != nil {
+		if err := b.Schema.Valid(); err != nil {
+			return nil, err
+		}
+		upd.Schema = b.Schema
+	}
+
+	return upd, nil
 }
 
 func newBucketUpdate(pb *platform.BucketUpdate) *bucketUpdate {
@@ -176,6 +229,13 @@ func newBucketUpdate(pb *platform.BucketUpdate) *bucketUpdate {
 			EverySeconds: d,
 		})
 	}
+
+	if pb.ShardGroupDuration != nil {
+		d := int64((*pb.ShardGroupDuration).Round(time.Second) / time.Second)
+		up.ShardGroupDurationSeconds = &d
+	}
+
+	up.Schema = pb.Schema
 	return up
 }
 
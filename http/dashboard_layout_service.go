@@ -0,0 +1,202 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/influxdata/platform"
+	"github.com/julienschmidt/httprouter"
+	"go.uber.org/zap"
+)
+
+// DashboardLayoutHandler is the handler for listing canned layouts and creating
+// dashboards from them. It is separate from the DashboardHandler, because its
+// paths would conflict with the dashboard ID parameter of its routes.
+type DashboardLayoutHandler struct {
+	*httprouter.Router
+
+	Logger *zap.Logger
+
+	DashboardLayoutService platform.DashboardLayoutService
+}
+
+const (
+	dashboardsTemplatesPath   = "/api/v2/dashboards/templates"
+	dashboardsTemplatesIDPath = "/api/v2/dashboards/templates/:id"
+)
+
+// NewDashboardLayoutHandler returns a new instance of DashboardLayoutHandler.
+func NewDashboardLayoutHandler(s platform.DashboardLayoutService) *DashboardLayoutHandler {
+	h := &DashboardLayoutHandler{
+		Router:                 NewRouter(),
+		Logger:                 zap.NewNop(),
+		DashboardLayoutService: s,
+	}
+
+	h.HandlerFunc("GET", dashboardsTemplatesPath, h.handleGetDashboardLayouts)
+	h.HandlerFunc("POST", dashboardsTemplatesIDPath, h.handlePostDashboardLayout)
+	return h
+}
+
+// isDashboardLayoutPath returns true if the path is routed to the DashboardLayoutHandler.
+func isDashboardLayoutPath(p string) bool {
+	return p == dashboardsTemplatesPath || strings.HasPrefix(p, dashboardsTemplatesPath+"/")
+}
+
+type dashboardLayoutsResponse struct {
+	Links     map[string]string           `json:"links"`
+	Templates []*platform.DashboardLayout `json:"templates"`
+}
+
+// handleGetDashboardLayouts is the HTTP handler for the GET /api/v2/dashboards/templates route.
+func (h *DashboardLayoutHandler) handleGetDashboardLayouts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ls, err := h.DashboardLayoutService.FindDashboardLayouts(ctx)
+	if err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	res := dashboardLayoutsResponse{
+		Links: map[string]string{
+			"self": dashboardsTemplatesPath,
+		},
+		Templates: ls,
+	}
+	if err := encodeResponse(ctx, w, http.StatusOK, res); err != nil {
+		h.Logger.Info("Failed to encode response", zap.Error(err))
+		return
+	}
+}
+
+type postDashboardLayoutRequest struct {
+	ID     string `json:"-"`
+	Bucket string `json:"bucket"`
+}
+
+// handlePostDashboardLayout is the HTTP handler for the POST /api/v2/dashboards/templates/:id route.
+func (h *DashboardLayoutHandler) handlePostDashboardLayout(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	req, err := decodePostDashboardLayoutRequest(ctx, r)
+	if err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	d, err := h.DashboardLayoutService.CreateDashboardFromLayout(ctx, req.ID, req.Bucket)
+	if err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	if err := encodeResponse(ctx, w, http.StatusCreated, newDashboardResponse(d)); err != nil {
+		h.Logger.Info("Failed to encode response", zap.Error(err))
+		return
+	}
+}
+
+func decodePostDashboardLayoutRequest(ctx context.Context, r *http.Request) (*postDashboardLayoutRequest, error) {
+	req := &postDashboardLayoutRequest{}
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		return nil, &platform.Error{
+			Code: platform.EInvalid,
+			Op:   platform.OpCreateDashboardFromLayout,
+			Err:  err,
+		}
+	}
+
+	params := httprouter.ParamsFromContext(ctx)
+	req.ID = params.ByName("id")
+	if req.Bucket == "" {
+		return nil, &platform.Error{
+			Code: platform.EInvalid,
+			Op:   platform.OpCreateDashboardFromLayout,
+			Msg:  "bucket is required",
+		}
+	}
+	return req, nil
+}
+
+// DashboardLayoutService connects to Influx via HTTP using tokens to list
+// layouts and create dashboards from them.
+type DashboardLayoutService struct {
+	Addr               string
+	Token              string
+	InsecureSkipVerify bool
+}
+
+var _ platform.DashboardLayoutService = (*DashboardLayoutService)(nil)
+
+// FindDashboardLayouts returns the layouts dashboards can be created from.
+func (s *DashboardLayoutService) FindDashboardLayouts(ctx context.Context) ([]*platform.DashboardLayout, error) {
+	u, err := newURL(s.Addr, dashboardsTemplatesPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	SetToken(s.Token, req)
+
+	hc := newClient(u.Scheme, s.InsecureSkipVerify)
+	resp, err := hc.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := CheckError(resp, true); err != nil {
+		return nil, err
+	}
+
+	var res dashboardLayoutsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return nil, err
+	}
+	return res.Templates, nil
+}
+
+// CreateDashboardFromLayout creates a dashboard from the layout whose views query the bucket.
+func (s *DashboardLayoutService) CreateDashboardFromLayout(ctx context.Context, id string, bucket string) (*platform.Dashboard, error) {
+	u, err := newURL(s.Addr, path.Join(dashboardsTemplatesPath, id))
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := json.Marshal(postDashboardLayoutRequest{Bucket: bucket})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", u.String(), bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	SetToken(s.Token, req)
+
+	hc := newClient(u.Scheme, s.InsecureSkipVerify)
+	resp, err := hc.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := CheckError(resp, true); err != nil {
+		return nil, err
+	}
+
+	var dr dashboardResponse
+	if err := json.NewDecoder(resp.Body).Decode(&dr); err != nil {
+		return nil, err
+	}
+	return dr.toPlatform(), nil
+}
@@ -0,0 +1,86 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/influxdata/platform"
+)
+
+// dashboardLayoutService creates a fixed dashboard from its layouts.
+type dashboardLayoutService struct {
+	layouts   []*platform.DashboardLayout
+	dashboard *platform.Dashboard
+	bucket    string
+}
+
+func (s *dashboardLayoutService) FindDashboardLayouts(ctx context.Context) ([]*platform.DashboardLayout, error) {
+	return s.layouts, nil
+}
+
+func (s *dashboardLayoutService) CreateDashboardFromLayout(ctx context.Context, id string, bucket string) (*platform.Dashboard, error) {
+	for _, l := range s.layouts {
+		if l.ID == id {
+			s.bucket = bucket
+			return s.dashboard, nil
+		}
+	}
+	return nil, &platform.Error{Code: platform.ENotFound, Msg: "layout not found"}
+}
+
+func TestDashboardLayoutHandler(t *testing.T) {
+	svc := &dashboardLayoutService{
+		layouts:   []*platform.DashboardLayout{{ID: "apache", Application: "apache", Measurement: "apache", Cells: 3}},
+		dashboard: &platform.Dashboard{ID: 1, Name: "apache"},
+	}
+	h := NewDashboardLayoutHandler(svc)
+
+	if !isDashboardLayoutPath(dashboardsTemplatesPath) || !isDashboardLayoutPath("/api/v2/dashboards/templates/apache") {
+		t.Fatal("template paths are not routed to the layout handler")
+	}
+	if isDashboardLayoutPath("/api/v2/dashboards/0000000000000001") {
+		t.Fatal("dashboard path is routed to the layout handler")
+	}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("GET", dashboardsTemplatesPath, nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("got status %d listing, want %d: %s", w.Code, http.StatusOK, w.Body.String())
+	}
+	var res dashboardLayoutsResponse
+	if err := json.NewDecoder(w.Body).Decode(&res); err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Templates) != 1 || *res.Templates[0] != *svc.layouts[0] {
+		t.Errorf("got templates %+v, want %+v", res.Templates, svc.layouts)
+	}
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("POST", "/api/v2/dashboards/templates/apache", bytes.NewReader([]byte(`{"bucket": "telegraf"}`))))
+	if w.Code != http.StatusCreated {
+		t.Fatalf("got status %d creating, want %d: %s", w.Code, http.StatusCreated, w.Body.String())
+	}
+	var dr dashboardResponse
+	if err := json.NewDecoder(w.Body).Decode(&dr); err != nil {
+		t.Fatal(err)
+	}
+	if dr.ID != svc.dashboard.ID || svc.bucket != "telegraf" {
+		t.Errorf("got dashboard %s querying %q, want %s querying %q", dr.ID, svc.bucket, svc.dashboard.ID, "telegraf")
+	}
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("POST", "/api/v2/dashboards/templates/missing", bytes.NewReader([]byte(`{"bucket": "telegraf"}`))))
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("got status %d creating from a missing layout, want %d", w.Code, http.StatusNotFound)
+	}
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("POST", "/api/v2/dashboards/templates/apache", bytes.NewReader([]byte(`{}`))))
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("got status %d creating without a bucket, want %d", w.Code, http.StatusBadRequest)
+	}
+}
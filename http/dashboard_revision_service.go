@@ -0,0 +1,167 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/kit/errors"
+	"github.com/julienschmidt/httprouter"
+)
+
+type dashboardRevisionsResponse struct {
+	Links     map[string]string            `json:"links"`
+	Revisions []*dashboardRevisionResponse `json:"revisions"`
+}
+
+// dashboardRevisionResponse is a revision without the state of the dashboard,
+// which is returned by the route of the revision.
+type dashboardRevisionResponse struct {
+	Links       map[string]string `json:"links"`
+	Revision    int               `json:"revision"`
+	Description string            `json:"description"`
+	UserID      platform.ID       `json:"userID,omitempty"`
+	Time        time.Time         `json:"time"`
+}
+
+func newDashboardRevisionsResponse(id platform.ID, rs []*platform.DashboardRevision) *dashboardRevisionsResponse {
+	res := &dashboardRevisionsResponse{
+		Links: map[string]string{
+			"self": fmt.Sprintf("/api/v2/dashboards/%s/revisions", id),
+		},
+		Revisions: make([]*dashboardRevisionResponse, 0, len(rs)),
+	}
+	for _, r := range rs {
+		res.Revisions = append(res.Revisions, newDashboardRevisionResponse(r))
+	}
+	return res
+}
+
+func newDashboardRevisionResponse(r *platform.DashboardRevision) *dashboardRevisionResponse {
+	links := map[string]string{
+		"self":    fmt.Sprintf("/api/v2/dashboards/%s/revisions/%d", r.DashboardID, r.Revision),
+		"restore": fmt.Sprintf("/api/v2/dashboards/%s/revisions/%d/restore", r.DashboardID, r.Revision),
+	}
+	if r.UserID.Valid() {
+		links["user"] = fmt.Sprintf("/api/v2/users/%s", r.UserID)
+	}
+	return &dashboardRevisionResponse{
+		Links:       links,
+		Revision:    r.Revision,
+		Description: r.Description,
+		UserID:      r.UserID,
+		Time:        r.Time,
+	}
+}
+
+// handleGetDashboardRevisions retrieves the revisions of a dashboard by the dashboards ID.
+func (h *DashboardHandler) handleGetDashboardRevisions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	req, err := decodeGetDashboardLogRequest(ctx, r)
+	if err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+	opts := req.opts
+	if r.URL.Query().Get("limit") == "" {
+		opts.Limit = platform.DefaultDashboardRevisionFindOptions.Limit
+	}
+
+	rs, _, err := h.DashboardRevisionService.FindDashboardRevisions(ctx, req.DashboardID, opts)
+	if err != nil {
+		EncodeError(ctx, dashboardRevisionError(err), w)
+		return
+	}
+
+	if err := encodeResponse(ctx, w, http.StatusOK, newDashboardRevisionsResponse(req.DashboardID, rs)); err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+}
+
+// handleGetDashboardRevision retrieves a revision of a dashboard with the state of the dashboard and its views.
+func (h *DashboardHandler) handleGetDashboardRevision(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	req, err := decodeDashboardRevisionRequest(ctx, r)
+	if err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	rev, err := h.DashboardRevisionService.FindDashboardRevision(ctx, req.DashboardID, req.Revision)
+	if err != nil {
+		EncodeError(ctx, dashboardRevisionError(err), w)
+		return
+	}
+
+	if err := encodeResponse(ctx, w, http.StatusOK, rev); err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+}
+
+// handlePostDashboardRevisionRestore rolls a dashboard back to a revision.
+func (h *DashboardHandler) handlePostDashboardRevisionRestore(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	req, err := decodeDashboardRevisionRequest(ctx, r)
+	if err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	d, err := h.DashboardRevisionService.RestoreDashboardRevision(ctx, req.DashboardID, req.Revision)
+	if err != nil {
+		EncodeError(ctx, dashboardRevisionError(err), w)
+		return
+	}
+
+	if err := encodeResponse(ctx, w, http.StatusOK, newDashboardResponse(d)); err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+}
+
+type dashboardRevisionRequest struct {
+	DashboardID platform.ID
+	Revision    int
+}
+
+func decodeDashboardRevisionRequest(ctx context.Context, r *http.Request) (*dashboardRevisionRequest, error) {
+	params := httprouter.ParamsFromContext(ctx)
+	id := params.ByName("id")
+	if id == "" {
+		return nil, errors.InvalidDataf("url missing id")
+	}
+
+	var i platform.ID
+	if err := i.DecodeFromString(id); err != nil {
+		return nil, err
+	}
+
+	rev, err := strconv.Atoi(params.ByName("revision"))
+	if err != nil {
+		return nil, &platform.Error{
+			Code: platform.EInvalid,
+			Msg:  fmt.Sprintf("invalid revision %q", params.ByName("revision")),
+		}
+	}
+
+	return &dashboardRevisionRequest{
+		DashboardID: i,
+		Revision:    rev,
+	}, nil
+}
+
+// dashboardRevisionError returns missing dashboards and revisions as not found errors.
+func dashboardRevisionError(err error) error {
+	if err == platform.ErrDashboardNotFound || err == platform.ErrDashboardRevisionNotFound {
+		return errors.New(err.Error(), errors.NotFound)
+	}
+	return err
+}
@@ -0,0 +1,109 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/mock"
+)
+
+// dashboardRevisionService serves fixed revisions of a dashboard.
+type dashboardRevisionService struct {
+	revisions []*platform.DashboardRevision
+	restored  int
+	opts      platform.FindOptions
+}
+
+func (s *dashboardRevisionService) FindDashboardRevisions(ctx context.Context, id platform.ID, opts platform.FindOptions) ([]*platform.DashboardRevision, int, error) {
+	s.opts = opts
+	return s.revisions, len(s.revisions), nil
+}
+
+func (s *dashboardRevisionService) FindDashboardRevision(ctx context.Context, id platform.ID, revision int) (*platform.DashboardRevision, error) {
+	for _, r := range s.revisions {
+		if r.DashboardID == id && r.Revision == revision {
+			return r, nil
+		}
+	}
+	return nil, platform.ErrDashboardRevisionNotFound
+}
+
+func (s *dashboardRevisionService) RestoreDashboardRevision(ctx context.Context, id platform.ID, revision int) (*platform.Dashboard, error) {
+	r, err := s.FindDashboardRevision(ctx, id, revision)
+	if err != nil {
+		return nil, err
+	}
+	s.restored = revision
+	return r.Dashboard, nil
+}
+
+func TestDashboardHandler_Revisions(t *testing.T) {
+	d := &platform.Dashboard{ID: 1, Name: "d"}
+	svc := &dashboardRevisionService{
+		revisions: []*platform.DashboardRevision{
+			{Revision: 2, DashboardID: 1, Description: "Dashboard Updated", UserID: 3, Time: time.Date(2018, 11, 1, 0, 0, 0, 0, time.UTC), Dashboard: d},
+			{Revision: 1, DashboardID: 1, Description: "Dashboard Created", Time: time.Date(2018, 10, 1, 0, 0, 0, 0, time.UTC), Dashboard: d},
+		},
+	}
+	h := NewDashboardHandler(mock.NewUserResourceMappingService(), mock.NewLabelService())
+	h.DashboardRevisionService = svc
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("GET", "/api/v2/dashboards/0000000000000001/revisions?offset=1", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("got status %d listing revisions, want %d: %s", w.Code, http.StatusOK, w.Body.String())
+	}
+	if !svc.opts.Descending || svc.opts.Offset != 1 || svc.opts.Limit != platform.DefaultDashboardRevisionFindOptions.Limit {
+		t.Errorf("got find options %+v", svc.opts)
+	}
+	var res dashboardRevisionsResponse
+	if err := json.NewDecoder(w.Body).Decode(&res); err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Revisions) != 2 {
+		t.Fatalf("got %d revisions, want 2", len(res.Revisions))
+	}
+	if r := res.Revisions[0]; r.Revision != 2 || r.UserID != 3 || r.Links["user"] != "/api/v2/users/0000000000000003" ||
+		r.Links["restore"] != "/api/v2/dashboards/0000000000000001/revisions/2/restore" {
+		t.Errorf("got revision %+v", r)
+	}
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("GET", "/api/v2/dashboards/0000000000000001/revisions/1", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("got status %d getting a revision, want %d: %s", w.Code, http.StatusOK, w.Body.String())
+	}
+	var rev platform.DashboardRevision
+	if err := json.NewDecoder(w.Body).Decode(&rev); err != nil {
+		t.Fatal(err)
+	}
+	if rev.Revision != 1 || rev.Dashboard == nil || rev.Dashboard.Name != d.Name {
+		t.Errorf("got revision %+v, want revision 1 with its dashboard", rev)
+	}
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("POST", "/api/v2/dashboards/0000000000000001/revisions/1/restore", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("got status %d restoring, want %d: %s", w.Code, http.StatusOK, w.Body.String())
+	}
+	if svc.restored != 1 {
+		t.Errorf("got revision %d restored, want 1", svc.restored)
+	}
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("POST", "/api/v2/dashboards/0000000000000001/revisions/5/restore", nil))
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("got status %d restoring a missing revision, want %d", w.Code, http.StatusNotFound)
+	}
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("GET", "/api/v2/dashboards/0000000000000001/revisions/latest", nil))
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("got status %d getting an invalid revision, want %d", w.Code, http.StatusBadRequest)
+	}
+}
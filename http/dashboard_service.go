@@ -21,6 +21,7 @@ type DashboardHandler struct {
 
 	DashboardService             platform.DashboardService
 	DashboardOperationLogService platform.DashboardOperationLogService
+	DashboardRevisionService     platform.DashboardRevisionService
 	UserResourceMappingService   platform.UserResourceMappingService
 	LabelService                 platform.LabelService
 	UserService                  platform.UserService
@@ -38,6 +39,10 @@ const (
 	dashboardsIDOwnersIDPath   = "/api/v2/dashboards/:id/owners/:userID"
 	dashboardsIDLabelsPath     = "/api/v2/dashboards/:id/labels"
 	dashboardsIDLabelsNamePath = "/api/v2/dashboards/:id/labels/:name"
+
+	dashboardsIDRevisionsPath          = "/api/v2/dashboards/:id/revisions"
+	dashboardsIDRevisionsIDPath        = "/api/v2/dashboards/:id/revisions/:revision"
+	dashboardsIDRevisionsIDRestorePath = "/api/v2/dashboards/:id/revisions/:revision/restore"
 )
 
 // NewDashboardHandler returns a new instance of DashboardHandler.
@@ -52,6 +57,9 @@ func NewDashboardHandler(mappingService platform.UserResourceMappingService, lab
 	h.HandlerFunc("GET", dashboardsPath, h.handleGetDashboards)
 	h.HandlerFunc("GET", dashboardsIDPath, h.handleGetDashboard)
 	h.HandlerFunc("GET", dashboardsIDLogPath, h.handleGetDashboardLog)
+	h.HandlerFunc("GET", dashboardsIDRevisionsPath, h.handleGetDashboardRevisions)
+	h.HandlerFunc("GET", dashboardsIDRevisionsIDPath, h.handleGetDashboardRevision)
+	h.HandlerFunc("POST", dashboardsIDRevisionsIDRestorePath, h.handlePostDashboardRevisionRestore)
 	h.HandlerFunc("DELETE", dashboardsIDPath, h.handleDeleteDashboard)
 	h.HandlerFunc("PATCH", dashboardsIDPath, h.handlePatchDashboard)
 
@@ -76,10 +84,11 @@ func NewDashboardHandler(mappingService platform.UserResourceMappingService, lab
 }
 
 type dashboardLinks struct {
-	Self   string `json:"self"`
-	Cells  string `json:"cells"`
-	Log    string `json:"log"`
-	Labels string `json:"labels"`
+	Self      string `json:"self"`
+	Cells     string `json:"cells"`
+	Log       string `json:"log"`
+	Revisions string `json:"revisions"`
+	Labels    string `json:"labels"`
 }
 
 type dashboardResponse struct {
@@ -104,10 +113,11 @@ func (d dashboardResponse) toPlatform() *platform.Dashboard {
 func newDashboardResponse(d *platform.Dashboard) dashboardResponse {
 	res := dashboardResponse{
 		Links: dashboardLinks{
-			Self:   fmt.Sprintf("/api/v2/dashboards/%s", d.ID),
-			Cells:  fmt.Sprintf("/api/v2/dashboards/%s/cells", d.ID),
-			Log:    fmt.Sprintf("/api/v2/dashboards/%s/log", d.ID),
-			Labels: fmt.Sprintf("/api/v2/dashboards/%s/labels", d.ID),
+			Self:      fmt.Sprintf("/api/v2/dashboards/%s", d.ID),
+			Cells:     fmt.Sprintf("/api/v2/dashboards/%s/cells", d.ID),
+			Log:       fmt.Sprintf("/api/v2/dashboards/%s/log", d.ID),
+			Revisions: fmt.Sprintf("/api/v2/dashboards/%s/revisions", d.ID),
+			Labels:    fmt.Sprintf("/api/v2/dashboards/%s/labels", d.ID),
 		},
 		Dashboard: *d,
 		Cells:     []dashboardCellResponse{},
@@ -0,0 +1,180 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"path"
+
+	"github.com/influxdata/platform"
+	"github.com/julienschmidt/httprouter"
+	"go.uber.org/zap"
+)
+
+// DashboardTemplateHandler is the handler for exporting and importing dashboards.
+// It is separate from the DashboardHandler, because the import path would
+// conflict with the dashboard ID parameter of its routes.
+type DashboardTemplateHandler struct {
+	*httprouter.Router
+
+	Logger *zap.Logger
+
+	DashboardTemplateService platform.DashboardTemplateService
+}
+
+const (
+	dashboardsIDExportPath = "/api/v2/dashboards/:id/export"
+	dashboardsImportPath   = "/api/v2/dashboards/import"
+)
+
+// NewDashboardTemplateHandler returns a new instance of DashboardTemplateHandler.
+func NewDashboardTemplateHandler(s platform.DashboardTemplateService) *DashboardTemplateHandler {
+	h := &DashboardTemplateHandler{
+		Router:                   NewRouter(),
+		Logger:                   zap.NewNop(),
+		DashboardTemplateService: s,
+	}
+
+	h.HandlerFunc("GET", dashboardsIDExportPath, h.handleGetDashboardExport)
+	h.HandlerFunc("POST", dashboardsImportPath, h.handlePostDashboardImport)
+	return h
+}
+
+// isDashboardTemplatePath returns true if the path is routed to the DashboardTemplateHandler.
+func isDashboardTemplatePath(p string) bool {
+	return p == dashboardsImportPath || path.Dir(path.Dir(p)) == dashboardsPath && path.Base(p) == "export"
+}
+
+// handleGetDashboardExport is the HTTP handler for the GET /api/v2/dashboards/:id/export route.
+func (h *DashboardTemplateHandler) handleGetDashboardExport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	req, err := decodeGetDashboardRequest(ctx, r)
+	if err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	t, err := h.DashboardTemplateService.ExportDashboard(ctx, req.DashboardID)
+	if err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	if err := encodeResponse(ctx, w, http.StatusOK, t); err != nil {
+		h.Logger.Info("Failed to encode response", zap.Error(err))
+		return
+	}
+}
+
+// handlePostDashboardImport is the HTTP handler for the POST /api/v2/dashboards/import route.
+func (h *DashboardTemplateHandler) handlePostDashboardImport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	t, err := decodePostDashboardImportRequest(ctx, r)
+	if err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	d, err := h.DashboardTemplateService.ImportDashboard(ctx, t)
+	if err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	if err := encodeResponse(ctx, w, http.StatusCreated, newDashboardResponse(d)); err != nil {
+		h.Logger.Info("Failed to encode response", zap.Error(err))
+		return
+	}
+}
+
+func decodePostDashboardImportRequest(ctx context.Context, r *http.Request) (*platform.DashboardTemplate, error) {
+	t := &platform.DashboardTemplate{}
+	if err := json.NewDecoder(r.Body).Decode(t); err != nil {
+		return nil, &platform.Error{
+			Code: platform.EInvalid,
+			Op:   platform.OpImportDashboard,
+			Err:  err,
+		}
+	}
+	return t, t.Valid()
+}
+
+// DashboardTemplateService connects to Influx via HTTP using tokens to export and import dashboards.
+type DashboardTemplateService struct {
+	Addr               string
+	Token              string
+	InsecureSkipVerify bool
+}
+
+var _ platform.DashboardTemplateService = (*DashboardTemplateService)(nil)
+
+// ExportDashboard returns a template of the dashboard.
+func (s *DashboardTemplateService) ExportDashboard(ctx context.Context, id platform.ID) (*platform.DashboardTemplate, error) {
+	u, err := newURL(s.Addr, path.Join(dashboardIDPath(id), "export"))
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	SetToken(s.Token, req)
+
+	hc := newClient(u.Scheme, s.InsecureSkipVerify)
+	resp, err := hc.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := CheckError(resp, true); err != nil {
+		return nil, err
+	}
+
+	var t platform.DashboardTemplate
+	if err := json.NewDecoder(resp.Body).Decode(&t); err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// ImportDashboard creates a dashboard from the template.
+func (s *DashboardTemplateService) ImportDashboard(ctx context.Context, t *platform.DashboardTemplate) (*platform.Dashboard, error) {
+	u, err := newURL(s.Addr, dashboardsImportPath)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", u.String(), bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	SetToken(s.Token, req)
+
+	hc := newClient(u.Scheme, s.InsecureSkipVerify)
+	resp, err := hc.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := CheckError(resp, true); err != nil {
+		return nil, err
+	}
+
+	var dr dashboardResponse
+	if err := json.NewDecoder(resp.Body).Decode(&dr); err != nil {
+		return nil, err
+	}
+	return dr.toPlatform(), nil
+}
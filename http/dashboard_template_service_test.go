@@ -0,0 +1,82 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/influxdata/platform"
+)
+
+// dashboardTemplateService exports a fixed template and imports it as a fixed dashboard.
+type dashboardTemplateService struct {
+	template  *platform.DashboardTemplate
+	dashboard *platform.Dashboard
+}
+
+func (s *dashboardTemplateService) ExportDashboard(ctx context.Context, id platform.ID) (*platform.DashboardTemplate, error) {
+	if id != s.template.Dashboard.ID {
+		return nil, &platform.Error{Code: platform.ENotFound, Msg: "dashboard not found"}
+	}
+	return s.template, nil
+}
+
+func (s *dashboardTemplateService) ImportDashboard(ctx context.Context, t *platform.DashboardTemplate) (*platform.Dashboard, error) {
+	return s.dashboard, nil
+}
+
+func TestDashboardTemplateHandler(t *testing.T) {
+	svc := &dashboardTemplateService{
+		template: &platform.DashboardTemplate{
+			Version:   platform.DashboardTemplateVersion,
+			Dashboard: &platform.Dashboard{ID: 1, Name: "d", Cells: []*platform.Cell{{ID: 2, ViewID: 3}}},
+			Views:     []*platform.View{{ViewContents: platform.ViewContents{ID: 3, Name: "v"}, Properties: platform.EmptyViewProperties{}}},
+			Macros:    []*platform.Macro{},
+			Labels:    []string{"l"},
+		},
+		dashboard: &platform.Dashboard{ID: 4, Name: "d"},
+	}
+	h := NewDashboardTemplateHandler(svc)
+
+	if !isDashboardTemplatePath("/api/v2/dashboards/0000000000000001/export") || !isDashboardTemplatePath(dashboardsImportPath) {
+		t.Fatal("template paths are not routed to the template handler")
+	}
+	if isDashboardTemplatePath("/api/v2/dashboards/0000000000000001/cells") {
+		t.Fatal("cells path is routed to the template handler")
+	}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("GET", "/api/v2/dashboards/0000000000000001/export", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("got status %d exporting, want %d: %s", w.Code, http.StatusOK, w.Body.String())
+	}
+	body := w.Body.Bytes()
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("GET", "/api/v2/dashboards/0000000000000005/export", nil))
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("got status %d exporting a missing dashboard, want %d", w.Code, http.StatusNotFound)
+	}
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("POST", dashboardsImportPath, bytes.NewReader(body)))
+	if w.Code != http.StatusCreated {
+		t.Fatalf("got status %d importing, want %d: %s", w.Code, http.StatusCreated, w.Body.String())
+	}
+	var dr dashboardResponse
+	if err := json.NewDecoder(w.Body).Decode(&dr); err != nil {
+		t.Fatal(err)
+	}
+	if dr.ID != svc.dashboard.ID {
+		t.Errorf("got dashboard %s, want %s", dr.ID, svc.dashboard.ID)
+	}
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("POST", dashboardsImportPath, bytes.NewReader([]byte(`{"version": 2}`))))
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("got status %d importing an unsupported version, want %d", w.Code, http.StatusBadRequest)
+	}
+}
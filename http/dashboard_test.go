@@ -110,6 +110,7 @@ func TestService_handleGetDashboards(t *testing.T) {
         "self": "/api/v2/dashboards/da7aba5e5d81e550",
         "cells": "/api/v2/dashboards/da7aba5e5d81e550/cells",
         "log": "/api/v2/dashboards/da7aba5e5d81e550/log",
+        "revisions": "/api/v2/dashboards/da7aba5e5d81e550/revisions",
 	"labels": "/api/v2/dashboards/da7aba5e5d81e550/labels"
       }
     },
@@ -125,6 +126,7 @@ func TestService_handleGetDashboards(t *testing.T) {
       "links": {
         "self": "/api/v2/dashboards/0ca2204eca2204e0",
         "log": "/api/v2/dashboards/0ca2204eca2204e0/log",
+        "revisions": "/api/v2/dashboards/0ca2204eca2204e0/revisions",
         "cells": "/api/v2/dashboards/0ca2204eca2204e0/cells",
 	"labels": "/api/v2/dashboards/0ca2204eca2204e0/labels"
       }
@@ -278,6 +280,7 @@ func TestService_handleGetDashboard(t *testing.T) {
   "links": {
     "self": "/api/v2/dashboards/020f755c3c082000",
     "log": "/api/v2/dashboards/020f755c3c082000/log",
+    "revisions": "/api/v2/dashboards/020f755c3c082000/revisions",
     "cells": "/api/v2/dashboards/020f755c3c082000/cells",
     "labels": "/api/v2/dashboards/020f755c3c082000/labels"
   }
@@ -422,6 +425,7 @@ func TestService_handlePostDashboard(t *testing.T) {
   "links": {
     "self": "/api/v2/dashboards/020f755c3c082000",
     "log": "/api/v2/dashboards/020f755c3c082000/log",
+    "revisions": "/api/v2/dashboards/020f755c3c082000/revisions",
     "cells": "/api/v2/dashboards/020f755c3c082000/cells",
     "labels": "/api/v2/dashboards/020f755c3c082000/labels"
   }
@@ -651,6 +655,7 @@ func TestService_handlePatchDashboard(t *testing.T) {
   "links": {
     "self": "/api/v2/dashboards/020f755c3c082000",
     "log": "/api/v2/dashboards/020f755c3c082000/log",
+    "revisions": "/api/v2/dashboards/020f755c3c082000/revisions",
     "cells": "/api/v2/dashboards/020f755c3c082000/cells",
     "labels": "/api/v2/dashboards/020f755c3c082000/labels"
   }
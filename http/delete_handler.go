@@ -0,0 +1,240 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/influxdata/platform"
+	pcontext "github.com/influxdata/platform/context"
+	"github.com/julienschmidt/httprouter"
+	"go.uber.org/zap"
+)
+
+// DeleteHandler receives delete requests and removes the matching data from a bucket.
+type DeleteHandler struct {
+	*httprouter.Router
+
+	Logger *zap.Logger
+
+	BucketService       platform.BucketService
+	OrganizationService platform.OrganizationService
+
+	DeleteService platform.DeleteService
+}
+
+const (
+	deletePath = "/api/v2/delete"
+)
+
+// NewDeleteHandler creates a new handler at /api/v2/delete to delete data over a time range.
+func NewDeleteHandler(s platform.DeleteService) *DeleteHandler {
+	h := &DeleteHandler{
+		Router:        NewRouter(),
+		Logger:        zap.NewNop(),
+		DeleteService: s,
+	}
+
+	h.HandlerFunc("POST", deletePath, h.handleDelete)
+	return h
+}
+
+func (h *DeleteHandler) handleDelete(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	a, err := pcontext.GetAuthorizer(ctx)
+	if err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	req, err := decodeDeleteRequest(ctx, r)
+	if err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	logger := h.Logger.With(zap.String("org", req.Org), zap.String("bucket", req.Bucket))
+
+	var org *platform.Organization
+	if id, err := platform.IDFromString(req.Org); err == nil {
+		// Decoded ID successfully. Make sure it's a real org.
+		o, err := h.OrganizationService.FindOrganizationByID(ctx, *id)
+		if err == nil {
+			org = o
+		} else if platform.ErrorCode(err) != platform.ENotFound {
+			EncodeError(ctx, err, w)
+			return
+		}
+	}
+	if org == nil {
+		o, err := h.OrganizationService.FindOrganization(ctx, platform.OrganizationFilter{Name: &req.Org})
+		if err != nil {
+			logger.Info("Failed to find organization", zap.Error(err))
+			EncodeError(ctx, &platform.Error{
+				Code: platform.ENotFound,
+				Msg:  fmt.Sprintf("organization %q not found", req.Org),
+			}, w)
+			return
+		}
+
+		org = o
+	}
+
+	var bucket *platform.Bucket
+	if id, err := platform.IDFromString(req.Bucket); err == nil {
+		// Decoded ID successfully. Make sure it's a real bucket.
+		b, err := h.BucketService.FindBucket(ctx, platform.BucketFilter{
+			OrganizationID: &org.ID,
+			ID:             id,
+		})
+		if err == nil {
+			bucket = b
+		} else if platform.ErrorCode(err) != platform.ENotFound {
+			EncodeError(ctx, err, w)
+			return
+		}
+	}
+
+	if bucket == nil {
+		b, err := h.BucketService.FindBucket(ctx, platform.BucketFilter{
+			OrganizationID: &org.ID,
+			Name:           &req.Bucket,
+		})
+		if err != nil {
+			logger.Info("Failed to find bucket", zap.Stringer("org_id", org.ID), zap.Error(err))
+			EncodeError(ctx, &platform.Error{
+				Code: platform.ENotFound,
+				Msg:  fmt.Sprintf("bucket %q not found", req.Bucket),
+			}, w)
+			return
+		}
+
+		bucket = b
+	}
+
+	if !a.Allowed(platform.NewPermissionAtID(bucket.ID, platform.WriteAction, platform.BucketResourceType, bucket.OrganizationID)) {
+		EncodeError(ctx, &platform.Error{
+			Code: platform.EForbidden,
+			Msg:  "insufficient permissions for delete",
+		}, w)
+		return
+	}
+
+	filter := platform.DeleteFilter{
+		OrganizationID: org.ID,
+		BucketID:       bucket.ID,
+		Range: platform.Timespan{
+			Start: req.Start,
+			Stop:  req.Stop,
+		},
+		Predicate: req.Predicate,
+	}
+
+	if err := h.DeleteService.DeleteBucketRangePredicate(ctx, filter); err != nil {
+		logger.Info("Error deleting data", zap.Error(err))
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+type deleteRequest struct {
+	Org       string    `json:"-"`
+	Bucket    string    `json:"-"`
+	Start     time.Time `json:"start"`
+	Stop      time.Time `json:"stop"`
+	Predicate string    `json:"predicate,omitempty"`
+}
+
+func decodeDeleteRequest(ctx context.Context, r *http.Request) (*deleteRequest, error) {
+	req := &deleteRequest{}
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		return nil, &platform.Error{
+			Code: platform.EInvalid,
+			Msg:  "invalid request body",
+			Err:  err,
+		}
+	}
+
+	qp := r.URL.Query()
+	req.Org = qp.Get("org")
+	req.Bucket = qp.Get("bucket")
+
+	if req.Org == "" {
+		return nil, &platform.Error{
+			Code: platform.EInvalid,
+			Msg:  "org is required",
+		}
+	}
+	if req.Bucket == "" {
+		return nil, &platform.Error{
+			Code: platform.EInvalid,
+			Msg:  "bucket is required",
+		}
+	}
+	if req.Start.IsZero() || req.Stop.IsZero() {
+		return nil, &platform.Error{
+			Code: platform.EInvalid,
+			Msg:  "start and stop are required",
+		}
+	}
+
+	return req, nil
+}
+
+// DeleteService sends delete requests to influxdb over HTTP.
+type DeleteService struct {
+	Addr               string
+	Token              string
+	InsecureSkipVerify bool
+}
+
+var _ platform.DeleteService = (*DeleteService)(nil)
+
+// DeleteBucketRangePredicate deletes data from the bucket described by the filter.
+func (s *DeleteService) DeleteBucketRangePredicate(ctx context.Context, filter platform.DeleteFilter) error {
+	if err := filter.Valid(); err != nil {
+		return err
+	}
+
+	u, err := newURL(s.Addr, deletePath)
+	if err != nil {
+		return err
+	}
+
+	octets, err := json.Marshal(deleteRequest{
+		Start:     filter.Range.Start,
+		Stop:      filter.Range.Stop,
+		Predicate: filter.Predicate,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("POST", u.String(), bytes.NewReader(octets))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	SetToken(s.Token, req)
+
+	params := req.URL.Query()
+	params.Set("org", filter.OrganizationID.String())
+	params.Set("bucket", filter.BucketID.String())
+	req.URL.RawQuery = params.Encode()
+
+	hc := newClient(u.Scheme, s.InsecureSkipVerify)
+
+	resp, err := hc.Do(req)
+	if err != nil {
+		return err
+	}
+
+	return CheckError(resp, true)
+}
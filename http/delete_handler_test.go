@@ -0,0 +1,106 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/influxdata/platform"
+	pcontext "github.com/influxdata/platform/context"
+	"github.com/influxdata/platform/mock"
+)
+
+func TestDeleteHandler_handleDelete(t *testing.T) {
+	orgID := platform.ID(1)
+	bucketID := platform.ID(2)
+
+	orgSvc := &mock.OrganizationService{
+		FindOrganizationByIDF: func(ctx context.Context, id platform.ID) (*platform.Organization, error) {
+			return &platform.Organization{ID: id, Name: "org"}, nil
+		},
+	}
+	bucketSvc := mock.NewBucketService()
+	bucketSvc.FindBucketFn = func(ctx context.Context, filter platform.BucketFilter) (*platform.Bucket, error) {
+		return &platform.Bucket{ID: *filter.ID, OrganizationID: *filter.OrganizationID, Name: "bucket"}, nil
+	}
+
+	tests := []struct {
+		name        string
+		body        string
+		permissions []platform.Permission
+		status      int
+		want        *platform.DeleteFilter
+	}{
+		{
+			name:        "delete with predicate",
+			body:        `{"start":"2018-01-01T00:00:00Z","stop":"2018-01-02T00:00:00Z","predicate":"host = 'a'"}`,
+			permissions: []platform.Permission{platform.WriteBucketPermission(bucketID)},
+			status:      http.StatusNoContent,
+			want: &platform.DeleteFilter{
+				OrganizationID: orgID,
+				BucketID:       bucketID,
+				Range: platform.Timespan{
+					Start: time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC),
+					Stop:  time.Date(2018, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+				Predicate: "host = 'a'",
+			},
+		},
+		{
+			name:        "missing write permission",
+			body:        `{"start":"2018-01-01T00:00:00Z","stop":"2018-01-02T00:00:00Z"}`,
+			permissions: []platform.Permission{platform.ReadBucketPermission(bucketID)},
+			status:      http.StatusForbidden,
+		},
+		{
+			name:        "missing stop",
+			body:        `{"start":"2018-01-01T00:00:00Z"}`,
+			permissions: []platform.Permission{platform.WriteBucketPermission(bucketID)},
+			status:      http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got *platform.DeleteFilter
+			h := NewDeleteHandler(&mock.DeleteService{
+				DeleteBucketRangePredicateF: func(ctx context.Context, filter platform.DeleteFilter) error {
+					got = &filter
+					return nil
+				},
+			})
+			h.OrganizationService = orgSvc
+			h.BucketService = bucketSvc
+
+			r := httptest.NewRequest("POST", "/api/v2/delete?org=0000000000000001&bucket=0000000000000002", bytes.NewBufferString(tt.body))
+			r = r.WithContext(pcontext.SetAuthorizer(r.Context(), &platform.Authorization{
+				Status:      platform.Active,
+				Permissions: tt.permissions,
+			}))
+			w := httptest.NewRecorder()
+
+			h.ServeHTTP(w, r)
+
+			if w.Code != tt.status {
+				t.Fatalf("got status %d, want %d: %s", w.Code, tt.status, w.Body.String())
+			}
+			if tt.want == nil {
+				if got != nil {
+					t.Fatalf("unexpected delete: %v", got)
+				}
+				return
+			}
+			if got == nil {
+				t.Fatal("expected delete to be called")
+			}
+			if got.OrganizationID != tt.want.OrganizationID || got.BucketID != tt.want.BucketID ||
+				!got.Range.Start.Equal(tt.want.Range.Start) || !got.Range.Stop.Equal(tt.want.Range.Stop) ||
+				got.Predicate != tt.want.Predicate {
+				t.Errorf("got filter %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,520 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"time"
+
+	"github.com/influxdata/platform"
+	"github.com/julienschmidt/httprouter"
+)
+
+const (
+	downsamplePoliciesPath   = "/api/v2/downsamplepolicies"
+	downsamplePoliciesIDPath = "/api/v2/downsamplepolicies/:id"
+)
+
+// DownsamplePolicyHandler is the handler for the downsample policy service.
+type DownsamplePolicyHandler struct {
+	*httprouter.Router
+
+	DownsamplePolicyService platform.DownsamplePolicyService
+}
+
+// NewDownsamplePolicyHandler creates a new DownsamplePolicyHandler.
+func NewDownsamplePolicyHandler() *DownsamplePolicyHandler {
+	h := &DownsamplePolicyHandler{
+		Router: NewRouter(),
+	}
+
+	h.HandlerFunc("GET", downsamplePoliciesPath, h.handleGetDownsamplePolicies)
+	h.HandlerFunc("POST", downsamplePoliciesPath, h.handlePostDownsamplePolicy)
+	h.HandlerFunc("GET", downsamplePoliciesIDPath, h.handleGetDownsamplePolicy)
+	h.HandlerFunc("PATCH", downsamplePoliciesIDPath, h.handlePatchDownsamplePolicy)
+	h.HandlerFunc("DELETE", downsamplePoliciesIDPath, h.handleDeleteDownsamplePolicy)
+
+	return h
+}
+
+// downsamplePolicy is used for serialization/deserialization with the window in seconds.
+type downsamplePolicy struct {
+	ID                  platform.ID            `json:"id,omitempty"`
+	OrganizationID      platform.ID            `json:"orgID"`
+	Name                string                 `json:"name"`
+	SourceBucketID      platform.ID            `json:"sourceBucketID"`
+	DestinationBucketID platform.ID            `json:"destinationBucketID"`
+	WindowSeconds       int64                  `json:"windowSeconds"`
+	Functions           []string               `json:"functions"`
+	FieldOverrides      map[string][]string    `json:"fieldOverrides,omitempty"`
+	TaskIDs             map[string]platform.ID `json:"taskIDs,omitempty"`
+}
+
+func newDownsamplePolicy(p *platform.DownsamplePolicy) downsamplePolicy {
+	return downsamplePolicy{
+		ID:                  p.ID,
+		OrganizationID:      p.OrganizationID,
+		Name:                p.Name,
+		SourceBucketID:      p.SourceBucketID,
+		DestinationBucketID: p.DestinationBucketID,
+		WindowSeconds:       int64(p.Window.Round(time.Second) / time.Second),
+		Functions:           p.Functions,
+		FieldOverrides:      p.FieldOverrides,
+		TaskIDs:             p.TaskIDs,
+	}
+}
+
+func (p *downsamplePolicy) toPlatform() *platform.DownsamplePolicy {
+	return &platform.DownsamplePolicy{
+		ID:                  p.ID,
+		OrganizationID:      p.OrganizationID,
+		Name:                p.Name,
+		SourceBucketID:      p.SourceBucketID,
+		DestinationBucketID: p.DestinationBucketID,
+		Window:              time.Duration(p.WindowSeconds) * time.Second,
+		Functions:           p.Functions,
+		FieldOverrides:      p.FieldOverrides,
+		TaskIDs:             p.TaskIDs,
+	}
+}
+
+// downsamplePolicyUpdate is used for serialization/deserialization with the window in seconds.
+type downsamplePolicyUpdate struct {
+	Name           *string             `json:"name,omitempty"`
+	WindowSeconds  *int64              `json:"windowSeconds,omitempty"`
+	Functions      []string            `json:"functions,omitempty"`
+	FieldOverrides map[string][]string `json:"fieldOverrides,omitempty"`
+}
+
+func newDownsamplePolicyUpdate(u platform.DownsamplePolicyUpdate) downsamplePolicyUpdate {
+	upd := downsamplePolicyUpdate{
+		Name:           u.Name,
+		Functions:      u.Functions,
+		FieldOverrides: u.FieldOverrides,
+	}
+	if u.Window != nil {
+		secs := int64(u.Window.Round(time.Second) / time.Second)
+		upd.WindowSeconds = &secs
+	}
+	return upd
+}
+
+func (u *downsamplePolicyUpdate) toPlatform() platform.DownsamplePolicyUpdate {
+	upd := platform.DownsamplePolicyUpdate{
+		Name:           u.Name,
+		Functions:      u.Functions,
+		FieldOverrides: u.FieldOverrides,
+	}
+	if u.WindowSeconds != nil {
+		d := time.Duration(*u.WindowSeconds) * time.Second
+		upd.Window = &d
+	}
+	return upd
+}
+
+type downsamplePolicyResponse struct {
+	Links map[string]string `json:"links"`
+	downsamplePolicy
+}
+
+func newDownsamplePolicyResponse(p *platform.DownsamplePolicy) *downsamplePolicyResponse {
+	return &downsamplePolicyResponse{
+		Links: map[string]string{
+			"self":              fmt.Sprintf("%s/%s", downsamplePoliciesPath, p.ID),
+			"sourceBucket":      fmt.Sprintf("/api/v2/buckets/%s", p.SourceBucketID),
+			"destinationBucket": fmt.Sprintf("/api/v2/buckets/%s", p.DestinationBucketID),
+		},
+		downsamplePolicy: newDownsamplePolicy(p),
+	}
+}
+
+type downsamplePoliciesResponse struct {
+	Links    map[string]string           `json:"links"`
+	Policies []*downsamplePolicyResponse `json:"policies"`
+}
+
+func newDownsamplePoliciesResponse(ps []*platform.DownsamplePolicy) *downsamplePoliciesResponse {
+	resp := &downsamplePoliciesResponse{
+		Links: map[string]string{
+			"self": downsamplePoliciesPath,
+		},
+		Policies: make([]*downsamplePolicyResponse, 0, len(ps)),
+	}
+	for _, p := range ps {
+		resp.Policies = append(resp.Policies, newDownsamplePolicyResponse(p))
+	}
+	return resp
+}
+
+// handlePostDownsamplePolicy is the HTTP handler for the POST /api/v2/downsamplepolicies route.
+func (h *DownsamplePolicyHandler) handlePostDownsamplePolicy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var dp downsamplePolicy
+	if err := json.NewDecoder(r.Body).Decode(&dp); err != nil {
+		EncodeError(ctx, &platform.Error{
+			Code: platform.EInvalid,
+			Msg:  "invalid json structure",
+			Err:  err,
+		}, w)
+		return
+	}
+
+	p := dp.toPlatform()
+	if err := h.DownsamplePolicyService.CreateDownsamplePolicy(ctx, p); err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	if err := encodeResponse(ctx, w, http.StatusCreated, newDownsamplePolicyResponse(p)); err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+}
+
+// handleGetDownsamplePolicies is the HTTP handler for the GET /api/v2/downsamplepolicies route.
+func (h *DownsamplePolicyHandler) handleGetDownsamplePolicies(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	filter, err := decodeDownsamplePolicyFilter(r)
+	if err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	ps, _, err := h.DownsamplePolicyService.FindDownsamplePolicies(ctx, filter)
+	if err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	if err := encodeResponse(ctx, w, http.StatusOK, newDownsamplePoliciesResponse(ps)); err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+}
+
+func decodeDownsamplePolicyFilter(r *http.Request) (platform.DownsamplePolicyFilter, error) {
+	var filter platform.DownsamplePolicyFilter
+	qp := r.URL.Query()
+
+	if id := qp.Get("orgID"); id != "" {
+		orgID, err := platform.IDFromString(id)
+		if err != nil {
+			return filter, &platform.Error{
+				Code: platform.EInvalid,
+				Err:  err,
+			}
+		}
+		filter.OrganizationID = orgID
+	}
+
+	if id := qp.Get("sourceBucketID"); id != "" {
+		bucketID, err := platform.IDFromString(id)
+		if err != nil {
+			return filter, &platform.Error{
+				Code: platform.EInvalid,
+				Err:  err,
+			}
+		}
+		filter.SourceBucketID = bucketID
+	}
+
+	return filter, nil
+}
+
+// handleGetDownsamplePolicy is the HTTP handler for the GET /api/v2/downsamplepolicies/:id route.
+func (h *DownsamplePolicyHandler) handleGetDownsamplePolicy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := decodeDownsamplePolicyID(ctx)
+	if err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	p, err := h.DownsamplePolicyService.FindDownsamplePolicyByID(ctx, id)
+	if err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	if err := encodeResponse(ctx, w, http.StatusOK, newDownsamplePolicyResponse(p)); err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+}
+
+// handlePatchDownsamplePolicy is the HTTP handler for the PATCH /api/v2/downsamplepolicies/:id route.
+func (h *DownsamplePolicyHandler) handlePatchDownsamplePolicy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := decodeDownsamplePolicyID(ctx)
+	if err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	var upd downsamplePolicyUpdate
+	if err := json.NewDecoder(r.Body).Decode(&upd); err != nil {
+		EncodeError(ctx, &platform.Error{
+			Code: platform.EInvalid,
+			Msg:  "invalid json structure",
+			Err:  err,
+		}, w)
+		return
+	}
+
+	p, err := h.DownsamplePolicyService.UpdateDownsamplePolicy(ctx, id, upd.toPlatform())
+	if err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	if err := encodeResponse(ctx, w, http.StatusOK, newDownsamplePolicyResponse(p)); err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+}
+
+// handleDeleteDownsamplePolicy is the HTTP handler for the DELETE /api/v2/downsamplepolicies/:id route.
+func (h *DownsamplePolicyHandler) handleDeleteDownsamplePolicy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := decodeDownsamplePolicyID(ctx)
+	if err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	if err := h.DownsamplePolicyService.DeleteDownsamplePolicy(ctx, id); err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func decodeDownsamplePolicyID(ctx context.Context) (platform.ID, error) {
+	params := httprouter.ParamsFromContext(ctx)
+	id := params.ByName("id")
+	if id == "" {
+		return platform.InvalidID(), &platform.Error{
+			Code: platform.EInvalid,
+			Msg:  "url missing id",
+		}
+	}
+
+	var i platform.ID
+	if err := i.DecodeFromString(id); err != nil {
+		return platform.InvalidID(), &platform.Error{
+			Code: platform.EInvalid,
+			Err:  err,
+		}
+	}
+	return i, nil
+}
+
+// DownsamplePolicyService connects to Influx via HTTP using tokens to manage downsample policies.
+type DownsamplePolicyService struct {
+	Addr               string
+	Token              string
+	InsecureSkipVerify bool
+}
+
+var _ platform.DownsamplePolicyService = (*DownsamplePolicyService)(nil)
+
+// FindDownsamplePolicyByID returns a single downsample policy by ID.
+func (s *DownsamplePolicyService) FindDownsamplePolicyByID(ctx context.Context, id platform.ID) (*platform.DownsamplePolicy, error) {
+	u, err := newURL(s.Addr, downsamplePolicyIDPath(id))
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	SetToken(s.Token, req)
+
+	hc := newClient(u.Scheme, s.InsecureSkipVerify)
+	resp, err := hc.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := CheckError(resp, true); err != nil {
+		return nil, err
+	}
+
+	var dp downsamplePolicy
+	if err := json.NewDecoder(resp.Body).Decode(&dp); err != nil {
+		return nil, err
+	}
+	return dp.toPlatform(), nil
+}
+
+// FindDownsamplePolicies returns the downsample policies that match filter.
+func (s *DownsamplePolicyService) FindDownsamplePolicies(ctx context.Context, filter platform.DownsamplePolicyFilter) ([]*platform.DownsamplePolicy, int, error) {
+	if filter.ID != nil {
+		p, err := s.FindDownsamplePolicyByID(ctx, *filter.ID)
+		if err != nil {
+			if platform.ErrorCode(err) == platform.ENotFound {
+				return nil, 0, nil
+			}
+			return nil, 0, err
+		}
+		if !filter.Matches(p) {
+			return nil, 0, nil
+		}
+		return []*platform.DownsamplePolicy{p}, 1, nil
+	}
+
+	u, err := newURL(s.Addr, downsamplePoliciesPath)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	query := u.Query()
+	if filter.OrganizationID != nil {
+		query.Set("orgID", filter.OrganizationID.String())
+	}
+	if filter.SourceBucketID != nil {
+		query.Set("sourceBucketID", filter.SourceBucketID.String())
+	}
+
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	req.URL.RawQuery = query.Encode()
+	SetToken(s.Token, req)
+
+	hc := newClient(u.Scheme, s.InsecureSkipVerify)
+	resp, err := hc.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+
+	if err := CheckError(resp, true); err != nil {
+		return nil, 0, err
+	}
+
+	var dps downsamplePoliciesResponse
+	if err := json.NewDecoder(resp.Body).Decode(&dps); err != nil {
+		return nil, 0, err
+	}
+
+	ps := make([]*platform.DownsamplePolicy, 0, len(dps.Policies))
+	for _, dp := range dps.Policies {
+		ps = append(ps, dp.toPlatform())
+	}
+	return ps, len(ps), nil
+}
+
+// CreateDownsamplePolicy creates a new downsample policy and sets p.ID with the new identifier.
+func (s *DownsamplePolicyService) CreateDownsamplePolicy(ctx context.Context, p *platform.DownsamplePolicy) error {
+	u, err := newURL(s.Addr, downsamplePoliciesPath)
+	if err != nil {
+		return err
+	}
+
+	octets, err := json.Marshal(newDownsamplePolicy(p))
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("POST", u.String(), bytes.NewReader(octets))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	SetToken(s.Token, req)
+
+	hc := newClient(u.Scheme, s.InsecureSkipVerify)
+	resp, err := hc.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := CheckError(resp, true); err != nil {
+		return err
+	}
+
+	var dp downsamplePolicy
+	if err := json.NewDecoder(resp.Body).Decode(&dp); err != nil {
+		return err
+	}
+	*p = *dp.toPlatform()
+	return nil
+}
+
+// UpdateDownsamplePolicy updates a single downsample policy with changeset.
+func (s *DownsamplePolicyService) UpdateDownsamplePolicy(ctx context.Context, id platform.ID, upd platform.DownsamplePolicyUpdate) (*platform.DownsamplePolicy, error) {
+	u, err := newURL(s.Addr, downsamplePolicyIDPath(id))
+	if err != nil {
+		return nil, err
+	}
+
+	octets, err := json.Marshal(newDownsamplePolicyUpdate(upd))
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", u.String(), bytes.NewReader(octets))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	SetToken(s.Token, req)
+
+	hc := newClient(u.Scheme, s.InsecureSkipVerify)
+	resp, err := hc.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := CheckError(resp, true); err != nil {
+		return nil, err
+	}
+
+	var dp downsamplePolicy
+	if err := json.NewDecoder(resp.Body).Decode(&dp); err != nil {
+		return nil, err
+	}
+	return dp.toPlatform(), nil
+}
+
+// DeleteDownsamplePolicy removes a downsample policy by ID.
+func (s *DownsamplePolicyService) DeleteDownsamplePolicy(ctx context.Context, id platform.ID) error {
+	u, err := newURL(s.Addr, downsamplePolicyIDPath(id))
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return err
+	}
+	SetToken(s.Token, req)
+
+	hc := newClient(u.Scheme, s.InsecureSkipVerify)
+	resp, err := hc.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return CheckError(resp, true)
+}
+
+func downsamplePolicyIDPath(id platform.ID) string {
+	return path.Join(downsamplePoliciesPath, id.String())
+}
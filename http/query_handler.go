@@ -38,6 +38,13 @@ type FluxHandler struct {
 	Now                 func() time.Time
 	OrganizationService platform.OrganizationService
 	ProxyQueryService   query.ProxyQueryService
+
+	// UsageRecorder, if set, counts the requests and response bytes of the
+	// queries of each organization.
+	UsageRecorder platform.UsageRecorder
+	// BucketService finds the organization of the buckets an authorization
+	// may read, when its permissions do not name one.
+	BucketService platform.BucketService
 }
 
 // NewFluxHandler returns a new handler at /api/v2/query for flux queries.
@@ -80,6 +87,9 @@ func (h *FluxHandler) handlePostQuery(w http.ResponseWriter, r *http.Request) {
 	hd.SetHeaders(w)
 
 	n, err := h.ProxyQueryService.Query(ctx, w, req)
+	if h.readsOrg(ctx, a, req.Request.OrganizationID) {
+		h.recordUsage(ctx, req.Request.OrganizationID, n)
+	}
 	if err != nil {
 		if n == 0 {
 			// Only record the error headers IFF nothing has been written to w.
@@ -93,6 +103,55 @@ func (h *FluxHandler) handlePostQuery(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// readsOrg reports whether a may read the buckets of the organization, or some
+// of them. The organization of a query request is not otherwise verified,
+// so its usage is only recorded if it does.
+func (h *FluxHandler) readsOrg(ctx context.Context, a platform.Authorizer, orgID platform.ID) bool {
+	if !orgID.Valid() {
+		return false
+	}
+	if a.Allowed(platform.NewPermission(platform.ReadAction, platform.BucketResourceType, orgID)) {
+		return true
+	}
+
+	auth, ok := a.(*platform.Authorization)
+	if !ok || !auth.IsActive() {
+		return false
+	}
+	for _, p := range auth.Permissions {
+		if p.Action != platform.ReadAction || p.Resource.Type != platform.BucketResourceType || p.Resource.ID == nil {
+			continue
+		}
+		if p.Resource.OrgID != nil {
+			if *p.Resource.OrgID == orgID {
+				return true
+			}
+			continue
+		}
+		if h.BucketService == nil {
+			continue
+		}
+		if b, err := h.BucketService.FindBucketByID(ctx, *p.Resource.ID); err == nil && b.OrganizationID == orgID {
+			return true
+		}
+	}
+	return false
+}
+
+// recordUsage counts a query request of the organization that wrote n bytes.
+func (h *FluxHandler) recordUsage(ctx context.Context, orgID platform.ID, n int64) {
+	if h.UsageRecorder == nil || !orgID.Valid() {
+		return
+	}
+
+	if err := h.UsageRecorder.RecordUsage(ctx, time.Now(),
+		platform.Usage{OrganizationID: &orgID, Type: platform.UsageQueryRequestCount, Value: 1},
+		platform.Usage{OrganizationID: &orgID, Type: platform.UsageQueryRequestBytes, Value: float64(n)},
+	); err != nil {
+		h.Logger.Info("Failed to record query usage", zap.Error(err))
+	}
+}
+
 type langRequest struct {
 	Query string `json:"query"`
 }
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"reflect"
@@ -15,7 +16,10 @@ import (
 	"github.com/influxdata/flux/csv"
 	"github.com/influxdata/flux/lang"
 	"github.com/influxdata/platform"
+	pcontext "github.com/influxdata/platform/context"
+	"github.com/influxdata/platform/mock"
 	"github.com/influxdata/platform/query"
+	querymock "github.com/influxdata/platform/query/mock"
 )
 
 func TestFluxService_Query(t *testing.T) {
@@ -393,3 +397,67 @@ func Test_postPlanRequest_Valid(t *testing.T) {
 		})
 	}
 }
+
+func TestFluxHandler_handlePostQuery_usage(t *testing.T) {
+	orgID := platform.ID(1)
+	otherOrgID := platform.ID(2)
+	bucketID := platform.ID(3)
+
+	tests := []struct {
+		name        string
+		permissions []platform.Permission
+		want        usageRecorder
+	}{
+		{
+			name:        "organization read",
+			permissions: []platform.Permission{platform.NewPermission(platform.ReadAction, platform.BucketResourceType, orgID)},
+			want:        usageRecorder{platform.UsageQueryRequestCount: 1, platform.UsageQueryRequestBytes: 4},
+		},
+		{
+			name:        "bucket read",
+			permissions: []platform.Permission{platform.ReadBucketPermission(bucketID)},
+			want:        usageRecorder{platform.UsageQueryRequestCount: 1, platform.UsageQueryRequestBytes: 4},
+		},
+		{
+			name:        "read of another organization",
+			permissions: []platform.Permission{platform.NewPermission(platform.ReadAction, platform.BucketResourceType, otherOrgID)},
+			want:        usageRecorder{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewFluxHandler()
+			h.OrganizationService = &mock.OrganizationService{
+				FindOrganizationF: func(ctx context.Context, filter platform.OrganizationFilter) (*platform.Organization, error) {
+					return &platform.Organization{ID: *filter.ID, Name: "org"}, nil
+				},
+			}
+			bucketSvc := mock.NewBucketService()
+			bucketSvc.FindBucketByIDFn = func(ctx context.Context, id platform.ID) (*platform.Bucket, error) {
+				return &platform.Bucket{ID: id, OrganizationID: orgID}, nil
+			}
+			h.BucketService = bucketSvc
+			h.ProxyQueryService = &querymock.ProxyQueryService{
+				QueryF: func(ctx context.Context, w io.Writer, req *query.ProxyRequest) (int64, error) {
+					n, err := io.WriteString(w, "a,b\n")
+					return int64(n), err
+				},
+			}
+			recorder := usageRecorder{}
+			h.UsageRecorder = recorder
+
+			// The organization of the request is the first one, whatever the authorization.
+			r := httptest.NewRequest("POST", "/api/v2/query?organizationID="+orgID.String(), bytes.NewBufferString(`{"query": "from(bucket: \"b\")"}`))
+			r = r.WithContext(pcontext.SetAuthorizer(r.Context(), &platform.Authorization{
+				Status:      platform.Active,
+				Permissions: tt.permissions,
+			}))
+			h.ServeHTTP(httptest.NewRecorder(), r)
+
+			if !reflect.DeepEqual(recorder, tt.want) {
+				t.Errorf("got usage %v, want %v", recorder, tt.want)
+			}
+		})
+	}
+}
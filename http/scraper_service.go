@@ -15,7 +15,8 @@ import (
 // ScraperHandler represents an HTTP API handler for scraper targets.
 type ScraperHandler struct {
 	*httprouter.Router
-	ScraperStorageService platform.ScraperTargetStoreService
+	ScraperStorageService      platform.ScraperTargetStoreService
+	ScraperTargetStatusService platform.ScraperTargetStatusService
 }
 
 const (
@@ -32,6 +33,7 @@ func NewScraperHandler() *ScraperHandler {
 	h.HandlerFunc("GET", targetPath+"/:id", h.handleGetScraperTarget)
 	h.HandlerFunc("PATCH", targetPath+"/:id", h.handlePatchScraperTarget)
 	h.HandlerFunc("DELETE", targetPath+"/:id", h.handleDeleteScraperTarget)
+	h.HandlerFunc("GET", targetPath+"/:id/status", h.handleGetScraperTargetStatus)
 	return h
 }
 
@@ -115,6 +117,32 @@ func (h *ScraperHandler) handleGetScraperTarget(w http.ResponseWriter, r *http.R
 	}
 }
 
+// handleGetScraperTargetStatus is the HTTP handler for the GET /api/v2/scrapertargets/:id/status route.
+func (h *ScraperHandler) handleGetScraperTargetStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := decodeScraperTargetIDRequest(ctx, r)
+	if err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+	if _, err := h.ScraperStorageService.GetTargetByID(ctx, *id); err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	status, err := h.ScraperTargetStatusService.GetTargetStatus(ctx, *id)
+	if err != nil && platform.ErrorCode(err) != platform.ENotFound {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	if err := encodeResponse(ctx, w, http.StatusOK, newTargetStatusResponse(*id, status)); err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+}
+
 // handleGetScraperTargets is the HTTP handler for the GET /api/v2/scrapertargets route.
 func (h *ScraperHandler) handleGetScraperTargets(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -142,6 +170,11 @@ func decodeScraperTargetUpdateRequest(ctx context.Context, r *http.Request) (
 		return nil, err
 	}
 	update.ID = *id
+	if update.Type != "" {
+		if err := update.Validate(); err != nil {
+			return nil, err
+		}
+	}
 	return update, nil
 }
 
@@ -150,6 +183,9 @@ func decodeScraperTargetAddRequest(ctx context.Context, r *http.Request) (*platf
 	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
 		return nil, err
 	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
 	return req, nil
 }
 
@@ -355,6 +391,42 @@ func (s *ScraperService) GetTargetByID(ctx context.Context, id platform.ID) (*pl
 	return &targetResp.ScraperTarget, nil
 }
 
+// GetTargetStatus returns the status of a single target by ID.
+func (s *ScraperService) GetTargetStatus(ctx context.Context, id platform.ID) (*platform.ScraperTargetStatus, error) {
+	url, err := newURL(s.Addr, targetIDStatusPath(id))
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", url.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	SetToken(s.Token, req)
+
+	hc := newClient(url.Scheme, s.InsecureSkipVerify)
+	resp, err := hc.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := CheckError(resp, true); err != nil {
+		return nil, err
+	}
+
+	var statusResp targetStatusResponse
+	if err := json.NewDecoder(resp.Body).Decode(&statusResp); err != nil {
+		return nil, err
+	}
+
+	return &statusResp.ScraperTargetStatus, nil
+}
+
+func targetIDStatusPath(id platform.ID) string {
+	return path.Join(targetPath, id.String(), "status")
+}
+
 func targetIDPath(id platform.ID) string {
 	return path.Join(targetPath, id.String())
 }
@@ -369,7 +441,8 @@ type getTargetsResponse struct {
 }
 
 type targetLinks struct {
-	Self string `json:"self"`
+	Self   string `json:"self"`
+	Status string `json:"status"`
 }
 
 type targetResponse struct {
@@ -395,8 +468,47 @@ func newListTargetsResponse(targets []platform.ScraperTarget) getTargetsResponse
 func newTargetResponse(target platform.ScraperTarget) targetResponse {
 	return targetResponse{
 		Links: targetLinks{
-			Self: targetIDPath(target.ID),
+			Self:   targetIDPath(target.ID),
+			Status: targetIDStatusPath(target.ID),
 		},
 		ScraperTarget: target,
 	}
 }
+
+// scraper target states reported by the status endpoint.
+const (
+	targetStateUp      = "up"
+	targetStateDown    = "down"
+	targetStateUnknown = "unknown"
+)
+
+type targetStatusLinks struct {
+	Self   string `json:"self"`
+	Target string `json:"target"`
+}
+
+type targetStatusResponse struct {
+	platform.ScraperTargetStatus
+	// State is up or down depending on the most recent scrape, or unknown if the target was never scraped.
+	State string            `json:"state"`
+	Links targetStatusLinks `json:"links"`
+}
+
+func newTargetStatusResponse(id platform.ID, status *platform.ScraperTargetStatus) targetStatusResponse {
+	res := targetStatusResponse{
+		State: targetStateUnknown,
+		Links: targetStatusLinks{
+			Self:   targetIDStatusPath(id),
+			Target: targetIDPath(id),
+		},
+	}
+	res.TargetID = id
+	if status != nil {
+		res.ScraperTargetStatus = *status
+		res.State = targetStateDown
+		if status.Up() {
+			res.State = targetStateUp
+		}
+	}
+	return res
+}
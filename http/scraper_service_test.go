@@ -2,8 +2,13 @@ package http
 
 import (
 	"context"
+	"io/ioutil"
+	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
 
 	"github.com/influxdata/platform"
 	"github.com/influxdata/platform/inmem"
@@ -37,3 +42,136 @@ func initScraperService(f platformtesting.TargetFields, t *testing.T) (platform.
 func TestScraperService(t *testing.T) {
 	platformtesting.ScraperService(initScraperService, t)
 }
+
+func TestScraperHandler_handleGetScraperTargetStatus(t *testing.T) {
+	targetID := platformtesting.MustIDBase16("020f755c3c082000")
+	now := time.Date(2018, 12, 1, 17, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		id      platform.ID
+		results []platform.ScrapeResult
+		status  int
+		body    string
+	}{
+		{
+			name:   "never scraped",
+			id:     targetID,
+			status: http.StatusOK,
+			body: `
+{
+  "targetID": "020f755c3c082000",
+  "lastScrape": "0001-01-01T00:00:00Z",
+  "lastScrapeDuration": 0,
+  "sampleCount": 0,
+  "consecutiveFailures": 0,
+  "state": "unknown",
+  "links": {
+    "self": "/api/v2/scrapertargets/020f755c3c082000/status",
+    "target": "/api/v2/scrapertargets/020f755c3c082000"
+  }
+}`,
+		},
+		{
+			name: "last scrape succeeded",
+			id:   targetID,
+			results: []platform.ScrapeResult{
+				{Time: now, Duration: time.Second, SampleCount: 10},
+			},
+			status: http.StatusOK,
+			body: `
+{
+  "targetID": "020f755c3c082000",
+  "lastScrape": "2018-12-01T17:00:00Z",
+  "lastScrapeDuration": 1000000000,
+  "sampleCount": 10,
+  "consecutiveFailures": 0,
+  "state": "up",
+  "links": {
+    "self": "/api/v2/scrapertargets/020f755c3c082000/status",
+    "target": "/api/v2/scrapertargets/020f755c3c082000"
+  }
+}`,
+		},
+		{
+			name: "last scrape failed",
+			id:   targetID,
+			results: []platform.ScrapeResult{
+				{Time: now, Duration: time.Second, SampleCount: 10},
+				{Time: now.Add(time.Minute), Duration: 2 * time.Second, Err: "connection refused"},
+			},
+			status: http.StatusOK,
+			body: `
+{
+  "targetID": "020f755c3c082000",
+  "lastScrape": "2018-12-01T17:01:00Z",
+  "lastScrapeDuration": 2000000000,
+  "sampleCount": 0,
+  "lastError": "connection refused",
+  "consecutiveFailures": 1,
+  "state": "down",
+  "links": {
+    "self": "/api/v2/scrapertargets/020f755c3c082000/status",
+    "target": "/api/v2/scrapertargets/020f755c3c082000"
+  }
+}`,
+		},
+		{
+			name:   "target not found",
+			id:     platformtesting.MustIDBase16("020f755c3c082001"),
+			status: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			svc := inmem.NewService()
+			if err := svc.PutTarget(ctx, &platform.ScraperTarget{
+				ID:         targetID,
+				Name:       "target1",
+				Type:       platform.PrometheusScraperType,
+				URL:        "http://localhost:9090/metrics",
+				OrgName:    "org1",
+				BucketName: "bucket1",
+			}); err != nil {
+				t.Fatal(err)
+			}
+			for _, r := range tt.results {
+				if _, err := svc.RecordScrape(ctx, targetID, r); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			h := NewScraperHandler()
+			h.ScraperStorageService = svc
+			h.ScraperTargetStatusService = svc
+
+			r := httptest.NewRequest("GET", "http://any.url", nil)
+			r = r.WithContext(context.WithValue(
+				context.Background(),
+				httprouter.ParamsKey,
+				httprouter.Params{
+					{
+						Key:   "id",
+						Value: tt.id.String(),
+					},
+				}))
+			w := httptest.NewRecorder()
+
+			h.handleGetScraperTargetStatus(w, r)
+
+			res := w.Result()
+			body, _ := ioutil.ReadAll(res.Body)
+
+			if res.StatusCode != tt.status {
+				t.Errorf("%q. handleGetScraperTargetStatus() = %v, want %v", tt.name, res.StatusCode, tt.status)
+			}
+			if tt.body != "" {
+				if eq, err := jsonEqual(string(body), tt.body); err != nil || !eq {
+					t.Errorf("%q. handleGetScraperTargetStatus() = \n***%v***\n,\nwant\n***%v***", tt.name, string(body), tt.body)
+				}
+			}
+		})
+	}
+}
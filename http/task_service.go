@@ -34,19 +34,20 @@ type TaskHandler struct {
 }
 
 const (
-	tasksPath              = "/api/v2/tasks"
-	tasksIDPath            = "/api/v2/tasks/:tid"
-	tasksIDLogsPath        = "/api/v2/tasks/:tid/logs"
-	tasksIDMembersPath     = "/api/v2/tasks/:tid/members"
-	tasksIDMembersIDPath   = "/api/v2/tasks/:tid/members/:userID"
-	tasksIDOwnersPath      = "/api/v2/tasks/:tid/owners"
-	tasksIDOwnersIDPath    = "/api/v2/tasks/:tid/owners/:userID"
-	tasksIDRunsPath        = "/api/v2/tasks/:tid/runs"
-	tasksIDRunsIDPath      = "/api/v2/tasks/:tid/runs/:rid"
-	tasksIDRunsIDLogsPath  = "/api/v2/tasks/:tid/runs/:rid/logs"
-	tasksIDRunsIDRetryPath = "/api/v2/tasks/:tid/runs/:rid/retry"
-	tasksIDLabelsPath      = "/api/v2/tasks/:tid/labels"
-	tasksIDLabelsNamePath  = "/api/v2/tasks/:tid/labels/:name"
+	tasksPath               = "/api/v2/tasks"
+	tasksIDPath             = "/api/v2/tasks/:tid"
+	tasksIDLogsPath         = "/api/v2/tasks/:tid/logs"
+	tasksIDDependenciesPath = "/api/v2/tasks/:tid/dependencies"
+	tasksIDMembersPath      = "/api/v2/tasks/:tid/members"
+	tasksIDMembersIDPath    = "/api/v2/tasks/:tid/members/:userID"
+	tasksIDOwnersPath       = "/api/v2/tasks/:tid/owners"
+	tasksIDOwnersIDPath     = "/api/v2/tasks/:tid/owners/:userID"
+	tasksIDRunsPath         = "/api/v2/tasks/:tid/runs"
+	tasksIDRunsIDPath       = "/api/v2/tasks/:tid/runs/:rid"
+	tasksIDRunsIDLogsPath   = "/api/v2/tasks/:tid/runs/:rid/logs"
+	tasksIDRunsIDRetryPath  = "/api/v2/tasks/:tid/runs/:rid/retry"
+	tasksIDLabelsPath       = "/api/v2/tasks/:tid/labels"
+	tasksIDLabelsNamePath   = "/api/v2/tasks/:tid/labels/:name"
 )
 
 // NewTaskHandler returns a new instance of TaskHandler.
@@ -66,6 +67,8 @@ func NewTaskHandler(mappingService platform.UserResourceMappingService, labelSer
 	h.HandlerFunc("PATCH", tasksIDPath, h.handleUpdateTask)
 	h.HandlerFunc("DELETE", tasksIDPath, h.handleDeleteTask)
 
+	h.HandlerFunc("GET", tasksIDDependenciesPath, h.handleGetDependencies)
+
 	h.HandlerFunc("GET", tasksIDLogsPath, h.handleGetLogs)
 	h.HandlerFunc("GET", tasksIDRunsIDLogsPath, h.handleGetLogs)
 
@@ -357,6 +360,42 @@ func decodeGetTaskRequest(ctx context.Context, r *http.Request) (*getTaskRequest
 	return req, nil
 }
 
+type dependenciesResponse struct {
+	Links map[string]string `json:"links"`
+	platform.TaskDependencies
+}
+
+func newDependenciesResponse(d platform.TaskDependencies) dependenciesResponse {
+	return dependenciesResponse{
+		Links: map[string]string{
+			"self": fmt.Sprintf("/api/v2/tasks/%s/dependencies", d.TaskID),
+			"task": fmt.Sprintf("/api/v2/tasks/%s", d.TaskID),
+		},
+		TaskDependencies: d,
+	}
+}
+
+func (h *TaskHandler) handleGetDependencies(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	req, err := decodeGetTaskRequest(ctx, r)
+	if err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	deps, err := h.TaskService.FindTaskDependencies(ctx, req.TaskID)
+	if err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+
+	if err := encodeResponse(ctx, w, http.StatusOK, newDependenciesResponse(*deps)); err != nil {
+		EncodeError(ctx, err, w)
+		return
+	}
+}
+
 func (h *TaskHandler) handleUpdateTask(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -1148,6 +1187,43 @@ func (t TaskService) RetryRun(ctx context.Context, taskID, runID platform.ID) (*
 	return &rs.Run, nil
 }
 
+// FindTaskDependencies returns the dependency graph reachable from a task.
+func (t TaskService) FindTaskDependencies(ctx context.Context, id platform.ID) (*platform.TaskDependencies, error) {
+	u, err := newURL(t.Addr, path.Join(taskIDPath(id), "dependencies"))
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	SetToken(t.Token, req)
+
+	hc := newClient(u.Scheme, t.InsecureSkipVerify)
+	resp, err := hc.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := CheckError(resp); err != nil {
+		if err.Error() == backend.ErrTaskNotFound.Error() {
+			// ErrTaskNotFound is expected as part of the FindTaskDependencies contract,
+			// so return that actual error instead of a different error that looks like it.
+			return nil, backend.ErrTaskNotFound
+		}
+		return nil, err
+	}
+
+	var dr dependenciesResponse
+	if err := json.NewDecoder(resp.Body).Decode(&dr); err != nil {
+		return nil, err
+	}
+
+	return &dr.TaskDependencies, nil
+}
+
 func cancelPath(taskID, runID platform.ID) string {
 	return path.Join(taskID.String(), runID.String())
 }
@@ -444,3 +444,103 @@ func TestTaskHandler_handleGetRuns(t *testing.T) {
 		})
 	}
 }
+
+func TestTaskHandler_handleGetDependencies(t *testing.T) {
+	type fields struct {
+		taskService platform.TaskService
+	}
+	type args struct {
+		taskID platform.ID
+	}
+	type wants struct {
+		statusCode  int
+		contentType string
+		body        string
+	}
+
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		wants  wants
+	}{
+		{
+			name: "get dependencies of a task",
+			fields: fields{
+				taskService: &mock.TaskService{
+					FindTaskDependenciesFn: func(ctx context.Context, id platform.ID) (*platform.TaskDependencies, error) {
+						return &platform.TaskDependencies{
+							TaskID:     id,
+							DependsOn:  []platform.ID{1},
+							Dependents: []platform.ID{3},
+							Edges: []platform.TaskDependency{
+								{Upstream: 1, Downstream: id},
+								{Upstream: id, Downstream: 3},
+							},
+						}, nil
+					},
+				},
+			},
+			args: args{
+				taskID: 2,
+			},
+			wants: wants{
+				statusCode:  http.StatusOK,
+				contentType: "application/json; charset=utf-8",
+				body: `
+{
+  "links": {
+    "self": "/api/v2/tasks/0000000000000002/dependencies",
+    "task": "/api/v2/tasks/0000000000000002"
+  },
+  "taskID": "0000000000000002",
+  "dependsOn": ["0000000000000001"],
+  "dependents": ["0000000000000003"],
+  "edges": [
+    {
+      "upstream": "0000000000000001",
+      "downstream": "0000000000000002"
+    },
+    {
+      "upstream": "0000000000000002",
+      "downstream": "0000000000000003"
+    }
+  ]
+}`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "http://any.url", nil)
+			r = r.WithContext(context.WithValue(
+				context.TODO(),
+				httprouter.ParamsKey,
+				httprouter.Params{
+					{
+						Key:   "tid",
+						Value: tt.args.taskID.String(),
+					},
+				}))
+			w := httptest.NewRecorder()
+			h := NewTaskHandler(mock.NewUserResourceMappingService(), mock.NewLabelService(), logger.New(os.Stdout))
+			h.TaskService = tt.fields.taskService
+			h.handleGetDependencies(w, r)
+
+			res := w.Result()
+			content := res.Header.Get("Content-Type")
+			body, _ := ioutil.ReadAll(res.Body)
+
+			if res.StatusCode != tt.wants.statusCode {
+				t.Errorf("%q. handleGetDependencies() = %v, want %v", tt.name, res.StatusCode, tt.wants.statusCode)
+			}
+			if tt.wants.contentType != "" && content != tt.wants.contentType {
+				t.Errorf("%q. handleGetDependencies() = %v, want %v", tt.name, content, tt.wants.contentType)
+			}
+			if eq, _ := jsonEqual(string(body), tt.wants.body); tt.wants.body != "" && !eq {
+				t.Errorf("%q. handleGetDependencies() = \n***%v***\n,\nwant\n***%v***", tt.name, string(body), tt.wants.body)
+			}
+		})
+	}
+}
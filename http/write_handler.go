@@ -1,12 +1,14 @@
 package http
 
 import (
+	"bufio"
+	"bytes"
 	"compress/gzip"
 	"context"
 	"fmt"
 	"io"
-	"io/ioutil"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/influxdata/platform"
@@ -29,6 +31,16 @@ type WriteHandler struct {
 	OrganizationService platform.OrganizationService
 
 	PointsWriter storage.PointsWriter
+
+	// UsageRecorder, if set, counts the requests, bytes and values written
+	// to each bucket.
+	UsageRecorder platform.UsageRecorder
+
+	// MaxBodySize is the largest request body accepted, in bytes. Zero means no limit.
+	MaxBodySize int64
+	// MaxBatchSize is the number of points written to storage at a time.
+	// DefaultWriteBatchSize is used if it is not set.
+	MaxBatchSize int
 }
 
 const (
@@ -51,15 +63,33 @@ func (h *WriteHandler) handleWrite(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	defer r.Body.Close()
 
-	in := r.Body
+	if h.MaxBodySize > 0 && r.ContentLength > h.MaxBodySize {
+		EncodeError(ctx, errors.Error{
+			Reference: errors.InvalidData,
+			Code:      http.StatusRequestEntityTooLarge,
+			Err:       fmt.Sprintf("request body exceeds the maximum size of %d bytes", h.MaxBodySize),
+		}, w)
+		return
+	}
+
+	body := &countingReadCloser{ReadCloser: r.Body}
+	var in io.Reader = body
+	if h.MaxBodySize > 0 {
+		in = &limitedReader{r: body, n: h.MaxBodySize, max: h.MaxBodySize}
+	}
 	if r.Header.Get("Content-Encoding") == "gzip" {
-		var err error
-		in, err = gzip.NewReader(r.Body)
+		zr, err := gzip.NewReader(in)
 		if err != nil {
 			EncodeError(ctx, errors.Wrap(err, "invalid gzip", errors.InvalidData), w)
 			return
 		}
-		defer in.Close()
+		defer zr.Close()
+		in = zr
+		// The limit also applies to the decompressed body, so that a small
+		// compressed body cannot expand without bound.
+		if h.MaxBodySize > 0 {
+			in = &limitedReader{r: zr, n: h.MaxBodySize, max: h.MaxBodySize}
+		}
 	}
 
 	a, err := pcontext.GetAuthorizer(ctx)
@@ -127,7 +157,7 @@ func (h *WriteHandler) handleWrite(w http.ResponseWriter, r *http.Request) {
 		bucket = b
 	}
 
-	if !a.Allowed(platform.WriteBucketPermission(bucket.ID)) {
+	if !a.Allowed(platform.NewPermissionAtID(bucket.ID, platform.WriteAction, platform.BucketResourceType, bucket.OrganizationID)) {
 		EncodeError(ctx, errors.Forbiddenf("insufficient permissions for write"), w)
 		return
 	}
@@ -135,33 +165,279 @@ func (h *WriteHandler) handleWrite(w http.ResponseWriter, r *http.Request) {
 	// TODO(jeff): we should be publishing with the org and bucket instead of
 	// parsing, rewriting, and publishing, but the interface isn't quite there yet.
 	// be sure to remove this when it is there!
-	data, err := ioutil.ReadAll(in)
+	res, err := h.writeLines(in, org.ID, bucket.ID, req.Precision)
+	h.recordUsage(ctx, org.ID, bucket.ID, body.n, res.values)
 	if err != nil {
-		logger.Info("Error reading body", zap.Error(err))
-		EncodeError(ctx, err, w)
-		return
+		logger.Info("Error writing points", zap.Error(err), zap.Int("accepted", res.Accepted))
+		if res.Accepted == 0 && res.Rejected == 0 {
+			if _, ok := err.(tooLargeError); ok {
+				EncodeError(ctx, errors.Error{
+					Reference: errors.InvalidData,
+					Code:      http.StatusRequestEntityTooLarge,
+					Err:       err.Error(),
+				}, w)
+				return
+			}
+			EncodeError(ctx, errors.BadRequestError(err.Error()), w)
+			return
+		}
+		res.Err = err.Error()
 	}
 
-	points, err := models.ParsePointsWithPrecision(data, time.Now(), req.Precision)
-	if err != nil {
-		logger.Info("Error parsing points", zap.Error(err))
-		EncodeError(ctx, err, w)
+	if res.Rejected == 0 && res.Err == "" {
+		w.WriteHeader(http.StatusNoContent)
 		return
 	}
 
-	exploded, err := tsdb.ExplodePoints(org.ID, bucket.ID, points)
-	if err != nil {
-		logger.Info("Error exploding points", zap.Error(err))
-		EncodeError(ctx, err, w)
-		return
+	res.Code = platform.EInvalid
+	res.Message = res.message()
+	w.Header().Set(PlatformErrorCodeHeader, res.Code)
+	w.Header().Set(ErrorHeader, res.Message)
+	w.Header().Set(ReferenceHeader, strconv.Itoa(errors.MalformedData))
+	if err := encodeResponse(ctx, w, http.StatusBadRequest, res); err != nil {
+		logger.Info("Failed to encode response", zap.Error(err))
 	}
+}
 
-	if err := h.PointsWriter.WritePoints(exploded); err != nil {
-		EncodeError(ctx, errors.BadRequestError(err.Error()), w)
+// recordUsage counts a write request of n bytes and values values to the bucket.
+func (h *WriteHandler) recordUsage(ctx context.Context, orgID, bucketID platform.ID, n int64, values int) {
+	if h.UsageRecorder == nil {
 		return
 	}
 
-	w.WriteHeader(http.StatusNoContent)
+	usages := []platform.Usage{
+		{Type: platform.UsageWriteRequestCount, Value: 1},
+		{Type: platform.UsageWriteRequestBytes, Value: float64(n)},
+		{Type: platform.UsageValues, Value: float64(values)},
+	}
+	for i := range usages {
+		usages[i].OrganizationID = &orgID
+		usages[i].BucketID = &bucketID
+	}
+	if err := h.UsageRecorder.RecordUsage(ctx, time.Now(), usages...); err != nil {
+		h.Logger.Info("Failed to record write usage", zap.Error(err))
+	}
+}
+
+// countingReadCloser counts the bytes read from a request body.
+type countingReadCloser struct {
+	io.ReadCloser
+	n int64
+}
+
+func (r *countingReadCloser) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	r.n += int64(n)
+	return n, err
+}
+
+// limitedReader reads at most max bytes from r and fails with a tooLargeError
+// if there are more. Unlike http.MaxBytesReader, the error can be told apart
+// from the others of a read.
+type limitedReader struct {
+	r   io.Reader
+	n   int64 // bytes left to read
+	max int64
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	if l.n < 0 {
+		return 0, l.err()
+	}
+	// Read one byte past the limit to tell whether there are more.
+	if int64(len(p)) > l.n+1 {
+		p = p[:l.n+1]
+	}
+	n, err := l.r.Read(p)
+	if int64(n) <= l.n {
+		l.n -= int64(n)
+		return n, err
+	}
+	n, l.n = int(l.n), -1
+	return n, l.err()
+}
+
+func (l *limitedReader) err() error {
+	return tooLargeError{fmt.Errorf("request body exceeds the maximum size of %d bytes", l.max)}
+}
+
+// writeLines parses the line protocol in r and writes it to the bucket in batches
+// of at most MaxBatchSize points, so that memory use does not grow with the size of
+// the request. Lines that fail to parse or are dropped by storage are recorded in
+// the response and the remaining lines are still written. A non-nil error means
+// the write stopped early; the response reports what happened up to that point.
+func (h *WriteHandler) writeLines(r io.Reader, orgID, bucketID platform.ID, precision string) (*writeResponse, error) {
+	batchSize := h.MaxBatchSize
+	if batchSize <= 0 {
+		batchSize = DefaultWriteBatchSize
+	}
+
+	res := &writeResponse{}
+	b := &writeBatch{}
+	now := time.Now()
+
+	s := bufio.NewScanner(r)
+	s.Buffer(nil, maxWriteLineSize)
+	s.Split(models.ScanLines)
+	if l, ok := r.(*limitedReader); ok {
+		// A line cut off by the size limit is not parsed; only the complete
+		// lines before it are, and the write stops with the limit's error.
+		s.Split(func(data []byte, atEOF bool) (int, []byte, error) {
+			return models.ScanLines(data, atEOF && l.n >= 0)
+		})
+	}
+
+	n := 1
+	for ; s.Scan(); n += 1 + bytes.Count(s.Bytes(), []byte{'\n'}) {
+		points, err := models.ParsePointsWithPrecision(s.Bytes(), now, precision)
+		if err != nil {
+			res.reject(n, err.Error())
+			continue
+		}
+		if len(points) == 0 {
+			// blank line or comment
+			continue
+		}
+
+		exploded, err := tsdb.ExplodePoints(orgID, bucketID, points)
+		if err != nil {
+			res.reject(n, err.Error())
+			continue
+		}
+		b.add(n, exploded)
+
+		if len(b.points) >= batchSize {
+			if err := b.write(h.PointsWriter, res); err != nil {
+				return res, err
+			}
+		}
+	}
+
+	if err := s.Err(); err != nil {
+		if _, ok := err.(tooLargeError); ok {
+			return res, err
+		}
+		if err == bufio.ErrTooLong {
+			return res, fmt.Errorf("line %d exceeds the maximum line size of %d bytes", n, maxWriteLineSize)
+		}
+		return res, err
+	}
+
+	return res, b.write(h.PointsWriter, res)
+}
+
+// writeBatch is a batch of exploded points with the line each one was parsed from.
+type writeBatch struct {
+	points []models.Point
+	lines  []int
+}
+
+func (b *writeBatch) add(line int, points []models.Point) {
+	b.points = append(b.points, points...)
+	for range points {
+		b.lines = append(b.lines, line)
+	}
+}
+
+// write writes the batch, records the outcome of each of its lines in res and
+// resets the batch. Series dropped by storage reject the lines they came from.
+func (b *writeBatch) write(w storage.PointsWriter, res *writeResponse) error {
+	if len(b.points) == 0 {
+		return nil
+	}
+	defer func() {
+		b.points, b.lines = b.points[:0], b.lines[:0]
+	}()
+
+	err := w.WritePoints(b.points)
+	pwe, ok := err.(tsdb.PartialWriteError)
+	if err != nil && !ok {
+		return err
+	}
+
+	rejected := make(map[int]struct{})
+	if ok {
+		dropped := make(map[string]struct{}, len(pwe.DroppedKeys))
+		for _, k := range pwe.DroppedKeys {
+			dropped[string(k)] = struct{}{}
+		}
+		for i, pt := range b.points {
+			line := b.lines[i]
+			if _, ok := rejected[line]; ok {
+				continue
+			}
+			if _, ok := dropped[string(pt.Key())]; ok {
+				rejected[line] = struct{}{}
+				res.reject(line, pwe.DroppedReason(pt.Key()))
+			}
+		}
+	}
+
+	// every point of a line is adjacent, so count each line once.
+	for i, line := range b.lines {
+		if _, ok := rejected[line]; ok {
+			continue
+		}
+		res.values++
+		if i > 0 && b.lines[i-1] == line {
+			continue
+		}
+		res.Accepted++
+	}
+	return nil
+}
+
+const (
+	// DefaultWriteBatchSize is the number of points written to storage at a time
+	// when the handler does not set MaxBatchSize.
+	DefaultWriteBatchSize = 5000
+
+	// maxWriteLineSize is the longest line of line protocol that will be accepted.
+	maxWriteLineSize = 16 * 1024 * 1024
+
+	// maxRejectedLines limits the number of rejected lines listed in a response.
+	maxRejectedLines = 1000
+)
+
+// tooLargeError is returned when the request body exceeds MaxBodySize.
+type tooLargeError struct {
+	error
+}
+
+// writeResponse reports the lines of a write that were not written.
+type writeResponse struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	// Err is set if the write stopped before the end of the request body.
+	Err string `json:"err,omitempty"`
+
+	Accepted int `json:"accepted"`
+	Rejected int `json:"rejected"`
+	// Lines lists the first rejected lines. Line numbers start at 1.
+	Lines []rejectedLine `json:"lines"`
+
+	// values is the number of field values written.
+	values int
+}
+
+// rejectedLine is a line of line protocol that was not written.
+type rejectedLine struct {
+	Line   int    `json:"line"`
+	Reason string `json:"reason"`
+}
+
+func (r *writeResponse) reject(line int, reason string) {
+	r.Rejected++
+	if len(r.Lines) < maxRejectedLines {
+		r.Lines = append(r.Lines, rejectedLine{Line: line, Reason: reason})
+	}
+}
+
+func (r *writeResponse) message() string {
+	if r.Err != "" {
+		return fmt.Sprintf("partial write: %d lines accepted, %d rejected, stopped early: %s", r.Accepted, r.Rejected, r.Err)
+	}
+	return fmt.Sprintf("partial write: %d lines accepted, %d rejected", r.Accepted, r.Rejected)
 }
 
 func decodeWriteRequest(ctx context.Context, r *http.Request) (*postWriteRequest, error) {
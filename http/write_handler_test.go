@@ -1,16 +1,24 @@
 package http
 
 import (
+	"bytes"
 	"compress/gzip"
 	"context"
+	"encoding/json"
 	"io"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/influxdata/platform"
+	pcontext "github.com/influxdata/platform/context"
+	"github.com/influxdata/platform/mock"
+	"github.com/influxdata/platform/models"
+	"github.com/influxdata/platform/tsdb"
 )
 
 func TestWriteService_Write(t *testing.T) {
@@ -69,3 +77,263 @@ func TestWriteService_Write(t *testing.T) {
 		})
 	}
 }
+
+func TestWriteHandler_handleWrite(t *testing.T) {
+	orgID := platform.ID(1)
+	bucketID := platform.ID(2)
+
+	orgSvc := &mock.OrganizationService{
+		FindOrganizationByIDF: func(ctx context.Context, id platform.ID) (*platform.Organization, error) {
+			return &platform.Organization{ID: id, Name: "org"}, nil
+		},
+	}
+	bucketSvc := mock.NewBucketService()
+	bucketSvc.FindBucketFn = func(ctx context.Context, filter platform.BucketFilter) (*platform.Bucket, error) {
+		return &platform.Bucket{ID: *filter.ID, OrganizationID: *filter.OrganizationID, Name: "bucket"}, nil
+	}
+
+	// droppedKeys returns the series keys storage would drop for the line protocol.
+	droppedKeys := func(lp string) [][]byte {
+		points, err := models.ParsePointsString(lp)
+		if err != nil {
+			t.Fatal(err)
+		}
+		exploded, err := tsdb.ExplodePoints(orgID, bucketID, points)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var keys [][]byte
+		for _, pt := range exploded {
+			keys = append(keys, pt.Key())
+		}
+		return keys
+	}
+
+	tests := []struct {
+		name        string
+		body        string
+		batchSize   int
+		maxBodySize int64
+		// unknownLength leaves the content length of the request unset.
+		unknownLength bool
+		// gzip compresses the body of the request.
+		gzip     bool
+		writeErr error
+		status   int
+		points   int
+		want     *writeResponse
+	}{
+		{
+			name:   "all lines accepted",
+			body:   "m,t=a f=1 1\nm,t=b f=2 2\n\n# comment\nm,t=c f=3,g=4 3\n",
+			status: http.StatusNoContent,
+			points: 4,
+		},
+		{
+			name:   "unparseable lines are rejected",
+			body:   "m,t=a f=1 1\nm,t=b\nm,t=c f=3 3\nm f=x 4",
+			status: http.StatusBadRequest,
+			points: 2,
+			want: &writeResponse{
+				Accepted: 2,
+				Rejected: 2,
+				Lines: []rejectedLine{
+					{Line: 2, Reason: "unable to parse 'm,t=b': missing fields"},
+					{Line: 4, Reason: "unable to parse 'm f=x 4': invalid boolean"},
+				},
+			},
+		},
+		{
+			name:      "quoted newlines do not end a line",
+			body:      "m s=\"a\nb\" 1\nm,t=b\nm f=1 3",
+			batchSize: 1,
+			status:    http.StatusBadRequest,
+			points:    2,
+			want: &writeResponse{
+				Accepted: 2,
+				Rejected: 1,
+				Lines: []rejectedLine{
+					{Line: 3, Reason: "unable to parse 'm,t=b': missing fields"},
+				},
+			},
+		},
+		{
+			name: "series dropped by storage are rejected",
+			body: "m,t=a f=1 1\nm,t=b f=2 2",
+			writeErr: tsdb.PartialWriteError{
+				Reason:      "dropped",
+				Dropped:     1,
+				DroppedKeys: droppedKeys("m,t=b f=2 2"),
+			},
+			status: http.StatusBadRequest,
+			points: 2,
+			want: &writeResponse{
+				Accepted: 1,
+				Rejected: 1,
+				Lines: []rejectedLine{
+					{Line: 2, Reason: "dropped"},
+				},
+			},
+		},
+		{
+			name: "series dropped by storage are rejected with the reason of their key",
+			body: "m,t=a f=1 1\nm,t=b f=2 2\nm,t=c f=3 3",
+			writeErr: tsdb.PartialWriteError{
+				Reason:      "dropped a",
+				Dropped:     2,
+				DroppedKeys: append(droppedKeys("m,t=a f=1 1"), droppedKeys("m,t=c f=3 3")...),
+				DroppedReasons: map[string]string{
+					string(droppedKeys("m,t=a f=1 1")[0]): "dropped a",
+					string(droppedKeys("m,t=c f=3 3")[0]): "dropped c",
+				},
+			},
+			status: http.StatusBadRequest,
+			points: 3,
+			want: &writeResponse{
+				Accepted: 1,
+				Rejected: 2,
+				Lines: []rejectedLine{
+					{Line: 1, Reason: "dropped a"},
+					{Line: 3, Reason: "dropped c"},
+				},
+			},
+		},
+		{
+			name:        "body too large",
+			body:        "m,t=a f=1 1\nm,t=b f=2 2",
+			maxBodySize: 10,
+			status:      http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:          "body too large without content length",
+			body:          "m,t=a f=1 1\nm,t=b f=2 2",
+			maxBodySize:   10,
+			unknownLength: true,
+			status:        http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:        "compressed body",
+			body:        "m,t=a f=1 1\nm,t=b f=2 2",
+			maxBodySize: 100,
+			gzip:        true,
+			status:      http.StatusNoContent,
+			points:      2,
+		},
+		{
+			name:        "compressed body too large once decompressed",
+			body:        strings.Repeat("m,t=a f=1 1\n", 10000),
+			maxBodySize: 1024,
+			gzip:        true,
+			status:      http.StatusRequestEntityTooLarge,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pw := &mock.PointsWriter{}
+			pw.ForceError(tt.writeErr)
+
+			h := NewWriteHandler(pw)
+			h.OrganizationService = orgSvc
+			h.BucketService = bucketSvc
+			h.MaxBatchSize = tt.batchSize
+			h.MaxBodySize = tt.maxBodySize
+
+			var body io.Reader = strings.NewReader(tt.body)
+			if tt.gzip {
+				var buf bytes.Buffer
+				zw := gzip.NewWriter(&buf)
+				if _, err := zw.Write([]byte(tt.body)); err != nil {
+					t.Fatal(err)
+				}
+				if err := zw.Close(); err != nil {
+					t.Fatal(err)
+				}
+				if int64(buf.Len()) > tt.maxBodySize {
+					t.Fatalf("compressed body of %d bytes exceeds the maximum size", buf.Len())
+				}
+				body = &buf
+			}
+			r := httptest.NewRequest("POST", "/api/v2/write?org=0000000000000001&bucket=0000000000000002&precision=s", body)
+			if tt.gzip {
+				r.Header.Set("Content-Encoding", "gzip")
+			}
+			if tt.unknownLength {
+				r.ContentLength = -1
+			}
+			r = r.WithContext(pcontext.SetAuthorizer(r.Context(), &platform.Authorization{
+				Status:      platform.Active,
+				Permissions: []platform.Permission{platform.WriteBucketPermission(bucketID)},
+			}))
+			w := httptest.NewRecorder()
+
+			h.ServeHTTP(w, r)
+
+			if w.Code != tt.status {
+				t.Fatalf("got status %d, want %d: %s", w.Code, tt.status, w.Body.String())
+			}
+			if got := len(pw.Points); got != tt.points {
+				t.Errorf("got %d points written, want %d", got, tt.points)
+			}
+			if tt.want == nil {
+				return
+			}
+
+			var got writeResponse
+			if err := json.NewDecoder(w.Body).Decode(&got); err != nil {
+				t.Fatal(err)
+			}
+			if got.Code != platform.EInvalid {
+				t.Errorf("got code %q, want %q", got.Code, platform.EInvalid)
+			}
+			if got.Accepted != tt.want.Accepted || got.Rejected != tt.want.Rejected || !reflect.DeepEqual(got.Lines, tt.want.Lines) {
+				t.Errorf("got response %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+// usageRecorder sums the usage recorded for each metric.
+type usageRecorder map[platform.UsageMetric]float64
+
+func (r usageRecorder) RecordUsage(ctx context.Context, t time.Time, usages ...platform.Usage) error {
+	for _, u := range usages {
+		r[u.Type] += u.Value
+	}
+	return nil
+}
+
+func TestWriteHandler_handleWrite_usage(t *testing.T) {
+	bucketID := platform.ID(2)
+
+	h := NewWriteHandler(&mock.PointsWriter{})
+	h.OrganizationService = &mock.OrganizationService{
+		FindOrganizationByIDF: func(ctx context.Context, id platform.ID) (*platform.Organization, error) {
+			return &platform.Organization{ID: id, Name: "org"}, nil
+		},
+	}
+	bucketSvc := mock.NewBucketService()
+	bucketSvc.FindBucketFn = func(ctx context.Context, filter platform.BucketFilter) (*platform.Bucket, error) {
+		return &platform.Bucket{ID: *filter.ID, OrganizationID: *filter.OrganizationID, Name: "bucket"}, nil
+	}
+	h.BucketService = bucketSvc
+	recorder := usageRecorder{}
+	h.UsageRecorder = recorder
+
+	body := "m,t=a f=1 1\nm,t=b\nm,t=c f=3,g=4 3\n"
+	r := httptest.NewRequest("POST", "/api/v2/write?org=0000000000000001&bucket=0000000000000002&precision=s", strings.NewReader(body))
+	r = r.WithContext(pcontext.SetAuthorizer(r.Context(), &platform.Authorization{
+		Status:      platform.Active,
+		Permissions: []platform.Permission{platform.WriteBucketPermission(bucketID)},
+	}))
+	h.ServeHTTP(httptest.NewRecorder(), r)
+
+	want := usageRecorder{
+		platform.UsageWriteRequestCount: 1,
+		platform.UsageWriteRequestBytes: float64(len(body)),
+		platform.UsageValues:            3,
+	}
+	if !reflect.DeepEqual(recorder, want) {
+		t.Errorf("got usage %v, want %v", recorder, want)
+	}
+}
@@ -0,0 +1,61 @@
+package inmem
+
+import (
+	"context"
+	"sort"
+
+	"github.com/influxdata/platform"
+)
+
+var _ platform.AuditLogService = (*Service)(nil)
+
+// RecordAuditEvent stores the event and sets its ID.
+func (s *Service) RecordAuditEvent(ctx context.Context, e *platform.AuditEvent) error {
+	s.auditMu.Lock()
+	defer s.auditMu.Unlock()
+
+	e.ID = s.IDGenerator.ID()
+	if e.Time.IsZero() {
+		e.Time = s.time()
+	}
+
+	ev := *e
+	s.auditLog = append(s.auditLog, &ev)
+	sort.SliceStable(s.auditLog, func(i, j int) bool {
+		return s.auditLog[i].Time.Before(s.auditLog[j].Time)
+	})
+	return nil
+}
+
+// FindAuditEvents returns the events that match the filter, ordered by time, and the total count of matching events.
+func (s *Service) FindAuditEvents(ctx context.Context, filter platform.AuditFilter, opt ...platform.FindOptions) ([]*platform.AuditEvent, int, error) {
+	opts := platform.DefaultAuditFindOptions
+	if len(opt) > 0 {
+		opts = opt[0]
+	}
+
+	s.auditMu.RLock()
+	defer s.auditMu.RUnlock()
+
+	matched := []*platform.AuditEvent{}
+	for i := range s.auditLog {
+		e := s.auditLog[i]
+		if opts.Descending {
+			e = s.auditLog[len(s.auditLog)-1-i]
+		}
+		if filter.Matches(e) {
+			ev := *e
+			matched = append(matched, &ev)
+		}
+	}
+
+	es := []*platform.AuditEvent{}
+	if opts.Offset < len(matched) {
+		es = matched[opts.Offset:]
+	}
+	if opts.Limit > 0 && len(es) > opts.Limit {
+		es = es[:opts.Limit]
+	}
+
+	return es, len(matched), nil
+}
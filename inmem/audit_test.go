@@ -0,0 +1,14 @@
+package inmem
+
+import (
+	"testing"
+
+	"github.com/influxdata/platform"
+	platformtesting "github.com/influxdata/platform/testing"
+)
+
+func TestAuditLogService_FindAuditEvents(t *testing.T) {
+	platformtesting.FindAuditEvents(func(t *testing.T) (platform.AuditLogService, string, func()) {
+		return NewService(), OpPrefix, func() {}
+	}, t)
+}
@@ -249,6 +249,17 @@ func (s *Service) UpdateBucket(ctx context.Context, id platform.ID, upd platform
 		b.RetentionPeriod = *upd.RetentionPeriod
 	}
 
+	if upd.ShardGroupDuration != nil {
+		b.ShardGroupDuration = *upd.ShardGroupDuration
+	}
+
+	if upd.Schema != nil {
+		b.Schema = upd.Schema
+		if upd.Schema.Empty() {
+			b.Schema = nil
+		}
+	}
+
 	s.bucketKV.Store(b.ID.String(), b)
 
 	return b, nil
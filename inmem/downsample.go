@@ -0,0 +1,111 @@
+package inmem
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/influxdata/platform"
+)
+
+const (
+	errDownsamplePolicyNotFound = "downsample policy not found"
+)
+
+var _ platform.DownsamplePolicyService = (*Service)(nil)
+
+func (s *Service) loadDownsamplePolicy(id platform.ID) (*platform.DownsamplePolicy, *platform.Error) {
+	i, ok := s.downsamplePolicyKV.Load(id.String())
+	if !ok {
+		return nil, &platform.Error{
+			Code: platform.ENotFound,
+			Msg:  errDownsamplePolicyNotFound,
+		}
+	}
+
+	p, ok := i.(platform.DownsamplePolicy)
+	if !ok {
+		return nil, &platform.Error{
+			Code: platform.EInvalid,
+			Msg:  fmt.Sprintf("type %T is not a downsample policy", i),
+		}
+	}
+	return &p, nil
+}
+
+// FindDownsamplePolicyByID returns a single downsample policy by ID.
+func (s *Service) FindDownsamplePolicyByID(ctx context.Context, id platform.ID) (*platform.DownsamplePolicy, error) {
+	p, pe := s.loadDownsamplePolicy(id)
+	if pe != nil {
+		return nil, &platform.Error{
+			Op:  OpPrefix + platform.OpFindDownsamplePolicyByID,
+			Err: pe,
+		}
+	}
+	return p, nil
+}
+
+// FindDownsamplePolicies returns the downsample policies that match filter.
+func (s *Service) FindDownsamplePolicies(ctx context.Context, filter platform.DownsamplePolicyFilter) ([]*platform.DownsamplePolicy, int, error) {
+	var err error
+	ps := []*platform.DownsamplePolicy{}
+	s.downsamplePolicyKV.Range(func(_, v interface{}) bool {
+		p, ok := v.(platform.DownsamplePolicy)
+		if !ok {
+			err = &platform.Error{
+				Code: platform.EInvalid,
+				Op:   OpPrefix + platform.OpFindDownsamplePolicies,
+				Msg:  fmt.Sprintf("type %T is not a downsample policy", v),
+			}
+			return false
+		}
+		if filter.Matches(&p) {
+			ps = append(ps, &p)
+		}
+		return true
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return ps, len(ps), nil
+}
+
+// CreateDownsamplePolicy creates a new downsample policy and sets p.ID with the new identifier.
+func (s *Service) CreateDownsamplePolicy(ctx context.Context, p *platform.DownsamplePolicy) error {
+	p.ID = s.IDGenerator.ID()
+	return s.PutDownsamplePolicy(ctx, p)
+}
+
+// PutDownsamplePolicy will put a downsample policy without setting an ID.
+func (s *Service) PutDownsamplePolicy(ctx context.Context, p *platform.DownsamplePolicy) error {
+	s.downsamplePolicyKV.Store(p.ID.String(), *p)
+	return nil
+}
+
+// UpdateDownsamplePolicy updates a single downsample policy with changeset.
+func (s *Service) UpdateDownsamplePolicy(ctx context.Context, id platform.ID, upd platform.DownsamplePolicyUpdate) (*platform.DownsamplePolicy, error) {
+	p, pe := s.loadDownsamplePolicy(id)
+	if pe != nil {
+		return nil, &platform.Error{
+			Op:  OpPrefix + platform.OpUpdateDownsamplePolicy,
+			Err: pe,
+		}
+	}
+
+	upd.Apply(p)
+	if err := s.PutDownsamplePolicy(ctx, p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// DeleteDownsamplePolicy removes a downsample policy by ID.
+func (s *Service) DeleteDownsamplePolicy(ctx context.Context, id platform.ID) error {
+	if _, pe := s.loadDownsamplePolicy(id); pe != nil {
+		return &platform.Error{
+			Op:  OpPrefix + platform.OpDeleteDownsamplePolicy,
+			Err: pe,
+		}
+	}
+	s.downsamplePolicyKV.Delete(id.String())
+	return nil
+}
@@ -0,0 +1,27 @@
+package inmem
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/platform"
+	platformtesting "github.com/influxdata/platform/testing"
+)
+
+func initDownsamplePolicyService(f platformtesting.DownsamplePolicyFields, t *testing.T) (platform.DownsamplePolicyService, string, func()) {
+	s := NewService()
+	s.IDGenerator = f.IDGenerator
+
+	ctx := context.Background()
+	for _, p := range f.DownsamplePolicies {
+		if err := s.PutDownsamplePolicy(ctx, p); err != nil {
+			t.Fatalf("failed to populate downsample policies")
+		}
+	}
+
+	return s, OpPrefix, func() {}
+}
+
+func TestDownsamplePolicyService(t *testing.T) {
+	platformtesting.DownsamplePolicyService(initDownsamplePolicyService, t)
+}
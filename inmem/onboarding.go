@@ -96,15 +96,7 @@ func (s *Service) Generate(ctx context.Context, req *platform.OnboardingRequest)
 		User:        u.Name,
 		UserID:      u.ID,
 		Description: onboardingTokenDesc,
-		Permissions: []platform.Permission{
-			platform.CreateUserPermission,
-			platform.DeleteUserPermission,
-			{
-				Resource: platform.OrganizationResource,
-				Action:   platform.WriteAction,
-			},
-			platform.WriteBucketPermission(bucket.ID),
-		},
+		Permissions: platform.OperPermissions(),
 	}
 	if err = s.CreateAuthorization(ctx, auth); err != nil {
 		return nil, err
@@ -8,10 +8,12 @@ import (
 )
 
 const (
-	errScraperTargetNotFound = "scraper target is not found"
+	errScraperTargetNotFound       = "scraper target is not found"
+	errScraperTargetStatusNotFound = "scraper target status is not found"
 )
 
 var _ platform.ScraperTargetStoreService = (*Service)(nil)
+var _ platform.ScraperTargetStatusService = (*Service)(nil)
 
 func (s *Service) loadScraperTarget(id platform.ID) (*platform.ScraperTarget, *platform.Error) {
 	i, ok := s.scraperTargetKV.Load(id.String())
@@ -71,6 +73,7 @@ func (s *Service) RemoveTarget(ctx context.Context, id platform.ID) error {
 		}
 	}
 	s.scraperTargetKV.Delete(id.String())
+	s.scraperStatusKV.Delete(id.String())
 	return nil
 }
 
@@ -118,3 +121,49 @@ func (s *Service) PutTarget(ctx context.Context, target *platform.ScraperTarget)
 	s.scraperTargetKV.Store(target.ID.String(), *target)
 	return nil
 }
+
+// GetTargetStatus returns the status of a scraper target.
+func (s *Service) GetTargetStatus(ctx context.Context, id platform.ID) (*platform.ScraperTargetStatus, error) {
+	i, ok := s.scraperStatusKV.Load(id.String())
+	if !ok {
+		return nil, &platform.Error{
+			Code: platform.ENotFound,
+			Op:   OpPrefix + platform.OpGetTargetStatus,
+			Msg:  errScraperTargetStatusNotFound,
+		}
+	}
+
+	st, ok := i.(platform.ScraperTargetStatus)
+	if !ok {
+		return nil, &platform.Error{
+			Code: platform.EInvalid,
+			Op:   OpPrefix + platform.OpGetTargetStatus,
+			Msg:  fmt.Sprintf("type %T is not a scraper target status", i),
+		}
+	}
+	return &st, nil
+}
+
+// RecordScrape updates the status of a scraper target with the result of a scrape.
+func (s *Service) RecordScrape(ctx context.Context, id platform.ID, r platform.ScrapeResult) (*platform.ScraperTargetStatus, error) {
+	if !id.Valid() {
+		return nil, &platform.Error{
+			Code: platform.EInvalid,
+			Op:   OpPrefix + platform.OpRecordScrape,
+			Msg:  "id is invalid",
+		}
+	}
+
+	s.scraperStatusMu.Lock()
+	defer s.scraperStatusMu.Unlock()
+
+	st := platform.ScraperTargetStatus{TargetID: id}
+	if i, ok := s.scraperStatusKV.Load(id.String()); ok {
+		if prev, ok := i.(platform.ScraperTargetStatus); ok {
+			st = prev
+		}
+	}
+	st.Apply(r)
+	s.scraperStatusKV.Store(id.String(), st)
+	return &st, nil
+}
@@ -39,3 +39,9 @@ func TestScraperTargetStoreService_UpdateTarget(t *testing.T) {
 func TestScraperTargetStoreService_GetTargetByID(t *testing.T) {
 	platformtesting.GetTargetByID(initScraperTargetStoreService, t)
 }
+
+func TestScraperTargetStatusService_RecordScrape(t *testing.T) {
+	platformtesting.RecordScrape(func(t *testing.T) (platform.ScraperTargetStatusService, string, func()) {
+		return NewService(), OpPrefix, func() {}
+	}, t)
+}
@@ -25,9 +25,14 @@ type Service struct {
 	userResourceMappingKV sync.Map
 	labelKV               sync.Map
 	scraperTargetKV       sync.Map
+	scraperStatusKV       sync.Map
+	scraperStatusMu       sync.Mutex
 	telegrafConfigKV      sync.Map
 	onboardingKV          sync.Map
 	basicAuthKV           sync.Map
+	downsamplePolicyKV    sync.Map
+	auditLog              []*platform.AuditEvent
+	auditMu               sync.RWMutex
 
 	TokenGenerator platform.TokenGenerator
 	IDGenerator    platform.IDGenerator
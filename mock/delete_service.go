@@ -0,0 +1,19 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/influxdata/platform"
+)
+
+var _ platform.DeleteService = &DeleteService{}
+
+// DeleteService is a mock implementation of platform.DeleteService.
+type DeleteService struct {
+	DeleteBucketRangePredicateF func(context.Context, platform.DeleteFilter) error
+}
+
+// DeleteBucketRangePredicate calls DeleteBucketRangePredicateF.
+func (s *DeleteService) DeleteBucketRangePredicate(ctx context.Context, filter platform.DeleteFilter) error {
+	return s.DeleteBucketRangePredicateF(ctx, filter)
+}
@@ -0,0 +1,43 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/influxdata/platform"
+)
+
+var _ platform.DownsamplePolicyService = &DownsamplePolicyService{}
+
+// DownsamplePolicyService is a mock implementation of platform.DownsamplePolicyService.
+type DownsamplePolicyService struct {
+	FindDownsamplePolicyByIDFn func(context.Context, platform.ID) (*platform.DownsamplePolicy, error)
+	FindDownsamplePoliciesFn   func(context.Context, platform.DownsamplePolicyFilter) ([]*platform.DownsamplePolicy, int, error)
+	CreateDownsamplePolicyFn   func(context.Context, *platform.DownsamplePolicy) error
+	UpdateDownsamplePolicyFn   func(context.Context, platform.ID, platform.DownsamplePolicyUpdate) (*platform.DownsamplePolicy, error)
+	DeleteDownsamplePolicyFn   func(context.Context, platform.ID) error
+}
+
+// FindDownsamplePolicyByID returns a single downsample policy by ID.
+func (s *DownsamplePolicyService) FindDownsamplePolicyByID(ctx context.Context, id platform.ID) (*platform.DownsamplePolicy, error) {
+	return s.FindDownsamplePolicyByIDFn(ctx, id)
+}
+
+// FindDownsamplePolicies returns the downsample policies that match filter.
+func (s *DownsamplePolicyService) FindDownsamplePolicies(ctx context.Context, filter platform.DownsamplePolicyFilter) ([]*platform.DownsamplePolicy, int, error) {
+	return s.FindDownsamplePoliciesFn(ctx, filter)
+}
+
+// CreateDownsamplePolicy creates a new downsample policy.
+func (s *DownsamplePolicyService) CreateDownsamplePolicy(ctx context.Context, p *platform.DownsamplePolicy) error {
+	return s.CreateDownsamplePolicyFn(ctx, p)
+}
+
+// UpdateDownsamplePolicy updates a single downsample policy with changeset.
+func (s *DownsamplePolicyService) UpdateDownsamplePolicy(ctx context.Context, id platform.ID, upd platform.DownsamplePolicyUpdate) (*platform.DownsamplePolicy, error) {
+	return s.UpdateDownsamplePolicyFn(ctx, id, upd)
+}
+
+// DeleteDownsamplePolicy removes a downsample policy by ID.
+func (s *DownsamplePolicyService) DeleteDownsamplePolicy(ctx context.Context, id platform.ID) error {
+	return s.DeleteDownsamplePolicyFn(ctx, id)
+}
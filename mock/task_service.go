@@ -19,6 +19,8 @@ type TaskService struct {
 	FindRunByIDFn  func(context.Context, platform.ID, platform.ID) (*platform.Run, error)
 	CancelRunFn    func(context.Context, platform.ID, platform.ID) error
 	RetryRunFn     func(context.Context, platform.ID, platform.ID) (*platform.Run, error)
+
+	FindTaskDependenciesFn func(context.Context, platform.ID) (*platform.TaskDependencies, error)
 }
 
 func (s *TaskService) FindTaskByID(ctx context.Context, id platform.ID) (*platform.Task, error) {
@@ -61,3 +63,7 @@ func (s *TaskService) CancelRun(ctx context.Context, taskID, runID platform.ID)
 func (s *TaskService) RetryRun(ctx context.Context, taskID, runID platform.ID) (*platform.Run, error) {
 	return s.RetryRunFn(ctx, taskID, runID)
 }
+
+func (s *TaskService) FindTaskDependencies(ctx context.Context, id platform.ID) (*platform.TaskDependencies, error) {
+	return s.FindTaskDependenciesFn(ctx, id)
+}
@@ -1156,6 +1156,27 @@ func scanLine(buf []byte, i int) (int, []byte) {
 	return i, buf[start:i]
 }
 
+// ScanLines is a bufio.SplitFunc that splits line protocol into lines. Unlike
+// bufio.ScanLines, newlines within quoted string field values do not end a line.
+// The returned lines do not include the trailing newline.
+func ScanLines(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	if atEOF && len(data) == 0 {
+		return 0, nil, nil
+	}
+
+	i, line := scanLine(data, 0)
+
+	// A newline in the last byte of data may still turn out to be escaped, so
+	// wait for more data unless there is none.
+	if i < len(data)-1 || (atEOF && i < len(data)) {
+		return i + 1, line, nil
+	}
+	if atEOF {
+		return len(data), data, nil
+	}
+	return 0, nil, nil
+}
+
 // scanTo returns the end position in buf and the next consecutive block
 // of bytes, starting from i and ending with stop byte, where stop byte
 // has not been escaped.
@@ -1,6 +1,7 @@
 package models_test
 
 import (
+	"bufio"
 	"bytes"
 	"fmt"
 	"io"
@@ -10,6 +11,7 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"testing/iotest"
 	"time"
 
 	"github.com/influxdata/platform/models"
@@ -2546,3 +2548,56 @@ func BenchmarkMakeKey(b *testing.B) {
 		})
 	}
 }
+
+func TestScanLines(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want []string
+	}{
+		{
+			name: "simple",
+			in:   "cpu value=1\nmem value=2\n",
+			want: []string{"cpu value=1", "mem value=2"},
+		},
+		{
+			name: "no trailing newline",
+			in:   "cpu value=1\nmem value=2",
+			want: []string{"cpu value=1", "mem value=2"},
+		},
+		{
+			name: "quoted newline",
+			in:   "cpu str=\"a\nb\" 1\nmem value=2",
+			want: []string{"cpu str=\"a\nb\" 1", "mem value=2"},
+		},
+		{
+			name: "escaped newline",
+			in:   "cpu,host=a\\\nb value=1\nmem value=2",
+			want: []string{"cpu,host=a\\\nb value=1", "mem value=2"},
+		},
+		{
+			name: "blank lines",
+			in:   "cpu value=1\n\n\nmem value=2",
+			want: []string{"cpu value=1", "", "", "mem value=2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// read a byte at a time so lines are split across reads.
+			s := bufio.NewScanner(iotest.OneByteReader(strings.NewReader(tt.in)))
+			s.Split(models.ScanLines)
+
+			var got []string
+			for s.Scan() {
+				got = append(got, s.Text())
+			}
+			if err := s.Err(); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
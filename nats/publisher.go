@@ -33,6 +33,14 @@ func (p *AsyncPublisher) Open() error {
 	return nil
 }
 
+// Close closes the connection to the NATS server.
+func (p *AsyncPublisher) Close() error {
+	if p.Connection == nil {
+		return nil
+	}
+	return p.Connection.Close()
+}
+
 func (p *AsyncPublisher) Publish(subject string, r io.Reader) error {
 	if p.Connection == nil {
 		return ErrNoNatsConnection
@@ -52,3 +60,45 @@ func (p *AsyncPublisher) Publish(subject string, r io.Reader) error {
 	_, err = p.Connection.PublishAsync(subject, data, ah)
 	return err
 }
+
+// SyncPublisher publishes messages and waits for the server to persist them.
+type SyncPublisher struct {
+	ClientID   string
+	Connection stan.Conn
+}
+
+func NewSyncPublisher(clientID string) *SyncPublisher {
+	return &SyncPublisher{ClientID: clientID}
+}
+
+// Open creates and maintains a connection to NATS server
+func (p *SyncPublisher) Open() error {
+	sc, err := stan.Connect(ServerName, p.ClientID)
+	if err != nil {
+		return err
+	}
+	p.Connection = sc
+	return nil
+}
+
+// Close closes the connection to the NATS server.
+func (p *SyncPublisher) Close() error {
+	if p.Connection == nil {
+		return nil
+	}
+	return p.Connection.Close()
+}
+
+// Publish returns once the server has acknowledged the message.
+func (p *SyncPublisher) Publish(subject string, r io.Reader) error {
+	if p.Connection == nil {
+		return ErrNoNatsConnection
+	}
+
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+
+	return p.Connection.Publish(subject, data)
+}
@@ -2,6 +2,7 @@ package nats
 
 import (
 	"errors"
+	"time"
 
 	stand "github.com/nats-io/nats-streaming-server/server"
 	"github.com/nats-io/nats-streaming-server/stores"
@@ -9,6 +10,18 @@ import (
 
 const ServerName = "platform"
 
+const (
+	// DefaultMaxAge is how long messages are kept in a channel when the
+	// Config does not set MaxAge. The streaming server does not delete
+	// acknowledged messages, so they are only removed once they are this old.
+	DefaultMaxAge = 24 * time.Hour
+
+	// DefaultMaxBytes is the size of the messages kept in a channel when the
+	// Config does not set MaxBytes. The oldest messages are removed, whether
+	// acknowledged or not, once a channel grows beyond it.
+	DefaultMaxBytes = 1 << 30
+)
+
 var ErrNoNatsConnection = errors.New("nats connection has not been established. Call Open() first")
 
 // Server wraps a connection to a NATS streaming server
@@ -23,6 +36,17 @@ func (s *Server) Open() error {
 	opts.StoreType = stores.TypeFile
 	opts.ID = ServerName
 	opts.FilestoreDir = s.config.FilestoreDir
+
+	// Limit channels by size and age rather than by their number of messages.
+	opts.StoreLimits.MaxMsgs = 0
+	opts.StoreLimits.MaxBytes = s.config.MaxBytes
+	if opts.StoreLimits.MaxBytes <= 0 {
+		opts.StoreLimits.MaxBytes = DefaultMaxBytes
+	}
+	opts.StoreLimits.MaxAge = s.config.MaxAge
+	if opts.StoreLimits.MaxAge <= 0 {
+		opts.StoreLimits.MaxAge = DefaultMaxAge
+	}
 	server, err := stand.RunServerWithOpts(opts, nil)
 	if err != nil {
 		return err
@@ -42,6 +66,12 @@ func (s *Server) Close() {
 type Config struct {
 	// The directory where nats persists message information
 	FilestoreDir string
+
+	// MaxAge is how long messages are kept in a channel.
+	MaxAge time.Duration
+
+	// MaxBytes is the size of the messages kept in a channel.
+	MaxBytes int64
 }
 
 // NewServer creates and returns a new server struct from the provided config
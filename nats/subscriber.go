@@ -9,9 +9,18 @@ type Subscriber interface {
 	Subscribe(subject, group string, handler Handler) error
 }
 
+// DefaultMaxInflight is the number of unacknowledged messages delivered to a
+// subscription when the QueueSubscriber does not set MaxInflight.
+const DefaultMaxInflight = 25
+
 type QueueSubscriber struct {
 	ClientID   string
 	Connection stan.Conn
+
+	// MaxInflight is the number of unacknowledged messages delivered to each
+	// subscription. Subscribers that must process messages in order set it
+	// to 1, so that an unacknowledged message is redelivered before the next.
+	MaxInflight int
 }
 
 func NewQueueSubscriber(clientID string) *QueueSubscriber {
@@ -28,6 +37,14 @@ func (s *QueueSubscriber) Open() error {
 	return nil
 }
 
+// Close closes the connection to the NATS server.
+func (s *QueueSubscriber) Close() error {
+	if s.Connection == nil {
+		return nil
+	}
+	return s.Connection.Close()
+}
+
 type messageHandler struct {
 	handler Handler
 	sub     subscription
@@ -42,8 +59,13 @@ func (s *QueueSubscriber) Subscribe(subject, group string, handler Handler) erro
 		return ErrNoNatsConnection
 	}
 
+	maxInflight := s.MaxInflight
+	if maxInflight <= 0 {
+		maxInflight = DefaultMaxInflight
+	}
+
 	mh := messageHandler{handler: handler}
-	sub, err := s.Connection.QueueSubscribe(subject, group, mh.handle, stan.DurableName(group), stan.SetManualAckMode(), stan.MaxInflight(25))
+	sub, err := s.Connection.QueueSubscribe(subject, group, mh.handle, stan.DurableName(group), stan.SetManualAckMode(), stan.MaxInflight(maxInflight))
 	if err != nil {
 		return err
 	}
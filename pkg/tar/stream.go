@@ -0,0 +1,147 @@
+// Package tar streams directories to tar archives and extracts them.
+package tar
+
+import (
+	"archive/tar"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/influxdata/platform/pkg/file"
+)
+
+// Stream walks over the directory and its sub directories, writing each file to
+// the tar writer. By default StreamFile is used, which will result in all files
+// being written. A custom writeFunc can be passed so that each file may be
+// written, modified and written, or skipped depending on the custom logic.
+//
+// Files are named by their path relative to dir, joined to relativePath.
+func Stream(tw *tar.Writer, dir, relativePath string, writeFunc func(f os.FileInfo, relativePath, fullPath string, tw *tar.Writer) error) error {
+	if writeFunc == nil {
+		writeFunc = StreamFile
+	}
+
+	return filepath.Walk(dir, func(path string, f os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		// Directories are created on extraction from the names of their files.
+		if f.IsDir() {
+			return nil
+		}
+
+		// Figure out the full relative path including any sub-dirs.
+		subDir, _ := filepath.Split(path)
+		subDir, err = filepath.Rel(dir, subDir)
+		if err != nil {
+			return err
+		}
+
+		return writeFunc(f, filepath.Join(relativePath, subDir), path, tw)
+	})
+}
+
+// StreamFile writes a single file to tw, extending the header name using the relativePath.
+func StreamFile(f os.FileInfo, relativePath, fullPath string, tw *tar.Writer) error {
+	return StreamRenameFile(f, f.Name(), relativePath, fullPath, tw)
+}
+
+// StreamRenameFile writes a single file to tw, using tarHeaderFileName instead of the actual filename,
+// e.g. when a *.tmp file must be written using the original file's name.
+func StreamRenameFile(f os.FileInfo, tarHeaderFileName, relativePath, fullPath string, tw *tar.Writer) error {
+	h, err := tar.FileInfoHeader(f, f.Name())
+	if err != nil {
+		return err
+	}
+	h.Name = filepath.ToSlash(filepath.Join(relativePath, tarHeaderFileName))
+
+	if err := tw.WriteHeader(h); err != nil {
+		return err
+	}
+
+	if !f.Mode().IsRegular() {
+		return nil
+	}
+
+	fr, err := os.Open(fullPath)
+	if err != nil {
+		return err
+	}
+	defer fr.Close()
+
+	_, err = io.CopyN(tw, fr, h.Size)
+	return err
+}
+
+// Restore reads a tar archive from r and extracts its files. fn returns the
+// path each file is extracted to from its name in the archive; files for
+// which it returns an empty path are skipped.
+func Restore(r io.Reader, fn func(name string) string) error {
+	tr := tar.NewReader(r)
+	for {
+		if err := extractFile(tr, fn); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+	}
+}
+
+// extractFile copies the next file from tr to the path returned by fn.
+func extractFile(tr *tar.Reader, fn func(name string) string) error {
+	hdr, err := tr.Next()
+	if err != nil {
+		return err
+	}
+
+	if hdr.Typeflag != tar.TypeReg && hdr.Typeflag != tar.TypeRegA {
+		return nil
+	}
+
+	// Names must not escape the directory they are extracted to.
+	name := filepath.Clean(filepath.FromSlash(hdr.Name))
+	if filepath.IsAbs(name) || name == ".." || strings.HasPrefix(name, ".."+string(filepath.Separator)) {
+		return fmt.Errorf("invalid file name in archive: %q", hdr.Name)
+	}
+
+	destPath := fn(name)
+	if destPath == "" {
+		return nil
+	}
+
+	dir := filepath.Dir(destPath)
+	if err := os.MkdirAll(dir, 0777); err != nil {
+		return err
+	}
+
+	tmp := destPath + ".tmp"
+
+	// Create new file on disk.
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_RDWR|os.O_TRUNC, 0666)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	// Copy from archive to the file.
+	if _, err := io.CopyN(f, tr, hdr.Size); err != nil {
+		return err
+	}
+
+	// Sync to disk & close.
+	if err := f.Sync(); err != nil {
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	if err := file.RenameFile(tmp, destPath); err != nil {
+		return err
+	}
+	return file.SyncDir(dir)
+}
@@ -14,6 +14,7 @@ import (
 	_ "github.com/influxdata/platform/query/functions" // Import the built-in functions
 	_ "github.com/influxdata/platform/query/functions/inputs"
 	_ "github.com/influxdata/platform/query/functions/outputs"
+	_ "github.com/influxdata/platform/query/functions/transformations"
 	_ "github.com/influxdata/platform/query/options" // Import the built-in options
 )
 
@@ -3,11 +3,13 @@ package control
 
 import (
 	"context"
+	"errors"
 
 	"github.com/influxdata/flux"
 	"github.com/influxdata/flux/control"
 	"github.com/influxdata/platform"
 	"github.com/influxdata/platform/query"
+	"github.com/influxdata/platform/query/functions"
 	"github.com/prometheus/client_golang/prometheus"
 )
 
@@ -17,6 +19,11 @@ const orgLabel = "org"
 // Controller implements AsyncQueryService by consuming a control.Controller.
 type Controller struct {
 	c *control.Controller
+
+	// SecretService resolves the secrets requested by Flux queries with the
+	// secrets of the query's organization, and redacts them from the errors
+	// of its queries. Without it, queries requesting secrets fail.
+	SecretService platform.SecretService
 }
 
 // NewController creates a new Controller specific to platform.
@@ -28,6 +35,12 @@ func New(config control.Config) *Controller {
 
 // Query satisfies the AsyncQueryService while ensuring the request is propagated on the context.
 func (c *Controller) Query(ctx context.Context, req *query.Request) (flux.Query, error) {
+	if c.SecretService != nil {
+		r := *req
+		r.Compiler = functions.WithSecrets(req.Compiler, c.SecretService)
+		req = &r
+	}
+
 	// Set the request on the context so platform specific Flux operations can retrieve it later.
 	ctx = query.ContextWithRequest(ctx, req)
 	// Set the org label value for controller metrics
@@ -38,13 +51,40 @@ func (c *Controller) Query(ctx context.Context, req *query.Request) (flux.Query,
 		// or other problem that the client must fix.
 		return q, &platform.Error{
 			Code: platform.EInvalid,
-			Msg:  err.Error(),
+			Msg:  c.redact(ctx, req.OrganizationID, err.Error()),
 		}
 	}
 
+	if c.SecretService != nil {
+		q = &redactingQuery{Query: q, ctx: ctx, c: c, orgID: req.OrganizationID}
+	}
 	return q, nil
 }
 
+// redact removes the values of the secrets of the organization from s.
+func (c *Controller) redact(ctx context.Context, orgID platform.ID, s string) string {
+	if c.SecretService == nil {
+		return s
+	}
+	return platform.RedactSecrets(ctx, c.SecretService, orgID, s)
+}
+
+// redactingQuery redacts the secrets of its organization from the error of a query.
+type redactingQuery struct {
+	flux.Query
+	ctx   context.Context
+	c     *Controller
+	orgID platform.ID
+}
+
+func (q *redactingQuery) Err() error {
+	err := q.Query.Err()
+	if err == nil {
+		return nil
+	}
+	return errors.New(q.c.redact(q.ctx, q.orgID, err.Error()))
+}
+
 // PrometheusCollectors satisifies the prom.PrometheusCollector interface.
 func (c *Controller) PrometheusCollectors() []prometheus.Collector {
 	return c.c.PrometheusCollectors()
@@ -0,0 +1,176 @@
+package functions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/interpreter"
+	"github.com/influxdata/flux/lang"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+	"github.com/influxdata/platform"
+	platcontext "github.com/influxdata/platform/context"
+	"github.com/influxdata/platform/query"
+)
+
+const (
+	// SecretsValue is the name of the Flux object holding the secret functions.
+	SecretsValue = "secrets"
+
+	secretKeyArg = "key"
+	nowOption    = "now"
+)
+
+func init() {
+	// Scripts compiled without a SecretLookup still type check, but fail when
+	// a secret is requested.
+	flux.RegisterBuiltInValue(SecretsValue, newSecrets(func(key string) (string, error) {
+		return "", errors.New("secrets are only available to queries of an organization")
+	}))
+}
+
+// SecretLookup returns the value of the secret with the given key.
+type SecretLookup func(key string) (string, error)
+
+// OrganizationSecrets returns a SecretLookup of the secrets of an organization.
+func OrganizationSecrets(ctx context.Context, s platform.SecretService, orgID platform.ID) SecretLookup {
+	return func(key string) (string, error) {
+		v, err := s.LoadSecret(ctx, orgID, key)
+		if err != nil {
+			return "", fmt.Errorf("unable to load secret %q: %v", key, err)
+		}
+		return v, nil
+	}
+}
+
+// AuthorizedSecrets returns a SecretLookup of the secrets of an organization
+// that fails unless a is allowed to read them.
+func AuthorizedSecrets(ctx context.Context, s platform.SecretService, a platform.Authorizer, orgID platform.ID) SecretLookup {
+	lookup := OrganizationSecrets(ctx, s, orgID)
+	return func(key string) (string, error) {
+		p := platform.NewPermission(platform.ReadAction, platform.SecretResourceType, orgID)
+		if a == nil || !a.Allowed(p) {
+			return "", &platform.Error{
+				Code: platform.EForbidden,
+				Msg:  fmt.Sprintf("unable to load secret %q: unauthorized to %s", key, p),
+			}
+		}
+		return lookup(key)
+	}
+}
+
+// IgnoreSecrets is a SecretLookup that returns an empty value for every key.
+// It is used to compile scripts whose secrets are not needed, such as when
+// validating them.
+func IgnoreSecrets(key string) (string, error) {
+	return "", nil
+}
+
+// Compile evaluates a Flux script like flux.Compile, resolving calls to
+// secrets.get with lookup.
+func Compile(ctx context.Context, q string, now time.Time, lookup SecretLookup) (*flux.Spec, error) {
+	itrp := flux.NewInterpreter()
+	itrp.SetOption(nowOption, values.NewFunction(
+		nowOption,
+		semantic.NewFunctionType(semantic.FunctionSignature{Return: semantic.Time}),
+		func(values.Object) (values.Value, error) {
+			return values.NewTime(values.ConvertTime(now)), nil
+		},
+		false,
+	))
+	itrp.SetVar(SecretsValue, newSecrets(lookup))
+
+	if err := flux.Eval(itrp, q); err != nil {
+		return nil, err
+	}
+	return flux.ToSpec(itrp, itrp.SideEffects()...), nil
+}
+
+// newSecrets returns the Flux object of the secret functions.
+func newSecrets(lookup SecretLookup) values.Object {
+	get := values.NewFunction(
+		"get",
+		semantic.NewFunctionType(semantic.FunctionSignature{
+			Parameters: map[string]semantic.Type{secretKeyArg: semantic.String},
+			Required:   []string{secretKeyArg},
+			Return:     semantic.String,
+		}),
+		func(args values.Object) (values.Value, error) {
+			return interpreter.DoFunctionCall(func(args interpreter.Arguments) (values.Value, error) {
+				key, err := args.GetRequiredString(secretKeyArg)
+				if err != nil {
+					return nil, err
+				}
+				v, err := lookup(key)
+				if err != nil {
+					return nil, err
+				}
+				return values.NewString(v), nil
+			}, args)
+		},
+		false,
+	)
+	return values.NewObjectWithValues(map[string]values.Value{"get": get})
+}
+
+// FluxCompiler compiles a Flux script, resolving its secrets with the secrets
+// of the organization of the query request on the context. Secrets are only
+// resolved if the authorization of the request, or else the authorizer on the
+// context, is allowed to read them.
+type FluxCompiler struct {
+	Query string `json:"query"`
+
+	// Now is the time the script is compiled at; it defaults to the current time.
+	Now time.Time `json:"now,omitempty"`
+
+	SecretService platform.SecretService `json:"-"`
+}
+
+// Compile compiles the script.
+func (c FluxCompiler) Compile(ctx context.Context) (*flux.Spec, error) {
+	req := query.RequestFromContext(ctx)
+	if req == nil {
+		return nil, errors.New("missing request on context")
+	}
+	if c.SecretService == nil {
+		return nil, errors.New("missing secret service")
+	}
+
+	now := c.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+	var a platform.Authorizer
+	if req.Authorization != nil {
+		a = req.Authorization
+	} else if ca, err := platcontext.GetAuthorizer(ctx); err == nil {
+		a = ca
+	}
+	return Compile(ctx, c.Query, now, AuthorizedSecrets(ctx, c.SecretService, a, req.OrganizationID))
+}
+
+// CompilerType returns the Flux compiler type, as the compiler only differs
+// from it by the resolution of secrets.
+func (c FluxCompiler) CompilerType() flux.CompilerType {
+	return lang.FluxCompilerType
+}
+
+// WithSecrets returns a compiler resolving the secrets of Flux scripts with s.
+// Other compilers are returned unchanged.
+func WithSecrets(c flux.Compiler, s platform.SecretService) flux.Compiler {
+	switch c := c.(type) {
+	case lang.FluxCompiler:
+		return FluxCompiler{Query: c.Query, SecretService: s}
+	case *lang.FluxCompiler:
+		return FluxCompiler{Query: c.Query, SecretService: s}
+	case FluxCompiler:
+		if c.SecretService == nil {
+			c.SecretService = s
+		}
+		return c
+	}
+	return c
+}
@@ -0,0 +1,104 @@
+package functions_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/functions/inputs"
+	"github.com/influxdata/flux/lang"
+	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/mock"
+	"github.com/influxdata/platform/query"
+	"github.com/influxdata/platform/query/functions"
+)
+
+const secretsScript = `from(bucket: secrets.get(key: "bucket")) |> range(start: -1h)`
+
+func fromBucket(t *testing.T, spec *flux.Spec) string {
+	t.Helper()
+	for _, op := range spec.Operations {
+		if from, ok := op.Spec.(*inputs.FromOpSpec); ok {
+			return from.Bucket
+		}
+	}
+	t.Fatal("query has no from operation")
+	return ""
+}
+
+func TestCompile_Secrets(t *testing.T) {
+	lookup := func(key string) (string, error) {
+		if key != "bucket" {
+			return "", errors.New("secret not found")
+		}
+		return "telegraf", nil
+	}
+
+	spec, err := functions.Compile(context.Background(), secretsScript, time.Now(), lookup)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := fromBucket(t, spec), "telegraf"; got != want {
+		t.Errorf("got bucket %q, want %q", got, want)
+	}
+
+	if _, err := functions.Compile(context.Background(), `from(bucket: secrets.get(key: "missing"))`, time.Now(), lookup); err == nil {
+		t.Error("expected error requesting a missing secret")
+	}
+
+	// Without a lookup, scripts requesting secrets fail.
+	if _, err := flux.Compile(context.Background(), secretsScript, time.Now()); err == nil || !strings.Contains(err.Error(), "secrets") {
+		t.Errorf("got error %v, want error about secrets", err)
+	}
+}
+
+func TestFluxCompiler_Secrets(t *testing.T) {
+	orgID := platform.ID(1)
+	s := mock.NewSecretService()
+	s.LoadSecretFn = func(ctx context.Context, id platform.ID, k string) (string, error) {
+		if id != orgID {
+			return "", errors.New("secret not found")
+		}
+		return "telegraf", nil
+	}
+
+	c := functions.WithSecrets(lang.FluxCompiler{Query: secretsScript}, s)
+	if got, want := c.CompilerType(), flux.CompilerType(lang.FluxCompilerType); got != want {
+		t.Errorf("got compiler type %q, want %q", got, want)
+	}
+
+	auth := &platform.Authorization{
+		Status:      platform.Active,
+		Permissions: []platform.Permission{platform.NewPermission(platform.ReadAction, platform.SecretResourceType, orgID)},
+	}
+	ctx := query.ContextWithRequest(context.Background(), &query.Request{Authorization: auth, OrganizationID: orgID})
+	spec, err := c.Compile(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := fromBucket(t, spec), "telegraf"; got != want {
+		t.Errorf("got bucket %q, want %q", got, want)
+	}
+
+	ctx = query.ContextWithRequest(context.Background(), &query.Request{Authorization: auth, OrganizationID: 2})
+	if _, err := c.Compile(ctx); err == nil {
+		t.Error("expected error resolving the secrets of another organization")
+	}
+
+	// The organization of a request is not verified, so the secrets of
+	// organizations the request is not authorized for are not resolved.
+	s.LoadSecretFn = func(ctx context.Context, id platform.ID, k string) (string, error) {
+		return "telegraf", nil
+	}
+	ctx = query.ContextWithRequest(context.Background(), &query.Request{Authorization: auth, OrganizationID: 2})
+	if _, err := c.Compile(ctx); err == nil || !strings.Contains(err.Error(), "unauthorized") {
+		t.Errorf("got error %v, want unauthorized error", err)
+	}
+	ctx = query.ContextWithRequest(context.Background(), &query.Request{OrganizationID: orgID})
+	if _, err := c.Compile(ctx); err == nil {
+		t.Error("expected error resolving secrets without an authorization")
+	}
+}
@@ -0,0 +1,178 @@
+package transformations
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/semantic"
+)
+
+// TableLimitKind is the kind for the `tableLimit` flux function.
+const TableLimitKind = "tableLimit"
+
+// TableLimitOpSpec is the flux.OperationSpec for the `tableLimit` flux function.
+// It limits the number of tables returned, skipping offset tables first.
+// Tables are ordered by their group key.
+type TableLimitOpSpec struct {
+	N      int64 `json:"n"`
+	Offset int64 `json:"offset"`
+}
+
+func init() {
+	tableLimitSignature := flux.FunctionSignature(
+		map[string]semantic.PolyType{
+			"n":      semantic.Int,
+			"offset": semantic.Int,
+		},
+		[]string{"n"},
+	)
+
+	flux.RegisterFunction(TableLimitKind, createTableLimitOpSpec, tableLimitSignature)
+	flux.RegisterOpSpec(TableLimitKind, newTableLimitOp)
+	plan.RegisterProcedureSpec(TableLimitKind, newTableLimitProcedure, TableLimitKind)
+	execute.RegisterTransformation(TableLimitKind, createTableLimitTransformation)
+}
+
+func createTableLimitOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
+	if err := a.AddParentFromArgs(args); err != nil {
+		return nil, err
+	}
+
+	spec := new(TableLimitOpSpec)
+
+	n, err := args.GetRequiredInt("n")
+	if err != nil {
+		return nil, err
+	}
+	spec.N = n
+
+	if offset, ok, err := args.GetInt("offset"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.Offset = offset
+	}
+	return spec, nil
+}
+
+func newTableLimitOp() flux.OperationSpec {
+	return new(TableLimitOpSpec)
+}
+
+func (s *TableLimitOpSpec) Kind() flux.OperationKind {
+	return TableLimitKind
+}
+
+type TableLimitProcedureSpec struct {
+	plan.DefaultCost
+	N      int64
+	Offset int64
+}
+
+func newTableLimitProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*TableLimitOpSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid spec type %T", qs)
+	}
+	return &TableLimitProcedureSpec{
+		N:      spec.N,
+		Offset: spec.Offset,
+	}, nil
+}
+
+func (s *TableLimitProcedureSpec) Kind() plan.ProcedureKind {
+	return TableLimitKind
+}
+
+func (s *TableLimitProcedureSpec) Copy() plan.ProcedureSpec {
+	ns := new(TableLimitProcedureSpec)
+	*ns = *s
+	return ns
+}
+
+func createTableLimitTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
+	s, ok := spec.(*TableLimitProcedureSpec)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid spec type %T", spec)
+	}
+	cache := execute.NewTableBuilderCache(a.Allocator())
+	d := execute.NewDataset(id, mode, cache)
+	t := NewTableLimitTransformation(d, cache, s, a.Allocator())
+	return t, d, nil
+}
+
+// tableLimitTransformation holds on to a copy of every table until it is
+// finished, because the tables to return are only known once all of them
+// have been seen.
+type tableLimitTransformation struct {
+	d     execute.Dataset
+	cache execute.TableBuilderCache
+
+	alloc     *memory.Allocator
+	n, offset int
+	tables    []flux.Table
+}
+
+func NewTableLimitTransformation(d execute.Dataset, cache execute.TableBuilderCache, spec *TableLimitProcedureSpec, a *memory.Allocator) *tableLimitTransformation {
+	return &tableLimitTransformation{
+		d:      d,
+		cache:  cache,
+		alloc:  a,
+		n:      int(spec.N),
+		offset: int(spec.Offset),
+	}
+}
+
+func (t *tableLimitTransformation) RetractTable(id execute.DatasetID, key flux.GroupKey) error {
+	return t.d.RetractTable(key)
+}
+
+func (t *tableLimitTransformation) Process(id execute.DatasetID, tbl flux.Table) error {
+	cpy, err := execute.CopyTable(tbl, t.alloc)
+	if err != nil {
+		return err
+	}
+	t.tables = append(t.tables, cpy)
+	return nil
+}
+
+func (t *tableLimitTransformation) UpdateWatermark(id execute.DatasetID, mark execute.Time) error {
+	return nil
+}
+
+func (t *tableLimitTransformation) UpdateProcessingTime(id execute.DatasetID, pt execute.Time) error {
+	return nil
+}
+
+func (t *tableLimitTransformation) Finish(id execute.DatasetID, err error) {
+	if err == nil {
+		err = t.appendTables()
+	}
+	t.d.Finish(err)
+}
+
+// appendTables appends the tables within the limit to the cache.
+func (t *tableLimitTransformation) appendTables() error {
+	sort.Slice(t.tables, func(i, j int) bool {
+		return t.tables[i].Key().Less(t.tables[j].Key())
+	})
+	for i, tbl := range t.tables {
+		if i < t.offset || i >= t.offset+t.n {
+			continue
+		}
+		builder, created := t.cache.TableBuilder(tbl.Key())
+		if !created {
+			return fmt.Errorf("tableLimit found duplicate table with key: %v", tbl.Key())
+		}
+		if err := execute.AddTableCols(tbl, builder); err != nil {
+			return err
+		}
+		if err := execute.AppendTable(tbl, builder); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package transformations_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/platform/query/functions/transformations"
+)
+
+func TestTableLimit_Process(t *testing.T) {
+	cols := []flux.ColMeta{
+		{Label: "t1", Type: flux.TString},
+		{Label: execute.DefaultTimeColLabel, Type: flux.TTime},
+		{Label: execute.DefaultValueColLabel, Type: flux.TFloat},
+	}
+	table := func(t1 string, v float64) *executetest.Table {
+		return &executetest.Table{
+			KeyCols: []string{"t1"},
+			ColMeta: cols,
+			Data: [][]interface{}{
+				{t1, execute.Time(1), v},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name string
+		spec *transformations.TableLimitProcedureSpec
+		data []flux.Table
+		want []*executetest.Table
+	}{
+		{
+			name: "limit",
+			spec: &transformations.TableLimitProcedureSpec{N: 2},
+			data: []flux.Table{table("c", 3), table("a", 1), table("b", 2)},
+			want: []*executetest.Table{table("a", 1), table("b", 2)},
+		},
+		{
+			name: "offset",
+			spec: &transformations.TableLimitProcedureSpec{N: 1, Offset: 1},
+			data: []flux.Table{table("c", 3), table("a", 1), table("b", 2)},
+			want: []*executetest.Table{table("b", 2)},
+		},
+		{
+			name: "offset past the tables",
+			spec: &transformations.TableLimitProcedureSpec{N: 1, Offset: 3},
+			data: []flux.Table{table("c", 3), table("a", 1), table("b", 2)},
+			want: []*executetest.Table(nil),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			// The tables are only output once the transformation is finished,
+			// so it cannot use the process test helper.
+			d := executetest.NewDataset(executetest.RandomDatasetID())
+			c := execute.NewTableBuilderCache(executetest.UnlimitedAllocator)
+			c.SetTriggerSpec(execute.DefaultTriggerSpec)
+			tx := transformations.NewTableLimitTransformation(d, c, tc.spec, executetest.UnlimitedAllocator)
+
+			parentID := executetest.RandomDatasetID()
+			for _, tbl := range tc.data {
+				if err := tx.Process(parentID, tbl); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if got, err := executetest.TablesFromCache(c); err != nil {
+				t.Fatal(err)
+			} else if len(got) != 0 {
+				t.Fatalf("expected no tables before finishing, got %d", len(got))
+			}
+			tx.Finish(parentID, nil)
+
+			got, err := executetest.TablesFromCache(c)
+			if err != nil {
+				t.Fatal(err)
+			}
+			executetest.NormalizeTables(got)
+			executetest.NormalizeTables(tc.want)
+			sort.Sort(executetest.SortedTables(got))
+			sort.Sort(executetest.SortedTables(tc.want))
+			if !cmp.Equal(tc.want, got) {
+				t.Errorf("unexpected tables -want/+got\n%s", cmp.Diff(tc.want, got))
+			}
+		})
+	}
+}
@@ -0,0 +1,369 @@
+package transformations
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+)
+
+// WindowFillKind is the kind for the `windowFill` flux function.
+const WindowFillKind = "windowFill"
+
+// The ways windowFill fills a window without rows.
+const (
+	// FillValue fills the column with a constant value.
+	FillValue = "value"
+	// FillPrevious fills the column with the value of the previous row.
+	FillPrevious = "previous"
+	// FillLinear fills the column with the value interpolated from the
+	// previous and the next row.
+	FillLinear = "linear"
+)
+
+// WindowFillOpSpec is the flux.OperationSpec for the `windowFill` flux function.
+// It adds a row at the start of each window between start and stop that has no
+// rows in the table. Windows start every interval, shifted by offset.
+// Windows that cannot be filled because there is no previous or next row are
+// left empty.
+type WindowFillOpSpec struct {
+	Column     string        `json:"column"`
+	TimeColumn string        `json:"timeColumn"`
+	Every      flux.Duration `json:"every"`
+	Offset     flux.Duration `json:"offset"`
+	Start      flux.Time     `json:"start"`
+	Stop       flux.Time     `json:"stop"`
+	Fill       string        `json:"fill"`
+	Value      float64       `json:"value"`
+}
+
+func init() {
+	windowFillSignature := flux.FunctionSignature(
+		map[string]semantic.PolyType{
+			"column":     semantic.String,
+			"timeColumn": semantic.String,
+			"every":      semantic.Duration,
+			"offset":     semantic.Duration,
+			"start":      semantic.Time,
+			"stop":       semantic.Time,
+			"fill":       semantic.String,
+			"value":      semantic.Float,
+		},
+		[]string{"every", "start", "stop"},
+	)
+
+	flux.RegisterFunction(WindowFillKind, createWindowFillOpSpec, windowFillSignature)
+	flux.RegisterOpSpec(WindowFillKind, newWindowFillOp)
+	plan.RegisterProcedureSpec(WindowFillKind, newWindowFillProcedure, WindowFillKind)
+	execute.RegisterTransformation(WindowFillKind, createWindowFillTransformation)
+}
+
+func createWindowFillOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
+	if err := a.AddParentFromArgs(args); err != nil {
+		return nil, err
+	}
+
+	spec := &WindowFillOpSpec{
+		Column:     execute.DefaultValueColLabel,
+		TimeColumn: execute.DefaultTimeColLabel,
+		Fill:       FillValue,
+	}
+
+	if col, ok, err := args.GetString("column"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.Column = col
+	}
+	if col, ok, err := args.GetString("timeColumn"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.TimeColumn = col
+	}
+
+	every, err := args.GetRequiredDuration("every")
+	if err != nil {
+		return nil, err
+	}
+	spec.Every = every
+	if offset, ok, err := args.GetDuration("offset"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.Offset = offset
+	}
+
+	if spec.Start, err = args.GetRequiredTime("start"); err != nil {
+		return nil, err
+	}
+	if spec.Stop, err = args.GetRequiredTime("stop"); err != nil {
+		return nil, err
+	}
+
+	if fill, ok, err := args.GetString("fill"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.Fill = fill
+	}
+	switch spec.Fill {
+	case FillValue, FillPrevious, FillLinear:
+	default:
+		return nil, fmt.Errorf("unknown fill %q", spec.Fill)
+	}
+	if value, ok, err := args.GetFloat("value"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.Value = value
+	}
+	return spec, nil
+}
+
+func newWindowFillOp() flux.OperationSpec {
+	return new(WindowFillOpSpec)
+}
+
+func (s *WindowFillOpSpec) Kind() flux.OperationKind {
+	return WindowFillKind
+}
+
+type WindowFillProcedureSpec struct {
+	plan.DefaultCost
+	Column     string
+	TimeColumn string
+	Every      execute.Duration
+	Offset     execute.Duration
+	Start      execute.Time
+	Stop       execute.Time
+	Fill       string
+	Value      float64
+}
+
+func newWindowFillProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*WindowFillOpSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid spec type %T", qs)
+	}
+	if spec.Every <= 0 {
+		return nil, fmt.Errorf("windowFill every must be positive, got %v", spec.Every)
+	}
+	return &WindowFillProcedureSpec{
+		Column:     spec.Column,
+		TimeColumn: spec.TimeColumn,
+		Every:      execute.Duration(spec.Every),
+		Offset:     execute.Duration(spec.Offset),
+		Start:      values.ConvertTime(spec.Start.Time(pa.Now())),
+		Stop:       values.ConvertTime(spec.Stop.Time(pa.Now())),
+		Fill:       spec.Fill,
+		Value:      spec.Value,
+	}, nil
+}
+
+func (s *WindowFillProcedureSpec) Kind() plan.ProcedureKind {
+	return WindowFillKind
+}
+
+func (s *WindowFillProcedureSpec) Copy() plan.ProcedureSpec {
+	ns := new(WindowFillProcedureSpec)
+	*ns = *s
+	return ns
+}
+
+func createWindowFillTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
+	s, ok := spec.(*WindowFillProcedureSpec)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid spec type %T", spec)
+	}
+	cache := execute.NewTableBuilderCache(a.Allocator())
+	d := execute.NewDataset(id, mode, cache)
+	t := NewWindowFillTransformation(d, cache, s)
+	return t, d, nil
+}
+
+type windowFillTransformation struct {
+	d     execute.Dataset
+	cache execute.TableBuilderCache
+	spec  WindowFillProcedureSpec
+}
+
+func NewWindowFillTransformation(d execute.Dataset, cache execute.TableBuilderCache, spec *WindowFillProcedureSpec) *windowFillTransformation {
+	return &windowFillTransformation{
+		d:     d,
+		cache: cache,
+		spec:  *spec,
+	}
+}
+
+func (t *windowFillTransformation) RetractTable(id execute.DatasetID, key flux.GroupKey) error {
+	return t.d.RetractTable(key)
+}
+
+func (t *windowFillTransformation) Process(id execute.DatasetID, tbl flux.Table) error {
+	builder, created := t.cache.TableBuilder(tbl.Key())
+	if !created {
+		return fmt.Errorf("windowFill found duplicate table with key: %v", tbl.Key())
+	}
+	if err := execute.AddTableCols(tbl, builder); err != nil {
+		return err
+	}
+
+	cols := tbl.Cols()
+	timeIdx := execute.ColIdx(t.spec.TimeColumn, cols)
+	if timeIdx < 0 {
+		return fmt.Errorf("missing time column %q", t.spec.TimeColumn)
+	} else if cols[timeIdx].Type != flux.TTime {
+		return fmt.Errorf("column %q is not of type time", t.spec.TimeColumn)
+	}
+	valueIdx := execute.ColIdx(t.spec.Column, cols)
+	if valueIdx < 0 {
+		return fmt.Errorf("missing column %q", t.spec.Column)
+	} else if tbl.Key().HasCol(t.spec.Column) {
+		return fmt.Errorf("cannot fill column %q of the group key", t.spec.Column)
+	}
+	if t.spec.Fill != FillPrevious {
+		switch typ := cols[valueIdx].Type; typ {
+		case flux.TFloat, flux.TInt, flux.TUInt:
+		default:
+			return fmt.Errorf("cannot fill column %q of type %v with %s fill", t.spec.Column, typ, t.spec.Fill)
+		}
+	}
+
+	var rows [][]values.Value
+	if err := tbl.Do(func(cr flux.ColReader) error {
+		for i, l := 0, cr.Len(); i < l; i++ {
+			row := make([]values.Value, len(cols))
+			for j := range cols {
+				row[j] = execute.ValueForRow(cr, i, j)
+			}
+			rows = append(rows, row)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if len(rows) == 0 {
+		// There are no values to fill the windows with.
+		return nil
+	}
+	sort.SliceStable(rows, func(i, j int) bool {
+		return rows[i][timeIdx].Time() < rows[j][timeIdx].Time()
+	})
+
+	var prev []values.Value
+	i := 0
+	for start := t.firstWindow(); start < t.spec.Stop; start += execute.Time(t.spec.Every) {
+		stop := start + execute.Time(t.spec.Every)
+		if i < len(rows) && rows[i][timeIdx].Time() < stop {
+			for ; i < len(rows) && rows[i][timeIdx].Time() < stop; i++ {
+				if err := appendRow(builder, rows[i]); err != nil {
+					return err
+				}
+			}
+			prev = rows[i-1]
+			continue
+		}
+
+		var next []values.Value
+		if i < len(rows) {
+			next = rows[i]
+		}
+		v, ok := t.fill(start, cols[valueIdx].Type, prev, next, timeIdx, valueIdx)
+		if !ok {
+			continue
+		}
+
+		// The other columns are copied from a neighbouring row.
+		row := make([]values.Value, len(cols))
+		if prev != nil {
+			copy(row, prev)
+		} else {
+			copy(row, next)
+		}
+		row[timeIdx] = values.NewTime(start)
+		row[valueIdx] = v
+		if err := appendRow(builder, row); err != nil {
+			return err
+		}
+	}
+	for ; i < len(rows); i++ {
+		if err := appendRow(builder, rows[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// firstWindow returns the start of the window that contains the start time.
+func (t *windowFillTransformation) firstWindow() execute.Time {
+	every, offset := t.spec.Every, t.spec.Offset%t.spec.Every
+	first := (t.spec.Start - execute.Time(offset)).Truncate(every) + execute.Time(offset)
+	if first > t.spec.Start {
+		first -= execute.Time(every)
+	}
+	return first
+}
+
+// fill returns the value of the column in the window that starts at start,
+// given the rows before and after it. It returns false if the window cannot
+// be filled.
+func (t *windowFillTransformation) fill(start execute.Time, typ flux.ColType, prev, next []values.Value, timeIdx, valueIdx int) (values.Value, bool) {
+	switch t.spec.Fill {
+	case FillValue:
+		switch typ {
+		case flux.TInt:
+			return values.NewInt(int64(t.spec.Value)), true
+		case flux.TUInt:
+			return values.NewUInt(uint64(t.spec.Value)), true
+		default:
+			return values.NewFloat(t.spec.Value), true
+		}
+	case FillPrevious:
+		if prev == nil {
+			return nil, false
+		}
+		return prev[valueIdx], true
+	case FillLinear:
+		if prev == nil || next == nil {
+			return nil, false
+		}
+		pt, nt := prev[timeIdx].Time(), next[timeIdx].Time()
+		if nt == pt {
+			return prev[valueIdx], true
+		}
+		pv, nv := prev[valueIdx], next[valueIdx]
+		switch typ {
+		case flux.TInt:
+			p, n := pv.Int(), nv.Int()
+			return values.NewInt(p + (n-p)*int64(start-pt)/int64(nt-pt)), true
+		case flux.TUInt:
+			p, n := float64(pv.UInt()), float64(nv.UInt())
+			return values.NewUInt(uint64(p + (n-p)*float64(start-pt)/float64(nt-pt))), true
+		default:
+			p, n := pv.Float(), nv.Float()
+			return values.NewFloat(p + (n-p)*float64(start-pt)/float64(nt-pt)), true
+		}
+	}
+	return nil, false
+}
+
+func appendRow(builder execute.TableBuilder, row []values.Value) error {
+	for j, v := range row {
+		if err := builder.AppendValue(j, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *windowFillTransformation) UpdateWatermark(id execute.DatasetID, mark execute.Time) error {
+	return t.d.UpdateWatermark(mark)
+}
+
+func (t *windowFillTransformation) UpdateProcessingTime(id execute.DatasetID, pt execute.Time) error {
+	return t.d.UpdateProcessingTime(pt)
+}
+
+func (t *windowFillTransformation) Finish(id execute.DatasetID, err error) {
+	t.d.Finish(err)
+}
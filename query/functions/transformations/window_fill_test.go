@@ -0,0 +1,175 @@
+package transformations_test
+
+import (
+	"testing"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/platform/query/functions/transformations"
+)
+
+func TestWindowFill_Process(t *testing.T) {
+	floatCols := []flux.ColMeta{
+		{Label: "t1", Type: flux.TString},
+		{Label: execute.DefaultTimeColLabel, Type: flux.TTime},
+		{Label: execute.DefaultValueColLabel, Type: flux.TFloat},
+	}
+	intCols := []flux.ColMeta{
+		{Label: "t1", Type: flux.TString},
+		{Label: execute.DefaultTimeColLabel, Type: flux.TTime},
+		{Label: execute.DefaultValueColLabel, Type: flux.TInt},
+	}
+	spec := func(fill string, value float64) *transformations.WindowFillProcedureSpec {
+		return &transformations.WindowFillProcedureSpec{
+			Column:     execute.DefaultValueColLabel,
+			TimeColumn: execute.DefaultTimeColLabel,
+			Every:      10,
+			Start:      0,
+			Stop:       60,
+			Fill:       fill,
+			Value:      value,
+		}
+	}
+
+	testCases := []struct {
+		name    string
+		spec    *transformations.WindowFillProcedureSpec
+		data    []flux.Table
+		want    []*executetest.Table
+		wantErr error
+	}{
+		{
+			name: "value",
+			spec: spec(transformations.FillValue, -1),
+			data: []flux.Table{&executetest.Table{
+				KeyCols: []string{"t1"},
+				ColMeta: intCols,
+				Data: [][]interface{}{
+					{"a", execute.Time(30), int64(2)},
+					{"a", execute.Time(10), int64(1)},
+				},
+			}},
+			want: []*executetest.Table{{
+				KeyCols: []string{"t1"},
+				ColMeta: intCols,
+				Data: [][]interface{}{
+					{"a", execute.Time(0), int64(-1)},
+					{"a", execute.Time(10), int64(1)},
+					{"a", execute.Time(20), int64(-1)},
+					{"a", execute.Time(30), int64(2)},
+					{"a", execute.Time(40), int64(-1)},
+					{"a", execute.Time(50), int64(-1)},
+				},
+			}},
+		},
+		{
+			name: "previous",
+			spec: spec(transformations.FillPrevious, 0),
+			data: []flux.Table{&executetest.Table{
+				KeyCols: []string{"t1"},
+				ColMeta: floatCols,
+				Data: [][]interface{}{
+					{"a", execute.Time(10), 1.5},
+					{"a", execute.Time(30), 2.5},
+				},
+			}},
+			want: []*executetest.Table{{
+				KeyCols: []string{"t1"},
+				ColMeta: floatCols,
+				Data: [][]interface{}{
+					{"a", execute.Time(10), 1.5},
+					{"a", execute.Time(20), 1.5},
+					{"a", execute.Time(30), 2.5},
+					{"a", execute.Time(40), 2.5},
+					{"a", execute.Time(50), 2.5},
+				},
+			}},
+		},
+		{
+			name: "linear",
+			spec: spec(transformations.FillLinear, 0),
+			data: []flux.Table{&executetest.Table{
+				KeyCols: []string{"t1"},
+				ColMeta: floatCols,
+				Data: [][]interface{}{
+					{"a", execute.Time(10), 1.0},
+					{"a", execute.Time(40), 4.0},
+				},
+			}},
+			want: []*executetest.Table{{
+				KeyCols: []string{"t1"},
+				ColMeta: floatCols,
+				Data: [][]interface{}{
+					{"a", execute.Time(10), 1.0},
+					{"a", execute.Time(20), 2.0},
+					{"a", execute.Time(30), 3.0},
+					{"a", execute.Time(40), 4.0},
+				},
+			}},
+		},
+		{
+			name: "offset",
+			spec: &transformations.WindowFillProcedureSpec{
+				Column:     execute.DefaultValueColLabel,
+				TimeColumn: execute.DefaultTimeColLabel,
+				Every:      10,
+				Offset:     5,
+				Start:      0,
+				Stop:       30,
+				Fill:       transformations.FillValue,
+			},
+			data: []flux.Table{&executetest.Table{
+				KeyCols: []string{"t1"},
+				ColMeta: floatCols,
+				Data: [][]interface{}{
+					{"a", execute.Time(15), 1.0},
+				},
+			}},
+			want: []*executetest.Table{{
+				KeyCols: []string{"t1"},
+				ColMeta: floatCols,
+				Data: [][]interface{}{
+					{"a", execute.Time(-5), 0.0},
+					{"a", execute.Time(5), 0.0},
+					{"a", execute.Time(15), 1.0},
+					{"a", execute.Time(25), 0.0},
+				},
+			}},
+		},
+		{
+			name: "string value",
+			spec: spec(transformations.FillValue, 0),
+			data: []flux.Table{&executetest.Table{
+				KeyCols: []string{"t1"},
+				ColMeta: []flux.ColMeta{
+					{Label: "t1", Type: flux.TString},
+					{Label: execute.DefaultTimeColLabel, Type: flux.TTime},
+					{Label: execute.DefaultValueColLabel, Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{"a", execute.Time(10), "x"},
+				},
+			}},
+			wantErr: errString(`cannot fill column "_value" of type string with value fill`),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			executetest.ProcessTestHelper(
+				t,
+				tc.data,
+				tc.want,
+				tc.wantErr,
+				func(d execute.Dataset, c execute.TableBuilderCache) execute.Transformation {
+					return transformations.NewWindowFillTransformation(d, c, tc.spec)
+				},
+			)
+		})
+	}
+}
+
+type errString string
+
+func (e errString) Error() string { return string(e) }
@@ -10,9 +10,12 @@ import (
 const CompilerType = "influxql"
 
 // AddCompilerMappings adds the influxql specific compiler mappings.
-func AddCompilerMappings(mappings flux.CompilerMappings, dbrpMappingSvc platform.DBRPMappingService) error {
+// The bucket service is used to expand wildcards with the schema of buckets.
+func AddCompilerMappings(mappings flux.CompilerMappings, dbrpMappingSvc platform.DBRPMappingService, bucketSvc platform.BucketService) error {
 	return mappings.Add(CompilerType, func() flux.Compiler {
-		return NewCompiler(dbrpMappingSvc)
+		c := NewCompiler(dbrpMappingSvc)
+		c.bucketSvc = bucketSvc
+		return c
 	})
 }
 
@@ -24,6 +27,7 @@ type Compiler struct {
 	Query   string `json:"query"`
 
 	dbrpMappingSvc platform.DBRPMappingService
+	bucketSvc      platform.BucketService
 }
 
 func NewCompiler(dbrpMappingSvc platform.DBRPMappingService) *Compiler {
@@ -42,6 +46,7 @@ func (c *Compiler) Compile(ctx context.Context) (*flux.Spec, error) {
 			DefaultRetentionPolicy: c.RP,
 		},
 	)
+	transpiler.BucketService = c.bucketSvc
 	return transpiler.Transpile(ctx, c.Query)
 }
 func (c *Compiler) CompilerType() flux.CompilerType {
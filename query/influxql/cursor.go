@@ -53,20 +53,11 @@ func createVarRefCursor(t *transpilerState, ref *influxql.VarRef) (cursor, error
 		return nil, err
 	}
 
-	valuer := influxql.NowValuer{Now: t.spec.Now}
-	_, tr, err := influxql.ConditionExpr(t.stmt.Condition, &valuer)
+	tr, err := t.timeRange()
 	if err != nil {
 		return nil, err
 	}
 
-	// If the maximum is not set and we have a windowing function, then
-	// the end time will be set to now.
-	if tr.Max.IsZero() {
-		if window, err := t.stmt.GroupByInterval(); err == nil && window > 0 {
-			tr.Max = t.spec.Now
-		}
-	}
-
 	range_ := t.op("range", &transformations.RangeOpSpec{
 		Start:       flux.Time{Absolute: tr.MinTime()},
 		Stop:        flux.Time{Absolute: tr.MaxTime()},
@@ -111,6 +102,24 @@ func createVarRefCursor(t *transpilerState, ref *influxql.VarRef) (cursor, error
 	}, nil
 }
 
+// timeRange returns the time range of the condition of the statement.
+func (t *transpilerState) timeRange() (influxql.TimeRange, error) {
+	valuer := influxql.NowValuer{Now: t.spec.Now}
+	_, tr, err := influxql.ConditionExpr(t.stmt.Condition, &valuer)
+	if err != nil {
+		return influxql.TimeRange{}, err
+	}
+
+	// If the maximum is not set and we have a windowing function, then
+	// the end time will be set to now.
+	if tr.Max.IsZero() {
+		if window, err := t.stmt.GroupByInterval(); err == nil && window > 0 {
+			tr.Max = t.spec.Now
+		}
+	}
+	return tr, nil
+}
+
 func (c *varRefCursor) ID() flux.OperationID {
 	return c.id
 }
@@ -34,16 +34,19 @@ func parseFunction(expr *influxql.Call) (*function, error) {
 				call: expr,
 			}, nil
 		case *influxql.Call:
-			if ref.Name == "distinct" {
-				return nil, errors.New("unimplemented: count(distinct)")
+			if ref.Name != "distinct" {
+				return nil, fmt.Errorf("expected field argument in %s()", expr.Name)
 			}
-			return nil, fmt.Errorf("expected field argument in %s()", expr.Name)
+			return parseCountDistinct(expr, ref)
 		case *influxql.Distinct:
-			return nil, errors.New("unimplemented: count(distinct)")
-		case *influxql.Wildcard:
-			return nil, errors.New("unimplemented: wildcard function")
-		case *influxql.RegexLiteral:
-			return nil, errors.New("unimplemented: wildcard regex function")
+			// Rewrite count(distinct value) as count(distinct(value)) so both
+			// forms are transpiled the same way.
+			distinct := &influxql.Call{
+				Name: "distinct",
+				Args: []influxql.Expr{&influxql.VarRef{Val: ref.Val}},
+			}
+			expr.Args[0] = distinct
+			return parseCountDistinct(expr, distinct)
 		default:
 			return nil, fmt.Errorf("expected field argument in %s()", expr.Name)
 		}
@@ -58,10 +61,6 @@ func parseFunction(expr *influxql.Call) (*function, error) {
 				Ref:  ref,
 				call: expr,
 			}, nil
-		case *influxql.Wildcard:
-			return nil, errors.New("unimplemented: wildcard function")
-		case *influxql.RegexLiteral:
-			return nil, errors.New("unimplemented: wildcard regex function")
 		default:
 			return nil, fmt.Errorf("expected field argument in %s()", expr.Name)
 		}
@@ -75,10 +74,6 @@ func parseFunction(expr *influxql.Call) (*function, error) {
 		switch ref := expr.Args[0].(type) {
 		case *influxql.VarRef:
 			functionRef = ref
-		case *influxql.Wildcard:
-			return nil, errors.New("unimplemented: wildcard function")
-		case *influxql.RegexLiteral:
-			return nil, errors.New("unimplemented: wildcard regex function")
 		default:
 			return nil, fmt.Errorf("expected field argument in %s()", expr.Name)
 		}
@@ -100,6 +95,36 @@ func parseFunction(expr *influxql.Call) (*function, error) {
 
 }
 
+// parseCountDistinct parses the distinct call within a count call.
+func parseCountDistinct(expr, distinct *influxql.Call) (*function, error) {
+	switch got := len(distinct.Args); {
+	case got == 0:
+		return nil, errors.New("distinct function requires at least one argument")
+	case got > 1:
+		return nil, errors.New("distinct function can only have one argument")
+	}
+
+	ref, ok := distinct.Args[0].(*influxql.VarRef)
+	if !ok {
+		return nil, errors.New("expected field argument in distinct()")
+	}
+	return &function{
+		Ref:  ref,
+		call: expr,
+	}, nil
+}
+
+// fieldArg returns the field a function is called on. When counting distinct
+// values, it is the argument of the distinct call.
+func fieldArg(call *influxql.Call) (*influxql.VarRef, bool) {
+	arg := call.Args[0]
+	if distinct, ok := arg.(*influxql.Call); ok && distinct.Name == "distinct" && len(distinct.Args) == 1 {
+		arg = distinct.Args[0]
+	}
+	ref, ok := arg.(*influxql.VarRef)
+	return ref, ok
+}
+
 // createFunctionCursor creates a new cursor that calls a function on one of the columns
 // and returns the result.
 func createFunctionCursor(t *transpilerState, call *influxql.Call, in cursor, normalize bool) (cursor, error) {
@@ -109,17 +134,30 @@ func createFunctionCursor(t *transpilerState, call *influxql.Call, in cursor, no
 	}
 	switch call.Name {
 	case "count":
-		value, ok := in.Value(call.Args[0])
+		ref, ok := fieldArg(call)
 		if !ok {
-			return nil, fmt.Errorf("undefined variable: %s", call.Args[0])
+			return nil, fmt.Errorf("expected field argument in %s()", call.Name)
+		}
+		value, ok := in.Value(ref)
+		if !ok {
+			return nil, fmt.Errorf("undefined variable: %s", ref)
+		}
+
+		id := in.ID()
+		if ref != call.Args[0] {
+			// Count the distinct values, which are output in the value column.
+			id = t.op("distinct", &transformations.DistinctOpSpec{
+				Column: value,
+			}, id)
+			value = execute.DefaultValueColLabel
 		}
 		cur.id = t.op("count", &transformations.CountOpSpec{
 			AggregateConfig: execute.AggregateConfig{
 				Columns: []string{value},
 			},
-		}, in.ID())
+		}, id)
 		cur.value = value
-		cur.exclude = map[influxql.Expr]struct{}{call.Args[0]: {}}
+		cur.exclude = map[influxql.Expr]struct{}{ref: {}}
 	case "min":
 		value, ok := in.Value(call.Args[0])
 		if !ok {
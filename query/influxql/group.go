@@ -11,6 +11,7 @@ import (
 	"github.com/influxdata/flux/functions/transformations"
 	"github.com/influxdata/flux/semantic"
 	"github.com/influxdata/influxql"
+	ptransformations "github.com/influxdata/platform/query/functions/transformations"
 	"github.com/pkg/errors"
 )
 
@@ -18,6 +19,9 @@ type groupInfo struct {
 	call     *influxql.Call
 	refs     []*influxql.VarRef
 	selector bool
+
+	// windowOffset is the offset of the time windows the group is grouped by.
+	windowOffset time.Duration
 }
 
 type groupVisitor struct {
@@ -51,12 +55,6 @@ func (v *groupVisitor) Visit(n influxql.Node) influxql.Visitor {
 		}
 		v.refs = append(v.refs, expr)
 		return nil
-	case *influxql.Wildcard:
-		v.err = errors.New("unimplemented: field wildcard")
-		return nil
-	case *influxql.RegexLiteral:
-		v.err = errors.New("unimplemented: field regex wildcard")
-		return nil
 	}
 	return v
 }
@@ -114,7 +112,7 @@ func (gr *groupInfo) createCursor(t *transpilerState) (cursor, error) {
 	// TODO(jsternberg): Determine which of these cursors are from fields and which are tags.
 	var cursors []cursor
 	if gr.call != nil {
-		ref, ok := gr.call.Args[0].(*influxql.VarRef)
+		ref, ok := fieldArg(gr.call)
 		if !ok {
 			// TODO(jsternberg): This should be validated and figured out somewhere else.
 			return nil, fmt.Errorf("first argument to %q must be a variable", gr.call.Name)
@@ -126,28 +124,38 @@ func (gr *groupInfo) createCursor(t *transpilerState) (cursor, error) {
 		cursors = append(cursors, cur)
 	}
 
+	// Variables are only known to be tags once they have been typed with the
+	// bucket schema, which happens when wildcards are expanded.
+	tags := make(map[influxql.VarRef]struct{})
+	var on []string
 	for _, ref := range gr.refs {
+		if ref.Type == influxql.Tag {
+			if _, ok := tags[*ref]; !ok {
+				tags[*ref] = struct{}{}
+				on = append(on, ref.Val)
+			}
+			continue
+		}
+
 		cur, err := createVarRefCursor(t, ref)
 		if err != nil {
 			return nil, err
 		}
 		cursors = append(cursors, cur)
 	}
+	if len(cursors) == 0 {
+		return nil, errors.New("at least 1 non-time field must be queried")
+	}
 
 	// TODO(jsternberg): Establish which variables in the condition are tags and which are fields.
 	// We need to create the references to fields here so they can be joined.
-	var (
-		tags map[influxql.VarRef]struct{}
-		cond influxql.Expr
-	)
+	var cond influxql.Expr
 	valuer := influxql.NowValuer{Now: t.spec.Now}
 	if t.stmt.Condition != nil {
 		var err error
 		if cond, _, err = influxql.ConditionExpr(t.stmt.Condition, &valuer); err != nil {
 			return nil, err
 		} else if cond != nil {
-			tags = make(map[influxql.VarRef]struct{})
-
 			// Walk through the condition for every variable reference. There will be no function
 			// calls here.
 			var condErr error
@@ -186,15 +194,11 @@ func (gr *groupInfo) createCursor(t *transpilerState) (cursor, error) {
 		}
 	}
 
-	// Join the cursors using an inner join.
+	// Join the cursors using an inner join on the time, the measurement and the
+	// selected tags, so the tags keep their names.
 	// TODO(jsternberg): We need to differentiate between various join types and this needs to be
-	// except: ["_field"] rather than joining on the _measurement. This also needs to specify what the time
-	// column should be.
-	if len(cursors) > 1 {
-		return nil, errors.New("unimplemented: joining fields within a cursor")
-	}
-
-	cur := Join(t, cursors, []string{"_measurement"})
+	// except: ["_field"] rather than joining on the _measurement.
+	cur := Join(t, cursors, append([]string{execute.DefaultTimeColLabel, "_measurement"}, on...))
 	if len(tags) > 0 {
 		cur = &tagsCursor{cursor: cur, tags: tags}
 	}
@@ -254,6 +258,12 @@ func (gr *groupInfo) createCursor(t *transpilerState) (cursor, error) {
 				}, cur.ID()),
 				cursor: cur,
 			}
+
+			if c, err := gr.fill(t, cur, interval); err != nil {
+				return nil, err
+			} else {
+				cur = c
+			}
 		}
 	} else {
 		// If we do not have a function, but we have a field option,
@@ -262,8 +272,6 @@ func (gr *groupInfo) createCursor(t *transpilerState) (cursor, error) {
 			return nil, errors.New("using GROUP BY requires at least one aggregate function")
 		}
 
-		// TODO(jsternberg): Fill needs to be somewhere and it's probably here somewhere.
-		// Move this to the correct location once we've figured it out.
 		switch t.stmt.Fill {
 		case influxql.NoFill:
 			return nil, errors.New("fill(none) must be used with a function")
@@ -274,14 +282,61 @@ func (gr *groupInfo) createCursor(t *transpilerState) (cursor, error) {
 	return cur, nil
 }
 
+// fill fills the windows of the function call that have no values with the fill
+// option of the statement. Windows without values are left out for fill(null),
+// the default, the same as for fill(none) because tables cannot hold null values.
+func (gr *groupInfo) fill(t *transpilerState, in cursor, interval time.Duration) (cursor, error) {
+	spec := &ptransformations.WindowFillOpSpec{
+		TimeColumn: execute.DefaultTimeColLabel,
+		Every:      flux.Duration(interval),
+		Offset:     flux.Duration(gr.windowOffset),
+	}
+	switch t.stmt.Fill {
+	case influxql.NumberFill:
+		spec.Fill = ptransformations.FillValue
+		switch v := t.stmt.FillValue.(type) {
+		case int64:
+			spec.Value = float64(v)
+		case float64:
+			spec.Value = v
+		default:
+			return nil, fmt.Errorf("unsupported fill value: %v", t.stmt.FillValue)
+		}
+	case influxql.PreviousFill:
+		spec.Fill = ptransformations.FillPrevious
+	case influxql.LinearFill:
+		spec.Fill = ptransformations.FillLinear
+	default:
+		return in, nil
+	}
+
+	value, ok := in.Value(gr.call)
+	if !ok {
+		return nil, fmt.Errorf("undefined variable: %s", gr.call)
+	}
+	spec.Column = value
+
+	// The windows end with the one that holds the inclusive end of the time range.
+	tr, err := t.timeRange()
+	if err != nil {
+		return nil, err
+	}
+	spec.Start = flux.Time{Absolute: tr.MinTime()}
+	spec.Stop = flux.Time{Absolute: tr.MaxTime().Add(1)}
+
+	return &groupCursor{
+		id:     t.op("windowFill", spec, in.ID()),
+		cursor: in,
+	}, nil
+}
+
 type groupCursor struct {
 	cursor
 	id flux.OperationID
 }
 
 func (gr *groupInfo) group(t *transpilerState, in cursor) (cursor, error) {
-	var windowEvery time.Duration
-	var windowStart time.Time
+	var windowEvery, windowOffset time.Duration
 	tags := []string{"_measurement", "_start"}
 	if len(t.stmt.Dimensions) > 0 {
 		// Maintain a set of the dimensions we have encountered.
@@ -313,7 +368,6 @@ func (gr *groupInfo) group(t *transpilerState, in cursor) (cursor, error) {
 					return nil, errors.New("multiple time dimensions not allowed")
 				} else {
 					windowEvery = lit.Val
-					var windowOffset time.Duration
 					if len(expr.Args) == 2 {
 						switch lit2 := expr.Args[1].(type) {
 						case *influxql.DurationLiteral:
@@ -347,9 +401,6 @@ func (gr *groupInfo) group(t *transpilerState, in cursor) (cursor, error) {
 						default:
 							return nil, errors.New("time dimension offset must be duration or now()")
 						}
-
-						//TODO set windowStart
-						windowStart = time.Unix(0, 0).Add(windowOffset)
 					}
 				}
 			case *influxql.Wildcard:
@@ -370,6 +421,21 @@ func (gr *groupInfo) group(t *transpilerState, in cursor) (cursor, error) {
 		Mode:    "by",
 	}, in.ID())
 
+	if windowEvery > 0 && t.stmt.Location != nil {
+		// Align the windows with the time zone using its offset at the start of
+		// the time range, the same as 1.x.
+		tr, err := t.timeRange()
+		if err != nil {
+			return nil, err
+		}
+		_, zone := tr.MinTime().In(t.stmt.Location).Zone()
+		windowOffset = (windowOffset - time.Duration(zone)*time.Second) % windowEvery
+		if windowOffset < 0 {
+			windowOffset += windowEvery
+		}
+	}
+	gr.windowOffset = windowOffset
+
 	if windowEvery > 0 {
 		windowOp := &transformations.WindowOpSpec{
 			Every:       flux.Duration(windowEvery),
@@ -379,8 +445,8 @@ func (gr *groupInfo) group(t *transpilerState, in cursor) (cursor, error) {
 			StopColumn:  execute.DefaultStopColLabel,
 		}
 
-		if !windowStart.IsZero() {
-			windowOp.Start = flux.Time{Absolute: windowStart}
+		if windowOffset != 0 {
+			windowOp.Start = flux.Time{Absolute: time.Unix(0, 0).Add(windowOffset)}
 		}
 
 		id = t.op("window", windowOp, id)
@@ -34,6 +34,9 @@ func (c *mapCursor) Value(expr influxql.Expr) (string, bool) {
 
 // mapFields will take the list of symbols and maps each of the operations
 // using the column names.
+// locationColumn is the name of the column with the time zone of the times of a table.
+const locationColumn = "_location"
+
 func (t *transpilerState) mapFields(in cursor) (cursor, error) {
 	columns := t.stmt.ColumnNames()
 	if len(columns) != len(t.stmt.Fields) {
@@ -67,6 +70,13 @@ func (t *transpilerState) mapFields(in cursor) (cursor, error) {
 			Value: value,
 		})
 	}
+	if t.stmt.Location != nil {
+		// Output the times in the time zone of the statement.
+		properties = append(properties, &semantic.Property{
+			Key:   &semantic.Identifier{Name: locationColumn},
+			Value: &semantic.StringLiteral{Value: t.stmt.Location.String()},
+		})
+	}
 	id := t.op("map", &transformations.MapOpSpec{
 		Fn: &semantic.FunctionExpression{
 			Block: &semantic.FunctionBlock{
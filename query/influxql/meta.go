@@ -0,0 +1,313 @@
+package influxql
+
+import (
+	"errors"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/functions/transformations"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/influxql"
+)
+
+// Names of the columns of meta query results.
+const (
+	measurementsName = "measurements"
+	fieldTypeColumn  = "fieldType"
+)
+
+// metaCursor is a cursor of series read by a meta query. It does not hold
+// any expression.
+type metaCursor struct {
+	id flux.OperationID
+}
+
+func (c *metaCursor) ID() flux.OperationID                    { return c.id }
+func (c *metaCursor) Keys() []influxql.Expr                   { return nil }
+func (c *metaCursor) Value(expr influxql.Expr) (string, bool) { return "", false }
+
+// metaSource reads the series of the database that meta queries are evaluated on,
+// restricted to the measurements of the sources and to the tag condition.
+// As with SHOW TAG VALUES, only the last hour is read unless the condition has a time range.
+func (t *transpilerState) metaSource(db string, sources influxql.Sources, cond influxql.Expr) (flux.OperationID, error) {
+	if db == "" {
+		if t.config.DefaultDatabase == "" {
+			return "", errDatabaseNameRequired
+		}
+		db = t.config.DefaultDatabase
+	}
+
+	op, err := t.from(&influxql.Measurement{Database: db})
+	if err != nil {
+		return "", err
+	}
+
+	valuer := influxql.NowValuer{Now: t.spec.Now}
+	cond, tr, err := influxql.ConditionExpr(cond, &valuer)
+	if err != nil {
+		return "", err
+	}
+
+	rangeSpec := &transformations.RangeOpSpec{
+		Start: flux.Time{
+			Relative:   -time.Hour,
+			IsRelative: true,
+		},
+		Stop: flux.Now,
+	}
+	if !tr.Min.IsZero() {
+		rangeSpec.Start = flux.Time{Absolute: tr.MinTime()}
+	}
+	if !tr.Max.IsZero() {
+		rangeSpec.Stop = flux.Time{Absolute: tr.MaxTime()}
+	}
+	op = t.op("range", rangeSpec, op)
+
+	if expr, err := measurementsExpr(sources); err != nil {
+		return "", err
+	} else if expr != nil {
+		op = t.filter(expr, op)
+	}
+
+	if cond != nil {
+		// Every variable of a meta query condition is a tag.
+		tags := make(map[influxql.VarRef]struct{})
+		influxql.WalkFunc(cond, func(node influxql.Node) {
+			if ref, ok := node.(*influxql.VarRef); ok {
+				tags[*ref] = struct{}{}
+			}
+		})
+
+		expr, err := t.mapField(cond, &tagsCursor{cursor: &metaCursor{id: op}, tags: tags})
+		if err != nil {
+			return "", err
+		}
+		op = t.filter(expr, op)
+	}
+	return op, nil
+}
+
+// measurementsExpr returns an expression matching the measurements of the sources,
+// or nil if there are no sources.
+func measurementsExpr(sources influxql.Sources) (semantic.Expression, error) {
+	var expr semantic.Expression
+	for i := len(sources) - 1; i >= 0; i-- {
+		mm, ok := sources[i].(*influxql.Measurement)
+		if !ok {
+			return nil, errors.New("unimplemented: source must be a measurement")
+		}
+
+		match := &semantic.BinaryExpression{
+			Operator: ast.EqualOperator,
+			Left: &semantic.MemberExpression{
+				Object:   &semantic.IdentifierExpression{Name: "r"},
+				Property: "_measurement",
+			},
+			Right: &semantic.StringLiteral{Value: mm.Name},
+		}
+		if mm.Regex != nil {
+			match.Operator = ast.RegexpMatchOperator
+			match.Right = &semantic.RegexpLiteral{Value: mm.Regex.Val}
+		}
+
+		if expr == nil {
+			expr = match
+		} else {
+			expr = &semantic.LogicalExpression{
+				Operator: ast.OrOperator,
+				Left:     match,
+				Right:    expr,
+			}
+		}
+	}
+	return expr, nil
+}
+
+// filter adds a filter of the records matching expr.
+func (t *transpilerState) filter(expr semantic.Expression, parent flux.OperationID) flux.OperationID {
+	return t.op("filter", &transformations.FilterOpSpec{
+		Fn: &semantic.FunctionExpression{
+			Block: &semantic.FunctionBlock{
+				Parameters: &semantic.FunctionParameters{
+					List: []*semantic.FunctionParameter{
+						{Key: &semantic.Identifier{Name: "r"}},
+					},
+				},
+				Body: expr,
+			},
+		},
+	}, parent)
+}
+
+// limit adds a limit of the rows of each table if the statement has a LIMIT or OFFSET.
+func (t *transpilerState) limit(limit, offset int, parent flux.OperationID) flux.OperationID {
+	if limit == 0 && offset == 0 {
+		return parent
+	}
+	n := int64(limit)
+	if n == 0 {
+		// An OFFSET without a LIMIT returns all the remaining rows.
+		n = int64(^uint64(0) >> 1)
+	}
+	return t.op("limit", &transformations.LimitOpSpec{
+		N:      n,
+		Offset: int64(offset),
+	}, parent)
+}
+
+func (t *transpilerState) transpileShowMeasurements(stmt *influxql.ShowMeasurementsStatement) (flux.OperationID, error) {
+	var sources influxql.Sources
+	if stmt.Source != nil {
+		sources = influxql.Sources{stmt.Source}
+	}
+	op, err := t.metaSource(stmt.Database, sources, stmt.Condition)
+	if err != nil {
+		return "", err
+	}
+
+	// Gather the measurement names in a single table named measurements.
+	op = t.op("keep", &transformations.KeepOpSpec{
+		Columns: []string{"_measurement"},
+	}, op)
+	op = t.op("duplicate", &transformations.DuplicateOpSpec{
+		Column: "_measurement",
+		As:     "name",
+	}, op)
+	op = t.op("set", &transformations.SetOpSpec{
+		Key:   "_measurement",
+		Value: measurementsName,
+	}, op)
+	op = t.op("distinct", &transformations.DistinctOpSpec{
+		Column: "name",
+	}, op)
+	op = t.op("sort", &transformations.SortOpSpec{
+		Columns: []string{execute.DefaultValueColLabel},
+	}, op)
+	op = t.limit(stmt.Limit, stmt.Offset, op)
+	return t.op("rename", &transformations.RenameOpSpec{
+		Columns: map[string]string{
+			execute.DefaultValueColLabel: "name",
+		},
+	}, op), nil
+}
+
+func (t *transpilerState) transpileShowTagKeys(stmt *influxql.ShowTagKeysStatement) (flux.OperationID, error) {
+	if stmt.SLimit != 0 || stmt.SOffset != 0 {
+		return "", errors.New("unimplemented: SLIMIT and SOFFSET with SHOW TAG KEYS")
+	}
+
+	op, err := t.metaSource(stmt.Database, stmt.Sources, stmt.Condition)
+	if err != nil {
+		return "", err
+	}
+
+	// List the tag keys of every series, and find the distinct keys of each measurement.
+	op = t.op("keys", &transformations.KeysOpSpec{
+		Except: []string{
+			execute.DefaultStartColLabel,
+			execute.DefaultStopColLabel,
+			execute.DefaultTimeColLabel,
+			execute.DefaultValueColLabel,
+			"_field",
+			"_measurement",
+		},
+	}, op)
+	op = t.op("group", &transformations.GroupOpSpec{
+		Columns: []string{"_measurement"},
+		Mode:    "by",
+	}, op)
+	op = t.op("distinct", &transformations.DistinctOpSpec{
+		Column: execute.DefaultValueColLabel,
+	}, op)
+	op = t.op("sort", &transformations.SortOpSpec{
+		Columns: []string{execute.DefaultValueColLabel},
+	}, op)
+	op = t.limit(stmt.Limit, stmt.Offset, op)
+	return t.op("rename", &transformations.RenameOpSpec{
+		Columns: map[string]string{
+			execute.DefaultValueColLabel: "tagKey",
+		},
+	}, op), nil
+}
+
+func (t *transpilerState) transpileShowFieldKeys(stmt *influxql.ShowFieldKeysStatement) (flux.OperationID, error) {
+	// Fields of different types cannot be gathered in the same table, so each
+	// field is a table and they are gathered by measurement when the response
+	// is encoded, which leaves no place to limit the fields of a measurement.
+	if stmt.Limit != 0 || stmt.Offset != 0 {
+		return "", errors.New("unimplemented: LIMIT and OFFSET with SHOW FIELD KEYS")
+	}
+
+	op, err := t.metaSource(stmt.Database, stmt.Sources, nil)
+	if err != nil {
+		return "", err
+	}
+
+	// Keep a single value of each field. Its type is the type of the field,
+	// which the response encoder reports for the fieldType column.
+	op = t.op("keep", &transformations.KeepOpSpec{
+		Columns: []string{"_measurement", "_field", execute.DefaultValueColLabel},
+	}, op)
+	op = t.op("limit", &transformations.LimitOpSpec{N: 1}, op)
+	return t.op("map", &transformations.MapOpSpec{
+		Fn: &semantic.FunctionExpression{
+			Block: &semantic.FunctionBlock{
+				Parameters: &semantic.FunctionParameters{
+					List: []*semantic.FunctionParameter{
+						{Key: &semantic.Identifier{Name: "r"}},
+					},
+				},
+				Body: &semantic.ObjectExpression{
+					Properties: []*semantic.Property{
+						{
+							Key: &semantic.Identifier{Name: "fieldKey"},
+							Value: &semantic.MemberExpression{
+								Object:   &semantic.IdentifierExpression{Name: "r"},
+								Property: "_field",
+							},
+						},
+						{
+							Key: &semantic.Identifier{Name: fieldTypeColumn},
+							Value: &semantic.MemberExpression{
+								Object:   &semantic.IdentifierExpression{Name: "r"},
+								Property: execute.DefaultValueColLabel,
+							},
+						},
+					},
+				},
+			},
+		},
+		MergeKey: true,
+	}, op), nil
+}
+
+func (t *transpilerState) transpileShowSeries(stmt *influxql.ShowSeriesStatement) (flux.OperationID, error) {
+	op, err := t.metaSource(stmt.Database, stmt.Sources, stmt.Condition)
+	if err != nil {
+		return "", err
+	}
+
+	// Reduce each series to a single row of its measurement and tags, and
+	// gather them in one table. The response encoder formats the rows of a
+	// table whose measurement is not part of the group key as series keys.
+	op = t.op("drop", &transformations.DropOpSpec{
+		Columns: []string{
+			execute.DefaultStartColLabel,
+			execute.DefaultStopColLabel,
+			execute.DefaultTimeColLabel,
+			execute.DefaultValueColLabel,
+			"_field",
+		},
+	}, op)
+	op = t.op("limit", &transformations.LimitOpSpec{N: 1}, op)
+	op = t.op("group", &transformations.GroupOpSpec{
+		Columns: []string{},
+		Mode:    "by",
+	}, op)
+	op = t.op("sort", &transformations.SortOpSpec{
+		Columns: []string{"_measurement"},
+	}, op)
+	return t.limit(stmt.Limit, stmt.Offset, op), nil
+}
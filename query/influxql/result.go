@@ -4,7 +4,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/influxdata/flux"
@@ -27,9 +29,16 @@ type MultiResultEncoder struct{}
 //  4.  All other columns are fields and will be output in the order they are found.
 //      TODO(jsternberg): This function currently requires the first column to be a time field, but this isn't
 //      a strict requirement and will be lifted when we begin to work on transpiling meta queries.
+//  5.  Tables of a result with the same name, tags and columns are output as a single series.
+//  6.  A fieldType column holds a value of each field, and the name of the InfluxQL type of the
+//      value is output instead of the value.
+//  7.  If the _measurement column is not in the group key, each row is output as a series key
+//      of the measurement and the other string columns in a single key column.
+//  8.  A _location column holds the name of the time zone the times of a table are output in.
 func (e *MultiResultEncoder) Encode(w io.Writer, results flux.ResultIterator) (int64, error) {
 	resp := Response{}
 	wc := &iocounter.Writer{Writer: w}
+	locations := make(map[string]*time.Location)
 
 	for results.More() {
 		res := results.Next()
@@ -44,6 +53,7 @@ func (e *MultiResultEncoder) Encode(w io.Writer, results flux.ResultIterator) (i
 		tables := res.Tables()
 
 		result := Result{StatementID: id}
+		series := make(map[string]*Row)
 		if err := tables.Do(func(tbl flux.Table) error {
 			var row Row
 
@@ -68,6 +78,18 @@ func (e *MultiResultEncoder) Encode(w io.Writer, results flux.ResultIterator) (i
 				}
 			}
 
+			if !tbl.Key().HasCol("_measurement") && execute.ColIdx("_measurement", tbl.Cols()) >= 0 {
+				row.Columns = []string{"key"}
+				if err := tbl.Do(func(cr flux.ColReader) error {
+					row.Values = append(row.Values, seriesKeys(tbl.Cols(), cr)...)
+					return nil
+				}); err != nil {
+					return err
+				}
+				result.Series = appendSeries(result.Series, series, &row)
+				return nil
+			}
+
 			// TODO: resultColMap should be constructed from query metadata once it is provided.
 			// for now we know that an influxql query ALWAYS has time first, so we put this placeholder
 			// here to catch this most obvious requirement.  Column orderings should be explicitly determined
@@ -77,7 +99,7 @@ func (e *MultiResultEncoder) Encode(w io.Writer, results flux.ResultIterator) (i
 			for _, c := range tbl.Cols() {
 				if c.Label == execute.DefaultTimeColLabel {
 					resultColMap[c.Label] = 0
-				} else if !tbl.Key().HasCol(c.Label) {
+				} else if c.Label != locationColumn && !tbl.Key().HasCol(c.Label) {
 					resultColMap[c.Label] = j
 					j++
 				}
@@ -106,13 +128,29 @@ func (e *MultiResultEncoder) Encode(w io.Writer, results flux.ResultIterator) (i
 					values[j] = make([]interface{}, len(row.Columns))
 				}
 
+				loc, err := location(locations, tbl.Cols(), cr)
+				if err != nil {
+					return err
+				}
+
 				j := 0
 				for idx, c := range tbl.Cols() {
-					if cr.Key().HasCol(c.Label) {
+					if c.Label == locationColumn || cr.Key().HasCol(c.Label) {
 						continue
 					}
 
 					j = resultColMap[c.Label]
+					if c.Label == fieldTypeColumn {
+						typ, err := fieldType(c.Type)
+						if err != nil {
+							return err
+						}
+						for i := range values {
+							values[i][j] = typ
+						}
+						continue
+					}
+
 					// Fill in the values for each column.
 					switch c.Type {
 					case flux.TFloat:
@@ -137,7 +175,7 @@ func (e *MultiResultEncoder) Encode(w io.Writer, results flux.ResultIterator) (i
 						}
 					case flux.TTime:
 						for i, v := range cr.Times(idx) {
-							values[i][j] = v.Time().Format(time.RFC3339Nano)
+							values[i][j] = v.Time().In(loc).Format(time.RFC3339Nano)
 						}
 					default:
 						return fmt.Errorf("unsupported column type: %s", c.Type)
@@ -150,7 +188,7 @@ func (e *MultiResultEncoder) Encode(w io.Writer, results flux.ResultIterator) (i
 				return err
 			}
 
-			result.Series = append(result.Series, &row)
+			result.Series = appendSeries(result.Series, series, &row)
 			return nil
 		}); err != nil {
 			resp.error(err)
@@ -167,6 +205,105 @@ func (e *MultiResultEncoder) Encode(w io.Writer, results flux.ResultIterator) (i
 	err := json.NewEncoder(wc).Encode(resp)
 	return wc.Count(), err
 }
+
+// appendSeries appends row to the series of a result, or appends its values to
+// the series with the same name, tags and columns.
+func appendSeries(rows []*Row, series map[string]*Row, row *Row) []*Row {
+	tags := make([]string, 0, len(row.Tags))
+	for k, v := range row.Tags {
+		tags = append(tags, k+"="+v)
+	}
+	sort.Strings(tags)
+	sig := strings.Join([]string{
+		row.Name,
+		strings.Join(tags, ","),
+		strings.Join(row.Columns, ","),
+	}, "\x00")
+
+	if r, ok := series[sig]; ok {
+		r.Values = append(r.Values, row.Values...)
+		return rows
+	}
+	series[sig] = row
+	return append(rows, row)
+}
+
+// seriesKeys returns the series key of each row, formatted as the measurement
+// followed by the non-empty string columns sorted by label.
+func seriesKeys(cols []flux.ColMeta, cr flux.ColReader) [][]interface{} {
+	var (
+		labels []string
+		tags   [][]string
+	)
+	idxs := make([]int, 0, len(cols))
+	for j, c := range cols {
+		if c.Type == flux.TString && c.Label != "_measurement" {
+			idxs = append(idxs, j)
+		}
+	}
+	sort.Slice(idxs, func(a, b int) bool {
+		return cols[idxs[a]].Label < cols[idxs[b]].Label
+	})
+	for _, j := range idxs {
+		labels = append(labels, cols[j].Label)
+		tags = append(tags, cr.Strings(j))
+	}
+	measurement := cr.Strings(execute.ColIdx("_measurement", cols))
+
+	values := make([][]interface{}, cr.Len())
+	for i := range values {
+		var key strings.Builder
+		key.WriteString(measurement[i])
+		for t, label := range labels {
+			if v := tags[t][i]; v != "" {
+				key.WriteString("," + label + "=" + v)
+			}
+		}
+		values[i] = []interface{}{key.String()}
+	}
+	return values
+}
+
+// location returns the time zone of the times of a table, read from its
+// location column. Loaded locations are cached in locations.
+func location(locations map[string]*time.Location, cols []flux.ColMeta, cr flux.ColReader) (*time.Location, error) {
+	idx := execute.ColIdx(locationColumn, cols)
+	if idx < 0 || cr.Len() == 0 {
+		return time.UTC, nil
+	} else if cols[idx].Type != flux.TString {
+		return nil, fmt.Errorf("location column must be a string, got %s", cols[idx].Type)
+	}
+
+	name := cr.Strings(idx)[0]
+	if loc, ok := locations[name]; ok {
+		return loc, nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, err
+	}
+	locations[name] = loc
+	return loc, nil
+}
+
+// fieldType returns the name of the InfluxQL type of a column type.
+func fieldType(typ flux.ColType) (string, error) {
+	switch typ {
+	case flux.TFloat:
+		return "float", nil
+	case flux.TInt:
+		return "integer", nil
+	case flux.TUInt:
+		return "unsigned", nil
+	case flux.TString:
+		return "string", nil
+	case flux.TBool:
+		return "boolean", nil
+	default:
+		return "", fmt.Errorf("unsupported field type: %s", typ)
+	}
+}
+
 func NewMultiResultEncoder() *MultiResultEncoder {
 	return new(MultiResultEncoder)
 }
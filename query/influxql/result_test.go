@@ -78,6 +78,95 @@ func TestMultiResultEncoder_Encode(t *testing.T) {
 			),
 			out: `{"results":[{"statement_id":0,"series":[{"columns":["name"],"values":[["telegraf"]]}]}]}`,
 		},
+		{
+			name: "Merge Series",
+			in: flux.NewSliceResultIterator(
+				[]flux.Result{&executetest.Result{
+					Nm: "0",
+					Tbls: []*executetest.Table{
+						{
+							KeyCols: []string{"_measurement", "_field"},
+							ColMeta: []flux.ColMeta{
+								{Label: "_measurement", Type: flux.TString},
+								{Label: "_field", Type: flux.TString},
+								{Label: "fieldKey", Type: flux.TString},
+								{Label: "fieldType", Type: flux.TFloat},
+							},
+							Data: [][]interface{}{
+								{"cpu", "usage_idle", "usage_idle", float64(90)},
+							},
+						},
+						{
+							KeyCols: []string{"_measurement", "_field"},
+							ColMeta: []flux.ColMeta{
+								{Label: "_measurement", Type: flux.TString},
+								{Label: "_field", Type: flux.TString},
+								{Label: "fieldKey", Type: flux.TString},
+								{Label: "fieldType", Type: flux.TInt},
+							},
+							Data: [][]interface{}{
+								{"mem", "total", "total", int64(1024)},
+							},
+						},
+						{
+							KeyCols: []string{"_measurement", "_field"},
+							ColMeta: []flux.ColMeta{
+								{Label: "_measurement", Type: flux.TString},
+								{Label: "_field", Type: flux.TString},
+								{Label: "fieldKey", Type: flux.TString},
+								{Label: "fieldType", Type: flux.TString},
+							},
+							Data: [][]interface{}{
+								{"cpu", "status", "status", "ok"},
+							},
+						},
+					},
+				}},
+			),
+			out: `{"results":[{"statement_id":0,"series":[{"name":"cpu","columns":["fieldKey","fieldType"],"values":[["usage_idle","float"],["status","string"]]},{"name":"mem","columns":["fieldKey","fieldType"],"values":[["total","integer"]]}]}]}`,
+		},
+		{
+			name: "Series Keys",
+			in: flux.NewSliceResultIterator(
+				[]flux.Result{&executetest.Result{
+					Nm: "0",
+					Tbls: []*executetest.Table{{
+						KeyCols: []string{},
+						ColMeta: []flux.ColMeta{
+							{Label: "_measurement", Type: flux.TString},
+							{Label: "region", Type: flux.TString},
+							{Label: "host", Type: flux.TString},
+						},
+						Data: [][]interface{}{
+							{"cpu", "west", "server01"},
+							{"mem", "", "server02"},
+						},
+					}},
+				}},
+			),
+			out: `{"results":[{"statement_id":0,"series":[{"columns":["key"],"values":[["cpu,host=server01,region=west"],["mem,host=server02"]]}]}]}`,
+		},
+		{
+			name: "Time Zone",
+			in: flux.NewSliceResultIterator(
+				[]flux.Result{&executetest.Result{
+					Nm: "0",
+					Tbls: []*executetest.Table{{
+						KeyCols: []string{"_measurement"},
+						ColMeta: []flux.ColMeta{
+							{Label: "_time", Type: flux.TTime},
+							{Label: "_measurement", Type: flux.TString},
+							{Label: "value", Type: flux.TFloat},
+							{Label: "_location", Type: flux.TString},
+						},
+						Data: [][]interface{}{
+							{ts("2018-05-24T09:00:00Z"), "m0", float64(2), "Asia/Kolkata"},
+						},
+					}},
+				}},
+			),
+			out: `{"results":[{"statement_id":0,"series":[{"name":"m0","columns":["time","value"],"values":[["2018-05-24T14:30:00+05:30",2]]}]}]}`,
+		},
 		{
 			name: "Error",
 			in:   &resultErrorIterator{Error: "expected"},
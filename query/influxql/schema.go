@@ -0,0 +1,116 @@
+package influxql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/influxdata/influxql"
+	"github.com/influxdata/platform"
+)
+
+// schemaRequiredMsg explains why a query with wildcards or regular expressions
+// in its fields or dimensions cannot be transpiled without a bucket schema.
+const schemaRequiredMsg = "wildcards and regular expressions in fields and GROUP BY are only expanded " +
+	"with the fields and required tags of a bucket schema; list the fields and tags explicitly instead"
+
+// schemaFieldMapper maps the fields and tags of measurements using the schema of
+// the bucket they are read from. The tags of a measurement are the tags its
+// schema requires. Fields and tags are not discovered from the data, so
+// buckets without a schema cannot expand wildcards and regular expressions.
+type schemaFieldMapper struct {
+	ctx     context.Context
+	t       *transpilerState
+	schemas map[platform.ID]*platform.BucketSchema
+}
+
+func (m *schemaFieldMapper) FieldDimensions(mm *influxql.Measurement) (map[string]influxql.DataType, map[string]struct{}, error) {
+	schema, err := m.schema(mm)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fields := make(map[string]influxql.DataType)
+	dimensions := make(map[string]struct{})
+	for _, ms := range schema.Measurements {
+		if mm.Regex != nil {
+			if !mm.Regex.Val.MatchString(ms.Name) {
+				continue
+			}
+		} else if ms.Name != mm.Name {
+			continue
+		}
+
+		for _, f := range ms.Fields {
+			typ := dataType(f.Type)
+			if fields[f.Name].LessThan(typ) {
+				fields[f.Name] = typ
+			}
+		}
+		for _, tag := range ms.RequiredTags {
+			dimensions[tag] = struct{}{}
+		}
+	}
+	return fields, dimensions, nil
+}
+
+func (m *schemaFieldMapper) MapType(mm *influxql.Measurement, field string) influxql.DataType {
+	fields, dimensions, err := m.FieldDimensions(mm)
+	if err != nil {
+		return influxql.Unknown
+	}
+	if typ, ok := fields[field]; ok {
+		return typ
+	}
+	if _, ok := dimensions[field]; ok {
+		return influxql.Tag
+	}
+	return influxql.Unknown
+}
+
+// schema returns the schema of the bucket the measurement is read from.
+func (m *schemaFieldMapper) schema(mm *influxql.Measurement) (*platform.BucketSchema, error) {
+	if m.t.bucketSvc == nil {
+		return nil, errors.New(schemaRequiredMsg)
+	}
+
+	id, err := m.t.bucketID(mm)
+	if err != nil {
+		return nil, err
+	}
+	if schema, ok := m.schemas[id]; ok {
+		return schema, nil
+	}
+
+	b, err := m.t.bucketSvc.FindBucketByID(m.ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if b.Schema == nil {
+		return nil, fmt.Errorf("bucket %q has no schema: %s", b.Name, schemaRequiredMsg)
+	}
+
+	if m.schemas == nil {
+		m.schemas = make(map[platform.ID]*platform.BucketSchema)
+	}
+	m.schemas[id] = b.Schema
+	return b.Schema, nil
+}
+
+// dataType returns the InfluxQL data type of a schema field type.
+func dataType(typ platform.SchemaFieldType) influxql.DataType {
+	switch typ {
+	case platform.SchemaFieldTypeFloat:
+		return influxql.Float
+	case platform.SchemaFieldTypeInteger:
+		return influxql.Integer
+	case platform.SchemaFieldTypeUnsigned:
+		return influxql.Unsigned
+	case platform.SchemaFieldTypeString:
+		return influxql.String
+	case platform.SchemaFieldTypeBoolean:
+		return influxql.Boolean
+	default:
+		return influxql.Unknown
+	}
+}
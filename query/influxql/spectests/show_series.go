@@ -0,0 +1,148 @@
+package spectests
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/functions/inputs"
+	"github.com/influxdata/flux/functions/transformations"
+	"github.com/influxdata/flux/semantic"
+)
+
+func init() {
+	RegisterFixture(
+		NewFixture(
+			`SHOW SERIES ON "db0" FROM /^c/ WHERE "host" = 'server01' LIMIT 10`,
+			&flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "from0",
+						Spec: &inputs.FromOpSpec{
+							BucketID: bucketID.String(),
+						},
+					},
+					{
+						ID: "range0",
+						Spec: &transformations.RangeOpSpec{
+							Start: flux.Time{
+								Relative:   -time.Hour,
+								IsRelative: true,
+							},
+							Stop: flux.Now,
+						},
+					},
+					{
+						ID: "filter0",
+						Spec: &transformations.FilterOpSpec{
+							Fn: &semantic.FunctionExpression{
+								Block: &semantic.FunctionBlock{
+									Parameters: &semantic.FunctionParameters{
+										List: []*semantic.FunctionParameter{
+											{Key: &semantic.Identifier{Name: "r"}},
+										},
+									},
+									Body: &semantic.BinaryExpression{
+										Operator: ast.RegexpMatchOperator,
+										Left: &semantic.MemberExpression{
+											Object: &semantic.IdentifierExpression{
+												Name: "r",
+											},
+											Property: "_measurement",
+										},
+										Right: &semantic.RegexpLiteral{
+											Value: regexp.MustCompile(`^c`),
+										},
+									},
+								},
+							},
+						},
+					},
+					{
+						ID: "filter1",
+						Spec: &transformations.FilterOpSpec{
+							Fn: &semantic.FunctionExpression{
+								Block: &semantic.FunctionBlock{
+									Parameters: &semantic.FunctionParameters{
+										List: []*semantic.FunctionParameter{
+											{Key: &semantic.Identifier{Name: "r"}},
+										},
+									},
+									Body: &semantic.BinaryExpression{
+										Operator: ast.EqualOperator,
+										Left: &semantic.MemberExpression{
+											Object: &semantic.IdentifierExpression{
+												Name: "r",
+											},
+											Property: "host",
+										},
+										Right: &semantic.StringLiteral{
+											Value: "server01",
+										},
+									},
+								},
+							},
+						},
+					},
+					{
+						ID: "drop0",
+						Spec: &transformations.DropOpSpec{
+							Columns: []string{
+								execute.DefaultStartColLabel,
+								execute.DefaultStopColLabel,
+								execute.DefaultTimeColLabel,
+								execute.DefaultValueColLabel,
+								"_field",
+							},
+						},
+					},
+					{
+						ID: "limit0",
+						Spec: &transformations.LimitOpSpec{
+							N: 1,
+						},
+					},
+					{
+						ID: "group0",
+						Spec: &transformations.GroupOpSpec{
+							Columns: []string{},
+							Mode:    "by",
+						},
+					},
+					{
+						ID: "sort0",
+						Spec: &transformations.SortOpSpec{
+							Columns: []string{"_measurement"},
+						},
+					},
+					{
+						ID: "limit1",
+						Spec: &transformations.LimitOpSpec{
+							N: 10,
+						},
+					},
+					{
+						ID: "yield0",
+						Spec: &transformations.YieldOpSpec{
+							Name: "0",
+						},
+					},
+				},
+				Edges: []flux.Edge{
+					{Parent: "from0", Child: "range0"},
+					{Parent: "range0", Child: "filter0"},
+					{Parent: "filter0", Child: "filter1"},
+					{Parent: "filter1", Child: "drop0"},
+					{Parent: "drop0", Child: "limit0"},
+					{Parent: "limit0", Child: "group0"},
+					{Parent: "group0", Child: "sort0"},
+					{Parent: "sort0", Child: "limit1"},
+					{Parent: "limit1", Child: "yield0"},
+				},
+				Now: Now(),
+			},
+		),
+	)
+}
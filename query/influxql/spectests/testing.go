@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"path/filepath"
 	"runtime"
+	"strings"
 	"testing"
 	"time"
 
@@ -18,10 +19,15 @@ import (
 )
 
 var dbrpMappingSvc = mock.NewDBRPMappingService()
+var bucketSvc = mock.NewBucketService()
 var organizationID platform.ID
 var bucketID platform.ID
 var altBucketID platform.ID
 
+// noSchemaBucketID is the bucket of the noschema retention policy, which has
+// no schema to expand wildcards with.
+var noSchemaBucketID = platformtesting.MustIDBase16("dddddddddddddddd")
+
 func init() {
 	mapping := platform.DBRPMapping{
 		Cluster:         "cluster",
@@ -39,25 +45,68 @@ func init() {
 		OrganizationID:  organizationID,
 		BucketID:        altBucketID,
 	}
-	dbrpMappingSvc.FindByFn = func(ctx context.Context, cluster string, db string, rp string) (*platform.DBRPMapping, error) {
-		if rp == "alternate" {
-			return &altMapping, nil
+	noSchemaMapping := platform.DBRPMapping{
+		Cluster:         "cluster",
+		Database:        "db0",
+		RetentionPolicy: "noschema",
+		OrganizationID:  organizationID,
+		BucketID:        noSchemaBucketID,
+	}
+	findMapping := func(rp string) *platform.DBRPMapping {
+		switch rp {
+		case "alternate":
+			return &altMapping
+		case "noschema":
+			return &noSchemaMapping
+		default:
+			return &mapping
 		}
-		return &mapping, nil
+	}
+	dbrpMappingSvc.FindByFn = func(ctx context.Context, cluster string, db string, rp string) (*platform.DBRPMapping, error) {
+		return findMapping(rp), nil
 	}
 	dbrpMappingSvc.FindFn = func(ctx context.Context, filter platform.DBRPMappingFilter) (*platform.DBRPMapping, error) {
-		if filter.RetentionPolicy != nil && *filter.RetentionPolicy == "alternate" {
-			return &altMapping, nil
+		if filter.RetentionPolicy != nil {
+			return findMapping(*filter.RetentionPolicy), nil
 		}
 		return &mapping, nil
 	}
 	dbrpMappingSvc.FindManyFn = func(ctx context.Context, filter platform.DBRPMappingFilter, opt ...platform.FindOptions) ([]*platform.DBRPMapping, int, error) {
 		m := &mapping
-		if filter.RetentionPolicy != nil && *filter.RetentionPolicy == "alternate" {
-			m = &altMapping
+		if filter.RetentionPolicy != nil {
+			m = findMapping(*filter.RetentionPolicy)
 		}
 		return []*platform.DBRPMapping{m}, 1, nil
 	}
+
+	// The schema is used to expand wildcards and regular expressions in fields.
+	bucketSvc.FindBucketByIDFn = func(ctx context.Context, id platform.ID) (*platform.Bucket, error) {
+		if id == noSchemaBucketID {
+			return &platform.Bucket{
+				ID:             id,
+				OrganizationID: organizationID,
+				Name:           "db0/noschema",
+			}, nil
+		}
+		return &platform.Bucket{
+			ID:             id,
+			OrganizationID: organizationID,
+			Name:           "db0/autogen",
+			Schema: &platform.BucketSchema{
+				Measurements: []platform.MeasurementSchema{
+					{
+						Name:         "cpu",
+						RequiredTags: []string{"host"},
+						Fields: []platform.FieldSchema{
+							{Name: "status", Type: platform.SchemaFieldTypeString},
+							{Name: "usage_idle", Type: platform.SchemaFieldTypeFloat},
+							{Name: "usage_user", Type: platform.SchemaFieldTypeFloat},
+						},
+					},
+				},
+			},
+		}, nil
+	}
 }
 
 // Fixture is a structure that will run tests.
@@ -101,6 +150,7 @@ func (f *fixture) Run(t *testing.T) {
 				NowFn:           Now,
 			},
 		)
+		transpiler.BucketService = bucketSvc
 		spec, err := transpiler.Transpile(context.Background(), f.stmt)
 		if err != nil {
 			t.Fatalf("%s:%d: unexpected error: %s", f.file, f.line, err)
@@ -126,6 +176,45 @@ func (f *fixture) Run(t *testing.T) {
 	})
 }
 
+type errorFixture struct {
+	stmt string
+	err  string
+
+	file string
+	line int
+}
+
+// NewErrorFixture returns a fixture for a statement that fails to transpile
+// with an error containing err.
+func NewErrorFixture(stmt string, err string) Fixture {
+	_, file, line, _ := runtime.Caller(1)
+	return &errorFixture{
+		stmt: stmt,
+		err:  err,
+		file: filepath.Base(file),
+		line: line,
+	}
+}
+
+func (f *errorFixture) Run(t *testing.T) {
+	t.Run(f.stmt, func(t *testing.T) {
+		transpiler := influxql.NewTranspilerWithConfig(
+			dbrpMappingSvc,
+			influxql.Config{
+				DefaultDatabase: "db0",
+				Cluster:         "cluster",
+				NowFn:           Now,
+			},
+		)
+		transpiler.BucketService = bucketSvc
+		if _, err := transpiler.Transpile(context.Background(), f.stmt); err == nil {
+			t.Fatalf("%s:%d: expected error", f.file, f.line)
+		} else if !strings.Contains(err.Error(), f.err) {
+			t.Fatalf("%s:%d: unexpected error: got %q, want %q", f.file, f.line, err, f.err)
+		}
+	})
+}
+
 type collection struct {
 	stmts []string
 	specs []*flux.Spec
@@ -0,0 +1,22 @@
+package spectests
+
+func init() {
+	RegisterFixture(
+		NewErrorFixture(
+			`SELECT * FROM db0.noschema.cpu`,
+			`bucket "db0/noschema" has no schema`,
+		),
+		NewErrorFixture(
+			`SELECT mean(*) FROM db0.noschema.cpu`,
+			`bucket "db0/noschema" has no schema`,
+		),
+		NewErrorFixture(
+			`SELECT mean(/^usage/) FROM db0.noschema.cpu`,
+			`bucket "db0/noschema" has no schema`,
+		),
+		NewErrorFixture(
+			`SELECT mean(usage_idle) FROM db0.noschema.cpu GROUP BY *`,
+			`bucket "db0/noschema" has no schema`,
+		),
+	)
+}
@@ -17,11 +17,18 @@ import (
 	"github.com/influxdata/influxql"
 	"github.com/influxdata/platform"
 	pinputs "github.com/influxdata/platform/query/functions/inputs"
+	ptransformations "github.com/influxdata/platform/query/functions/transformations"
 )
 
 // Transpiler converts InfluxQL queries into a query spec.
 type Transpiler struct {
-	Config         *Config
+	Config *Config
+
+	// BucketService is used to read the schema of buckets to expand wildcards
+	// and regular expressions in the fields of SELECT statements.
+	// Statements that use them cannot be transpiled without it.
+	BucketService platform.BucketService
+
 	dbrpMappingSvc platform.DBRPMappingService
 }
 
@@ -44,6 +51,7 @@ func (t *Transpiler) Transpile(ctx context.Context, txt string) (*flux.Spec, err
 	}
 
 	transpiler := newTranspilerState(t.dbrpMappingSvc, t.Config)
+	transpiler.bucketSvc = t.BucketService
 	for i, s := range q.Statements {
 		if err := transpiler.Transpile(ctx, i, s); err != nil {
 			return nil, err
@@ -58,6 +66,7 @@ type transpilerState struct {
 	spec           *flux.Spec
 	nextID         map[string]int
 	dbrpMappingSvc platform.DBRPMappingService
+	bucketSvc      platform.BucketService
 }
 
 func newTranspilerState(dbrpMappingSvc platform.DBRPMappingService, config *Config) *transpilerState {
@@ -97,6 +106,14 @@ func (t *transpilerState) transpile(ctx context.Context, s influxql.Statement) (
 		return t.transpileShowDatabases(ctx, stmt)
 	case *influxql.ShowRetentionPoliciesStatement:
 		return t.transpileShowRetentionPolicies(ctx, stmt)
+	case *influxql.ShowMeasurementsStatement:
+		return t.transpileShowMeasurements(stmt)
+	case *influxql.ShowTagKeysStatement:
+		return t.transpileShowTagKeys(stmt)
+	case *influxql.ShowFieldKeysStatement:
+		return t.transpileShowFieldKeys(stmt)
+	case *influxql.ShowSeriesStatement:
+		return t.transpileShowSeries(stmt)
 	default:
 		return "", fmt.Errorf("unknown statement type %T", s)
 	}
@@ -298,6 +315,19 @@ func (t *transpilerState) transpileSelect(ctx context.Context, stmt *influxql.Se
 	t.stmt = stmt.Clone()
 	t.stmt.OmitTime = true
 
+	// Expand the wildcards and regular expressions in the fields and dimensions
+	// with the fields and tags of the bucket schema.
+	if t.stmt.HasFieldWildcard() || t.stmt.HasDimensionWildcard() {
+		if err := validateWildcardFunctions(t.stmt); err != nil {
+			return "", err
+		}
+		other, err := t.stmt.RewriteFields(&schemaFieldMapper{ctx: ctx, t: t})
+		if err != nil {
+			return "", err
+		}
+		t.stmt = other
+	}
+
 	groups, err := identifyGroups(t.stmt)
 	if err != nil {
 		return "", err
@@ -323,7 +353,78 @@ func (t *transpilerState) transpileSelect(ctx context.Context, stmt *influxql.Se
 	if err != nil {
 		return "", err
 	}
-	return cur.ID(), nil
+
+	// Each table is a series now, so the rows can be ordered and limited.
+	id, err := t.orderBy(cur.ID())
+	if err != nil {
+		return "", err
+	}
+	id = t.limit(t.stmt.Limit, t.stmt.Offset, id)
+	return t.seriesLimit(t.stmt.SLimit, t.stmt.SOffset, id), nil
+}
+
+// orderBy sorts the rows of each series by time in descending order if the
+// statement has an ORDER BY time DESC.
+func (t *transpilerState) orderBy(parent flux.OperationID) (flux.OperationID, error) {
+	if len(t.stmt.SortFields) == 0 {
+		return parent, nil
+	} else if len(t.stmt.SortFields) > 1 || t.stmt.SortFields[0].Name != "time" {
+		return "", errors.New("only ORDER BY time supported at this time")
+	} else if t.stmt.SortFields[0].Ascending {
+		return parent, nil
+	}
+	return t.op("sort", &transformations.SortOpSpec{
+		Columns: []string{execute.DefaultTimeColLabel},
+		Desc:    true,
+	}, parent), nil
+}
+
+// seriesLimit adds a limit of the series if the statement has a SLIMIT or SOFFSET.
+func (t *transpilerState) seriesLimit(limit, offset int, parent flux.OperationID) flux.OperationID {
+	if limit == 0 && offset == 0 {
+		return parent
+	}
+	n := int64(limit)
+	if n == 0 {
+		// A SOFFSET without a SLIMIT returns all the remaining series.
+		n = int64(^uint64(0) >> 1)
+	}
+	return t.op("tableLimit", &ptransformations.TableLimitOpSpec{
+		N:      n,
+		Offset: int64(offset),
+	}, parent)
+}
+
+// validateWildcardFunctions returns an error if a function of a wildcard or a
+// regular expression is mixed with fields. Such a function is an aggregate of
+// every matching field, even if it is a selector.
+func validateWildcardFunctions(stmt *influxql.SelectStatement) error {
+	var hasWildcardFunction, hasFields bool
+	for _, f := range stmt.Fields {
+		call, ok := f.Expr.(*influxql.Call)
+		if !ok {
+			if ref, ok := f.Expr.(*influxql.VarRef); !ok || ref.Val != "time" {
+				hasFields = true
+			}
+			continue
+		}
+
+		for len(call.Args) > 0 {
+			switch arg := call.Args[0].(type) {
+			case *influxql.Call:
+				call = arg
+				continue
+			case *influxql.Wildcard, *influxql.RegexLiteral:
+				hasWildcardFunction = true
+			}
+			break
+		}
+	}
+
+	if hasWildcardFunction && hasFields {
+		return errors.New("mixing aggregate and non-aggregate queries is not supported")
+	}
+	return nil
 }
 
 func (t *transpilerState) mapType(ref *influxql.VarRef) influxql.DataType {
@@ -332,10 +433,24 @@ func (t *transpilerState) mapType(ref *influxql.VarRef) influxql.DataType {
 }
 
 func (t *transpilerState) from(m *influxql.Measurement) (flux.OperationID, error) {
+	bucketID, err := t.bucketID(m)
+	if err != nil {
+		return "", err
+	}
+
+	spec := &inputs.FromOpSpec{
+		BucketID: bucketID.String(),
+	}
+	return t.op("from", spec), nil
+}
+
+// bucketID returns the ID of the bucket the database and retention policy of
+// the measurement are mapped to.
+func (t *transpilerState) bucketID(m *influxql.Measurement) (platform.ID, error) {
 	db, rp := m.Database, m.RetentionPolicy
 	if db == "" {
 		if t.config.DefaultDatabase == "" {
-			return "", errors.New("database is required")
+			return 0, errors.New("database is required")
 		}
 		db = t.config.DefaultDatabase
 	}
@@ -357,13 +472,9 @@ func (t *transpilerState) from(m *influxql.Measurement) (flux.OperationID, error
 	filter.Default = &defaultRP
 	mapping, err := t.dbrpMappingSvc.Find(context.TODO(), filter)
 	if err != nil {
-		return "", err
+		return 0, err
 	}
-
-	spec := &inputs.FromOpSpec{
-		BucketID: mapping.BucketID.String(),
-	}
-	return t.op("from", spec), nil
+	return mapping.BucketID, nil
 }
 
 func (t *transpilerState) op(name string, spec flux.OperationSpec, parents ...flux.OperationID) flux.OperationID {
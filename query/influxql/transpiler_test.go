@@ -14,6 +14,7 @@ import (
 )
 
 var dbrpMappingSvc = mock.NewDBRPMappingService()
+var bucketSvc = mock.NewBucketService()
 
 func init() {
 	mapping := platform.DBRPMapping{
@@ -33,6 +34,24 @@ func init() {
 	dbrpMappingSvc.FindManyFn = func(ctx context.Context, filter platform.DBRPMappingFilter, opt ...platform.FindOptions) ([]*platform.DBRPMapping, int, error) {
 		return []*platform.DBRPMapping{&mapping}, 1, nil
 	}
+	bucketSvc.FindBucketByIDFn = func(ctx context.Context, id platform.ID) (*platform.Bucket, error) {
+		return &platform.Bucket{
+			ID:   id,
+			Name: "db0/autogen",
+			Schema: &platform.BucketSchema{
+				Measurements: []platform.MeasurementSchema{
+					{
+						Name:         "cpu",
+						RequiredTags: []string{"host"},
+						Fields: []platform.FieldSchema{
+							{Name: "total", Type: platform.SchemaFieldTypeFloat},
+							{Name: "value", Type: platform.SchemaFieldTypeFloat},
+						},
+					},
+				},
+			},
+		}, nil
+	}
 }
 
 func TestTranspiler(t *testing.T) {
@@ -393,6 +412,7 @@ func TestTranspiler_Compile(t *testing.T) {
 					DefaultDatabase: "db0",
 				},
 			)
+			transpiler.BucketService = bucketSvc
 			if _, err := transpiler.Transpile(context.Background(), tt.s); err != nil {
 				if got, want := err.Error(), tt.err; got != want {
 					if cause := errors.Cause(err); strings.HasPrefix(cause.Error(), "unimplemented") {
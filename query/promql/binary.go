@@ -0,0 +1,373 @@
+package promql
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/functions/transformations"
+	"github.com/influxdata/flux/semantic"
+)
+
+// BinaryOpKind is an enum for the binary operators.
+type BinaryOpKind int
+
+// Possible BinaryOpKinds.
+const (
+	UnknownBinaryOpKind BinaryOpKind = iota
+	AddKind
+	SubKind
+	MulKind
+	DivKind
+	ModKind
+	PowKind
+	EqualKind
+	NotEqualKind
+	GreaterKind
+	GreaterEqualKind
+	LessKind
+	LessEqualKind
+)
+
+func ToBinaryOpKind(op string) BinaryOpKind {
+	switch op {
+	case "+":
+		return AddKind
+	case "-":
+		return SubKind
+	case "*":
+		return MulKind
+	case "/":
+		return DivKind
+	case "%":
+		return ModKind
+	case "^":
+		return PowKind
+	case "==":
+		return EqualKind
+	case "!=":
+		return NotEqualKind
+	case ">":
+		return GreaterKind
+	case ">=":
+		return GreaterEqualKind
+	case "<":
+		return LessKind
+	case "<=":
+		return LessEqualKind
+	default:
+		return UnknownBinaryOpKind
+	}
+}
+
+// IsComparison returns true for the operators that compare their operands.
+func (k BinaryOpKind) IsComparison() bool {
+	return k >= EqualKind
+}
+
+// binaryOperatorLookup maps the operators to flux.
+// Flux has no modulo or power operators.
+var binaryOperatorLookup = map[BinaryOpKind]ast.OperatorKind{
+	AddKind:          ast.AdditionOperator,
+	SubKind:          ast.SubtractionOperator,
+	MulKind:          ast.MultiplicationOperator,
+	DivKind:          ast.DivisionOperator,
+	EqualKind:        ast.EqualOperator,
+	NotEqualKind:     ast.NotEqualOperator,
+	GreaterKind:      ast.GreaterThanOperator,
+	GreaterEqualKind: ast.GreaterThanEqualOperator,
+	LessKind:         ast.LessThanOperator,
+	LessEqualKind:    ast.LessThanEqualOperator,
+}
+
+// VectorMatching selects the labels that match the samples
+// of the two vectors of a binary expression.
+type VectorMatching struct {
+	On       bool          `json:"on,omitempty"`
+	Ignoring bool          `json:"ignoring,omitempty"`
+	Labels   []*Identifier `json:"labels,omitempty"`
+}
+
+func NewVectorMatching(kind string, labels interface{}) (*VectorMatching, error) {
+	matching := &VectorMatching{}
+	if strings.ToLower(kind) == "on" {
+		matching.On = true
+	} else {
+		matching.Ignoring = true
+	}
+	if labels != nil {
+		matching.Labels = labels.([]*Identifier)
+	}
+	return matching, nil
+}
+
+func (m *VectorMatching) labels() []string {
+	labels := make([]string, len(m.Labels))
+	for i := range m.Labels {
+		labels[i] = m.Labels[i].Name
+	}
+	return labels
+}
+
+type BinaryExpr struct {
+	Op         BinaryOpKind    `json:"op,omitempty"`
+	LHS        Expr            `json:"lhs,omitempty"`
+	RHS        Expr            `json:"rhs,omitempty"`
+	ReturnBool bool            `json:"return_bool,omitempty"`
+	Matching   *VectorMatching `json:"matching,omitempty"`
+}
+
+// binaryOperand is the operator and the right hand side of a binary
+// expression whose left hand side is still being parsed.
+type binaryOperand struct {
+	op         BinaryOpKind
+	returnBool bool
+	matching   *VectorMatching
+	rhs        Expr
+}
+
+func NewBinaryOperand(op BinaryOpKind, returnBool bool, matching interface{}, rhs Expr) (*binaryOperand, error) {
+	operand := &binaryOperand{
+		op:         op,
+		returnBool: returnBool,
+		rhs:        rhs,
+	}
+	if matching != nil {
+		operand.matching = matching.(*VectorMatching)
+	}
+	return operand, nil
+}
+
+// NewBinaryExprs returns the left associative binary expressions of the first
+// expression and the rest of the operands.
+func NewBinaryExprs(first Expr, rest interface{}) (Expr, error) {
+	expr := first
+	for _, r := range toIfaceSlice(rest) {
+		operand := r.(*binaryOperand)
+		var err error
+		expr, err = NewBinaryExpr(operand.op, expr, operand.rhs, operand.returnBool, operand.matching)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return expr, nil
+}
+
+// NewBinaryExpr returns the binary expression of the operands.
+// Expressions between scalars are evaluated right away.
+func NewBinaryExpr(op BinaryOpKind, lhs, rhs Expr, returnBool bool, matching *VectorMatching) (Expr, error) {
+	l, lok := lhs.(*Number)
+	r, rok := rhs.(*Number)
+	if returnBool && !op.IsComparison() {
+		return nil, fmt.Errorf("bool modifier can only be used on comparison operators")
+	}
+	if matching != nil && (lok || rok) {
+		return nil, fmt.Errorf("vector matching only allowed between vectors")
+	}
+	if lok && rok {
+		return evalScalars(op, l.Val, r.Val, returnBool)
+	}
+	return &BinaryExpr{
+		Op:         op,
+		LHS:        lhs,
+		RHS:        rhs,
+		ReturnBool: returnBool,
+		Matching:   matching,
+	}, nil
+}
+
+func evalScalars(op BinaryOpKind, l, r float64, returnBool bool) (*Number, error) {
+	if op.IsComparison() && !returnBool {
+		return nil, fmt.Errorf("comparisons between scalars must use bool modifier")
+	}
+	var v float64
+	switch op {
+	case AddKind:
+		v = l + r
+	case SubKind:
+		v = l - r
+	case MulKind:
+		v = l * r
+	case DivKind:
+		v = l / r
+	case ModKind:
+		v = math.Mod(l, r)
+	case PowKind:
+		v = math.Pow(l, r)
+	case EqualKind:
+		v = boolToFloat(l == r)
+	case NotEqualKind:
+		v = boolToFloat(l != r)
+	case GreaterKind:
+		v = boolToFloat(l > r)
+	case GreaterEqualKind:
+		v = boolToFloat(l >= r)
+	case LessKind:
+		v = boolToFloat(l < r)
+	case LessEqualKind:
+		v = boolToFloat(l <= r)
+	default:
+		return nil, fmt.Errorf("unknown binary operator kind %d", op)
+	}
+	return &Number{v}, nil
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func (e *BinaryExpr) QuerySpec() (*flux.Spec, error) {
+	return querySpec(e)
+}
+
+func (e *BinaryExpr) build(b *specBuilder) (vector, error) {
+	op, ok := binaryOperatorLookup[e.Op]
+	if !ok {
+		return vector{}, fmt.Errorf("unable to run binary operator kind %d yet", e.Op)
+	}
+	if n, ok := e.RHS.(*Number); ok {
+		return e.buildScalar(b, op, e.LHS, n, false)
+	}
+	if n, ok := e.LHS.(*Number); ok {
+		return e.buildScalar(b, op, e.RHS, n, true)
+	}
+	return e.buildVectors(b, op)
+}
+
+// buildScalar applies the operator between the samples of the vector and
+// the scalar. Comparisons without the bool modifier filter the samples.
+func (e *BinaryExpr) buildScalar(b *specBuilder, op ast.OperatorKind, expr Expr, n *Number, scalarLeft bool) (vector, error) {
+	in, err := expr.build(b)
+	if err != nil {
+		return vector{}, err
+	}
+	value := column(execute.DefaultValueColLabel)
+	scalar := &semantic.FloatLiteral{Value: n.Val}
+	operation := &semantic.BinaryExpression{
+		Operator: op,
+		Left:     value,
+		Right:    scalar,
+	}
+	if scalarLeft {
+		operation.Left, operation.Right = scalar, value
+	}
+	return e.apply(b, in.id, operation, value, in.hasTime), nil
+}
+
+// buildVectors joins the samples of the vectors that have the same labels
+// and applies the operator between their values. The metric name is
+// dropped, and only the matching labels are kept with on.
+func (e *BinaryExpr) buildVectors(b *specBuilder, op ast.OperatorKind) (vector, error) {
+	lhs, err := e.LHS.build(b)
+	if err != nil {
+		return vector{}, err
+	}
+	rhs, err := e.RHS.build(b)
+	if err != nil {
+		return vector{}, err
+	}
+
+	var on []string
+	if e.Matching != nil && e.Matching.On {
+		on = e.Matching.labels()
+		if lhs.hasTime && rhs.hasTime {
+			on = append(on, execute.DefaultTimeColLabel)
+		}
+	}
+	lhsValue := execute.DefaultValueColLabel + "_lhs"
+	rhsValue := execute.DefaultValueColLabel + "_rhs"
+	left := e.matchingLabels(b, lhs, lhsValue)
+	right := e.matchingLabels(b, rhs, rhsValue)
+
+	join := b.op("join", &transformations.JoinOpSpec{
+		TableNames: map[flux.OperationID]string{
+			left:  "lhs",
+			right: "rhs",
+		},
+		On:     on,
+		Method: "inner",
+	}, left, right)
+	if on == nil {
+		// The samples were joined on all the columns the vectors share.
+		// Group them back into series.
+		join = b.op("group", &transformations.GroupOpSpec{
+			Mode:    "except",
+			Columns: []string{lhsValue, rhsValue, execute.DefaultTimeColLabel},
+		}, join)
+	}
+
+	operation := &semantic.BinaryExpression{
+		Operator: op,
+		Left:     column(lhsValue),
+		Right:    column(rhsValue),
+	}
+	return e.apply(b, join, operation, column(lhsValue), lhs.hasTime || rhs.hasTime), nil
+}
+
+// matchingLabels keeps the labels of the vector used to match the samples,
+// and renames its value column so that it is not matched.
+func (e *BinaryExpr) matchingLabels(b *specBuilder, in vector, value string) flux.OperationID {
+	if e.Matching != nil && e.Matching.On {
+		columns := append(e.Matching.labels(), execute.DefaultValueColLabel)
+		if in.hasTime {
+			columns = append(columns, execute.DefaultTimeColLabel)
+		}
+		id := b.op("keep", &transformations.KeepOpSpec{
+			Columns: columns,
+		}, in.id)
+		return b.op("rename", &transformations.RenameOpSpec{
+			Columns: map[string]string{
+				execute.DefaultValueColLabel: value,
+			},
+		}, id)
+	}
+
+	columns := []string{"_metric", execute.DefaultStartColLabel, execute.DefaultStopColLabel}
+	if e.Matching != nil {
+		columns = append(columns, e.Matching.labels()...)
+	}
+	id := b.op("drop", &transformations.DropOpSpec{
+		Columns: columns,
+	}, in.id)
+	id = b.op("rename", &transformations.RenameOpSpec{
+		Columns: map[string]string{
+			execute.DefaultValueColLabel: value,
+		},
+	}, id)
+	// Join only intersects the group keys on the columns it is given,
+	// so the series are joined as a single table.
+	return b.op("group", &transformations.GroupOpSpec{
+		Mode:    "by",
+		Columns: []string{},
+	}, id)
+}
+
+// apply outputs the result of the operation as the value of the samples.
+// Comparisons without the bool modifier keep the samples for which the
+// operation is true, with the value of the left hand side.
+func (e *BinaryExpr) apply(b *specBuilder, parent flux.OperationID, operation *semantic.BinaryExpression, lhs semantic.Expression, hasTime bool) vector {
+	var value semantic.Expression = operation
+	if e.Op.IsComparison() {
+		if e.ReturnBool {
+			value = toFloat(operation)
+		} else {
+			parent = b.op("filter", &transformations.FilterOpSpec{
+				Fn: rowFn(operation),
+			}, parent)
+			value = lhs
+		}
+	}
+	return vector{
+		id: b.op("map", &transformations.MapOpSpec{
+			Fn:       rowFn(valueRow(value, hasTime)),
+			MergeKey: true,
+		}, parent),
+		hasTime: hasTime,
+	}
+}
@@ -0,0 +1,124 @@
+package promql
+
+import (
+	"fmt"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/semantic"
+)
+
+// Expr is a promql expression that can be built into flux operations.
+type Expr interface {
+	QueryBuilder
+	// build adds the operations that compute the expression to the spec.
+	build(b *specBuilder) (vector, error)
+}
+
+// vector is the output of the operations that compute an expression.
+type vector struct {
+	// id is the operation that outputs the tables of the vector.
+	id flux.OperationID
+	// hasTime is set when the tables still have a time column.
+	// Aggregates drop it.
+	hasTime bool
+}
+
+// specBuilder adds the operations of expressions to a flux.Spec.
+// Operations are identified by their name; when a name is used more
+// than once, the next operations get a numbered suffix.
+type specBuilder struct {
+	spec *flux.Spec
+	ids  map[string]int
+}
+
+func newSpecBuilder() *specBuilder {
+	return &specBuilder{
+		spec: &flux.Spec{
+			Operations: []*flux.Operation{},
+			Edges:      []flux.Edge{},
+		},
+		ids: make(map[string]int),
+	}
+}
+
+// querySpec returns the spec that computes the expression.
+func querySpec(expr Expr) (*flux.Spec, error) {
+	b := newSpecBuilder()
+	if _, err := expr.build(b); err != nil {
+		return nil, err
+	}
+	return b.spec, nil
+}
+
+// op adds an operation with the spec to the parents and returns its ID.
+func (b *specBuilder) op(name string, spec flux.OperationSpec, parents ...flux.OperationID) flux.OperationID {
+	id := flux.OperationID(name)
+	if n := b.ids[name]; n > 0 {
+		id = flux.OperationID(fmt.Sprintf("%s%d", name, n))
+	}
+	b.ids[name]++
+
+	b.spec.Operations = append(b.spec.Operations, &flux.Operation{
+		ID:   id,
+		Spec: spec,
+	})
+	for _, parent := range parents {
+		b.spec.Edges = append(b.spec.Edges, flux.Edge{
+			Parent: parent,
+			Child:  id,
+		})
+	}
+	return id
+}
+
+// rowFn returns the function of a row r that evaluates to body.
+func rowFn(body semantic.Expression) *semantic.FunctionExpression {
+	return &semantic.FunctionExpression{
+		Block: &semantic.FunctionBlock{
+			Parameters: &semantic.FunctionParameters{
+				List: []*semantic.FunctionParameter{{Key: &semantic.Identifier{Name: "r"}}},
+			},
+			Body: body,
+		},
+	}
+}
+
+// column returns the expression that references the column of the row r.
+func column(name string) *semantic.MemberExpression {
+	return &semantic.MemberExpression{
+		Object: &semantic.IdentifierExpression{
+			Name: "r",
+		},
+		Property: name,
+	}
+}
+
+// valueRow returns the object of a row with the value, keeping the time
+// column when the vector has one.
+func valueRow(value semantic.Expression, hasTime bool) *semantic.ObjectExpression {
+	properties := []*semantic.Property{{
+		Key:   &semantic.Identifier{Name: execute.DefaultValueColLabel},
+		Value: value,
+	}}
+	if hasTime {
+		properties = append(properties, &semantic.Property{
+			Key:   &semantic.Identifier{Name: execute.DefaultTimeColLabel},
+			Value: column(execute.DefaultTimeColLabel),
+		})
+	}
+	return &semantic.ObjectExpression{Properties: properties}
+}
+
+// toFloat returns the expression that converts the value to a float.
+func toFloat(value semantic.Expression) *semantic.CallExpression {
+	return &semantic.CallExpression{
+		Callee: &semantic.IdentifierExpression{Name: "float"},
+		Arguments: &semantic.ObjectExpression{
+			Properties: []*semantic.Property{{
+				Key:   &semantic.Identifier{Name: "v"},
+				Value: value,
+			}},
+		},
+	}
+}
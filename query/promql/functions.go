@@ -0,0 +1,294 @@
+package promql
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/functions/transformations"
+	"github.com/influxdata/flux/semantic"
+)
+
+// function describes a promql function.
+type function struct {
+	// args are the kinds of the arguments of the function:
+	// NumberKind for a scalar, SelectorKind for a range vector
+	// and ExprKind for an instant vector.
+	args []ArgKind
+	// build adds the operations of the function to the spec.
+	build func(b *specBuilder, args []Expr) (vector, error)
+}
+
+var functions = map[string]function{
+	"rate": {
+		args: []ArgKind{SelectorKind},
+		build: func(b *specBuilder, args []Expr) (vector, error) {
+			sel := args[0].(*Selector)
+			in, err := increase(b, sel, true)
+			if err != nil {
+				return vector{}, err
+			}
+			// The increase is averaged over the seconds of the range.
+			rate := &semantic.BinaryExpression{
+				Operator: ast.DivisionOperator,
+				Left:     column(execute.DefaultValueColLabel),
+				Right:    &semantic.FloatLiteral{Value: sel.Range.Seconds()},
+			}
+			return vector{
+				id: b.op("map", &transformations.MapOpSpec{
+					Fn:       rowFn(valueRow(rate, false)),
+					MergeKey: true,
+				}, in.id),
+			}, nil
+		},
+	},
+	"irate": {
+		args: []ArgKind{SelectorKind},
+		build: func(b *specBuilder, args []Expr) (vector, error) {
+			return overTime(b, args[0], true,
+				&flux.Operation{
+					ID: "derivative",
+					Spec: &transformations.DerivativeOpSpec{
+						Unit:        flux.Duration(time.Second),
+						NonNegative: true,
+						Columns:     []string{execute.DefaultValueColLabel},
+						TimeColumn:  execute.DefaultTimeColLabel,
+					},
+				},
+				&flux.Operation{
+					ID:   "last",
+					Spec: &transformations.LastOpSpec{},
+				},
+			)
+		},
+	},
+	"increase": {
+		args: []ArgKind{SelectorKind},
+		build: func(b *specBuilder, args []Expr) (vector, error) {
+			return increase(b, args[0].(*Selector), true)
+		},
+	},
+	"delta": {
+		args: []ArgKind{SelectorKind},
+		build: func(b *specBuilder, args []Expr) (vector, error) {
+			return increase(b, args[0].(*Selector), false)
+		},
+	},
+	"avg_over_time": {
+		args: []ArgKind{SelectorKind},
+		build: func(b *specBuilder, args []Expr) (vector, error) {
+			return overTime(b, args[0], false, &flux.Operation{
+				ID: "mean",
+				Spec: &transformations.MeanOpSpec{
+					AggregateConfig: execute.DefaultAggregateConfig,
+				},
+			})
+		},
+	},
+	"min_over_time": {
+		args: []ArgKind{SelectorKind},
+		build: func(b *specBuilder, args []Expr) (vector, error) {
+			return overTime(b, args[0], true, &flux.Operation{
+				ID:   "min",
+				Spec: &transformations.MinOpSpec{},
+			})
+		},
+	},
+	"max_over_time": {
+		args: []ArgKind{SelectorKind},
+		build: func(b *specBuilder, args []Expr) (vector, error) {
+			return overTime(b, args[0], true, &flux.Operation{
+				ID:   "max",
+				Spec: &transformations.MaxOpSpec{},
+			})
+		},
+	},
+	"sum_over_time": {
+		args: []ArgKind{SelectorKind},
+		build: func(b *specBuilder, args []Expr) (vector, error) {
+			return overTime(b, args[0], false, &flux.Operation{
+				ID: "sum",
+				Spec: &transformations.SumOpSpec{
+					AggregateConfig: execute.DefaultAggregateConfig,
+				},
+			})
+		},
+	},
+	"count_over_time": {
+		args: []ArgKind{SelectorKind},
+		build: func(b *specBuilder, args []Expr) (vector, error) {
+			return overTime(b, args[0], false, &flux.Operation{
+				ID: "count",
+				Spec: &transformations.CountOpSpec{
+					AggregateConfig: execute.DefaultAggregateConfig,
+				},
+			})
+		},
+	},
+	"stddev_over_time": {
+		args: []ArgKind{SelectorKind},
+		build: func(b *specBuilder, args []Expr) (vector, error) {
+			return overTime(b, args[0], false, &flux.Operation{
+				ID: "stddev",
+				Spec: &transformations.StddevOpSpec{
+					AggregateConfig: execute.DefaultAggregateConfig,
+				},
+			})
+		},
+	},
+	"quantile_over_time": {
+		args: []ArgKind{NumberKind, SelectorKind},
+		build: func(b *specBuilder, args []Expr) (vector, error) {
+			return overTime(b, args[1], false, &flux.Operation{
+				ID: "percentile",
+				Spec: &transformations.PercentileOpSpec{
+					Percentile:      args[0].(*Number).Val,
+					Method:          "exact_mean",
+					AggregateConfig: execute.DefaultAggregateConfig,
+				},
+			})
+		},
+	},
+	"histogram_quantile": {
+		args: []ArgKind{NumberKind, ExprKind},
+		build: func(b *specBuilder, args []Expr) (vector, error) {
+			in, err := args[1].build(b)
+			if err != nil {
+				return vector{}, err
+			}
+			// The buckets of a histogram are the series that only differ
+			// by their upper bound, the le label.
+			group := b.op("group", &transformations.GroupOpSpec{
+				Mode:    "except",
+				Columns: []string{"le", execute.DefaultTimeColLabel, execute.DefaultValueColLabel},
+			}, in.id)
+			bounds := b.op("map", &transformations.MapOpSpec{
+				Fn: rowFn(&semantic.ObjectExpression{
+					Properties: []*semantic.Property{
+						{
+							Key:   &semantic.Identifier{Name: "le"},
+							Value: toFloat(column("le")),
+						},
+						{
+							Key:   &semantic.Identifier{Name: execute.DefaultValueColLabel},
+							Value: column(execute.DefaultValueColLabel),
+						},
+					},
+				}),
+				MergeKey: true,
+			}, group)
+			return vector{
+				id: b.op("histogramQuantile", &transformations.HistogramQuantileOpSpec{
+					Quantile:         args[0].(*Number).Val,
+					CountColumn:      execute.DefaultValueColLabel,
+					UpperBoundColumn: "le",
+					ValueColumn:      execute.DefaultValueColLabel,
+				}, bounds),
+			}, nil
+		},
+	},
+}
+
+// increase adds the difference between the first and the last samples of the
+// range vector. Counter resets are ignored when nonNegative is set.
+func increase(b *specBuilder, sel *Selector, nonNegative bool) (vector, error) {
+	return overTime(b, sel, false,
+		&flux.Operation{
+			ID: "difference",
+			Spec: &transformations.DifferenceOpSpec{
+				NonNegative: nonNegative,
+				Columns:     []string{execute.DefaultValueColLabel},
+			},
+		},
+		&flux.Operation{
+			ID: "sum",
+			Spec: &transformations.SumOpSpec{
+				AggregateConfig: execute.DefaultAggregateConfig,
+			},
+		},
+	)
+}
+
+// overTime adds the operations that compute the samples of the range vector
+// into a single sample. When the last operation is a selector, the time of
+// the selected sample is dropped like aggregates do, so that the sample
+// matches the samples of other range vectors.
+func overTime(b *specBuilder, expr Expr, selector bool, ops ...*flux.Operation) (vector, error) {
+	in, err := expr.build(b)
+	if err != nil {
+		return vector{}, err
+	}
+	parent := in.id
+	for _, op := range ops {
+		parent = b.op(string(op.ID), op.Spec, parent)
+	}
+	if selector {
+		parent = b.op("drop", &transformations.DropOpSpec{
+			Columns: []string{execute.DefaultTimeColLabel},
+		}, parent)
+	}
+	return vector{id: parent}, nil
+}
+
+type Function struct {
+	Name string `json:"name,omitempty"`
+	Args []Expr `json:"args,omitempty"`
+}
+
+func (f *Function) QuerySpec() (*flux.Spec, error) {
+	return querySpec(f)
+}
+
+func (f *Function) build(b *specBuilder) (vector, error) {
+	return functions[f.Name].build(b, f.Args)
+}
+
+// NewFunction returns the call of the function, checking its arguments.
+func NewFunction(name *Identifier, args interface{}) (*Function, error) {
+	fn, ok := functions[name.Name]
+	if !ok {
+		return nil, fmt.Errorf("unknown function %q", name.Name)
+	}
+	var exprs []Expr
+	if args != nil {
+		exprs = args.([]Expr)
+	}
+	if len(exprs) != len(fn.args) {
+		return nil, fmt.Errorf("expected %d argument(s) in call to %q, got %d", len(fn.args), name.Name, len(exprs))
+	}
+	for i, kind := range fn.args {
+		switch arg := exprs[i]; kind {
+		case NumberKind:
+			if _, ok := arg.(*Number); !ok {
+				return nil, fmt.Errorf("expected type scalar in call to function %q", name.Name)
+			}
+		case SelectorKind:
+			if sel, ok := arg.(*Selector); !ok || sel.Range == 0 {
+				return nil, fmt.Errorf("expected type range vector in call to function %q", name.Name)
+			}
+		default:
+			if sel, ok := arg.(*Selector); ok && sel.Range != 0 {
+				return nil, fmt.Errorf("expected type instant vector in call to function %q", name.Name)
+			}
+			if _, ok := arg.(*Number); ok {
+				return nil, fmt.Errorf("expected type instant vector in call to function %q", name.Name)
+			}
+		}
+	}
+	return &Function{
+		Name: name.Name,
+		Args: exprs,
+	}, nil
+}
+
+func NewExprList(first Expr, rest interface{}) ([]Expr, error) {
+	exprs := []Expr{first}
+	for _, e := range toIfaceSlice(rest) {
+		if expr, ok := e.(Expr); ok {
+			exprs = append(exprs, expr)
+		}
+	}
+	return exprs, nil
+}
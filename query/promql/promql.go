@@ -48,17 +48,13 @@ var g = &grammar{
 									},
 									&ruleRefExpr{
 										pos:  position{line: 11, col: 32, offset: 265},
-										name: "AggregateExpression",
-									},
-									&ruleRefExpr{
-										pos:  position{line: 11, col: 54, offset: 287},
-										name: "VectorSelector",
+										name: "Expression",
 									},
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 11, col: 71, offset: 304},
+							pos:  position{line: 11, col: 45, offset: 278},
 							name: "EOF",
 						},
 					},
@@ -67,39 +63,39 @@ var g = &grammar{
 		},
 		{
 			name: "SourceChar",
-			pos:  position{line: 15, col: 1, offset: 337},
+			pos:  position{line: 15, col: 1, offset: 311},
 			expr: &anyMatcher{
-				line: 15, col: 14, offset: 350,
+				line: 15, col: 14, offset: 324,
 			},
 		},
 		{
 			name: "Comment",
-			pos:  position{line: 17, col: 1, offset: 353},
+			pos:  position{line: 17, col: 1, offset: 327},
 			expr: &actionExpr{
-				pos: position{line: 17, col: 11, offset: 363},
+				pos: position{line: 17, col: 11, offset: 337},
 				run: (*parser).callonComment1,
 				expr: &seqExpr{
-					pos: position{line: 17, col: 11, offset: 363},
+					pos: position{line: 17, col: 11, offset: 337},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 17, col: 11, offset: 363},
+							pos:        position{line: 17, col: 11, offset: 337},
 							val:        "#",
 							ignoreCase: false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 17, col: 15, offset: 367},
+							pos: position{line: 17, col: 15, offset: 341},
 							expr: &seqExpr{
-								pos: position{line: 17, col: 17, offset: 369},
+								pos: position{line: 17, col: 17, offset: 343},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 17, col: 17, offset: 369},
+										pos: position{line: 17, col: 17, offset: 343},
 										expr: &ruleRefExpr{
-											pos:  position{line: 17, col: 18, offset: 370},
+											pos:  position{line: 17, col: 18, offset: 344},
 											name: "EOL",
 										},
 									},
 									&ruleRefExpr{
-										pos:  position{line: 17, col: 22, offset: 374},
+										pos:  position{line: 17, col: 22, offset: 348},
 										name: "SourceChar",
 									},
 								},
@@ -111,15 +107,15 @@ var g = &grammar{
 		},
 		{
 			name: "Identifier",
-			pos:  position{line: 21, col: 1, offset: 434},
+			pos:  position{line: 21, col: 1, offset: 408},
 			expr: &actionExpr{
-				pos: position{line: 21, col: 14, offset: 447},
+				pos: position{line: 21, col: 14, offset: 421},
 				run: (*parser).callonIdentifier1,
 				expr: &labeledExpr{
-					pos:   position{line: 21, col: 14, offset: 447},
+					pos:   position{line: 21, col: 14, offset: 421},
 					label: "ident",
 					expr: &ruleRefExpr{
-						pos:  position{line: 21, col: 20, offset: 453},
+						pos:  position{line: 21, col: 20, offset: 427},
 						name: "IdentifierName",
 					},
 				},
@@ -127,21 +123,21 @@ var g = &grammar{
 		},
 		{
 			name: "IdentifierName",
-			pos:  position{line: 29, col: 1, offset: 637},
+			pos:  position{line: 29, col: 1, offset: 611},
 			expr: &actionExpr{
-				pos: position{line: 29, col: 18, offset: 654},
+				pos: position{line: 29, col: 18, offset: 628},
 				run: (*parser).callonIdentifierName1,
 				expr: &seqExpr{
-					pos: position{line: 29, col: 18, offset: 654},
+					pos: position{line: 29, col: 18, offset: 628},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 29, col: 18, offset: 654},
+							pos:  position{line: 29, col: 18, offset: 628},
 							name: "IdentifierStart",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 29, col: 34, offset: 670},
+							pos: position{line: 29, col: 34, offset: 644},
 							expr: &ruleRefExpr{
-								pos:  position{line: 29, col: 34, offset: 670},
+								pos:  position{line: 29, col: 34, offset: 644},
 								name: "IdentifierPart",
 							},
 						},
@@ -151,9 +147,9 @@ var g = &grammar{
 		},
 		{
 			name: "IdentifierStart",
-			pos:  position{line: 32, col: 1, offset: 721},
+			pos:  position{line: 32, col: 1, offset: 695},
 			expr: &charClassMatcher{
-				pos:        position{line: 32, col: 19, offset: 739},
+				pos:        position{line: 32, col: 19, offset: 713},
 				val:        "[\\pL_]",
 				chars:      []rune{'_'},
 				classes:    []*unicode.RangeTable{rangeTable("L")},
@@ -163,16 +159,16 @@ var g = &grammar{
 		},
 		{
 			name: "IdentifierPart",
-			pos:  position{line: 33, col: 1, offset: 746},
+			pos:  position{line: 33, col: 1, offset: 720},
 			expr: &choiceExpr{
-				pos: position{line: 33, col: 18, offset: 763},
+				pos: position{line: 33, col: 18, offset: 737},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 33, col: 18, offset: 763},
+						pos:  position{line: 33, col: 18, offset: 737},
 						name: "IdentifierStart",
 					},
 					&charClassMatcher{
-						pos:        position{line: 33, col: 36, offset: 781},
+						pos:        position{line: 33, col: 36, offset: 755},
 						val:        "[\\p{Nd}]",
 						classes:    []*unicode.RangeTable{rangeTable("Nd")},
 						ignoreCase: false,
@@ -183,74 +179,74 @@ var g = &grammar{
 		},
 		{
 			name: "StringLiteral",
-			pos:  position{line: 35, col: 1, offset: 791},
+			pos:  position{line: 35, col: 1, offset: 765},
 			expr: &choiceExpr{
-				pos: position{line: 35, col: 17, offset: 807},
+				pos: position{line: 35, col: 17, offset: 781},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 35, col: 17, offset: 807},
+						pos: position{line: 35, col: 17, offset: 781},
 						run: (*parser).callonStringLiteral2,
 						expr: &choiceExpr{
-							pos: position{line: 35, col: 19, offset: 809},
+							pos: position{line: 35, col: 19, offset: 783},
 							alternatives: []interface{}{
 								&seqExpr{
-									pos: position{line: 35, col: 19, offset: 809},
+									pos: position{line: 35, col: 19, offset: 783},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 35, col: 19, offset: 809},
+											pos:        position{line: 35, col: 19, offset: 783},
 											val:        "\"",
 											ignoreCase: false,
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 35, col: 23, offset: 813},
+											pos: position{line: 35, col: 23, offset: 787},
 											expr: &ruleRefExpr{
-												pos:  position{line: 35, col: 23, offset: 813},
+												pos:  position{line: 35, col: 23, offset: 787},
 												name: "DoubleStringChar",
 											},
 										},
 										&litMatcher{
-											pos:        position{line: 35, col: 41, offset: 831},
+											pos:        position{line: 35, col: 41, offset: 805},
 											val:        "\"",
 											ignoreCase: false,
 										},
 									},
 								},
 								&seqExpr{
-									pos: position{line: 35, col: 47, offset: 837},
+									pos: position{line: 35, col: 47, offset: 811},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 35, col: 47, offset: 837},
+											pos:        position{line: 35, col: 47, offset: 811},
 											val:        "'",
 											ignoreCase: false,
 										},
 										&ruleRefExpr{
-											pos:  position{line: 35, col: 51, offset: 841},
+											pos:  position{line: 35, col: 51, offset: 815},
 											name: "SingleStringChar",
 										},
 										&litMatcher{
-											pos:        position{line: 35, col: 68, offset: 858},
+											pos:        position{line: 35, col: 68, offset: 832},
 											val:        "'",
 											ignoreCase: false,
 										},
 									},
 								},
 								&seqExpr{
-									pos: position{line: 35, col: 74, offset: 864},
+									pos: position{line: 35, col: 74, offset: 838},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 35, col: 74, offset: 864},
+											pos:        position{line: 35, col: 74, offset: 838},
 											val:        "`",
 											ignoreCase: false,
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 35, col: 78, offset: 868},
+											pos: position{line: 35, col: 78, offset: 842},
 											expr: &ruleRefExpr{
-												pos:  position{line: 35, col: 78, offset: 868},
+												pos:  position{line: 35, col: 78, offset: 842},
 												name: "RawStringChar",
 											},
 										},
 										&litMatcher{
-											pos:        position{line: 35, col: 93, offset: 883},
+											pos:        position{line: 35, col: 93, offset: 857},
 											val:        "`",
 											ignoreCase: false,
 										},
@@ -260,35 +256,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 41, col: 5, offset: 1029},
+						pos: position{line: 41, col: 5, offset: 1003},
 						run: (*parser).callonStringLiteral18,
 						expr: &choiceExpr{
-							pos: position{line: 41, col: 7, offset: 1031},
+							pos: position{line: 41, col: 7, offset: 1005},
 							alternatives: []interface{}{
 								&seqExpr{
-									pos: position{line: 41, col: 9, offset: 1033},
+									pos: position{line: 41, col: 9, offset: 1007},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 41, col: 9, offset: 1033},
+											pos:        position{line: 41, col: 9, offset: 1007},
 											val:        "\"",
 											ignoreCase: false,
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 41, col: 13, offset: 1037},
+											pos: position{line: 41, col: 13, offset: 1011},
 											expr: &ruleRefExpr{
-												pos:  position{line: 41, col: 13, offset: 1037},
+												pos:  position{line: 41, col: 13, offset: 1011},
 												name: "DoubleStringChar",
 											},
 										},
 										&choiceExpr{
-											pos: position{line: 41, col: 33, offset: 1057},
+											pos: position{line: 41, col: 33, offset: 1031},
 											alternatives: []interface{}{
 												&ruleRefExpr{
-													pos:  position{line: 41, col: 33, offset: 1057},
+													pos:  position{line: 41, col: 33, offset: 1031},
 													name: "EOL",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 41, col: 39, offset: 1063},
+													pos:  position{line: 41, col: 39, offset: 1037},
 													name: "EOF",
 												},
 											},
@@ -296,29 +292,29 @@ var g = &grammar{
 									},
 								},
 								&seqExpr{
-									pos: position{line: 41, col: 51, offset: 1075},
+									pos: position{line: 41, col: 51, offset: 1049},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 41, col: 51, offset: 1075},
+											pos:        position{line: 41, col: 51, offset: 1049},
 											val:        "'",
 											ignoreCase: false,
 										},
 										&zeroOrOneExpr{
-											pos: position{line: 41, col: 55, offset: 1079},
+											pos: position{line: 41, col: 55, offset: 1053},
 											expr: &ruleRefExpr{
-												pos:  position{line: 41, col: 55, offset: 1079},
+												pos:  position{line: 41, col: 55, offset: 1053},
 												name: "SingleStringChar",
 											},
 										},
 										&choiceExpr{
-											pos: position{line: 41, col: 75, offset: 1099},
+											pos: position{line: 41, col: 75, offset: 1073},
 											alternatives: []interface{}{
 												&ruleRefExpr{
-													pos:  position{line: 41, col: 75, offset: 1099},
+													pos:  position{line: 41, col: 75, offset: 1073},
 													name: "EOL",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 41, col: 81, offset: 1105},
+													pos:  position{line: 41, col: 81, offset: 1079},
 													name: "EOF",
 												},
 											},
@@ -326,22 +322,22 @@ var g = &grammar{
 									},
 								},
 								&seqExpr{
-									pos: position{line: 41, col: 91, offset: 1115},
+									pos: position{line: 41, col: 91, offset: 1089},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 41, col: 91, offset: 1115},
+											pos:        position{line: 41, col: 91, offset: 1089},
 											val:        "`",
 											ignoreCase: false,
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 41, col: 95, offset: 1119},
+											pos: position{line: 41, col: 95, offset: 1093},
 											expr: &ruleRefExpr{
-												pos:  position{line: 41, col: 95, offset: 1119},
+												pos:  position{line: 41, col: 95, offset: 1093},
 												name: "RawStringChar",
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 41, col: 110, offset: 1134},
+											pos:  position{line: 41, col: 110, offset: 1108},
 											name: "EOF",
 										},
 									},
@@ -354,51 +350,51 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleStringChar",
-			pos:  position{line: 45, col: 1, offset: 1205},
+			pos:  position{line: 45, col: 1, offset: 1179},
 			expr: &choiceExpr{
-				pos: position{line: 45, col: 20, offset: 1224},
+				pos: position{line: 45, col: 20, offset: 1198},
 				alternatives: []interface{}{
 					&seqExpr{
-						pos: position{line: 45, col: 20, offset: 1224},
+						pos: position{line: 45, col: 20, offset: 1198},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 45, col: 20, offset: 1224},
+								pos: position{line: 45, col: 20, offset: 1198},
 								expr: &choiceExpr{
-									pos: position{line: 45, col: 23, offset: 1227},
+									pos: position{line: 45, col: 23, offset: 1201},
 									alternatives: []interface{}{
 										&litMatcher{
-											pos:        position{line: 45, col: 23, offset: 1227},
+											pos:        position{line: 45, col: 23, offset: 1201},
 											val:        "\"",
 											ignoreCase: false,
 										},
 										&litMatcher{
-											pos:        position{line: 45, col: 29, offset: 1233},
+											pos:        position{line: 45, col: 29, offset: 1207},
 											val:        "\\",
 											ignoreCase: false,
 										},
 										&ruleRefExpr{
-											pos:  position{line: 45, col: 36, offset: 1240},
+											pos:  position{line: 45, col: 36, offset: 1214},
 											name: "EOL",
 										},
 									},
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 45, col: 42, offset: 1246},
+								pos:  position{line: 45, col: 42, offset: 1220},
 								name: "SourceChar",
 							},
 						},
 					},
 					&seqExpr{
-						pos: position{line: 45, col: 55, offset: 1259},
+						pos: position{line: 45, col: 55, offset: 1233},
 						exprs: []interface{}{
 							&litMatcher{
-								pos:        position{line: 45, col: 55, offset: 1259},
+								pos:        position{line: 45, col: 55, offset: 1233},
 								val:        "\\",
 								ignoreCase: false,
 							},
 							&ruleRefExpr{
-								pos:  position{line: 45, col: 60, offset: 1264},
+								pos:  position{line: 45, col: 60, offset: 1238},
 								name: "DoubleStringEscape",
 							},
 						},
@@ -408,51 +404,51 @@ var g = &grammar{
 		},
 		{
 			name: "SingleStringChar",
-			pos:  position{line: 46, col: 1, offset: 1283},
+			pos:  position{line: 46, col: 1, offset: 1257},
 			expr: &choiceExpr{
-				pos: position{line: 46, col: 20, offset: 1302},
+				pos: position{line: 46, col: 20, offset: 1276},
 				alternatives: []interface{}{
 					&seqExpr{
-						pos: position{line: 46, col: 20, offset: 1302},
+						pos: position{line: 46, col: 20, offset: 1276},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 46, col: 20, offset: 1302},
+								pos: position{line: 46, col: 20, offset: 1276},
 								expr: &choiceExpr{
-									pos: position{line: 46, col: 23, offset: 1305},
+									pos: position{line: 46, col: 23, offset: 1279},
 									alternatives: []interface{}{
 										&litMatcher{
-											pos:        position{line: 46, col: 23, offset: 1305},
+											pos:        position{line: 46, col: 23, offset: 1279},
 											val:        "'",
 											ignoreCase: false,
 										},
 										&litMatcher{
-											pos:        position{line: 46, col: 29, offset: 1311},
+											pos:        position{line: 46, col: 29, offset: 1285},
 											val:        "\\",
 											ignoreCase: false,
 										},
 										&ruleRefExpr{
-											pos:  position{line: 46, col: 36, offset: 1318},
+											pos:  position{line: 46, col: 36, offset: 1292},
 											name: "EOL",
 										},
 									},
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 46, col: 42, offset: 1324},
+								pos:  position{line: 46, col: 42, offset: 1298},
 								name: "SourceChar",
 							},
 						},
 					},
 					&seqExpr{
-						pos: position{line: 46, col: 55, offset: 1337},
+						pos: position{line: 46, col: 55, offset: 1311},
 						exprs: []interface{}{
 							&litMatcher{
-								pos:        position{line: 46, col: 55, offset: 1337},
+								pos:        position{line: 46, col: 55, offset: 1311},
 								val:        "\\",
 								ignoreCase: false,
 							},
 							&ruleRefExpr{
-								pos:  position{line: 46, col: 60, offset: 1342},
+								pos:  position{line: 46, col: 60, offset: 1316},
 								name: "SingleStringEscape",
 							},
 						},
@@ -462,20 +458,20 @@ var g = &grammar{
 		},
 		{
 			name: "RawStringChar",
-			pos:  position{line: 47, col: 1, offset: 1361},
+			pos:  position{line: 47, col: 1, offset: 1335},
 			expr: &seqExpr{
-				pos: position{line: 47, col: 17, offset: 1377},
+				pos: position{line: 47, col: 17, offset: 1351},
 				exprs: []interface{}{
 					&notExpr{
-						pos: position{line: 47, col: 17, offset: 1377},
+						pos: position{line: 47, col: 17, offset: 1351},
 						expr: &litMatcher{
-							pos:        position{line: 47, col: 18, offset: 1378},
+							pos:        position{line: 47, col: 18, offset: 1352},
 							val:        "`",
 							ignoreCase: false,
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 47, col: 22, offset: 1382},
+						pos:  position{line: 47, col: 22, offset: 1356},
 						name: "SourceChar",
 					},
 				},
@@ -483,40 +479,40 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleStringEscape",
-			pos:  position{line: 49, col: 1, offset: 1394},
+			pos:  position{line: 49, col: 1, offset: 1368},
 			expr: &choiceExpr{
-				pos: position{line: 49, col: 22, offset: 1415},
+				pos: position{line: 49, col: 22, offset: 1389},
 				alternatives: []interface{}{
 					&choiceExpr{
-						pos: position{line: 49, col: 24, offset: 1417},
+						pos: position{line: 49, col: 24, offset: 1391},
 						alternatives: []interface{}{
 							&litMatcher{
-								pos:        position{line: 49, col: 24, offset: 1417},
+								pos:        position{line: 49, col: 24, offset: 1391},
 								val:        "\"",
 								ignoreCase: false,
 							},
 							&ruleRefExpr{
-								pos:  position{line: 49, col: 30, offset: 1423},
+								pos:  position{line: 49, col: 30, offset: 1397},
 								name: "CommonEscapeSequence",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 50, col: 7, offset: 1452},
+						pos: position{line: 50, col: 7, offset: 1426},
 						run: (*parser).callonDoubleStringEscape5,
 						expr: &choiceExpr{
-							pos: position{line: 50, col: 9, offset: 1454},
+							pos: position{line: 50, col: 9, offset: 1428},
 							alternatives: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 50, col: 9, offset: 1454},
+									pos:  position{line: 50, col: 9, offset: 1428},
 									name: "SourceChar",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 50, col: 22, offset: 1467},
+									pos:  position{line: 50, col: 22, offset: 1441},
 									name: "EOL",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 50, col: 28, offset: 1473},
+									pos:  position{line: 50, col: 28, offset: 1447},
 									name: "EOF",
 								},
 							},
@@ -527,40 +523,40 @@ var g = &grammar{
 		},
 		{
 			name: "SingleStringEscape",
-			pos:  position{line: 53, col: 1, offset: 1538},
+			pos:  position{line: 53, col: 1, offset: 1512},
 			expr: &choiceExpr{
-				pos: position{line: 53, col: 22, offset: 1559},
+				pos: position{line: 53, col: 22, offset: 1533},
 				alternatives: []interface{}{
 					&choiceExpr{
-						pos: position{line: 53, col: 24, offset: 1561},
+						pos: position{line: 53, col: 24, offset: 1535},
 						alternatives: []interface{}{
 							&litMatcher{
-								pos:        position{line: 53, col: 24, offset: 1561},
+								pos:        position{line: 53, col: 24, offset: 1535},
 								val:        "'",
 								ignoreCase: false,
 							},
 							&ruleRefExpr{
-								pos:  position{line: 53, col: 30, offset: 1567},
+								pos:  position{line: 53, col: 30, offset: 1541},
 								name: "CommonEscapeSequence",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 54, col: 7, offset: 1596},
+						pos: position{line: 54, col: 7, offset: 1570},
 						run: (*parser).callonSingleStringEscape5,
 						expr: &choiceExpr{
-							pos: position{line: 54, col: 9, offset: 1598},
+							pos: position{line: 54, col: 9, offset: 1572},
 							alternatives: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 54, col: 9, offset: 1598},
+									pos:  position{line: 54, col: 9, offset: 1572},
 									name: "SourceChar",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 54, col: 22, offset: 1611},
+									pos:  position{line: 54, col: 22, offset: 1585},
 									name: "EOL",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 54, col: 28, offset: 1617},
+									pos:  position{line: 54, col: 28, offset: 1591},
 									name: "EOF",
 								},
 							},
@@ -571,28 +567,28 @@ var g = &grammar{
 		},
 		{
 			name: "CommonEscapeSequence",
-			pos:  position{line: 58, col: 1, offset: 1683},
+			pos:  position{line: 58, col: 1, offset: 1657},
 			expr: &choiceExpr{
-				pos: position{line: 58, col: 24, offset: 1706},
+				pos: position{line: 58, col: 24, offset: 1680},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 58, col: 24, offset: 1706},
+						pos:  position{line: 58, col: 24, offset: 1680},
 						name: "SingleCharEscape",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 58, col: 43, offset: 1725},
+						pos:  position{line: 58, col: 43, offset: 1699},
 						name: "OctalEscape",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 58, col: 57, offset: 1739},
+						pos:  position{line: 58, col: 57, offset: 1713},
 						name: "HexEscape",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 58, col: 69, offset: 1751},
+						pos:  position{line: 58, col: 69, offset: 1725},
 						name: "LongUnicodeEscape",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 58, col: 89, offset: 1771},
+						pos:  position{line: 58, col: 89, offset: 1745},
 						name: "ShortUnicodeEscape",
 					},
 				},
@@ -600,47 +596,47 @@ var g = &grammar{
 		},
 		{
 			name: "SingleCharEscape",
-			pos:  position{line: 59, col: 1, offset: 1790},
+			pos:  position{line: 59, col: 1, offset: 1764},
 			expr: &choiceExpr{
-				pos: position{line: 59, col: 20, offset: 1809},
+				pos: position{line: 59, col: 20, offset: 1783},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 59, col: 20, offset: 1809},
+						pos:        position{line: 59, col: 20, offset: 1783},
 						val:        "a",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 59, col: 26, offset: 1815},
+						pos:        position{line: 59, col: 26, offset: 1789},
 						val:        "b",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 59, col: 32, offset: 1821},
+						pos:        position{line: 59, col: 32, offset: 1795},
 						val:        "n",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 59, col: 38, offset: 1827},
+						pos:        position{line: 59, col: 38, offset: 1801},
 						val:        "f",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 59, col: 44, offset: 1833},
+						pos:        position{line: 59, col: 44, offset: 1807},
 						val:        "r",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 59, col: 50, offset: 1839},
+						pos:        position{line: 59, col: 50, offset: 1813},
 						val:        "t",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 59, col: 56, offset: 1845},
+						pos:        position{line: 59, col: 56, offset: 1819},
 						val:        "v",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 59, col: 62, offset: 1851},
+						pos:        position{line: 59, col: 62, offset: 1825},
 						val:        "\\",
 						ignoreCase: false,
 					},
@@ -649,50 +645,50 @@ var g = &grammar{
 		},
 		{
 			name: "OctalEscape",
-			pos:  position{line: 60, col: 1, offset: 1856},
+			pos:  position{line: 60, col: 1, offset: 1830},
 			expr: &choiceExpr{
-				pos: position{line: 60, col: 15, offset: 1870},
+				pos: position{line: 60, col: 15, offset: 1844},
 				alternatives: []interface{}{
 					&seqExpr{
-						pos: position{line: 60, col: 15, offset: 1870},
+						pos: position{line: 60, col: 15, offset: 1844},
 						exprs: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 60, col: 15, offset: 1870},
+								pos:  position{line: 60, col: 15, offset: 1844},
 								name: "OctalDigit",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 60, col: 26, offset: 1881},
+								pos:  position{line: 60, col: 26, offset: 1855},
 								name: "OctalDigit",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 60, col: 37, offset: 1892},
+								pos:  position{line: 60, col: 37, offset: 1866},
 								name: "OctalDigit",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 61, col: 7, offset: 1909},
+						pos: position{line: 61, col: 7, offset: 1883},
 						run: (*parser).callonOctalEscape6,
 						expr: &seqExpr{
-							pos: position{line: 61, col: 7, offset: 1909},
+							pos: position{line: 61, col: 7, offset: 1883},
 							exprs: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 61, col: 7, offset: 1909},
+									pos:  position{line: 61, col: 7, offset: 1883},
 									name: "OctalDigit",
 								},
 								&choiceExpr{
-									pos: position{line: 61, col: 20, offset: 1922},
+									pos: position{line: 61, col: 20, offset: 1896},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 61, col: 20, offset: 1922},
+											pos:  position{line: 61, col: 20, offset: 1896},
 											name: "SourceChar",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 61, col: 33, offset: 1935},
+											pos:  position{line: 61, col: 33, offset: 1909},
 											name: "EOL",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 61, col: 39, offset: 1941},
+											pos:  position{line: 61, col: 39, offset: 1915},
 											name: "EOF",
 										},
 									},
@@ -705,52 +701,52 @@ var g = &grammar{
 		},
 		{
 			name: "HexEscape",
-			pos:  position{line: 64, col: 1, offset: 2002},
+			pos:  position{line: 64, col: 1, offset: 1976},
 			expr: &choiceExpr{
-				pos: position{line: 64, col: 13, offset: 2014},
+				pos: position{line: 64, col: 13, offset: 1988},
 				alternatives: []interface{}{
 					&seqExpr{
-						pos: position{line: 64, col: 13, offset: 2014},
+						pos: position{line: 64, col: 13, offset: 1988},
 						exprs: []interface{}{
 							&litMatcher{
-								pos:        position{line: 64, col: 13, offset: 2014},
+								pos:        position{line: 64, col: 13, offset: 1988},
 								val:        "x",
 								ignoreCase: false,
 							},
 							&ruleRefExpr{
-								pos:  position{line: 64, col: 17, offset: 2018},
+								pos:  position{line: 64, col: 17, offset: 1992},
 								name: "HexDigit",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 64, col: 26, offset: 2027},
+								pos:  position{line: 64, col: 26, offset: 2001},
 								name: "HexDigit",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 65, col: 7, offset: 2042},
+						pos: position{line: 65, col: 7, offset: 2016},
 						run: (*parser).callonHexEscape6,
 						expr: &seqExpr{
-							pos: position{line: 65, col: 7, offset: 2042},
+							pos: position{line: 65, col: 7, offset: 2016},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 65, col: 7, offset: 2042},
+									pos:        position{line: 65, col: 7, offset: 2016},
 									val:        "x",
 									ignoreCase: false,
 								},
 								&choiceExpr{
-									pos: position{line: 65, col: 13, offset: 2048},
+									pos: position{line: 65, col: 13, offset: 2022},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 65, col: 13, offset: 2048},
+											pos:  position{line: 65, col: 13, offset: 2022},
 											name: "SourceChar",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 65, col: 26, offset: 2061},
+											pos:  position{line: 65, col: 26, offset: 2035},
 											name: "EOL",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 65, col: 32, offset: 2067},
+											pos:  position{line: 65, col: 32, offset: 2041},
 											name: "EOF",
 										},
 									},
@@ -763,80 +759,80 @@ var g = &grammar{
 		},
 		{
 			name: "LongUnicodeEscape",
-			pos:  position{line: 68, col: 1, offset: 2134},
+			pos:  position{line: 68, col: 1, offset: 2108},
 			expr: &choiceExpr{
-				pos: position{line: 69, col: 5, offset: 2159},
+				pos: position{line: 69, col: 5, offset: 2133},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 69, col: 5, offset: 2159},
+						pos: position{line: 69, col: 5, offset: 2133},
 						run: (*parser).callonLongUnicodeEscape2,
 						expr: &seqExpr{
-							pos: position{line: 69, col: 5, offset: 2159},
+							pos: position{line: 69, col: 5, offset: 2133},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 69, col: 5, offset: 2159},
+									pos:        position{line: 69, col: 5, offset: 2133},
 									val:        "U",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 69, col: 9, offset: 2163},
+									pos:  position{line: 69, col: 9, offset: 2137},
 									name: "HexDigit",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 69, col: 18, offset: 2172},
+									pos:  position{line: 69, col: 18, offset: 2146},
 									name: "HexDigit",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 69, col: 27, offset: 2181},
+									pos:  position{line: 69, col: 27, offset: 2155},
 									name: "HexDigit",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 69, col: 36, offset: 2190},
+									pos:  position{line: 69, col: 36, offset: 2164},
 									name: "HexDigit",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 69, col: 45, offset: 2199},
+									pos:  position{line: 69, col: 45, offset: 2173},
 									name: "HexDigit",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 69, col: 54, offset: 2208},
+									pos:  position{line: 69, col: 54, offset: 2182},
 									name: "HexDigit",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 69, col: 63, offset: 2217},
+									pos:  position{line: 69, col: 63, offset: 2191},
 									name: "HexDigit",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 69, col: 72, offset: 2226},
+									pos:  position{line: 69, col: 72, offset: 2200},
 									name: "HexDigit",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 72, col: 7, offset: 2328},
+						pos: position{line: 72, col: 7, offset: 2302},
 						run: (*parser).callonLongUnicodeEscape13,
 						expr: &seqExpr{
-							pos: position{line: 72, col: 7, offset: 2328},
+							pos: position{line: 72, col: 7, offset: 2302},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 72, col: 7, offset: 2328},
+									pos:        position{line: 72, col: 7, offset: 2302},
 									val:        "U",
 									ignoreCase: false,
 								},
 								&choiceExpr{
-									pos: position{line: 72, col: 13, offset: 2334},
+									pos: position{line: 72, col: 13, offset: 2308},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 72, col: 13, offset: 2334},
+											pos:  position{line: 72, col: 13, offset: 2308},
 											name: "SourceChar",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 72, col: 26, offset: 2347},
+											pos:  position{line: 72, col: 26, offset: 2321},
 											name: "EOL",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 72, col: 32, offset: 2353},
+											pos:  position{line: 72, col: 32, offset: 2327},
 											name: "EOF",
 										},
 									},
@@ -849,64 +845,64 @@ var g = &grammar{
 		},
 		{
 			name: "ShortUnicodeEscape",
-			pos:  position{line: 75, col: 1, offset: 2416},
+			pos:  position{line: 75, col: 1, offset: 2390},
 			expr: &choiceExpr{
-				pos: position{line: 76, col: 5, offset: 2442},
+				pos: position{line: 76, col: 5, offset: 2416},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 76, col: 5, offset: 2442},
+						pos: position{line: 76, col: 5, offset: 2416},
 						run: (*parser).callonShortUnicodeEscape2,
 						expr: &seqExpr{
-							pos: position{line: 76, col: 5, offset: 2442},
+							pos: position{line: 76, col: 5, offset: 2416},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 76, col: 5, offset: 2442},
+									pos:        position{line: 76, col: 5, offset: 2416},
 									val:        "u",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 76, col: 9, offset: 2446},
+									pos:  position{line: 76, col: 9, offset: 2420},
 									name: "HexDigit",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 76, col: 18, offset: 2455},
+									pos:  position{line: 76, col: 18, offset: 2429},
 									name: "HexDigit",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 76, col: 27, offset: 2464},
+									pos:  position{line: 76, col: 27, offset: 2438},
 									name: "HexDigit",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 76, col: 36, offset: 2473},
+									pos:  position{line: 76, col: 36, offset: 2447},
 									name: "HexDigit",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 79, col: 7, offset: 2575},
+						pos: position{line: 79, col: 7, offset: 2549},
 						run: (*parser).callonShortUnicodeEscape9,
 						expr: &seqExpr{
-							pos: position{line: 79, col: 7, offset: 2575},
+							pos: position{line: 79, col: 7, offset: 2549},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 79, col: 7, offset: 2575},
+									pos:        position{line: 79, col: 7, offset: 2549},
 									val:        "u",
 									ignoreCase: false,
 								},
 								&choiceExpr{
-									pos: position{line: 79, col: 13, offset: 2581},
+									pos: position{line: 79, col: 13, offset: 2555},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 79, col: 13, offset: 2581},
+											pos:  position{line: 79, col: 13, offset: 2555},
 											name: "SourceChar",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 79, col: 26, offset: 2594},
+											pos:  position{line: 79, col: 26, offset: 2568},
 											name: "EOL",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 79, col: 32, offset: 2600},
+											pos:  position{line: 79, col: 32, offset: 2574},
 											name: "EOF",
 										},
 									},
@@ -919,9 +915,9 @@ var g = &grammar{
 		},
 		{
 			name: "OctalDigit",
-			pos:  position{line: 83, col: 1, offset: 2664},
+			pos:  position{line: 83, col: 1, offset: 2638},
 			expr: &charClassMatcher{
-				pos:        position{line: 83, col: 14, offset: 2677},
+				pos:        position{line: 83, col: 14, offset: 2651},
 				val:        "[0-7]",
 				ranges:     []rune{'0', '7'},
 				ignoreCase: false,
@@ -930,9 +926,9 @@ var g = &grammar{
 		},
 		{
 			name: "DecimalDigit",
-			pos:  position{line: 84, col: 1, offset: 2683},
+			pos:  position{line: 84, col: 1, offset: 2657},
 			expr: &charClassMatcher{
-				pos:        position{line: 84, col: 16, offset: 2698},
+				pos:        position{line: 84, col: 16, offset: 2672},
 				val:        "[0-9]",
 				ranges:     []rune{'0', '9'},
 				ignoreCase: false,
@@ -941,9 +937,9 @@ var g = &grammar{
 		},
 		{
 			name: "HexDigit",
-			pos:  position{line: 85, col: 1, offset: 2704},
+			pos:  position{line: 85, col: 1, offset: 2678},
 			expr: &charClassMatcher{
-				pos:        position{line: 85, col: 12, offset: 2715},
+				pos:        position{line: 85, col: 12, offset: 2689},
 				val:        "[0-9a-f]i",
 				ranges:     []rune{'0', '9', 'a', 'f'},
 				ignoreCase: true,
@@ -952,44 +948,44 @@ var g = &grammar{
 		},
 		{
 			name: "CharClassMatcher",
-			pos:  position{line: 87, col: 1, offset: 2726},
+			pos:  position{line: 87, col: 1, offset: 2700},
 			expr: &choiceExpr{
-				pos: position{line: 87, col: 20, offset: 2745},
+				pos: position{line: 87, col: 20, offset: 2719},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 87, col: 20, offset: 2745},
+						pos: position{line: 87, col: 20, offset: 2719},
 						run: (*parser).callonCharClassMatcher2,
 						expr: &seqExpr{
-							pos: position{line: 87, col: 20, offset: 2745},
+							pos: position{line: 87, col: 20, offset: 2719},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 87, col: 20, offset: 2745},
+									pos:        position{line: 87, col: 20, offset: 2719},
 									val:        "[",
 									ignoreCase: false,
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 87, col: 24, offset: 2749},
+									pos: position{line: 87, col: 24, offset: 2723},
 									expr: &choiceExpr{
-										pos: position{line: 87, col: 26, offset: 2751},
+										pos: position{line: 87, col: 26, offset: 2725},
 										alternatives: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 87, col: 26, offset: 2751},
+												pos:  position{line: 87, col: 26, offset: 2725},
 												name: "ClassCharRange",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 87, col: 43, offset: 2768},
+												pos:  position{line: 87, col: 43, offset: 2742},
 												name: "ClassChar",
 											},
 											&seqExpr{
-												pos: position{line: 87, col: 55, offset: 2780},
+												pos: position{line: 87, col: 55, offset: 2754},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 87, col: 55, offset: 2780},
+														pos:        position{line: 87, col: 55, offset: 2754},
 														val:        "\\",
 														ignoreCase: false,
 													},
 													&ruleRefExpr{
-														pos:  position{line: 87, col: 60, offset: 2785},
+														pos:  position{line: 87, col: 60, offset: 2759},
 														name: "UnicodeClassEscape",
 													},
 												},
@@ -998,14 +994,14 @@ var g = &grammar{
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 87, col: 82, offset: 2807},
+									pos:        position{line: 87, col: 82, offset: 2781},
 									val:        "]",
 									ignoreCase: false,
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 87, col: 86, offset: 2811},
+									pos: position{line: 87, col: 86, offset: 2785},
 									expr: &litMatcher{
-										pos:        position{line: 87, col: 86, offset: 2811},
+										pos:        position{line: 87, col: 86, offset: 2785},
 										val:        "i",
 										ignoreCase: false,
 									},
@@ -1014,44 +1010,44 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 89, col: 5, offset: 2853},
+						pos: position{line: 89, col: 5, offset: 2827},
 						run: (*parser).callonCharClassMatcher15,
 						expr: &seqExpr{
-							pos: position{line: 89, col: 5, offset: 2853},
+							pos: position{line: 89, col: 5, offset: 2827},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 89, col: 5, offset: 2853},
+									pos:        position{line: 89, col: 5, offset: 2827},
 									val:        "[",
 									ignoreCase: false,
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 89, col: 9, offset: 2857},
+									pos: position{line: 89, col: 9, offset: 2831},
 									expr: &seqExpr{
-										pos: position{line: 89, col: 11, offset: 2859},
+										pos: position{line: 89, col: 11, offset: 2833},
 										exprs: []interface{}{
 											&notExpr{
-												pos: position{line: 89, col: 11, offset: 2859},
+												pos: position{line: 89, col: 11, offset: 2833},
 												expr: &ruleRefExpr{
-													pos:  position{line: 89, col: 14, offset: 2862},
+													pos:  position{line: 89, col: 14, offset: 2836},
 													name: "EOL",
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 89, col: 20, offset: 2868},
+												pos:  position{line: 89, col: 20, offset: 2842},
 												name: "SourceChar",
 											},
 										},
 									},
 								},
 								&choiceExpr{
-									pos: position{line: 89, col: 36, offset: 2884},
+									pos: position{line: 89, col: 36, offset: 2858},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 89, col: 36, offset: 2884},
+											pos:  position{line: 89, col: 36, offset: 2858},
 											name: "EOL",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 89, col: 42, offset: 2890},
+											pos:  position{line: 89, col: 42, offset: 2864},
 											name: "EOF",
 										},
 									},
@@ -1064,21 +1060,21 @@ var g = &grammar{
 		},
 		{
 			name: "ClassCharRange",
-			pos:  position{line: 93, col: 1, offset: 2962},
+			pos:  position{line: 93, col: 1, offset: 2936},
 			expr: &seqExpr{
-				pos: position{line: 93, col: 18, offset: 2979},
+				pos: position{line: 93, col: 18, offset: 2953},
 				exprs: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 93, col: 18, offset: 2979},
+						pos:  position{line: 93, col: 18, offset: 2953},
 						name: "ClassChar",
 					},
 					&litMatcher{
-						pos:        position{line: 93, col: 28, offset: 2989},
+						pos:        position{line: 93, col: 28, offset: 2963},
 						val:        "-",
 						ignoreCase: false,
 					},
 					&ruleRefExpr{
-						pos:  position{line: 93, col: 32, offset: 2993},
+						pos:  position{line: 93, col: 32, offset: 2967},
 						name: "ClassChar",
 					},
 				},
@@ -1086,51 +1082,51 @@ var g = &grammar{
 		},
 		{
 			name: "ClassChar",
-			pos:  position{line: 94, col: 1, offset: 3003},
+			pos:  position{line: 94, col: 1, offset: 2977},
 			expr: &choiceExpr{
-				pos: position{line: 94, col: 13, offset: 3015},
+				pos: position{line: 94, col: 13, offset: 2989},
 				alternatives: []interface{}{
 					&seqExpr{
-						pos: position{line: 94, col: 13, offset: 3015},
+						pos: position{line: 94, col: 13, offset: 2989},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 94, col: 13, offset: 3015},
+								pos: position{line: 94, col: 13, offset: 2989},
 								expr: &choiceExpr{
-									pos: position{line: 94, col: 16, offset: 3018},
+									pos: position{line: 94, col: 16, offset: 2992},
 									alternatives: []interface{}{
 										&litMatcher{
-											pos:        position{line: 94, col: 16, offset: 3018},
+											pos:        position{line: 94, col: 16, offset: 2992},
 											val:        "]",
 											ignoreCase: false,
 										},
 										&litMatcher{
-											pos:        position{line: 94, col: 22, offset: 3024},
+											pos:        position{line: 94, col: 22, offset: 2998},
 											val:        "\\",
 											ignoreCase: false,
 										},
 										&ruleRefExpr{
-											pos:  position{line: 94, col: 29, offset: 3031},
+											pos:  position{line: 94, col: 29, offset: 3005},
 											name: "EOL",
 										},
 									},
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 94, col: 35, offset: 3037},
+								pos:  position{line: 94, col: 35, offset: 3011},
 								name: "SourceChar",
 							},
 						},
 					},
 					&seqExpr{
-						pos: position{line: 94, col: 48, offset: 3050},
+						pos: position{line: 94, col: 48, offset: 3024},
 						exprs: []interface{}{
 							&litMatcher{
-								pos:        position{line: 94, col: 48, offset: 3050},
+								pos:        position{line: 94, col: 48, offset: 3024},
 								val:        "\\",
 								ignoreCase: false,
 							},
 							&ruleRefExpr{
-								pos:  position{line: 94, col: 53, offset: 3055},
+								pos:  position{line: 94, col: 53, offset: 3029},
 								name: "CharClassEscape",
 							},
 						},
@@ -1140,51 +1136,51 @@ var g = &grammar{
 		},
 		{
 			name: "CharClassEscape",
-			pos:  position{line: 95, col: 1, offset: 3071},
+			pos:  position{line: 95, col: 1, offset: 3045},
 			expr: &choiceExpr{
-				pos: position{line: 95, col: 19, offset: 3089},
+				pos: position{line: 95, col: 19, offset: 3063},
 				alternatives: []interface{}{
 					&choiceExpr{
-						pos: position{line: 95, col: 21, offset: 3091},
+						pos: position{line: 95, col: 21, offset: 3065},
 						alternatives: []interface{}{
 							&litMatcher{
-								pos:        position{line: 95, col: 21, offset: 3091},
+								pos:        position{line: 95, col: 21, offset: 3065},
 								val:        "]",
 								ignoreCase: false,
 							},
 							&ruleRefExpr{
-								pos:  position{line: 95, col: 27, offset: 3097},
+								pos:  position{line: 95, col: 27, offset: 3071},
 								name: "CommonEscapeSequence",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 96, col: 7, offset: 3126},
+						pos: position{line: 96, col: 7, offset: 3100},
 						run: (*parser).callonCharClassEscape5,
 						expr: &seqExpr{
-							pos: position{line: 96, col: 7, offset: 3126},
+							pos: position{line: 96, col: 7, offset: 3100},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 96, col: 7, offset: 3126},
+									pos: position{line: 96, col: 7, offset: 3100},
 									expr: &litMatcher{
-										pos:        position{line: 96, col: 8, offset: 3127},
+										pos:        position{line: 96, col: 8, offset: 3101},
 										val:        "p",
 										ignoreCase: false,
 									},
 								},
 								&choiceExpr{
-									pos: position{line: 96, col: 14, offset: 3133},
+									pos: position{line: 96, col: 14, offset: 3107},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 96, col: 14, offset: 3133},
+											pos:  position{line: 96, col: 14, offset: 3107},
 											name: "SourceChar",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 96, col: 27, offset: 3146},
+											pos:  position{line: 96, col: 27, offset: 3120},
 											name: "EOL",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 96, col: 33, offset: 3152},
+											pos:  position{line: 96, col: 33, offset: 3126},
 											name: "EOF",
 										},
 									},
@@ -1197,49 +1193,49 @@ var g = &grammar{
 		},
 		{
 			name: "UnicodeClassEscape",
-			pos:  position{line: 100, col: 1, offset: 3218},
+			pos:  position{line: 100, col: 1, offset: 3192},
 			expr: &seqExpr{
-				pos: position{line: 100, col: 22, offset: 3239},
+				pos: position{line: 100, col: 22, offset: 3213},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 100, col: 22, offset: 3239},
+						pos:        position{line: 100, col: 22, offset: 3213},
 						val:        "p",
 						ignoreCase: false,
 					},
 					&choiceExpr{
-						pos: position{line: 101, col: 7, offset: 3252},
+						pos: position{line: 101, col: 7, offset: 3226},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 101, col: 7, offset: 3252},
+								pos:  position{line: 101, col: 7, offset: 3226},
 								name: "SingleCharUnicodeClass",
 							},
 							&actionExpr{
-								pos: position{line: 102, col: 7, offset: 3281},
+								pos: position{line: 102, col: 7, offset: 3255},
 								run: (*parser).callonUnicodeClassEscape5,
 								expr: &seqExpr{
-									pos: position{line: 102, col: 7, offset: 3281},
+									pos: position{line: 102, col: 7, offset: 3255},
 									exprs: []interface{}{
 										&notExpr{
-											pos: position{line: 102, col: 7, offset: 3281},
+											pos: position{line: 102, col: 7, offset: 3255},
 											expr: &litMatcher{
-												pos:        position{line: 102, col: 8, offset: 3282},
+												pos:        position{line: 102, col: 8, offset: 3256},
 												val:        "{",
 												ignoreCase: false,
 											},
 										},
 										&choiceExpr{
-											pos: position{line: 102, col: 14, offset: 3288},
+											pos: position{line: 102, col: 14, offset: 3262},
 											alternatives: []interface{}{
 												&ruleRefExpr{
-													pos:  position{line: 102, col: 14, offset: 3288},
+													pos:  position{line: 102, col: 14, offset: 3262},
 													name: "SourceChar",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 102, col: 27, offset: 3301},
+													pos:  position{line: 102, col: 27, offset: 3275},
 													name: "EOL",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 102, col: 33, offset: 3307},
+													pos:  position{line: 102, col: 33, offset: 3281},
 													name: "EOF",
 												},
 											},
@@ -1248,26 +1244,26 @@ var g = &grammar{
 								},
 							},
 							&actionExpr{
-								pos: position{line: 103, col: 7, offset: 3378},
+								pos: position{line: 103, col: 7, offset: 3352},
 								run: (*parser).callonUnicodeClassEscape13,
 								expr: &seqExpr{
-									pos: position{line: 103, col: 7, offset: 3378},
+									pos: position{line: 103, col: 7, offset: 3352},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 103, col: 7, offset: 3378},
+											pos:        position{line: 103, col: 7, offset: 3352},
 											val:        "{",
 											ignoreCase: false,
 										},
 										&labeledExpr{
-											pos:   position{line: 103, col: 11, offset: 3382},
+											pos:   position{line: 103, col: 11, offset: 3356},
 											label: "ident",
 											expr: &ruleRefExpr{
-												pos:  position{line: 103, col: 17, offset: 3388},
+												pos:  position{line: 103, col: 17, offset: 3362},
 												name: "IdentifierName",
 											},
 										},
 										&litMatcher{
-											pos:        position{line: 103, col: 32, offset: 3403},
+											pos:        position{line: 103, col: 32, offset: 3377},
 											val:        "}",
 											ignoreCase: false,
 										},
@@ -1275,34 +1271,34 @@ var g = &grammar{
 								},
 							},
 							&actionExpr{
-								pos: position{line: 109, col: 7, offset: 3567},
+								pos: position{line: 109, col: 7, offset: 3541},
 								run: (*parser).callonUnicodeClassEscape19,
 								expr: &seqExpr{
-									pos: position{line: 109, col: 7, offset: 3567},
+									pos: position{line: 109, col: 7, offset: 3541},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 109, col: 7, offset: 3567},
+											pos:        position{line: 109, col: 7, offset: 3541},
 											val:        "{",
 											ignoreCase: false,
 										},
 										&ruleRefExpr{
-											pos:  position{line: 109, col: 11, offset: 3571},
+											pos:  position{line: 109, col: 11, offset: 3545},
 											name: "IdentifierName",
 										},
 										&choiceExpr{
-											pos: position{line: 109, col: 28, offset: 3588},
+											pos: position{line: 109, col: 28, offset: 3562},
 											alternatives: []interface{}{
 												&litMatcher{
-													pos:        position{line: 109, col: 28, offset: 3588},
+													pos:        position{line: 109, col: 28, offset: 3562},
 													val:        "]",
 													ignoreCase: false,
 												},
 												&ruleRefExpr{
-													pos:  position{line: 109, col: 34, offset: 3594},
+													pos:  position{line: 109, col: 34, offset: 3568},
 													name: "EOL",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 109, col: 40, offset: 3600},
+													pos:  position{line: 109, col: 40, offset: 3574},
 													name: "EOF",
 												},
 											},
@@ -1317,9 +1313,9 @@ var g = &grammar{
 		},
 		{
 			name: "SingleCharUnicodeClass",
-			pos:  position{line: 114, col: 1, offset: 3680},
+			pos:  position{line: 114, col: 1, offset: 3654},
 			expr: &charClassMatcher{
-				pos:        position{line: 114, col: 26, offset: 3705},
+				pos:        position{line: 114, col: 26, offset: 3679},
 				val:        "[LMNCPZS]",
 				chars:      []rune{'L', 'M', 'N', 'C', 'P', 'Z', 'S'},
 				ignoreCase: false,
@@ -1328,39 +1324,39 @@ var g = &grammar{
 		},
 		{
 			name: "Number",
-			pos:  position{line: 117, col: 1, offset: 3717},
+			pos:  position{line: 117, col: 1, offset: 3691},
 			expr: &actionExpr{
-				pos: position{line: 117, col: 10, offset: 3726},
+				pos: position{line: 117, col: 10, offset: 3700},
 				run: (*parser).callonNumber1,
 				expr: &seqExpr{
-					pos: position{line: 117, col: 10, offset: 3726},
+					pos: position{line: 117, col: 10, offset: 3700},
 					exprs: []interface{}{
 						&zeroOrOneExpr{
-							pos: position{line: 117, col: 10, offset: 3726},
+							pos: position{line: 117, col: 10, offset: 3700},
 							expr: &litMatcher{
-								pos:        position{line: 117, col: 10, offset: 3726},
+								pos:        position{line: 117, col: 10, offset: 3700},
 								val:        "-",
 								ignoreCase: false,
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 117, col: 15, offset: 3731},
+							pos:  position{line: 117, col: 15, offset: 3705},
 							name: "Integer",
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 117, col: 23, offset: 3739},
+							pos: position{line: 117, col: 23, offset: 3713},
 							expr: &seqExpr{
-								pos: position{line: 117, col: 25, offset: 3741},
+								pos: position{line: 117, col: 25, offset: 3715},
 								exprs: []interface{}{
 									&litMatcher{
-										pos:        position{line: 117, col: 25, offset: 3741},
+										pos:        position{line: 117, col: 25, offset: 3715},
 										val:        ".",
 										ignoreCase: false,
 									},
 									&oneOrMoreExpr{
-										pos: position{line: 117, col: 29, offset: 3745},
+										pos: position{line: 117, col: 29, offset: 3719},
 										expr: &ruleRefExpr{
-											pos:  position{line: 117, col: 29, offset: 3745},
+											pos:  position{line: 117, col: 29, offset: 3719},
 											name: "Digit",
 										},
 									},
@@ -1373,29 +1369,29 @@ var g = &grammar{
 		},
 		{
 			name: "Integer",
-			pos:  position{line: 121, col: 1, offset: 3797},
+			pos:  position{line: 121, col: 1, offset: 3771},
 			expr: &choiceExpr{
-				pos: position{line: 121, col: 11, offset: 3807},
+				pos: position{line: 121, col: 11, offset: 3781},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 121, col: 11, offset: 3807},
+						pos:        position{line: 121, col: 11, offset: 3781},
 						val:        "0",
 						ignoreCase: false,
 					},
 					&actionExpr{
-						pos: position{line: 121, col: 17, offset: 3813},
+						pos: position{line: 121, col: 17, offset: 3787},
 						run: (*parser).callonInteger3,
 						expr: &seqExpr{
-							pos: position{line: 121, col: 17, offset: 3813},
+							pos: position{line: 121, col: 17, offset: 3787},
 							exprs: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 121, col: 17, offset: 3813},
+									pos:  position{line: 121, col: 17, offset: 3787},
 									name: "NonZeroDigit",
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 121, col: 30, offset: 3826},
+									pos: position{line: 121, col: 30, offset: 3800},
 									expr: &ruleRefExpr{
-										pos:  position{line: 121, col: 30, offset: 3826},
+										pos:  position{line: 121, col: 30, offset: 3800},
 										name: "Digit",
 									},
 								},
@@ -1407,9 +1403,9 @@ var g = &grammar{
 		},
 		{
 			name: "NonZeroDigit",
-			pos:  position{line: 125, col: 1, offset: 3890},
+			pos:  position{line: 125, col: 1, offset: 3864},
 			expr: &charClassMatcher{
-				pos:        position{line: 125, col: 16, offset: 3905},
+				pos:        position{line: 125, col: 16, offset: 3879},
 				val:        "[1-9]",
 				ranges:     []rune{'1', '9'},
 				ignoreCase: false,
@@ -1418,9 +1414,9 @@ var g = &grammar{
 		},
 		{
 			name: "Digit",
-			pos:  position{line: 126, col: 1, offset: 3911},
+			pos:  position{line: 126, col: 1, offset: 3885},
 			expr: &charClassMatcher{
-				pos:        position{line: 126, col: 9, offset: 3919},
+				pos:        position{line: 126, col: 9, offset: 3893},
 				val:        "[0-9]",
 				ranges:     []rune{'0', '9'},
 				ignoreCase: false,
@@ -1429,31 +1425,31 @@ var g = &grammar{
 		},
 		{
 			name: "LabelBlock",
-			pos:  position{line: 128, col: 1, offset: 3926},
+			pos:  position{line: 128, col: 1, offset: 3900},
 			expr: &choiceExpr{
-				pos: position{line: 128, col: 14, offset: 3939},
+				pos: position{line: 128, col: 14, offset: 3913},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 128, col: 14, offset: 3939},
+						pos: position{line: 128, col: 14, offset: 3913},
 						run: (*parser).callonLabelBlock2,
 						expr: &seqExpr{
-							pos: position{line: 128, col: 14, offset: 3939},
+							pos: position{line: 128, col: 14, offset: 3913},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 128, col: 14, offset: 3939},
+									pos:        position{line: 128, col: 14, offset: 3913},
 									val:        "{",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 128, col: 18, offset: 3943},
+									pos:   position{line: 128, col: 18, offset: 3917},
 									label: "block",
 									expr: &ruleRefExpr{
-										pos:  position{line: 128, col: 24, offset: 3949},
+										pos:  position{line: 128, col: 24, offset: 3923},
 										name: "LabelMatches",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 128, col: 37, offset: 3962},
+									pos:        position{line: 128, col: 37, offset: 3936},
 									val:        "}",
 									ignoreCase: false,
 								},
@@ -1461,22 +1457,22 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 130, col: 5, offset: 3994},
+						pos: position{line: 130, col: 5, offset: 3968},
 						run: (*parser).callonLabelBlock8,
 						expr: &seqExpr{
-							pos: position{line: 130, col: 5, offset: 3994},
+							pos: position{line: 130, col: 5, offset: 3968},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 130, col: 5, offset: 3994},
+									pos:        position{line: 130, col: 5, offset: 3968},
 									val:        "{",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 130, col: 9, offset: 3998},
+									pos:  position{line: 130, col: 9, offset: 3972},
 									name: "LabelMatches",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 130, col: 22, offset: 4011},
+									pos:  position{line: 130, col: 22, offset: 3985},
 									name: "EOF",
 								},
 							},
@@ -1487,12 +1483,12 @@ var g = &grammar{
 		},
 		{
 			name: "NanoSecondUnits",
-			pos:  position{line: 134, col: 1, offset: 4076},
+			pos:  position{line: 134, col: 1, offset: 4050},
 			expr: &actionExpr{
-				pos: position{line: 134, col: 19, offset: 4094},
+				pos: position{line: 134, col: 19, offset: 4068},
 				run: (*parser).callonNanoSecondUnits1,
 				expr: &litMatcher{
-					pos:        position{line: 134, col: 19, offset: 4094},
+					pos:        position{line: 134, col: 19, offset: 4068},
 					val:        "ns",
 					ignoreCase: false,
 				},
@@ -1500,25 +1496,25 @@ var g = &grammar{
 		},
 		{
 			name: "MicroSecondUnits",
-			pos:  position{line: 139, col: 1, offset: 4199},
+			pos:  position{line: 139, col: 1, offset: 4173},
 			expr: &actionExpr{
-				pos: position{line: 139, col: 20, offset: 4218},
+				pos: position{line: 139, col: 20, offset: 4192},
 				run: (*parser).callonMicroSecondUnits1,
 				expr: &choiceExpr{
-					pos: position{line: 139, col: 21, offset: 4219},
+					pos: position{line: 139, col: 21, offset: 4193},
 					alternatives: []interface{}{
 						&litMatcher{
-							pos:        position{line: 139, col: 21, offset: 4219},
+							pos:        position{line: 139, col: 21, offset: 4193},
 							val:        "us",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 139, col: 28, offset: 4226},
+							pos:        position{line: 139, col: 28, offset: 4200},
 							val:        "µs",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 139, col: 35, offset: 4234},
+							pos:        position{line: 139, col: 35, offset: 4208},
 							val:        "μs",
 							ignoreCase: false,
 						},
@@ -1528,12 +1524,12 @@ var g = &grammar{
 		},
 		{
 			name: "MilliSecondUnits",
-			pos:  position{line: 144, col: 1, offset: 4343},
+			pos:  position{line: 144, col: 1, offset: 4317},
 			expr: &actionExpr{
-				pos: position{line: 144, col: 20, offset: 4362},
+				pos: position{line: 144, col: 20, offset: 4336},
 				run: (*parser).callonMilliSecondUnits1,
 				expr: &litMatcher{
-					pos:        position{line: 144, col: 20, offset: 4362},
+					pos:        position{line: 144, col: 20, offset: 4336},
 					val:        "ms",
 					ignoreCase: false,
 				},
@@ -1541,12 +1537,12 @@ var g = &grammar{
 		},
 		{
 			name: "SecondUnits",
-			pos:  position{line: 149, col: 1, offset: 4469},
+			pos:  position{line: 149, col: 1, offset: 4443},
 			expr: &actionExpr{
-				pos: position{line: 149, col: 15, offset: 4483},
+				pos: position{line: 149, col: 15, offset: 4457},
 				run: (*parser).callonSecondUnits1,
 				expr: &litMatcher{
-					pos:        position{line: 149, col: 15, offset: 4483},
+					pos:        position{line: 149, col: 15, offset: 4457},
 					val:        "s",
 					ignoreCase: false,
 				},
@@ -1554,12 +1550,12 @@ var g = &grammar{
 		},
 		{
 			name: "MinuteUnits",
-			pos:  position{line: 153, col: 1, offset: 4520},
+			pos:  position{line: 153, col: 1, offset: 4494},
 			expr: &actionExpr{
-				pos: position{line: 153, col: 15, offset: 4534},
+				pos: position{line: 153, col: 15, offset: 4508},
 				run: (*parser).callonMinuteUnits1,
 				expr: &litMatcher{
-					pos:        position{line: 153, col: 15, offset: 4534},
+					pos:        position{line: 153, col: 15, offset: 4508},
 					val:        "m",
 					ignoreCase: false,
 				},
@@ -1567,12 +1563,12 @@ var g = &grammar{
 		},
 		{
 			name: "HourUnits",
-			pos:  position{line: 157, col: 1, offset: 4571},
+			pos:  position{line: 157, col: 1, offset: 4545},
 			expr: &actionExpr{
-				pos: position{line: 157, col: 13, offset: 4583},
+				pos: position{line: 157, col: 13, offset: 4557},
 				run: (*parser).callonHourUnits1,
 				expr: &litMatcher{
-					pos:        position{line: 157, col: 13, offset: 4583},
+					pos:        position{line: 157, col: 13, offset: 4557},
 					val:        "h",
 					ignoreCase: false,
 				},
@@ -1580,12 +1576,12 @@ var g = &grammar{
 		},
 		{
 			name: "DayUnits",
-			pos:  position{line: 161, col: 1, offset: 4618},
+			pos:  position{line: 161, col: 1, offset: 4592},
 			expr: &actionExpr{
-				pos: position{line: 161, col: 12, offset: 4629},
+				pos: position{line: 161, col: 12, offset: 4603},
 				run: (*parser).callonDayUnits1,
 				expr: &litMatcher{
-					pos:        position{line: 161, col: 12, offset: 4629},
+					pos:        position{line: 161, col: 12, offset: 4603},
 					val:        "d",
 					ignoreCase: false,
 				},
@@ -1593,12 +1589,12 @@ var g = &grammar{
 		},
 		{
 			name: "WeekUnits",
-			pos:  position{line: 167, col: 1, offset: 4837},
+			pos:  position{line: 167, col: 1, offset: 4811},
 			expr: &actionExpr{
-				pos: position{line: 167, col: 13, offset: 4849},
+				pos: position{line: 167, col: 13, offset: 4823},
 				run: (*parser).callonWeekUnits1,
 				expr: &litMatcher{
-					pos:        position{line: 167, col: 13, offset: 4849},
+					pos:        position{line: 167, col: 13, offset: 4823},
 					val:        "w",
 					ignoreCase: false,
 				},
@@ -1606,12 +1602,12 @@ var g = &grammar{
 		},
 		{
 			name: "YearUnits",
-			pos:  position{line: 173, col: 1, offset: 5060},
+			pos:  position{line: 173, col: 1, offset: 5034},
 			expr: &actionExpr{
-				pos: position{line: 173, col: 13, offset: 5072},
+				pos: position{line: 173, col: 13, offset: 5046},
 				run: (*parser).callonYearUnits1,
 				expr: &litMatcher{
-					pos:        position{line: 173, col: 13, offset: 5072},
+					pos:        position{line: 173, col: 13, offset: 5046},
 					val:        "y",
 					ignoreCase: false,
 				},
@@ -1619,44 +1615,44 @@ var g = &grammar{
 		},
 		{
 			name: "DurationUnits",
-			pos:  position{line: 179, col: 1, offset: 5269},
+			pos:  position{line: 179, col: 1, offset: 5243},
 			expr: &choiceExpr{
-				pos: position{line: 179, col: 18, offset: 5286},
+				pos: position{line: 179, col: 18, offset: 5260},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 179, col: 18, offset: 5286},
+						pos:  position{line: 179, col: 18, offset: 5260},
 						name: "NanoSecondUnits",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 179, col: 36, offset: 5304},
+						pos:  position{line: 179, col: 36, offset: 5278},
 						name: "MicroSecondUnits",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 179, col: 55, offset: 5323},
+						pos:  position{line: 179, col: 55, offset: 5297},
 						name: "MilliSecondUnits",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 179, col: 74, offset: 5342},
+						pos:  position{line: 179, col: 74, offset: 5316},
 						name: "SecondUnits",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 179, col: 88, offset: 5356},
+						pos:  position{line: 179, col: 88, offset: 5330},
 						name: "MinuteUnits",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 179, col: 102, offset: 5370},
+						pos:  position{line: 179, col: 102, offset: 5344},
 						name: "HourUnits",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 179, col: 114, offset: 5382},
+						pos:  position{line: 179, col: 114, offset: 5356},
 						name: "DayUnits",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 179, col: 125, offset: 5393},
+						pos:  position{line: 179, col: 125, offset: 5367},
 						name: "WeekUnits",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 179, col: 137, offset: 5405},
+						pos:  position{line: 179, col: 137, offset: 5379},
 						name: "YearUnits",
 					},
 				},
@@ -1664,26 +1660,26 @@ var g = &grammar{
 		},
 		{
 			name: "Duration",
-			pos:  position{line: 181, col: 1, offset: 5417},
+			pos:  position{line: 181, col: 1, offset: 5391},
 			expr: &actionExpr{
-				pos: position{line: 181, col: 12, offset: 5428},
+				pos: position{line: 181, col: 12, offset: 5402},
 				run: (*parser).callonDuration1,
 				expr: &seqExpr{
-					pos: position{line: 181, col: 12, offset: 5428},
+					pos: position{line: 181, col: 12, offset: 5402},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 181, col: 12, offset: 5428},
+							pos:   position{line: 181, col: 12, offset: 5402},
 							label: "dur",
 							expr: &ruleRefExpr{
-								pos:  position{line: 181, col: 16, offset: 5432},
+								pos:  position{line: 181, col: 16, offset: 5406},
 								name: "Integer",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 181, col: 24, offset: 5440},
+							pos:   position{line: 181, col: 24, offset: 5414},
 							label: "units",
 							expr: &ruleRefExpr{
-								pos:  position{line: 181, col: 30, offset: 5446},
+								pos:  position{line: 181, col: 30, offset: 5420},
 								name: "DurationUnits",
 							},
 						},
@@ -1693,82 +1689,82 @@ var g = &grammar{
 		},
 		{
 			name: "Operators",
-			pos:  position{line: 187, col: 1, offset: 5595},
+			pos:  position{line: 187, col: 1, offset: 5569},
 			expr: &choiceExpr{
-				pos: position{line: 187, col: 13, offset: 5607},
+				pos: position{line: 187, col: 13, offset: 5581},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 187, col: 13, offset: 5607},
+						pos:        position{line: 187, col: 13, offset: 5581},
 						val:        "-",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 187, col: 19, offset: 5613},
+						pos:        position{line: 187, col: 19, offset: 5587},
 						val:        "+",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 187, col: 25, offset: 5619},
+						pos:        position{line: 187, col: 25, offset: 5593},
 						val:        "*",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 187, col: 31, offset: 5625},
+						pos:        position{line: 187, col: 31, offset: 5599},
 						val:        "%",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 187, col: 37, offset: 5631},
+						pos:        position{line: 187, col: 37, offset: 5605},
 						val:        "/",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 187, col: 43, offset: 5637},
+						pos:        position{line: 187, col: 43, offset: 5611},
 						val:        "==",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 187, col: 50, offset: 5644},
+						pos:        position{line: 187, col: 50, offset: 5618},
 						val:        "!=",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 187, col: 57, offset: 5651},
+						pos:        position{line: 187, col: 57, offset: 5625},
 						val:        "<=",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 187, col: 64, offset: 5658},
+						pos:        position{line: 187, col: 64, offset: 5632},
 						val:        "<",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 187, col: 70, offset: 5664},
+						pos:        position{line: 187, col: 70, offset: 5638},
 						val:        ">=",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 187, col: 77, offset: 5671},
+						pos:        position{line: 187, col: 77, offset: 5645},
 						val:        ">",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 187, col: 83, offset: 5677},
+						pos:        position{line: 187, col: 83, offset: 5651},
 						val:        "=~",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 187, col: 90, offset: 5684},
+						pos:        position{line: 187, col: 90, offset: 5658},
 						val:        "!~",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 187, col: 97, offset: 5691},
+						pos:        position{line: 187, col: 97, offset: 5665},
 						val:        "^",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 187, col: 103, offset: 5697},
+						pos:        position{line: 187, col: 103, offset: 5671},
 						val:        "=",
 						ignoreCase: false,
 					},
@@ -1777,42 +1773,42 @@ var g = &grammar{
 		},
 		{
 			name: "LabelOperators",
-			pos:  position{line: 189, col: 1, offset: 5702},
+			pos:  position{line: 189, col: 1, offset: 5676},
 			expr: &choiceExpr{
-				pos: position{line: 189, col: 19, offset: 5720},
+				pos: position{line: 189, col: 19, offset: 5694},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 189, col: 19, offset: 5720},
+						pos: position{line: 189, col: 19, offset: 5694},
 						run: (*parser).callonLabelOperators2,
 						expr: &litMatcher{
-							pos:        position{line: 189, col: 19, offset: 5720},
+							pos:        position{line: 189, col: 19, offset: 5694},
 							val:        "!=",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 191, col: 5, offset: 5756},
+						pos: position{line: 191, col: 5, offset: 5730},
 						run: (*parser).callonLabelOperators4,
 						expr: &litMatcher{
-							pos:        position{line: 191, col: 5, offset: 5756},
+							pos:        position{line: 191, col: 5, offset: 5730},
 							val:        "=~",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 193, col: 5, offset: 5794},
+						pos: position{line: 193, col: 5, offset: 5768},
 						run: (*parser).callonLabelOperators6,
 						expr: &litMatcher{
-							pos:        position{line: 193, col: 5, offset: 5794},
+							pos:        position{line: 193, col: 5, offset: 5768},
 							val:        "!~",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 195, col: 5, offset: 5834},
+						pos: position{line: 195, col: 5, offset: 5808},
 						run: (*parser).callonLabelOperators8,
 						expr: &litMatcher{
-							pos:        position{line: 195, col: 5, offset: 5834},
+							pos:        position{line: 195, col: 5, offset: 5808},
 							val:        "=",
 							ignoreCase: false,
 						},
@@ -1822,57 +1818,57 @@ var g = &grammar{
 		},
 		{
 			name: "Label",
-			pos:  position{line: 199, col: 1, offset: 5865},
+			pos:  position{line: 199, col: 1, offset: 5839},
 			expr: &ruleRefExpr{
-				pos:  position{line: 199, col: 9, offset: 5873},
+				pos:  position{line: 199, col: 9, offset: 5847},
 				name: "Identifier",
 			},
 		},
 		{
 			name: "LabelMatch",
-			pos:  position{line: 200, col: 1, offset: 5884},
+			pos:  position{line: 200, col: 1, offset: 5858},
 			expr: &actionExpr{
-				pos: position{line: 200, col: 14, offset: 5897},
+				pos: position{line: 200, col: 14, offset: 5871},
 				run: (*parser).callonLabelMatch1,
 				expr: &seqExpr{
-					pos: position{line: 200, col: 14, offset: 5897},
+					pos: position{line: 200, col: 14, offset: 5871},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 200, col: 14, offset: 5897},
+							pos:   position{line: 200, col: 14, offset: 5871},
 							label: "label",
 							expr: &ruleRefExpr{
-								pos:  position{line: 200, col: 20, offset: 5903},
+								pos:  position{line: 200, col: 20, offset: 5877},
 								name: "Label",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 200, col: 26, offset: 5909},
+							pos:  position{line: 200, col: 26, offset: 5883},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 200, col: 29, offset: 5912},
+							pos:   position{line: 200, col: 29, offset: 5886},
 							label: "op",
 							expr: &ruleRefExpr{
-								pos:  position{line: 200, col: 32, offset: 5915},
+								pos:  position{line: 200, col: 32, offset: 5889},
 								name: "LabelOperators",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 200, col: 47, offset: 5930},
+							pos:  position{line: 200, col: 47, offset: 5904},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 200, col: 50, offset: 5933},
+							pos:   position{line: 200, col: 50, offset: 5907},
 							label: "match",
 							expr: &choiceExpr{
-								pos: position{line: 200, col: 58, offset: 5941},
+								pos: position{line: 200, col: 58, offset: 5915},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 200, col: 58, offset: 5941},
+										pos:  position{line: 200, col: 58, offset: 5915},
 										name: "StringLiteral",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 200, col: 74, offset: 5957},
+										pos:  position{line: 200, col: 74, offset: 5931},
 										name: "Number",
 									},
 								},
@@ -1884,32 +1880,32 @@ var g = &grammar{
 		},
 		{
 			name: "LabelMatches",
-			pos:  position{line: 203, col: 1, offset: 6047},
+			pos:  position{line: 203, col: 1, offset: 6021},
 			expr: &actionExpr{
-				pos: position{line: 203, col: 16, offset: 6062},
+				pos: position{line: 203, col: 16, offset: 6036},
 				run: (*parser).callonLabelMatches1,
 				expr: &seqExpr{
-					pos: position{line: 203, col: 16, offset: 6062},
+					pos: position{line: 203, col: 16, offset: 6036},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 203, col: 16, offset: 6062},
+							pos:   position{line: 203, col: 16, offset: 6036},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 203, col: 22, offset: 6068},
+								pos:  position{line: 203, col: 22, offset: 6042},
 								name: "LabelMatch",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 203, col: 33, offset: 6079},
+							pos:  position{line: 203, col: 33, offset: 6053},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 203, col: 36, offset: 6082},
+							pos:   position{line: 203, col: 36, offset: 6056},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 203, col: 41, offset: 6087},
+								pos: position{line: 203, col: 41, offset: 6061},
 								expr: &ruleRefExpr{
-									pos:  position{line: 203, col: 41, offset: 6087},
+									pos:  position{line: 203, col: 41, offset: 6061},
 									name: "LabelMatchesRest",
 								},
 							},
@@ -1920,27 +1916,27 @@ var g = &grammar{
 		},
 		{
 			name: "LabelMatchesRest",
-			pos:  position{line: 207, col: 1, offset: 6166},
+			pos:  position{line: 207, col: 1, offset: 6140},
 			expr: &actionExpr{
-				pos: position{line: 207, col: 21, offset: 6186},
+				pos: position{line: 207, col: 21, offset: 6160},
 				run: (*parser).callonLabelMatchesRest1,
 				expr: &seqExpr{
-					pos: position{line: 207, col: 21, offset: 6186},
+					pos: position{line: 207, col: 21, offset: 6160},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 207, col: 21, offset: 6186},
+							pos:        position{line: 207, col: 21, offset: 6160},
 							val:        ",",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 207, col: 25, offset: 6190},
+							pos:  position{line: 207, col: 25, offset: 6164},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 207, col: 28, offset: 6193},
+							pos:   position{line: 207, col: 28, offset: 6167},
 							label: "match",
 							expr: &ruleRefExpr{
-								pos:  position{line: 207, col: 34, offset: 6199},
+								pos:  position{line: 207, col: 34, offset: 6173},
 								name: "LabelMatch",
 							},
 						},
@@ -1950,27 +1946,27 @@ var g = &grammar{
 		},
 		{
 			name: "LabelList",
-			pos:  position{line: 211, col: 1, offset: 6237},
+			pos:  position{line: 211, col: 1, offset: 6211},
 			expr: &choiceExpr{
-				pos: position{line: 211, col: 13, offset: 6249},
+				pos: position{line: 211, col: 13, offset: 6223},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 211, col: 13, offset: 6249},
+						pos: position{line: 211, col: 13, offset: 6223},
 						run: (*parser).callonLabelList2,
 						expr: &seqExpr{
-							pos: position{line: 211, col: 14, offset: 6250},
+							pos: position{line: 211, col: 14, offset: 6224},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 211, col: 14, offset: 6250},
+									pos:        position{line: 211, col: 14, offset: 6224},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 211, col: 18, offset: 6254},
+									pos:  position{line: 211, col: 18, offset: 6228},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 211, col: 21, offset: 6257},
+									pos:        position{line: 211, col: 21, offset: 6231},
 									val:        ")",
 									ignoreCase: false,
 								},
@@ -1978,49 +1974,49 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 213, col: 6, offset: 6289},
+						pos: position{line: 213, col: 6, offset: 6263},
 						run: (*parser).callonLabelList7,
 						expr: &seqExpr{
-							pos: position{line: 213, col: 6, offset: 6289},
+							pos: position{line: 213, col: 6, offset: 6263},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 213, col: 6, offset: 6289},
+									pos:        position{line: 213, col: 6, offset: 6263},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 213, col: 10, offset: 6293},
+									pos:  position{line: 213, col: 10, offset: 6267},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 213, col: 13, offset: 6296},
+									pos:   position{line: 213, col: 13, offset: 6270},
 									label: "label",
 									expr: &ruleRefExpr{
-										pos:  position{line: 213, col: 19, offset: 6302},
+										pos:  position{line: 213, col: 19, offset: 6276},
 										name: "Label",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 213, col: 25, offset: 6308},
+									pos:  position{line: 213, col: 25, offset: 6282},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 213, col: 28, offset: 6311},
+									pos:   position{line: 213, col: 28, offset: 6285},
 									label: "rest",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 213, col: 33, offset: 6316},
+										pos: position{line: 213, col: 33, offset: 6290},
 										expr: &ruleRefExpr{
-											pos:  position{line: 213, col: 33, offset: 6316},
+											pos:  position{line: 213, col: 33, offset: 6290},
 											name: "LabelListRest",
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 213, col: 48, offset: 6331},
+									pos:  position{line: 213, col: 48, offset: 6305},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 213, col: 51, offset: 6334},
+									pos:        position{line: 213, col: 51, offset: 6308},
 									val:        ")",
 									ignoreCase: false,
 								},
@@ -2032,27 +2028,27 @@ var g = &grammar{
 		},
 		{
 			name: "LabelListRest",
-			pos:  position{line: 217, col: 1, offset: 6400},
+			pos:  position{line: 217, col: 1, offset: 6374},
 			expr: &actionExpr{
-				pos: position{line: 217, col: 18, offset: 6417},
+				pos: position{line: 217, col: 18, offset: 6391},
 				run: (*parser).callonLabelListRest1,
 				expr: &seqExpr{
-					pos: position{line: 217, col: 18, offset: 6417},
+					pos: position{line: 217, col: 18, offset: 6391},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 217, col: 18, offset: 6417},
+							pos:        position{line: 217, col: 18, offset: 6391},
 							val:        ",",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 217, col: 22, offset: 6421},
+							pos:  position{line: 217, col: 22, offset: 6395},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 217, col: 25, offset: 6424},
+							pos:   position{line: 217, col: 25, offset: 6398},
 							label: "label",
 							expr: &ruleRefExpr{
-								pos:  position{line: 217, col: 31, offset: 6430},
+								pos:  position{line: 217, col: 31, offset: 6404},
 								name: "Label",
 							},
 						},
@@ -2062,62 +2058,62 @@ var g = &grammar{
 		},
 		{
 			name: "VectorSelector",
-			pos:  position{line: 221, col: 1, offset: 6463},
+			pos:  position{line: 221, col: 1, offset: 6437},
 			expr: &actionExpr{
-				pos: position{line: 221, col: 18, offset: 6480},
+				pos: position{line: 221, col: 18, offset: 6454},
 				run: (*parser).callonVectorSelector1,
 				expr: &seqExpr{
-					pos: position{line: 221, col: 18, offset: 6480},
+					pos: position{line: 221, col: 18, offset: 6454},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 221, col: 18, offset: 6480},
+							pos:   position{line: 221, col: 18, offset: 6454},
 							label: "metric",
 							expr: &ruleRefExpr{
-								pos:  position{line: 221, col: 25, offset: 6487},
+								pos:  position{line: 221, col: 25, offset: 6461},
 								name: "Identifier",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 221, col: 36, offset: 6498},
+							pos:  position{line: 221, col: 36, offset: 6472},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 221, col: 40, offset: 6502},
+							pos:   position{line: 221, col: 40, offset: 6476},
 							label: "block",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 221, col: 46, offset: 6508},
+								pos: position{line: 221, col: 46, offset: 6482},
 								expr: &ruleRefExpr{
-									pos:  position{line: 221, col: 46, offset: 6508},
+									pos:  position{line: 221, col: 46, offset: 6482},
 									name: "LabelBlock",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 221, col: 58, offset: 6520},
+							pos:  position{line: 221, col: 58, offset: 6494},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 221, col: 61, offset: 6523},
+							pos:   position{line: 221, col: 61, offset: 6497},
 							label: "rng",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 221, col: 65, offset: 6527},
+								pos: position{line: 221, col: 65, offset: 6501},
 								expr: &ruleRefExpr{
-									pos:  position{line: 221, col: 65, offset: 6527},
+									pos:  position{line: 221, col: 65, offset: 6501},
 									name: "Range",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 221, col: 72, offset: 6534},
+							pos:  position{line: 221, col: 72, offset: 6508},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 221, col: 75, offset: 6537},
+							pos:   position{line: 221, col: 75, offset: 6511},
 							label: "offset",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 221, col: 82, offset: 6544},
+								pos: position{line: 221, col: 82, offset: 6518},
 								expr: &ruleRefExpr{
-									pos:  position{line: 221, col: 82, offset: 6544},
+									pos:  position{line: 221, col: 82, offset: 6518},
 									name: "Offset",
 								},
 							},
@@ -2128,36 +2124,36 @@ var g = &grammar{
 		},
 		{
 			name: "Range",
-			pos:  position{line: 225, col: 1, offset: 6622},
+			pos:  position{line: 225, col: 1, offset: 6596},
 			expr: &actionExpr{
-				pos: position{line: 225, col: 9, offset: 6630},
+				pos: position{line: 225, col: 9, offset: 6604},
 				run: (*parser).callonRange1,
 				expr: &seqExpr{
-					pos: position{line: 225, col: 9, offset: 6630},
+					pos: position{line: 225, col: 9, offset: 6604},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 225, col: 9, offset: 6630},
+							pos:        position{line: 225, col: 9, offset: 6604},
 							val:        "[",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 225, col: 13, offset: 6634},
+							pos:  position{line: 225, col: 13, offset: 6608},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 225, col: 16, offset: 6637},
+							pos:   position{line: 225, col: 16, offset: 6611},
 							label: "dur",
 							expr: &ruleRefExpr{
-								pos:  position{line: 225, col: 20, offset: 6641},
+								pos:  position{line: 225, col: 20, offset: 6615},
 								name: "Duration",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 225, col: 29, offset: 6650},
+							pos:  position{line: 225, col: 29, offset: 6624},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 225, col: 32, offset: 6653},
+							pos:        position{line: 225, col: 32, offset: 6627},
 							val:        "]",
 							ignoreCase: false,
 						},
@@ -2167,27 +2163,27 @@ var g = &grammar{
 		},
 		{
 			name: "Offset",
-			pos:  position{line: 229, col: 1, offset: 6682},
+			pos:  position{line: 229, col: 1, offset: 6656},
 			expr: &actionExpr{
-				pos: position{line: 229, col: 10, offset: 6691},
+				pos: position{line: 229, col: 10, offset: 6665},
 				run: (*parser).callonOffset1,
 				expr: &seqExpr{
-					pos: position{line: 229, col: 10, offset: 6691},
+					pos: position{line: 229, col: 10, offset: 6665},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 229, col: 10, offset: 6691},
+							pos:        position{line: 229, col: 10, offset: 6665},
 							val:        "offset",
 							ignoreCase: true,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 229, col: 20, offset: 6701},
+							pos:  position{line: 229, col: 20, offset: 6675},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 229, col: 23, offset: 6704},
+							pos:   position{line: 229, col: 23, offset: 6678},
 							label: "dur",
 							expr: &ruleRefExpr{
-								pos:  position{line: 229, col: 27, offset: 6708},
+								pos:  position{line: 229, col: 27, offset: 6682},
 								name: "Duration",
 							},
 						},
@@ -2197,12 +2193,12 @@ var g = &grammar{
 		},
 		{
 			name: "CountValueOperator",
-			pos:  position{line: 233, col: 1, offset: 6742},
+			pos:  position{line: 233, col: 1, offset: 6716},
 			expr: &actionExpr{
-				pos: position{line: 233, col: 22, offset: 6763},
+				pos: position{line: 233, col: 22, offset: 6737},
 				run: (*parser).callonCountValueOperator1,
 				expr: &litMatcher{
-					pos:        position{line: 233, col: 22, offset: 6763},
+					pos:        position{line: 233, col: 22, offset: 6737},
 					val:        "count_values",
 					ignoreCase: true,
 				},
@@ -2210,28 +2206,28 @@ var g = &grammar{
 		},
 		{
 			name: "BinaryAggregateOperators",
-			pos:  position{line: 239, col: 1, offset: 6848},
+			pos:  position{line: 239, col: 1, offset: 6822},
 			expr: &actionExpr{
-				pos: position{line: 239, col: 29, offset: 6876},
+				pos: position{line: 239, col: 29, offset: 6850},
 				run: (*parser).callonBinaryAggregateOperators1,
 				expr: &labeledExpr{
-					pos:   position{line: 239, col: 29, offset: 6876},
+					pos:   position{line: 239, col: 29, offset: 6850},
 					label: "op",
 					expr: &choiceExpr{
-						pos: position{line: 239, col: 33, offset: 6880},
+						pos: position{line: 239, col: 33, offset: 6854},
 						alternatives: []interface{}{
 							&litMatcher{
-								pos:        position{line: 239, col: 33, offset: 6880},
+								pos:        position{line: 239, col: 33, offset: 6854},
 								val:        "topk",
 								ignoreCase: true,
 							},
 							&litMatcher{
-								pos:        position{line: 239, col: 43, offset: 6890},
+								pos:        position{line: 239, col: 43, offset: 6864},
 								val:        "bottomk",
 								ignoreCase: true,
 							},
 							&litMatcher{
-								pos:        position{line: 239, col: 56, offset: 6903},
+								pos:        position{line: 239, col: 56, offset: 6877},
 								val:        "quantile",
 								ignoreCase: true,
 							},
@@ -2242,48 +2238,48 @@ var g = &grammar{
 		},
 		{
 			name: "UnaryAggregateOperators",
-			pos:  position{line: 245, col: 1, offset: 7005},
+			pos:  position{line: 245, col: 1, offset: 6979},
 			expr: &actionExpr{
-				pos: position{line: 245, col: 27, offset: 7031},
+				pos: position{line: 245, col: 27, offset: 7005},
 				run: (*parser).callonUnaryAggregateOperators1,
 				expr: &labeledExpr{
-					pos:   position{line: 245, col: 27, offset: 7031},
+					pos:   position{line: 245, col: 27, offset: 7005},
 					label: "op",
 					expr: &choiceExpr{
-						pos: position{line: 245, col: 31, offset: 7035},
+						pos: position{line: 245, col: 31, offset: 7009},
 						alternatives: []interface{}{
 							&litMatcher{
-								pos:        position{line: 245, col: 31, offset: 7035},
+								pos:        position{line: 245, col: 31, offset: 7009},
 								val:        "sum",
 								ignoreCase: true,
 							},
 							&litMatcher{
-								pos:        position{line: 245, col: 40, offset: 7044},
+								pos:        position{line: 245, col: 40, offset: 7018},
 								val:        "min",
 								ignoreCase: true,
 							},
 							&litMatcher{
-								pos:        position{line: 245, col: 49, offset: 7053},
+								pos:        position{line: 245, col: 49, offset: 7027},
 								val:        "max",
 								ignoreCase: true,
 							},
 							&litMatcher{
-								pos:        position{line: 245, col: 58, offset: 7062},
+								pos:        position{line: 245, col: 58, offset: 7036},
 								val:        "avg",
 								ignoreCase: true,
 							},
 							&litMatcher{
-								pos:        position{line: 245, col: 67, offset: 7071},
+								pos:        position{line: 245, col: 67, offset: 7045},
 								val:        "stddev",
 								ignoreCase: true,
 							},
 							&litMatcher{
-								pos:        position{line: 245, col: 79, offset: 7083},
+								pos:        position{line: 245, col: 79, offset: 7057},
 								val:        "stdvar",
 								ignoreCase: true,
 							},
 							&litMatcher{
-								pos:        position{line: 245, col: 91, offset: 7095},
+								pos:        position{line: 245, col: 91, offset: 7069},
 								val:        "count",
 								ignoreCase: true,
 							},
@@ -2294,20 +2290,20 @@ var g = &grammar{
 		},
 		{
 			name: "AggregateOperators",
-			pos:  position{line: 251, col: 1, offset: 7194},
+			pos:  position{line: 251, col: 1, offset: 7168},
 			expr: &choiceExpr{
-				pos: position{line: 251, col: 22, offset: 7215},
+				pos: position{line: 251, col: 22, offset: 7189},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 251, col: 22, offset: 7215},
+						pos:  position{line: 251, col: 22, offset: 7189},
 						name: "CountValueOperator",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 251, col: 43, offset: 7236},
+						pos:  position{line: 251, col: 43, offset: 7210},
 						name: "BinaryAggregateOperators",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 251, col: 70, offset: 7263},
+						pos:  position{line: 251, col: 70, offset: 7237},
 						name: "UnaryAggregateOperators",
 					},
 				},
@@ -2315,41 +2311,41 @@ var g = &grammar{
 		},
 		{
 			name: "AggregateBy",
-			pos:  position{line: 253, col: 1, offset: 7288},
+			pos:  position{line: 253, col: 1, offset: 7262},
 			expr: &actionExpr{
-				pos: position{line: 253, col: 15, offset: 7302},
+				pos: position{line: 253, col: 15, offset: 7276},
 				run: (*parser).callonAggregateBy1,
 				expr: &seqExpr{
-					pos: position{line: 253, col: 15, offset: 7302},
+					pos: position{line: 253, col: 15, offset: 7276},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 253, col: 15, offset: 7302},
+							pos:        position{line: 253, col: 15, offset: 7276},
 							val:        "by",
 							ignoreCase: true,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 253, col: 21, offset: 7308},
+							pos:  position{line: 253, col: 21, offset: 7282},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 253, col: 24, offset: 7311},
+							pos:   position{line: 253, col: 24, offset: 7285},
 							label: "labels",
 							expr: &ruleRefExpr{
-								pos:  position{line: 253, col: 31, offset: 7318},
+								pos:  position{line: 253, col: 31, offset: 7292},
 								name: "LabelList",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 253, col: 41, offset: 7328},
+							pos:  position{line: 253, col: 41, offset: 7302},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 253, col: 44, offset: 7331},
+							pos:   position{line: 253, col: 44, offset: 7305},
 							label: "keep",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 253, col: 49, offset: 7336},
+								pos: position{line: 253, col: 49, offset: 7310},
 								expr: &litMatcher{
-									pos:        position{line: 253, col: 49, offset: 7336},
+									pos:        position{line: 253, col: 49, offset: 7310},
 									val:        "keep_common",
 									ignoreCase: true,
 								},
@@ -2361,27 +2357,27 @@ var g = &grammar{
 		},
 		{
 			name: "AggregateWithout",
-			pos:  position{line: 260, col: 1, offset: 7449},
+			pos:  position{line: 260, col: 1, offset: 7423},
 			expr: &actionExpr{
-				pos: position{line: 260, col: 20, offset: 7468},
+				pos: position{line: 260, col: 20, offset: 7442},
 				run: (*parser).callonAggregateWithout1,
 				expr: &seqExpr{
-					pos: position{line: 260, col: 20, offset: 7468},
+					pos: position{line: 260, col: 20, offset: 7442},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 260, col: 20, offset: 7468},
+							pos:        position{line: 260, col: 20, offset: 7442},
 							val:        "without",
 							ignoreCase: true,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 260, col: 31, offset: 7479},
+							pos:  position{line: 260, col: 31, offset: 7453},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 260, col: 34, offset: 7482},
+							pos:   position{line: 260, col: 34, offset: 7456},
 							label: "labels",
 							expr: &ruleRefExpr{
-								pos:  position{line: 260, col: 41, offset: 7489},
+								pos:  position{line: 260, col: 41, offset: 7463},
 								name: "LabelList",
 							},
 						},
@@ -2391,16 +2387,16 @@ var g = &grammar{
 		},
 		{
 			name: "AggregateGroup",
-			pos:  position{line: 267, col: 1, offset: 7601},
+			pos:  position{line: 267, col: 1, offset: 7575},
 			expr: &choiceExpr{
-				pos: position{line: 267, col: 18, offset: 7618},
+				pos: position{line: 267, col: 18, offset: 7592},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 267, col: 18, offset: 7618},
+						pos:  position{line: 267, col: 18, offset: 7592},
 						name: "AggregateBy",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 267, col: 32, offset: 7632},
+						pos:  position{line: 267, col: 32, offset: 7606},
 						name: "AggregateWithout",
 					},
 				},
@@ -2408,86 +2404,86 @@ var g = &grammar{
 		},
 		{
 			name: "AggregateExpression",
-			pos:  position{line: 269, col: 1, offset: 7650},
+			pos:  position{line: 269, col: 1, offset: 7624},
 			expr: &choiceExpr{
-				pos: position{line: 270, col: 1, offset: 7672},
+				pos: position{line: 270, col: 1, offset: 7646},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 270, col: 1, offset: 7672},
+						pos: position{line: 270, col: 1, offset: 7646},
 						run: (*parser).callonAggregateExpression2,
 						expr: &seqExpr{
-							pos: position{line: 270, col: 1, offset: 7672},
+							pos: position{line: 270, col: 1, offset: 7646},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 270, col: 1, offset: 7672},
+									pos:   position{line: 270, col: 1, offset: 7646},
 									label: "op",
 									expr: &ruleRefExpr{
-										pos:  position{line: 270, col: 4, offset: 7675},
+										pos:  position{line: 270, col: 4, offset: 7649},
 										name: "CountValueOperator",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 270, col: 24, offset: 7695},
+									pos:  position{line: 270, col: 24, offset: 7669},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 270, col: 27, offset: 7698},
+									pos:        position{line: 270, col: 27, offset: 7672},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 270, col: 31, offset: 7702},
+									pos:  position{line: 270, col: 31, offset: 7676},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 270, col: 34, offset: 7705},
+									pos:   position{line: 270, col: 34, offset: 7679},
 									label: "param",
 									expr: &ruleRefExpr{
-										pos:  position{line: 270, col: 40, offset: 7711},
+										pos:  position{line: 270, col: 40, offset: 7685},
 										name: "StringLiteral",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 270, col: 54, offset: 7725},
+									pos:  position{line: 270, col: 54, offset: 7699},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 270, col: 57, offset: 7728},
+									pos:        position{line: 270, col: 57, offset: 7702},
 									val:        ",",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 270, col: 61, offset: 7732},
+									pos:  position{line: 270, col: 61, offset: 7706},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 270, col: 64, offset: 7735},
+									pos:   position{line: 270, col: 64, offset: 7709},
 									label: "vector",
 									expr: &ruleRefExpr{
-										pos:  position{line: 270, col: 71, offset: 7742},
-										name: "VectorSelector",
+										pos:  position{line: 270, col: 71, offset: 7716},
+										name: "Expression",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 270, col: 86, offset: 7757},
+									pos:  position{line: 270, col: 82, offset: 7727},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 270, col: 89, offset: 7760},
+									pos:        position{line: 270, col: 85, offset: 7730},
 									val:        ")",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 270, col: 93, offset: 7764},
+									pos:  position{line: 270, col: 89, offset: 7734},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 270, col: 96, offset: 7767},
+									pos:   position{line: 270, col: 92, offset: 7737},
 									label: "group",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 270, col: 102, offset: 7773},
+										pos: position{line: 270, col: 98, offset: 7743},
 										expr: &ruleRefExpr{
-											pos:  position{line: 270, col: 102, offset: 7773},
+											pos:  position{line: 270, col: 98, offset: 7743},
 											name: "AggregateGroup",
 										},
 									},
@@ -2496,82 +2492,82 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 276, col: 1, offset: 7921},
+						pos: position{line: 276, col: 1, offset: 7886},
 						run: (*parser).callonAggregateExpression22,
 						expr: &seqExpr{
-							pos: position{line: 276, col: 1, offset: 7921},
+							pos: position{line: 276, col: 1, offset: 7886},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 276, col: 1, offset: 7921},
+									pos:   position{line: 276, col: 1, offset: 7886},
 									label: "op",
 									expr: &ruleRefExpr{
-										pos:  position{line: 276, col: 4, offset: 7924},
+										pos:  position{line: 276, col: 4, offset: 7889},
 										name: "CountValueOperator",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 276, col: 24, offset: 7944},
+									pos:  position{line: 276, col: 24, offset: 7909},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 276, col: 27, offset: 7947},
+									pos:   position{line: 276, col: 27, offset: 7912},
 									label: "group",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 276, col: 33, offset: 7953},
+										pos: position{line: 276, col: 33, offset: 7918},
 										expr: &ruleRefExpr{
-											pos:  position{line: 276, col: 33, offset: 7953},
+											pos:  position{line: 276, col: 33, offset: 7918},
 											name: "AggregateGroup",
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 276, col: 49, offset: 7969},
+									pos:  position{line: 276, col: 49, offset: 7934},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 276, col: 52, offset: 7972},
+									pos:        position{line: 276, col: 52, offset: 7937},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 276, col: 56, offset: 7976},
+									pos:  position{line: 276, col: 56, offset: 7941},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 276, col: 59, offset: 7979},
+									pos:   position{line: 276, col: 59, offset: 7944},
 									label: "param",
 									expr: &ruleRefExpr{
-										pos:  position{line: 276, col: 65, offset: 7985},
+										pos:  position{line: 276, col: 65, offset: 7950},
 										name: "StringLiteral",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 276, col: 79, offset: 7999},
+									pos:  position{line: 276, col: 79, offset: 7964},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 276, col: 82, offset: 8002},
+									pos:        position{line: 276, col: 82, offset: 7967},
 									val:        ",",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 276, col: 86, offset: 8006},
+									pos:  position{line: 276, col: 86, offset: 7971},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 276, col: 89, offset: 8009},
+									pos:   position{line: 276, col: 89, offset: 7974},
 									label: "vector",
 									expr: &ruleRefExpr{
-										pos:  position{line: 276, col: 96, offset: 8016},
-										name: "VectorSelector",
+										pos:  position{line: 276, col: 96, offset: 7981},
+										name: "Expression",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 276, col: 111, offset: 8031},
+									pos:  position{line: 276, col: 107, offset: 7992},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 276, col: 114, offset: 8034},
+									pos:        position{line: 276, col: 110, offset: 7995},
 									val:        ")",
 									ignoreCase: false,
 								},
@@ -2579,81 +2575,81 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 282, col: 1, offset: 8170},
+						pos: position{line: 282, col: 1, offset: 8126},
 						run: (*parser).callonAggregateExpression42,
 						expr: &seqExpr{
-							pos: position{line: 282, col: 1, offset: 8170},
+							pos: position{line: 282, col: 1, offset: 8126},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 282, col: 1, offset: 8170},
+									pos:   position{line: 282, col: 1, offset: 8126},
 									label: "op",
 									expr: &ruleRefExpr{
-										pos:  position{line: 282, col: 4, offset: 8173},
+										pos:  position{line: 282, col: 4, offset: 8129},
 										name: "BinaryAggregateOperators",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 282, col: 30, offset: 8199},
+									pos:  position{line: 282, col: 30, offset: 8155},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 282, col: 33, offset: 8202},
+									pos:        position{line: 282, col: 33, offset: 8158},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 282, col: 37, offset: 8206},
+									pos:  position{line: 282, col: 37, offset: 8162},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 282, col: 41, offset: 8210},
+									pos:   position{line: 282, col: 41, offset: 8166},
 									label: "param",
 									expr: &ruleRefExpr{
-										pos:  position{line: 282, col: 47, offset: 8216},
+										pos:  position{line: 282, col: 47, offset: 8172},
 										name: "Number",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 282, col: 54, offset: 8223},
+									pos:  position{line: 282, col: 54, offset: 8179},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 282, col: 57, offset: 8226},
+									pos:        position{line: 282, col: 57, offset: 8182},
 									val:        ",",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 282, col: 61, offset: 8230},
+									pos:  position{line: 282, col: 61, offset: 8186},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 282, col: 64, offset: 8233},
+									pos:   position{line: 282, col: 64, offset: 8189},
 									label: "vector",
 									expr: &ruleRefExpr{
-										pos:  position{line: 282, col: 71, offset: 8240},
-										name: "VectorSelector",
+										pos:  position{line: 282, col: 71, offset: 8196},
+										name: "Expression",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 282, col: 86, offset: 8255},
+									pos:  position{line: 282, col: 82, offset: 8207},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 282, col: 89, offset: 8258},
+									pos:        position{line: 282, col: 85, offset: 8210},
 									val:        ")",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 282, col: 93, offset: 8262},
+									pos:  position{line: 282, col: 89, offset: 8214},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 282, col: 96, offset: 8265},
+									pos:   position{line: 282, col: 92, offset: 8217},
 									label: "group",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 282, col: 102, offset: 8271},
+										pos: position{line: 282, col: 98, offset: 8223},
 										expr: &ruleRefExpr{
-											pos:  position{line: 282, col: 102, offset: 8271},
+											pos:  position{line: 282, col: 98, offset: 8223},
 											name: "AggregateGroup",
 										},
 									},
@@ -2662,82 +2658,82 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 288, col: 1, offset: 8412},
+						pos: position{line: 288, col: 1, offset: 8359},
 						run: (*parser).callonAggregateExpression62,
 						expr: &seqExpr{
-							pos: position{line: 288, col: 1, offset: 8412},
+							pos: position{line: 288, col: 1, offset: 8359},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 288, col: 1, offset: 8412},
+									pos:   position{line: 288, col: 1, offset: 8359},
 									label: "op",
 									expr: &ruleRefExpr{
-										pos:  position{line: 288, col: 4, offset: 8415},
+										pos:  position{line: 288, col: 4, offset: 8362},
 										name: "BinaryAggregateOperators",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 288, col: 30, offset: 8441},
+									pos:  position{line: 288, col: 30, offset: 8388},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 288, col: 33, offset: 8444},
+									pos:   position{line: 288, col: 33, offset: 8391},
 									label: "group",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 288, col: 39, offset: 8450},
+										pos: position{line: 288, col: 39, offset: 8397},
 										expr: &ruleRefExpr{
-											pos:  position{line: 288, col: 39, offset: 8450},
+											pos:  position{line: 288, col: 39, offset: 8397},
 											name: "AggregateGroup",
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 288, col: 55, offset: 8466},
+									pos:  position{line: 288, col: 55, offset: 8413},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 288, col: 58, offset: 8469},
+									pos:        position{line: 288, col: 58, offset: 8416},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 288, col: 62, offset: 8473},
+									pos:  position{line: 288, col: 62, offset: 8420},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 288, col: 66, offset: 8477},
+									pos:   position{line: 288, col: 66, offset: 8424},
 									label: "param",
 									expr: &ruleRefExpr{
-										pos:  position{line: 288, col: 72, offset: 8483},
+										pos:  position{line: 288, col: 72, offset: 8430},
 										name: "Number",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 288, col: 79, offset: 8490},
+									pos:  position{line: 288, col: 79, offset: 8437},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 288, col: 82, offset: 8493},
+									pos:        position{line: 288, col: 82, offset: 8440},
 									val:        ",",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 288, col: 86, offset: 8497},
+									pos:  position{line: 288, col: 86, offset: 8444},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 288, col: 89, offset: 8500},
+									pos:   position{line: 288, col: 89, offset: 8447},
 									label: "vector",
 									expr: &ruleRefExpr{
-										pos:  position{line: 288, col: 96, offset: 8507},
-										name: "VectorSelector",
+										pos:  position{line: 288, col: 96, offset: 8454},
+										name: "Expression",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 288, col: 111, offset: 8522},
+									pos:  position{line: 288, col: 107, offset: 8465},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 288, col: 114, offset: 8525},
+									pos:        position{line: 288, col: 110, offset: 8468},
 									val:        ")",
 									ignoreCase: false,
 								},
@@ -2745,60 +2741,60 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 294, col: 1, offset: 8654},
+						pos: position{line: 294, col: 1, offset: 8592},
 						run: (*parser).callonAggregateExpression82,
 						expr: &seqExpr{
-							pos: position{line: 294, col: 1, offset: 8654},
+							pos: position{line: 294, col: 1, offset: 8592},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 294, col: 1, offset: 8654},
+									pos:   position{line: 294, col: 1, offset: 8592},
 									label: "op",
 									expr: &ruleRefExpr{
-										pos:  position{line: 294, col: 4, offset: 8657},
+										pos:  position{line: 294, col: 4, offset: 8595},
 										name: "UnaryAggregateOperators",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 294, col: 29, offset: 8682},
+									pos:  position{line: 294, col: 29, offset: 8620},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 294, col: 32, offset: 8685},
+									pos:        position{line: 294, col: 32, offset: 8623},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 294, col: 36, offset: 8689},
+									pos:  position{line: 294, col: 36, offset: 8627},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 294, col: 39, offset: 8692},
+									pos:   position{line: 294, col: 39, offset: 8630},
 									label: "vector",
 									expr: &ruleRefExpr{
-										pos:  position{line: 294, col: 46, offset: 8699},
-										name: "VectorSelector",
+										pos:  position{line: 294, col: 46, offset: 8637},
+										name: "Expression",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 294, col: 61, offset: 8714},
+									pos:  position{line: 294, col: 57, offset: 8648},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 294, col: 64, offset: 8717},
+									pos:        position{line: 294, col: 60, offset: 8651},
 									val:        ")",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 294, col: 68, offset: 8721},
+									pos:  position{line: 294, col: 64, offset: 8655},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 294, col: 71, offset: 8724},
+									pos:   position{line: 294, col: 67, offset: 8658},
 									label: "group",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 294, col: 77, offset: 8730},
+										pos: position{line: 294, col: 73, offset: 8664},
 										expr: &ruleRefExpr{
-											pos:  position{line: 294, col: 77, offset: 8730},
+											pos:  position{line: 294, col: 73, offset: 8664},
 											name: "AggregateGroup",
 										},
 									},
@@ -2807,61 +2803,61 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 298, col: 1, offset: 8823},
+						pos: position{line: 298, col: 1, offset: 8752},
 						run: (*parser).callonAggregateExpression97,
 						expr: &seqExpr{
-							pos: position{line: 298, col: 1, offset: 8823},
+							pos: position{line: 298, col: 1, offset: 8752},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 298, col: 1, offset: 8823},
+									pos:   position{line: 298, col: 1, offset: 8752},
 									label: "op",
 									expr: &ruleRefExpr{
-										pos:  position{line: 298, col: 4, offset: 8826},
+										pos:  position{line: 298, col: 4, offset: 8755},
 										name: "UnaryAggregateOperators",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 298, col: 29, offset: 8851},
+									pos:  position{line: 298, col: 29, offset: 8780},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 298, col: 32, offset: 8854},
+									pos:   position{line: 298, col: 32, offset: 8783},
 									label: "group",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 298, col: 38, offset: 8860},
+										pos: position{line: 298, col: 38, offset: 8789},
 										expr: &ruleRefExpr{
-											pos:  position{line: 298, col: 38, offset: 8860},
+											pos:  position{line: 298, col: 38, offset: 8789},
 											name: "AggregateGroup",
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 298, col: 54, offset: 8876},
+									pos:  position{line: 298, col: 54, offset: 8805},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 298, col: 57, offset: 8879},
+									pos:        position{line: 298, col: 57, offset: 8808},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 298, col: 61, offset: 8883},
+									pos:  position{line: 298, col: 61, offset: 8812},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 298, col: 64, offset: 8886},
+									pos:   position{line: 298, col: 64, offset: 8815},
 									label: "vector",
 									expr: &ruleRefExpr{
-										pos:  position{line: 298, col: 71, offset: 8893},
-										name: "VectorSelector",
+										pos:  position{line: 298, col: 71, offset: 8822},
+										name: "Expression",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 298, col: 86, offset: 8908},
+									pos:  position{line: 298, col: 82, offset: 8833},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 298, col: 89, offset: 8911},
+									pos:        position{line: 298, col: 85, offset: 8836},
 									val:        ")",
 									ignoreCase: false,
 								},
@@ -2872,110 +2868,362 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "__",
-			pos:  position{line: 302, col: 1, offset: 8991},
-			expr: &zeroOrMoreExpr{
-				pos: position{line: 302, col: 6, offset: 8996},
-				expr: &choiceExpr{
-					pos: position{line: 302, col: 8, offset: 8998},
-					alternatives: []interface{}{
+			name: "FunctionCall",
+			pos:  position{line: 302, col: 1, offset: 8911},
+			expr: &actionExpr{
+				pos: position{line: 302, col: 16, offset: 8926},
+				run: (*parser).callonFunctionCall1,
+				expr: &seqExpr{
+					pos: position{line: 302, col: 16, offset: 8926},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 302, col: 16, offset: 8926},
+							label: "name",
+							expr: &ruleRefExpr{
+								pos:  position{line: 302, col: 21, offset: 8931},
+								name: "Identifier",
+							},
+						},
 						&ruleRefExpr{
-							pos:  position{line: 302, col: 8, offset: 8998},
-							name: "Whitespace",
+							pos:  position{line: 302, col: 32, offset: 8942},
+							name: "__",
+						},
+						&litMatcher{
+							pos:        position{line: 302, col: 35, offset: 8945},
+							val:        "(",
+							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 302, col: 21, offset: 9011},
-							name: "EOL",
+							pos:  position{line: 302, col: 39, offset: 8949},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 302, col: 42, offset: 8952},
+							label: "args",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 302, col: 47, offset: 8957},
+								expr: &ruleRefExpr{
+									pos:  position{line: 302, col: 47, offset: 8957},
+									name: "FunctionArgs",
+								},
+							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 302, col: 27, offset: 9017},
-							name: "Comment",
+							pos:  position{line: 302, col: 61, offset: 8971},
+							name: "__",
+						},
+						&litMatcher{
+							pos:        position{line: 302, col: 64, offset: 8974},
+							val:        ")",
+							ignoreCase: false,
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "_",
-			pos:  position{line: 303, col: 1, offset: 9028},
-			expr: &zeroOrMoreExpr{
-				pos: position{line: 303, col: 5, offset: 9032},
-				expr: &ruleRefExpr{
-					pos:  position{line: 303, col: 5, offset: 9032},
-					name: "Whitespace",
+			name: "FunctionArgs",
+			pos:  position{line: 306, col: 1, offset: 9032},
+			expr: &actionExpr{
+				pos: position{line: 306, col: 16, offset: 9047},
+				run: (*parser).callonFunctionArgs1,
+				expr: &seqExpr{
+					pos: position{line: 306, col: 16, offset: 9047},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 306, col: 16, offset: 9047},
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 306, col: 22, offset: 9053},
+								name: "Expression",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 306, col: 33, offset: 9064},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 306, col: 36, offset: 9067},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 306, col: 41, offset: 9072},
+								expr: &ruleRefExpr{
+									pos:  position{line: 306, col: 41, offset: 9072},
+									name: "FunctionArgsRest",
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		{
-			name: "Whitespace",
-			pos:  position{line: 305, col: 1, offset: 9045},
-			expr: &charClassMatcher{
-				pos:        position{line: 305, col: 14, offset: 9058},
-				val:        "[ \\t\\r]",
-				chars:      []rune{' ', '\t', '\r'},
-				ignoreCase: false,
-				inverted:   false,
+			name: "FunctionArgsRest",
+			pos:  position{line: 310, col: 1, offset: 9138},
+			expr: &actionExpr{
+				pos: position{line: 310, col: 20, offset: 9157},
+				run: (*parser).callonFunctionArgsRest1,
+				expr: &seqExpr{
+					pos: position{line: 310, col: 20, offset: 9157},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 310, col: 20, offset: 9157},
+							val:        ",",
+							ignoreCase: false,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 310, col: 24, offset: 9161},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 310, col: 27, offset: 9164},
+							label: "arg",
+							expr: &ruleRefExpr{
+								pos:  position{line: 310, col: 31, offset: 9168},
+								name: "Expression",
+							},
+						},
+					},
+				},
 			},
 		},
 		{
-			name: "EOL",
-			pos:  position{line: 306, col: 1, offset: 9066},
-			expr: &litMatcher{
-				pos:        position{line: 306, col: 7, offset: 9072},
-				val:        "\n",
-				ignoreCase: false,
+			name: "ParenExpression",
+			pos:  position{line: 314, col: 1, offset: 9204},
+			expr: &actionExpr{
+				pos: position{line: 314, col: 19, offset: 9222},
+				run: (*parser).callonParenExpression1,
+				expr: &seqExpr{
+					pos: position{line: 314, col: 19, offset: 9222},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 314, col: 19, offset: 9222},
+							val:        "(",
+							ignoreCase: false,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 314, col: 23, offset: 9226},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 314, col: 26, offset: 9229},
+							label: "expr",
+							expr: &ruleRefExpr{
+								pos:  position{line: 314, col: 31, offset: 9234},
+								name: "Expression",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 314, col: 42, offset: 9245},
+							name: "__",
+						},
+						&litMatcher{
+							pos:        position{line: 314, col: 45, offset: 9248},
+							val:        ")",
+							ignoreCase: false,
+						},
+					},
+				},
 			},
 		},
 		{
-			name: "EOS",
-			pos:  position{line: 307, col: 1, offset: 9077},
+			name: "UnaryExpression",
+			pos:  position{line: 318, col: 1, offset: 9278},
 			expr: &choiceExpr{
-				pos: position{line: 307, col: 7, offset: 9083},
+				pos: position{line: 318, col: 19, offset: 9296},
 				alternatives: []interface{}{
-					&seqExpr{
-						pos: position{line: 307, col: 7, offset: 9083},
-						exprs: []interface{}{
-							&ruleRefExpr{
-								pos:  position{line: 307, col: 7, offset: 9083},
-								name: "__",
+					&ruleRefExpr{
+						pos:  position{line: 318, col: 19, offset: 9296},
+						name: "ParenExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 318, col: 37, offset: 9314},
+						name: "AggregateExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 318, col: 59, offset: 9336},
+						name: "FunctionCall",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 318, col: 74, offset: 9351},
+						name: "Number",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 318, col: 83, offset: 9360},
+						name: "VectorSelector",
+					},
+				},
+			},
+		},
+		{
+			name: "ReturnBool",
+			pos:  position{line: 320, col: 1, offset: 9376},
+			expr: &actionExpr{
+				pos: position{line: 320, col: 14, offset: 9389},
+				run: (*parser).callonReturnBool1,
+				expr: &seqExpr{
+					pos: position{line: 320, col: 14, offset: 9389},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 320, col: 14, offset: 9389},
+							val:        "bool",
+							ignoreCase: true,
+						},
+						&notExpr{
+							pos: position{line: 320, col: 22, offset: 9397},
+							expr: &ruleRefExpr{
+								pos:  position{line: 320, col: 23, offset: 9398},
+								name: "IdentifierPart",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "VectorMatching",
+			pos:  position{line: 324, col: 1, offset: 9439},
+			expr: &actionExpr{
+				pos: position{line: 324, col: 18, offset: 9456},
+				run: (*parser).callonVectorMatching1,
+				expr: &seqExpr{
+					pos: position{line: 324, col: 18, offset: 9456},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 324, col: 18, offset: 9456},
+							label: "kind",
+							expr: &choiceExpr{
+								pos: position{line: 324, col: 25, offset: 9463},
+								alternatives: []interface{}{
+									&litMatcher{
+										pos:        position{line: 324, col: 25, offset: 9463},
+										val:        "on",
+										ignoreCase: true,
+									},
+									&litMatcher{
+										pos:        position{line: 324, col: 33, offset: 9471},
+										val:        "ignoring",
+										ignoreCase: true,
+									},
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 324, col: 47, offset: 9485},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 324, col: 50, offset: 9488},
+							label: "labels",
+							expr: &ruleRefExpr{
+								pos:  position{line: 324, col: 57, offset: 9495},
+								name: "LabelList",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ComparisonOperators",
+			pos:  position{line: 328, col: 1, offset: 9570},
+			expr: &actionExpr{
+				pos: position{line: 328, col: 23, offset: 9592},
+				run: (*parser).callonComparisonOperators1,
+				expr: &labeledExpr{
+					pos:   position{line: 328, col: 23, offset: 9592},
+					label: "op",
+					expr: &choiceExpr{
+						pos: position{line: 328, col: 28, offset: 9597},
+						alternatives: []interface{}{
+							&litMatcher{
+								pos:        position{line: 328, col: 28, offset: 9597},
+								val:        "==",
+								ignoreCase: false,
 							},
 							&litMatcher{
-								pos:        position{line: 307, col: 10, offset: 9086},
-								val:        ";",
+								pos:        position{line: 328, col: 35, offset: 9604},
+								val:        "!=",
+								ignoreCase: false,
+							},
+							&litMatcher{
+								pos:        position{line: 328, col: 42, offset: 9611},
+								val:        ">=",
+								ignoreCase: false,
+							},
+							&litMatcher{
+								pos:        position{line: 328, col: 49, offset: 9618},
+								val:        ">",
+								ignoreCase: false,
+							},
+							&litMatcher{
+								pos:        position{line: 328, col: 55, offset: 9624},
+								val:        "<=",
+								ignoreCase: false,
+							},
+							&litMatcher{
+								pos:        position{line: 328, col: 62, offset: 9631},
+								val:        "<",
 								ignoreCase: false,
 							},
 						},
 					},
-					&seqExpr{
-						pos: position{line: 307, col: 16, offset: 9092},
-						exprs: []interface{}{
-							&ruleRefExpr{
-								pos:  position{line: 307, col: 16, offset: 9092},
-								name: "_",
-							},
-							&zeroOrOneExpr{
-								pos: position{line: 307, col: 18, offset: 9094},
-								expr: &ruleRefExpr{
-									pos:  position{line: 307, col: 18, offset: 9094},
-									name: "SingleLineComment",
-								},
+				},
+			},
+		},
+		{
+			name: "AdditiveOperators",
+			pos:  position{line: 332, col: 1, offset: 9694},
+			expr: &actionExpr{
+				pos: position{line: 332, col: 21, offset: 9714},
+				run: (*parser).callonAdditiveOperators1,
+				expr: &labeledExpr{
+					pos:   position{line: 332, col: 21, offset: 9714},
+					label: "op",
+					expr: &choiceExpr{
+						pos: position{line: 332, col: 26, offset: 9719},
+						alternatives: []interface{}{
+							&litMatcher{
+								pos:        position{line: 332, col: 26, offset: 9719},
+								val:        "+",
+								ignoreCase: false,
 							},
-							&ruleRefExpr{
-								pos:  position{line: 307, col: 37, offset: 9113},
-								name: "EOL",
+							&litMatcher{
+								pos:        position{line: 332, col: 32, offset: 9725},
+								val:        "-",
+								ignoreCase: false,
 							},
 						},
 					},
-					&seqExpr{
-						pos: position{line: 307, col: 43, offset: 9119},
-						exprs: []interface{}{
-							&ruleRefExpr{
-								pos:  position{line: 307, col: 43, offset: 9119},
-								name: "__",
+				},
+			},
+		},
+		{
+			name: "MultiplicativeOperators",
+			pos:  position{line: 336, col: 1, offset: 9788},
+			expr: &actionExpr{
+				pos: position{line: 336, col: 27, offset: 9814},
+				run: (*parser).callonMultiplicativeOperators1,
+				expr: &labeledExpr{
+					pos:   position{line: 336, col: 27, offset: 9814},
+					label: "op",
+					expr: &choiceExpr{
+						pos: position{line: 336, col: 32, offset: 9819},
+						alternatives: []interface{}{
+							&litMatcher{
+								pos:        position{line: 336, col: 32, offset: 9819},
+								val:        "*",
+								ignoreCase: false,
 							},
-							&ruleRefExpr{
-								pos:  position{line: 307, col: 46, offset: 9122},
-								name: "EOF",
+							&litMatcher{
+								pos:        position{line: 336, col: 38, offset: 9825},
+								val:        "/",
+								ignoreCase: false,
+							},
+							&litMatcher{
+								pos:        position{line: 336, col: 44, offset: 9831},
+								val:        "%",
+								ignoreCase: false,
 							},
 						},
 					},
@@ -2983,23 +3231,490 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "EOF",
-			pos:  position{line: 309, col: 1, offset: 9127},
-			expr: &notExpr{
-				pos: position{line: 309, col: 7, offset: 9133},
-				expr: &anyMatcher{
-					line: 309, col: 8, offset: 9134,
+			name: "PowerExpression",
+			pos:  position{line: 341, col: 1, offset: 9961},
+			expr: &actionExpr{
+				pos: position{line: 341, col: 19, offset: 9979},
+				run: (*parser).callonPowerExpression1,
+				expr: &seqExpr{
+					pos: position{line: 341, col: 19, offset: 9979},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 341, col: 19, offset: 9979},
+							label: "lhs",
+							expr: &ruleRefExpr{
+								pos:  position{line: 341, col: 23, offset: 9983},
+								name: "UnaryExpression",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 341, col: 39, offset: 9999},
+							label: "rest",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 341, col: 44, offset: 10004},
+								expr: &ruleRefExpr{
+									pos:  position{line: 341, col: 44, offset: 10004},
+									name: "PowerRest",
+								},
+							},
+						},
+					},
 				},
 			},
 		},
-	},
-}
-
-func (c *current) onGrammar1(grammar interface{}) (interface{}, error) {
-	return grammar, nil
-}
-
-func (p *parser) callonGrammar1() (interface{}, error) {
+		{
+			name: "PowerRest",
+			pos:  position{line: 348, col: 1, offset: 10130},
+			expr: &actionExpr{
+				pos: position{line: 348, col: 13, offset: 10142},
+				run: (*parser).callonPowerRest1,
+				expr: &seqExpr{
+					pos: position{line: 348, col: 13, offset: 10142},
+					exprs: []interface{}{
+						&ruleRefExpr{
+							pos:  position{line: 348, col: 13, offset: 10142},
+							name: "__",
+						},
+						&litMatcher{
+							pos:        position{line: 348, col: 16, offset: 10145},
+							val:        "^",
+							ignoreCase: false,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 348, col: 20, offset: 10149},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 348, col: 23, offset: 10152},
+							label: "matching",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 348, col: 32, offset: 10161},
+								expr: &ruleRefExpr{
+									pos:  position{line: 348, col: 32, offset: 10161},
+									name: "VectorMatching",
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 348, col: 48, offset: 10177},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 348, col: 51, offset: 10180},
+							label: "rhs",
+							expr: &ruleRefExpr{
+								pos:  position{line: 348, col: 55, offset: 10184},
+								name: "PowerExpression",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "MultiplicativeExpression",
+			pos:  position{line: 352, col: 1, offset: 10271},
+			expr: &actionExpr{
+				pos: position{line: 352, col: 28, offset: 10298},
+				run: (*parser).callonMultiplicativeExpression1,
+				expr: &seqExpr{
+					pos: position{line: 352, col: 28, offset: 10298},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 352, col: 28, offset: 10298},
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 352, col: 34, offset: 10304},
+								name: "PowerExpression",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 352, col: 50, offset: 10320},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 352, col: 55, offset: 10325},
+								expr: &ruleRefExpr{
+									pos:  position{line: 352, col: 55, offset: 10325},
+									name: "MultiplicativeRest",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "MultiplicativeRest",
+			pos:  position{line: 356, col: 1, offset: 10396},
+			expr: &actionExpr{
+				pos: position{line: 356, col: 22, offset: 10417},
+				run: (*parser).callonMultiplicativeRest1,
+				expr: &seqExpr{
+					pos: position{line: 356, col: 22, offset: 10417},
+					exprs: []interface{}{
+						&ruleRefExpr{
+							pos:  position{line: 356, col: 22, offset: 10417},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 356, col: 25, offset: 10420},
+							label: "op",
+							expr: &ruleRefExpr{
+								pos:  position{line: 356, col: 28, offset: 10423},
+								name: "MultiplicativeOperators",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 356, col: 52, offset: 10447},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 356, col: 55, offset: 10450},
+							label: "matching",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 356, col: 64, offset: 10459},
+								expr: &ruleRefExpr{
+									pos:  position{line: 356, col: 64, offset: 10459},
+									name: "VectorMatching",
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 356, col: 80, offset: 10475},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 356, col: 83, offset: 10478},
+							label: "rhs",
+							expr: &ruleRefExpr{
+								pos:  position{line: 356, col: 87, offset: 10482},
+								name: "PowerExpression",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "AdditiveExpression",
+			pos:  position{line: 360, col: 1, offset: 10579},
+			expr: &actionExpr{
+				pos: position{line: 360, col: 22, offset: 10600},
+				run: (*parser).callonAdditiveExpression1,
+				expr: &seqExpr{
+					pos: position{line: 360, col: 22, offset: 10600},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 360, col: 22, offset: 10600},
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 360, col: 28, offset: 10606},
+								name: "MultiplicativeExpression",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 360, col: 53, offset: 10631},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 360, col: 58, offset: 10636},
+								expr: &ruleRefExpr{
+									pos:  position{line: 360, col: 58, offset: 10636},
+									name: "AdditiveRest",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "AdditiveRest",
+			pos:  position{line: 364, col: 1, offset: 10701},
+			expr: &actionExpr{
+				pos: position{line: 364, col: 16, offset: 10716},
+				run: (*parser).callonAdditiveRest1,
+				expr: &seqExpr{
+					pos: position{line: 364, col: 16, offset: 10716},
+					exprs: []interface{}{
+						&ruleRefExpr{
+							pos:  position{line: 364, col: 16, offset: 10716},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 364, col: 19, offset: 10719},
+							label: "op",
+							expr: &ruleRefExpr{
+								pos:  position{line: 364, col: 22, offset: 10722},
+								name: "AdditiveOperators",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 364, col: 40, offset: 10740},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 364, col: 43, offset: 10743},
+							label: "matching",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 364, col: 52, offset: 10752},
+								expr: &ruleRefExpr{
+									pos:  position{line: 364, col: 52, offset: 10752},
+									name: "VectorMatching",
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 364, col: 68, offset: 10768},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 364, col: 71, offset: 10771},
+							label: "rhs",
+							expr: &ruleRefExpr{
+								pos:  position{line: 364, col: 75, offset: 10775},
+								name: "MultiplicativeExpression",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ComparisonExpression",
+			pos:  position{line: 368, col: 1, offset: 10881},
+			expr: &actionExpr{
+				pos: position{line: 368, col: 24, offset: 10904},
+				run: (*parser).callonComparisonExpression1,
+				expr: &seqExpr{
+					pos: position{line: 368, col: 24, offset: 10904},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 368, col: 24, offset: 10904},
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 368, col: 30, offset: 10910},
+								name: "AdditiveExpression",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 368, col: 49, offset: 10929},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 368, col: 54, offset: 10934},
+								expr: &ruleRefExpr{
+									pos:  position{line: 368, col: 54, offset: 10934},
+									name: "ComparisonRest",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ComparisonRest",
+			pos:  position{line: 372, col: 1, offset: 11001},
+			expr: &actionExpr{
+				pos: position{line: 372, col: 18, offset: 11018},
+				run: (*parser).callonComparisonRest1,
+				expr: &seqExpr{
+					pos: position{line: 372, col: 18, offset: 11018},
+					exprs: []interface{}{
+						&ruleRefExpr{
+							pos:  position{line: 372, col: 18, offset: 11018},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 372, col: 21, offset: 11021},
+							label: "op",
+							expr: &ruleRefExpr{
+								pos:  position{line: 372, col: 24, offset: 11024},
+								name: "ComparisonOperators",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 372, col: 44, offset: 11044},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 372, col: 47, offset: 11047},
+							label: "rb",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 372, col: 50, offset: 11050},
+								expr: &ruleRefExpr{
+									pos:  position{line: 372, col: 50, offset: 11050},
+									name: "ReturnBool",
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 372, col: 62, offset: 11062},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 372, col: 65, offset: 11065},
+							label: "matching",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 372, col: 74, offset: 11074},
+								expr: &ruleRefExpr{
+									pos:  position{line: 372, col: 74, offset: 11074},
+									name: "VectorMatching",
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 372, col: 90, offset: 11090},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 372, col: 93, offset: 11093},
+							label: "rhs",
+							expr: &ruleRefExpr{
+								pos:  position{line: 372, col: 97, offset: 11097},
+								name: "AdditiveExpression",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Expression",
+			pos:  position{line: 376, col: 1, offset: 11201},
+			expr: &ruleRefExpr{
+				pos:  position{line: 376, col: 14, offset: 11214},
+				name: "ComparisonExpression",
+			},
+		},
+		{
+			name: "__",
+			pos:  position{line: 378, col: 1, offset: 11236},
+			expr: &zeroOrMoreExpr{
+				pos: position{line: 378, col: 6, offset: 11241},
+				expr: &choiceExpr{
+					pos: position{line: 378, col: 8, offset: 11243},
+					alternatives: []interface{}{
+						&ruleRefExpr{
+							pos:  position{line: 378, col: 8, offset: 11243},
+							name: "Whitespace",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 378, col: 21, offset: 11256},
+							name: "EOL",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 378, col: 27, offset: 11262},
+							name: "Comment",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "_",
+			pos:  position{line: 379, col: 1, offset: 11273},
+			expr: &zeroOrMoreExpr{
+				pos: position{line: 379, col: 5, offset: 11277},
+				expr: &ruleRefExpr{
+					pos:  position{line: 379, col: 5, offset: 11277},
+					name: "Whitespace",
+				},
+			},
+		},
+		{
+			name: "Whitespace",
+			pos:  position{line: 381, col: 1, offset: 11290},
+			expr: &charClassMatcher{
+				pos:        position{line: 381, col: 14, offset: 11303},
+				val:        "[ \\t\\r]",
+				chars:      []rune{' ', '\t', '\r'},
+				ignoreCase: false,
+				inverted:   false,
+			},
+		},
+		{
+			name: "EOL",
+			pos:  position{line: 382, col: 1, offset: 11311},
+			expr: &litMatcher{
+				pos:        position{line: 382, col: 7, offset: 11317},
+				val:        "\n",
+				ignoreCase: false,
+			},
+		},
+		{
+			name: "EOS",
+			pos:  position{line: 383, col: 1, offset: 11322},
+			expr: &choiceExpr{
+				pos: position{line: 383, col: 7, offset: 11328},
+				alternatives: []interface{}{
+					&seqExpr{
+						pos: position{line: 383, col: 7, offset: 11328},
+						exprs: []interface{}{
+							&ruleRefExpr{
+								pos:  position{line: 383, col: 7, offset: 11328},
+								name: "__",
+							},
+							&litMatcher{
+								pos:        position{line: 383, col: 10, offset: 11331},
+								val:        ";",
+								ignoreCase: false,
+							},
+						},
+					},
+					&seqExpr{
+						pos: position{line: 383, col: 16, offset: 11337},
+						exprs: []interface{}{
+							&ruleRefExpr{
+								pos:  position{line: 383, col: 16, offset: 11337},
+								name: "_",
+							},
+							&zeroOrOneExpr{
+								pos: position{line: 383, col: 18, offset: 11339},
+								expr: &ruleRefExpr{
+									pos:  position{line: 383, col: 18, offset: 11339},
+									name: "SingleLineComment",
+								},
+							},
+							&ruleRefExpr{
+								pos:  position{line: 383, col: 37, offset: 11358},
+								name: "EOL",
+							},
+						},
+					},
+					&seqExpr{
+						pos: position{line: 383, col: 43, offset: 11364},
+						exprs: []interface{}{
+							&ruleRefExpr{
+								pos:  position{line: 383, col: 43, offset: 11364},
+								name: "__",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 383, col: 46, offset: 11367},
+								name: "EOF",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "EOF",
+			pos:  position{line: 385, col: 1, offset: 11372},
+			expr: &notExpr{
+				pos: position{line: 385, col: 7, offset: 11378},
+				expr: &anyMatcher{
+					line: 385, col: 8, offset: 11379,
+				},
+			},
+		},
+	},
+}
+
+func (c *current) onGrammar1(grammar interface{}) (interface{}, error) {
+	return grammar, nil
+}
+
+func (p *parser) callonGrammar1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
 	return p.cur.onGrammar1(stack["grammar"])
@@ -3016,7 +3731,8 @@ func (p *parser) callonComment1() (interface{}, error) {
 }
 
 func (c *current) onIdentifier1(ident interface{}) (interface{}, error) {
-	if reservedWords[string(c.text)] {
+	i := string(c.text)
+	if reservedWords[i] {
 		return nil, errors.New("identifier is a reserved word")
 	}
 	return &Identifier{ident.(string)}, nil
@@ -3555,7 +4271,7 @@ func (p *parser) callonAggregateWithout1() (interface{}, error) {
 func (c *current) onAggregateExpression2(op, param, vector, group interface{}) (interface{}, error) {
 	oper := op.(*Operator)
 	oper.Arg = param.(*StringLiteral)
-	return NewAggregateExpr(oper, vector.(*Selector), group)
+	return NewAggregateExpr(oper, vector.(Expr), group)
 }
 
 func (p *parser) callonAggregateExpression2() (interface{}, error) {
@@ -3567,7 +4283,7 @@ func (p *parser) callonAggregateExpression2() (interface{}, error) {
 func (c *current) onAggregateExpression22(op, group, param, vector interface{}) (interface{}, error) {
 	oper := op.(*Operator)
 	oper.Arg = param.(*StringLiteral)
-	return NewAggregateExpr(oper, vector.(*Selector), group)
+	return NewAggregateExpr(oper, vector.(Expr), group)
 }
 
 func (p *parser) callonAggregateExpression22() (interface{}, error) {
@@ -3579,7 +4295,7 @@ func (p *parser) callonAggregateExpression22() (interface{}, error) {
 func (c *current) onAggregateExpression42(op, param, vector, group interface{}) (interface{}, error) {
 	oper := op.(*Operator)
 	oper.Arg = param.(*Number)
-	return NewAggregateExpr(oper, vector.(*Selector), group)
+	return NewAggregateExpr(oper, vector.(Expr), group)
 }
 
 func (p *parser) callonAggregateExpression42() (interface{}, error) {
@@ -3591,7 +4307,7 @@ func (p *parser) callonAggregateExpression42() (interface{}, error) {
 func (c *current) onAggregateExpression62(op, group, param, vector interface{}) (interface{}, error) {
 	oper := op.(*Operator)
 	oper.Arg = param.(*Number)
-	return NewAggregateExpr(oper, vector.(*Selector), group)
+	return NewAggregateExpr(oper, vector.(Expr), group)
 }
 
 func (p *parser) callonAggregateExpression62() (interface{}, error) {
@@ -3601,7 +4317,7 @@ func (p *parser) callonAggregateExpression62() (interface{}, error) {
 }
 
 func (c *current) onAggregateExpression82(op, vector, group interface{}) (interface{}, error) {
-	return NewAggregateExpr(op.(*Operator), vector.(*Selector), group)
+	return NewAggregateExpr(op.(*Operator), vector.(Expr), group)
 }
 
 func (p *parser) callonAggregateExpression82() (interface{}, error) {
@@ -3611,7 +4327,7 @@ func (p *parser) callonAggregateExpression82() (interface{}, error) {
 }
 
 func (c *current) onAggregateExpression97(op, group, vector interface{}) (interface{}, error) {
-	return NewAggregateExpr(op.(*Operator), vector.(*Selector), group)
+	return NewAggregateExpr(op.(*Operator), vector.(Expr), group)
 }
 
 func (p *parser) callonAggregateExpression97() (interface{}, error) {
@@ -3620,6 +4336,179 @@ func (p *parser) callonAggregateExpression97() (interface{}, error) {
 	return p.cur.onAggregateExpression97(stack["op"], stack["group"], stack["vector"])
 }
 
+func (c *current) onFunctionCall1(name, args interface{}) (interface{}, error) {
+	return NewFunction(name.(*Identifier), args)
+}
+
+func (p *parser) callonFunctionCall1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onFunctionCall1(stack["name"], stack["args"])
+}
+
+func (c *current) onFunctionArgs1(first, rest interface{}) (interface{}, error) {
+	return NewExprList(first.(Expr), rest)
+}
+
+func (p *parser) callonFunctionArgs1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onFunctionArgs1(stack["first"], stack["rest"])
+}
+
+func (c *current) onFunctionArgsRest1(arg interface{}) (interface{}, error) {
+	return arg, nil
+}
+
+func (p *parser) callonFunctionArgsRest1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onFunctionArgsRest1(stack["arg"])
+}
+
+func (c *current) onParenExpression1(expr interface{}) (interface{}, error) {
+	return expr, nil
+}
+
+func (p *parser) callonParenExpression1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onParenExpression1(stack["expr"])
+}
+
+func (c *current) onReturnBool1() (interface{}, error) {
+	return true, nil
+}
+
+func (p *parser) callonReturnBool1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onReturnBool1()
+}
+
+func (c *current) onVectorMatching1(kind, labels interface{}) (interface{}, error) {
+	return NewVectorMatching(string(kind.([]byte)), labels)
+}
+
+func (p *parser) callonVectorMatching1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onVectorMatching1(stack["kind"], stack["labels"])
+}
+
+func (c *current) onComparisonOperators1(op interface{}) (interface{}, error) {
+	return ToBinaryOpKind(string(op.([]byte))), nil
+}
+
+func (p *parser) callonComparisonOperators1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onComparisonOperators1(stack["op"])
+}
+
+func (c *current) onAdditiveOperators1(op interface{}) (interface{}, error) {
+	return ToBinaryOpKind(string(op.([]byte))), nil
+}
+
+func (p *parser) callonAdditiveOperators1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAdditiveOperators1(stack["op"])
+}
+
+func (c *current) onMultiplicativeOperators1(op interface{}) (interface{}, error) {
+	return ToBinaryOpKind(string(op.([]byte))), nil
+}
+
+func (p *parser) callonMultiplicativeOperators1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onMultiplicativeOperators1(stack["op"])
+}
+
+func (c *current) onPowerExpression1(lhs, rest interface{}) (interface{}, error) {
+	if rest == nil {
+		return lhs, nil
+	}
+	return NewBinaryExprs(lhs.(Expr), []interface{}{rest})
+}
+
+func (p *parser) callonPowerExpression1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPowerExpression1(stack["lhs"], stack["rest"])
+}
+
+func (c *current) onPowerRest1(matching, rhs interface{}) (interface{}, error) {
+	return NewBinaryOperand(PowKind, false, matching, rhs.(Expr))
+}
+
+func (p *parser) callonPowerRest1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPowerRest1(stack["matching"], stack["rhs"])
+}
+
+func (c *current) onMultiplicativeExpression1(first, rest interface{}) (interface{}, error) {
+	return NewBinaryExprs(first.(Expr), rest)
+}
+
+func (p *parser) callonMultiplicativeExpression1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onMultiplicativeExpression1(stack["first"], stack["rest"])
+}
+
+func (c *current) onMultiplicativeRest1(op, matching, rhs interface{}) (interface{}, error) {
+	return NewBinaryOperand(op.(BinaryOpKind), false, matching, rhs.(Expr))
+}
+
+func (p *parser) callonMultiplicativeRest1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onMultiplicativeRest1(stack["op"], stack["matching"], stack["rhs"])
+}
+
+func (c *current) onAdditiveExpression1(first, rest interface{}) (interface{}, error) {
+	return NewBinaryExprs(first.(Expr), rest)
+}
+
+func (p *parser) callonAdditiveExpression1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAdditiveExpression1(stack["first"], stack["rest"])
+}
+
+func (c *current) onAdditiveRest1(op, matching, rhs interface{}) (interface{}, error) {
+	return NewBinaryOperand(op.(BinaryOpKind), false, matching, rhs.(Expr))
+}
+
+func (p *parser) callonAdditiveRest1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAdditiveRest1(stack["op"], stack["matching"], stack["rhs"])
+}
+
+func (c *current) onComparisonExpression1(first, rest interface{}) (interface{}, error) {
+	return NewBinaryExprs(first.(Expr), rest)
+}
+
+func (p *parser) callonComparisonExpression1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onComparisonExpression1(stack["first"], stack["rest"])
+}
+
+func (c *current) onComparisonRest1(op, rb, matching, rhs interface{}) (interface{}, error) {
+	return NewBinaryOperand(op.(BinaryOpKind), rb != nil, matching, rhs.(Expr))
+}
+
+func (p *parser) callonComparisonRest1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onComparisonRest1(stack["op"], stack["rb"], stack["matching"], stack["rhs"])
+}
+
 var (
 	// errNoRule is returned when the grammar to parse has no rule.
 	errNoRule = errors.New("grammar has no rule")
@@ -3678,18 +4567,17 @@ func Entrypoint(ruleName string) Option {
 //
 // Example usage:
 //
-//     input := "input"
-//     stats := Stats{}
-//     _, err := Parse("input-file", []byte(input), Statistics(&stats, "no match"))
-//     if err != nil {
-//         log.Panicln(err)
-//     }
-//     b, err := json.MarshalIndent(stats.ChoiceAltCnt, "", "  ")
-//     if err != nil {
-//         log.Panicln(err)
-//     }
-//     fmt.Println(string(b))
-//
+//	input := "input"
+//	stats := Stats{}
+//	_, err := Parse("input-file", []byte(input), Statistics(&stats, "no match"))
+//	if err != nil {
+//	    log.Panicln(err)
+//	}
+//	b, err := json.MarshalIndent(stats.ChoiceAltCnt, "", "  ")
+//	if err != nil {
+//	    log.Panicln(err)
+//	}
+//	fmt.Println(string(b))
 func Statistics(stats *Stats, choiceNoMatch string) Option {
 	return func(p *parser) Option {
 		oldStats := p.Stats
@@ -8,6 +8,7 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/influxdata/flux"
 	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/execute"
 	"github.com/influxdata/flux/functions/inputs"
 	"github.com/influxdata/flux/functions/transformations"
 	"github.com/influxdata/flux/semantic"
@@ -334,6 +335,212 @@ func TestParsePromQL(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:   "sum of a rate by label",
+			promql: `sum(rate(http_requests_total[5m])) by (job)`,
+			want: &AggregateExpr{
+				Op: &Operator{
+					Kind: SumKind,
+				},
+				Selector: &Function{
+					Name: "rate",
+					Args: []Expr{
+						&Selector{
+							Name:  "http_requests_total",
+							Range: 5 * time.Minute,
+						},
+					},
+				},
+				Aggregate: &Aggregate{
+					By: true,
+					Labels: []*Identifier{
+						{
+							Name: "job",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:   "histogram quantile",
+			promql: `histogram_quantile(0.9, rate(http_request_duration_seconds_bucket[10m]))`,
+			want: &Function{
+				Name: "histogram_quantile",
+				Args: []Expr{
+					&Number{
+						Val: 0.9,
+					},
+					&Function{
+						Name: "rate",
+						Args: []Expr{
+							&Selector{
+								Name:  "http_request_duration_seconds_bucket",
+								Range: 10 * time.Minute,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "unknown function",
+			promql:  `foo(bar)`,
+			wantErr: true,
+			want:    "",
+		},
+		{
+			name:    "rate of an instant vector",
+			promql:  `rate(http_requests_total)`,
+			wantErr: true,
+			want:    "",
+		},
+		{
+			name:    "histogram quantile without a scalar",
+			promql:  `histogram_quantile(http_requests_total, http_requests_total)`,
+			wantErr: true,
+			want:    "",
+		},
+		{
+			name:   "multiplication binds tighter than addition",
+			promql: `a + b * 2`,
+			want: &BinaryExpr{
+				Op: AddKind,
+				LHS: &Selector{
+					Name: "a",
+				},
+				RHS: &BinaryExpr{
+					Op: MulKind,
+					LHS: &Selector{
+						Name: "b",
+					},
+					RHS: &Number{
+						Val: 2,
+					},
+				},
+			},
+		},
+		{
+			name:   "subtraction is left associative",
+			promql: `a - b - c`,
+			want: &BinaryExpr{
+				Op: SubKind,
+				LHS: &BinaryExpr{
+					Op: SubKind,
+					LHS: &Selector{
+						Name: "a",
+					},
+					RHS: &Selector{
+						Name: "b",
+					},
+				},
+				RHS: &Selector{
+					Name: "c",
+				},
+			},
+		},
+		{
+			name:   "parenthesis",
+			promql: `(a + b) / c`,
+			want: &BinaryExpr{
+				Op: DivKind,
+				LHS: &BinaryExpr{
+					Op: AddKind,
+					LHS: &Selector{
+						Name: "a",
+					},
+					RHS: &Selector{
+						Name: "b",
+					},
+				},
+				RHS: &Selector{
+					Name: "c",
+				},
+			},
+		},
+		{
+			name:   "scalars are evaluated",
+			promql: `a * (60 * 60) ^ 2`,
+			want: &BinaryExpr{
+				Op: MulKind,
+				LHS: &Selector{
+					Name: "a",
+				},
+				RHS: &Number{
+					Val: 3600 * 3600,
+				},
+			},
+		},
+		{
+			name:   "comparison with bool and vector matching",
+			promql: `errors_total > bool on(job, instance) requests_total`,
+			want: &BinaryExpr{
+				Op: GreaterKind,
+				LHS: &Selector{
+					Name: "errors_total",
+				},
+				RHS: &Selector{
+					Name: "requests_total",
+				},
+				ReturnBool: true,
+				Matching: &VectorMatching{
+					On: true,
+					Labels: []*Identifier{
+						{
+							Name: "job",
+						},
+						{
+							Name: "instance",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:   "ignoring",
+			promql: `errors_total / ignoring(code) requests_total`,
+			want: &BinaryExpr{
+				Op: DivKind,
+				LHS: &Selector{
+					Name: "errors_total",
+				},
+				RHS: &Selector{
+					Name: "requests_total",
+				},
+				Matching: &VectorMatching{
+					Ignoring: true,
+					Labels: []*Identifier{
+						{
+							Name: "code",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:   "metric name starting with bool",
+			promql: `a > boolean_total`,
+			want: &BinaryExpr{
+				Op: GreaterKind,
+				LHS: &Selector{
+					Name: "a",
+				},
+				RHS: &Selector{
+					Name: "boolean_total",
+				},
+			},
+		},
+		{
+			name:    "comparison between scalars without bool",
+			promql:  `1 > 2`,
+			wantErr: true,
+			want:    "",
+		},
+		{
+			name:    "bool modifier on arithmetic",
+			promql:  `a + bool b`,
+			wantErr: true,
+			want:    "",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -421,7 +628,7 @@ func TestBuild(t *testing.T) {
 						},
 					},
 					{
-						ID: flux.OperationID("count"), Spec: &transformations.CountOpSpec{},
+						ID: flux.OperationID("count"), Spec: &transformations.CountOpSpec{AggregateConfig: execute.DefaultAggregateConfig},
 					},
 				},
 				Edges: []flux.Edge{
@@ -448,7 +655,11 @@ func TestBuild(t *testing.T) {
 					{
 						ID: flux.OperationID("range"),
 						Spec: &transformations.RangeOpSpec{
-							Start: flux.Time{Relative: -time.Minute * 7},
+							Start:       flux.Time{IsRelative: true, Relative: -time.Minute * 7},
+							Stop:        flux.Time{IsRelative: true, Relative: -time.Minute * 5},
+							TimeColumn:  execute.DefaultTimeColLabel,
+							StartColumn: execute.DefaultStartColLabel,
+							StopColumn:  execute.DefaultStopColLabel,
 						},
 					},
 					{
@@ -516,7 +727,11 @@ func TestBuild(t *testing.T) {
 					{
 						ID: flux.OperationID("range"),
 						Spec: &transformations.RangeOpSpec{
-							Start: flux.Time{Relative: -170 * time.Hour},
+							Start:       flux.Time{IsRelative: true, Relative: -170 * time.Hour},
+							Stop:        flux.Time{IsRelative: true},
+							TimeColumn:  execute.DefaultTimeColLabel,
+							StartColumn: execute.DefaultStartColLabel,
+							StopColumn:  execute.DefaultStopColLabel,
 						},
 					},
 					{
@@ -559,7 +774,124 @@ func TestBuild(t *testing.T) {
 						},
 					},
 					{
-						ID: flux.OperationID("sum"), Spec: &transformations.SumOpSpec{},
+						ID: flux.OperationID("sum"), Spec: &transformations.SumOpSpec{AggregateConfig: execute.DefaultAggregateConfig},
+					},
+				},
+				Edges: []flux.Edge{
+					{
+						Parent: flux.OperationID("from"),
+						Child:  flux.OperationID("range"),
+					},
+					{
+						Parent: flux.OperationID("range"),
+						Child:  flux.OperationID("where"),
+					},
+					{
+						Parent: flux.OperationID("where"),
+						Child:  flux.OperationID("sum"),
+					},
+				},
+			},
+		},
+		{
+			name:   "vector multiplied by a scalar",
+			promql: `node_cpu * 100`,
+			want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID:   flux.OperationID("from"),
+						Spec: &inputs.FromOpSpec{Bucket: "prometheus"},
+					},
+					{
+						ID:   flux.OperationID("where"),
+						Spec: metricFilter("node_cpu"),
+					},
+					{
+						ID: flux.OperationID("map"),
+						Spec: &transformations.MapOpSpec{
+							Fn: rowFn(&semantic.ObjectExpression{
+								Properties: []*semantic.Property{
+									{
+										Key: &semantic.Identifier{Name: "_value"},
+										Value: &semantic.BinaryExpression{
+											Operator: ast.MultiplicationOperator,
+											Left:     column("_value"),
+											Right:    &semantic.FloatLiteral{Value: 100},
+										},
+									},
+									{
+										Key:   &semantic.Identifier{Name: "_time"},
+										Value: column("_time"),
+									},
+								},
+							}),
+							MergeKey: true,
+						},
+					},
+				},
+				Edges: []flux.Edge{
+					{
+						Parent: flux.OperationID("from"),
+						Child:  flux.OperationID("where"),
+					},
+					{
+						Parent: flux.OperationID("where"),
+						Child:  flux.OperationID("map"),
+					},
+				},
+			},
+		},
+		{
+			name:   "rate",
+			promql: `rate(http_requests_total[5m])`,
+			want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID:   flux.OperationID("from"),
+						Spec: &inputs.FromOpSpec{Bucket: "prometheus"},
+					},
+					{
+						ID: flux.OperationID("range"),
+						Spec: &transformations.RangeOpSpec{
+							Start:       flux.Time{IsRelative: true, Relative: -5 * time.Minute},
+							Stop:        flux.Time{IsRelative: true},
+							TimeColumn:  execute.DefaultTimeColLabel,
+							StartColumn: execute.DefaultStartColLabel,
+							StopColumn:  execute.DefaultStopColLabel,
+						},
+					},
+					{
+						ID:   flux.OperationID("where"),
+						Spec: metricFilter("http_requests_total"),
+					},
+					{
+						ID: flux.OperationID("difference"),
+						Spec: &transformations.DifferenceOpSpec{
+							NonNegative: true,
+							Columns:     []string{"_value"},
+						},
+					},
+					{
+						ID:   flux.OperationID("sum"),
+						Spec: &transformations.SumOpSpec{AggregateConfig: execute.DefaultAggregateConfig},
+					},
+					{
+						ID: flux.OperationID("map"),
+						Spec: &transformations.MapOpSpec{
+							Fn: rowFn(&semantic.ObjectExpression{
+								Properties: []*semantic.Property{
+									{
+										Key: &semantic.Identifier{Name: "_value"},
+										Value: &semantic.BinaryExpression{
+											Operator: ast.DivisionOperator,
+											Left:     column("_value"),
+											Right:    &semantic.FloatLiteral{Value: 300},
+										},
+									},
+								},
+							}),
+							MergeKey: true,
+						},
 					},
 				},
 				Edges: []flux.Edge{
@@ -573,11 +905,211 @@ func TestBuild(t *testing.T) {
 					},
 					{
 						Parent: flux.OperationID("where"),
+						Child:  flux.OperationID("difference"),
+					},
+					{
+						Parent: flux.OperationID("difference"),
 						Child:  flux.OperationID("sum"),
 					},
+					{
+						Parent: flux.OperationID("sum"),
+						Child:  flux.OperationID("map"),
+					},
+				},
+			},
+		},
+		{
+			name:   "histogram quantile",
+			promql: `histogram_quantile(0.9, request_duration_bucket)`,
+			want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID:   flux.OperationID("from"),
+						Spec: &inputs.FromOpSpec{Bucket: "prometheus"},
+					},
+					{
+						ID:   flux.OperationID("where"),
+						Spec: metricFilter("request_duration_bucket"),
+					},
+					{
+						ID: flux.OperationID("group"),
+						Spec: &transformations.GroupOpSpec{
+							Mode:    "except",
+							Columns: []string{"le", "_time", "_value"},
+						},
+					},
+					{
+						ID: flux.OperationID("map"),
+						Spec: &transformations.MapOpSpec{
+							Fn: rowFn(&semantic.ObjectExpression{
+								Properties: []*semantic.Property{
+									{
+										Key:   &semantic.Identifier{Name: "le"},
+										Value: toFloat(column("le")),
+									},
+									{
+										Key:   &semantic.Identifier{Name: "_value"},
+										Value: column("_value"),
+									},
+								},
+							}),
+							MergeKey: true,
+						},
+					},
+					{
+						ID: flux.OperationID("histogramQuantile"),
+						Spec: &transformations.HistogramQuantileOpSpec{
+							Quantile:         0.9,
+							CountColumn:      "_value",
+							UpperBoundColumn: "le",
+							ValueColumn:      "_value",
+						},
+					},
+				},
+				Edges: []flux.Edge{
+					{
+						Parent: flux.OperationID("from"),
+						Child:  flux.OperationID("where"),
+					},
+					{
+						Parent: flux.OperationID("where"),
+						Child:  flux.OperationID("group"),
+					},
+					{
+						Parent: flux.OperationID("group"),
+						Child:  flux.OperationID("map"),
+					},
+					{
+						Parent: flux.OperationID("map"),
+						Child:  flux.OperationID("histogramQuantile"),
+					},
+				},
+			},
+		},
+		{
+			name:   "vectors matched on labels",
+			promql: `errors_total / on(job) requests_total`,
+			want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID:   flux.OperationID("from"),
+						Spec: &inputs.FromOpSpec{Bucket: "prometheus"},
+					},
+					{
+						ID:   flux.OperationID("where"),
+						Spec: metricFilter("errors_total"),
+					},
+					{
+						ID:   flux.OperationID("from1"),
+						Spec: &inputs.FromOpSpec{Bucket: "prometheus"},
+					},
+					{
+						ID:   flux.OperationID("where1"),
+						Spec: metricFilter("requests_total"),
+					},
+					{
+						ID: flux.OperationID("keep"),
+						Spec: &transformations.KeepOpSpec{
+							Columns: []string{"job", "_value", "_time"},
+						},
+					},
+					{
+						ID: flux.OperationID("rename"),
+						Spec: &transformations.RenameOpSpec{
+							Columns: map[string]string{"_value": "_value_lhs"},
+						},
+					},
+					{
+						ID: flux.OperationID("keep1"),
+						Spec: &transformations.KeepOpSpec{
+							Columns: []string{"job", "_value", "_time"},
+						},
+					},
+					{
+						ID: flux.OperationID("rename1"),
+						Spec: &transformations.RenameOpSpec{
+							Columns: map[string]string{"_value": "_value_rhs"},
+						},
+					},
+					{
+						ID: flux.OperationID("join"),
+						Spec: &transformations.JoinOpSpec{
+							TableNames: map[flux.OperationID]string{
+								"rename":  "lhs",
+								"rename1": "rhs",
+							},
+							On:     []string{"job", "_time"},
+							Method: "inner",
+						},
+					},
+					{
+						ID: flux.OperationID("map"),
+						Spec: &transformations.MapOpSpec{
+							Fn: rowFn(&semantic.ObjectExpression{
+								Properties: []*semantic.Property{
+									{
+										Key: &semantic.Identifier{Name: "_value"},
+										Value: &semantic.BinaryExpression{
+											Operator: ast.DivisionOperator,
+											Left:     column("_value_lhs"),
+											Right:    column("_value_rhs"),
+										},
+									},
+									{
+										Key:   &semantic.Identifier{Name: "_time"},
+										Value: column("_time"),
+									},
+								},
+							}),
+							MergeKey: true,
+						},
+					},
+				},
+				Edges: []flux.Edge{
+					{
+						Parent: flux.OperationID("from"),
+						Child:  flux.OperationID("where"),
+					},
+					{
+						Parent: flux.OperationID("from1"),
+						Child:  flux.OperationID("where1"),
+					},
+					{
+						Parent: flux.OperationID("where"),
+						Child:  flux.OperationID("keep"),
+					},
+					{
+						Parent: flux.OperationID("keep"),
+						Child:  flux.OperationID("rename"),
+					},
+					{
+						Parent: flux.OperationID("where1"),
+						Child:  flux.OperationID("keep1"),
+					},
+					{
+						Parent: flux.OperationID("keep1"),
+						Child:  flux.OperationID("rename1"),
+					},
+					{
+						Parent: flux.OperationID("rename"),
+						Child:  flux.OperationID("join"),
+					},
+					{
+						Parent: flux.OperationID("rename1"),
+						Child:  flux.OperationID("join"),
+					},
+					{
+						Parent: flux.OperationID("join"),
+						Child:  flux.OperationID("map"),
+					},
 				},
 			},
 		},
+		{
+			name:    "modulo between vectors",
+			promql:  `a % b`,
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -587,10 +1119,21 @@ func TestBuild(t *testing.T) {
 				t.Errorf("Build() %s error = %v, wantErr %v", tt.promql, err, tt.wantErr)
 				return
 			}
-			opts := append(semantictest.CmpOptions, []cmp.Option{cmp.AllowUnexported(flux.Spec{}), cmpopts.IgnoreUnexported(flux.Spec{})}...)
+			opts := append(semantictest.CmpOptions, []cmp.Option{cmp.AllowUnexported(flux.Spec{}), cmpopts.IgnoreUnexported(flux.Spec{}), cmpopts.IgnoreUnexported(transformations.JoinOpSpec{})}...)
 			if !cmp.Equal(tt.want, got, opts...) {
 				t.Errorf("Build() = %s -want/+got\n%s", tt.promql, cmp.Diff(tt.want, got, opts...))
 			}
 		})
 	}
 }
+
+// metricFilter returns the filter of the rows of the metric.
+func metricFilter(name string) *transformations.FilterOpSpec {
+	return &transformations.FilterOpSpec{
+		Fn: rowFn(&semantic.BinaryExpression{
+			Operator: ast.EqualOperator,
+			Left:     column("_metric"),
+			Right:    &semantic.StringLiteral{Value: name},
+		}),
+	}
+}
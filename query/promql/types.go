@@ -8,6 +8,7 @@ import (
 
 	"github.com/influxdata/flux"
 	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/execute"
 	"github.com/influxdata/flux/functions/inputs"
 	"github.com/influxdata/flux/functions/transformations"
 	"github.com/influxdata/flux/semantic"
@@ -91,6 +92,14 @@ func (n *Number) Value() interface{} {
 	return n.Val
 }
 
+func (n *Number) QuerySpec() (*flux.Spec, error) {
+	return nil, fmt.Errorf("unable to represent the scalar %v as a vector", n.Val)
+}
+
+func (n *Number) build(b *specBuilder) (vector, error) {
+	return vector{}, fmt.Errorf("unable to use the scalar %v as a vector", n.Val)
+}
+
 func NewNumber(val string) (*Number, error) {
 	num, err := strconv.ParseFloat(val, 64)
 	if err != nil {
@@ -142,48 +151,31 @@ type Selector struct {
 }
 
 func (s *Selector) QuerySpec() (*flux.Spec, error) {
-	parent := "from"
-	ops := []*flux.Operation{
-		{
-			ID: "from", // TODO: Change this to a UUID
-			Spec: &inputs.FromOpSpec{
-				Bucket: "prometheus",
-			},
-		},
-	}
-	edges := []flux.Edge{}
+	return querySpec(s)
+}
+
+func (s *Selector) build(b *specBuilder) (vector, error) {
+	parent := b.op("from", &inputs.FromOpSpec{
+		Bucket: "prometheus",
+	})
 
 	rng, err := NewRangeOp(s.Range, s.Offset)
 	if err != nil {
-		return nil, err
+		return vector{}, err
 	}
 
 	if rng != nil {
-		ops = append(ops, rng)
-		edge := flux.Edge{
-			Parent: flux.OperationID(parent),
-			Child:  "range",
-		}
-		parent = "range"
-		edges = append(edges, edge)
+		parent = b.op(string(rng.ID), rng.Spec, parent)
 	}
 
 	where, err := NewWhereOperation(s.Name, s.LabelMatchers)
 	if err != nil {
-		return nil, err
-	}
-
-	ops = append(ops, where)
-	edge := flux.Edge{
-		Parent: flux.OperationID(parent),
-		Child:  "where",
+		return vector{}, err
 	}
-	parent = "where"
-	edges = append(edges, edge)
 
-	return &flux.Spec{
-		Operations: ops,
-		Edges:      edges,
+	return vector{
+		id:      b.op(string(where.ID), where.Spec, parent),
+		hasTime: true,
 	}, nil
 }
 
@@ -195,8 +187,16 @@ func NewRangeOp(rng, offset time.Duration) (*flux.Operation, error) {
 		ID: "range", // TODO: Change this to a UUID
 		Spec: &transformations.RangeOpSpec{
 			Start: flux.Time{
-				Relative: -rng - offset,
+				IsRelative: true,
+				Relative:   -rng - offset,
 			},
+			Stop: flux.Time{
+				IsRelative: true,
+				Relative:   -offset,
+			},
+			TimeColumn:  execute.DefaultTimeColLabel,
+			StartColumn: execute.DefaultStartColLabel,
+			StopColumn:  execute.DefaultStopColLabel,
 		},
 	}, nil
 }
@@ -377,8 +377,10 @@ func (o *Operator) QuerySpec() (*flux.Operation, error) {
 		return nil, fmt.Errorf("unable to run %d yet", o.Kind)
 	case CountKind:
 		return &flux.Operation{
-			ID:   "count",
-			Spec: &transformations.CountOpSpec{},
+			ID: "count",
+			Spec: &transformations.CountOpSpec{
+				AggregateConfig: execute.DefaultAggregateConfig,
+			},
 		}, nil
 	//case TopKind:
 	//	return &flux.Operation{
@@ -387,29 +389,35 @@ func (o *Operator) QuerySpec() (*flux.Operation, error) {
 	//	}, nil
 	case SumKind:
 		return &flux.Operation{
-			ID:   "sum",
-			Spec: &transformations.SumOpSpec{},
+			ID: "sum",
+			Spec: &transformations.SumOpSpec{
+				AggregateConfig: execute.DefaultAggregateConfig,
+			},
+		}, nil
+	case MinKind:
+		return &flux.Operation{
+			ID:   "min",
+			Spec: &transformations.MinOpSpec{},
+		}, nil
+	case MaxKind:
+		return &flux.Operation{
+			ID:   "max",
+			Spec: &transformations.MaxOpSpec{},
+		}, nil
+	case AvgKind:
+		return &flux.Operation{
+			ID: "mean",
+			Spec: &transformations.MeanOpSpec{
+				AggregateConfig: execute.DefaultAggregateConfig,
+			},
+		}, nil
+	case StdevKind:
+		return &flux.Operation{
+			ID: "stddev",
+			Spec: &transformations.StddevOpSpec{
+				AggregateConfig: execute.DefaultAggregateConfig,
+			},
 		}, nil
-	//case MinKind:
-	//	return &flux.Operation{
-	//		ID:   "min",
-	//		Spec: &transformations.MinOpSpec{},
-	//	}, nil
-	//case MaxKind:
-	//	return &flux.Operation{
-	//		ID:   "max",
-	//		Spec: &transformations.MaxOpSpec{},
-	//	}, nil
-	//case AvgKind:
-	//	return &flux.Operation{
-	//		ID:   "mean",
-	//		Spec: &transformations.MeanOpSpec{},
-	//	}, nil
-	//case StdevKind:
-	//	return &flux.Operation{
-	//		ID:   "stddev",
-	//		Spec: &transformations.StddevOpSpec{},
-	//	}, nil
 	default:
 		return nil, fmt.Errorf("unknown Op kind %d", o.Kind)
 	}
@@ -417,54 +425,46 @@ func (o *Operator) QuerySpec() (*flux.Operation, error) {
 
 type AggregateExpr struct {
 	Op        *Operator  `json:"op,omitempty"`
-	Selector  *Selector  `json:"selector,omitempty"`
+	Selector  Expr       `json:"selector,omitempty"`
 	Aggregate *Aggregate `json:"aggregate,omitempty"`
 }
 
 func (a *AggregateExpr) QuerySpec() (*flux.Spec, error) {
-	spec, err := a.Selector.QuerySpec()
+	return querySpec(a)
+}
+
+func (a *AggregateExpr) build(b *specBuilder) (vector, error) {
+	in, err := a.Selector.build(b)
 	if err != nil {
-		return nil, err
+		return vector{}, err
 	}
 
+	parent := in.id
 	if a.Aggregate != nil {
 		agg, err := a.Aggregate.QuerySpec()
 		if err != nil {
-			return nil, err
+			return vector{}, err
 		}
-
-		parent := flux.OperationID("from")
-		if len(spec.Edges) > 0 {
-			tail := spec.Edges[len(spec.Edges)-1]
-			parent = tail.Child
-		}
-
-		spec.Operations = append(spec.Operations, agg)
-		spec.Edges = append(spec.Edges, flux.Edge{
-			Parent: parent,
-			Child:  agg.ID,
-		})
+		parent = b.op(string(agg.ID), agg.Spec, parent)
 	}
 
 	op, err := a.Op.QuerySpec()
 	if err != nil {
-		return nil, err
+		return vector{}, err
 	}
 
-	parent := flux.OperationID("from")
-	if len(spec.Edges) > 0 {
-		tail := spec.Edges[len(spec.Edges)-1]
-		parent = tail.Child
+	// Min and max select a row, which keeps its time.
+	hasTime := false
+	if a.Op.Kind == MinKind || a.Op.Kind == MaxKind {
+		hasTime = in.hasTime
 	}
-	spec.Operations = append(spec.Operations, op)
-	spec.Edges = append(spec.Edges, flux.Edge{
-		Parent: parent,
-		Child:  op.ID,
-	})
-	return spec, nil
+	return vector{
+		id:      b.op(string(op.ID), op.Spec, parent),
+		hasTime: hasTime,
+	}, nil
 }
 
-func NewAggregateExpr(op *Operator, selector *Selector, group interface{}) (*AggregateExpr, error) {
+func NewAggregateExpr(op *Operator, selector Expr, group interface{}) (*AggregateExpr, error) {
 	expr := &AggregateExpr{
 		Op:       op,
 		Selector: selector,
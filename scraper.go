@@ -2,6 +2,12 @@ package platform
 
 import (
 	"context"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
 )
 
 // ops for ScraperTarget Store
@@ -13,6 +19,12 @@ const (
 	OpUpdateTarget  = "UpdateTarget"
 )
 
+// ops for ScraperTargetStatus Store
+const (
+	OpGetTargetStatus = "GetTargetStatus"
+	OpRecordScrape    = "RecordScrape"
+)
+
 // ScraperTarget is a target to scrape
 type ScraperTarget struct {
 	ID         ID          `json:"id,omitempty"`
@@ -21,6 +33,212 @@ type ScraperTarget struct {
 	URL        string      `json:"url"`
 	OrgName    string      `json:"org"`
 	BucketName string      `json:"bucket"`
+
+	// JSON configures how a JSON scraper target's response is turned into metrics.
+	// It is required for targets of JSONScraperType and must be empty otherwise.
+	JSON *JSONScraperOptions `json:"json,omitempty"`
+
+	// Interval is the time between scrapes of the target.
+	// If zero, the scheduler's default interval is used.
+	Interval time.Duration `json:"interval,omitempty"`
+	// Timeout bounds each scrape of the target.
+	// If zero, the scheduler's default timeout is used.
+	Timeout time.Duration `json:"timeout,omitempty"`
+	// Headers are added to every scrape request of the target.
+	// They are stored and returned in plain text, so they may not carry
+	// credentials; use BearerTokenSecret or BasicAuthPasswordSecret instead.
+	Headers map[string]string `json:"headers,omitempty"`
+	// OrgID is the organization whose secrets hold the target's bearer token
+	// or basic auth password.
+	OrgID ID `json:"orgID,omitempty"`
+	// BearerTokenSecret is the key of the organization secret whose value
+	// is sent as a bearer token with every scrape request of the target.
+	BearerTokenSecret string `json:"bearerTokenSecret,omitempty"`
+	// BasicAuthUsername is the user name sent with basic authentication
+	// of every scrape request of the target.
+	BasicAuthUsername string `json:"basicAuthUsername,omitempty"`
+	// BasicAuthPasswordSecret is the key of the organization secret whose
+	// value is the password sent with BasicAuthUsername.
+	BasicAuthPasswordSecret string `json:"basicAuthPasswordSecret,omitempty"`
+	// TLS configures how the certificate of an https target is verified.
+	TLS *ScraperTLSConfig `json:"tls,omitempty"`
+}
+
+// credentialHeaders are the headers of scrape requests that carry credentials,
+// which scraper targets may not store in plain text.
+var credentialHeaders = map[string]bool{
+	"Authorization":       true,
+	"Proxy-Authorization": true,
+	"Cookie":              true,
+}
+
+// ScraperTLSConfig configures how the certificate of a scraper target is verified.
+type ScraperTLSConfig struct {
+	// CA is a PEM encoded certificate bundle used instead of the system roots.
+	CA string `json:"ca,omitempty"`
+	// InsecureSkipVerify disables verification of the target's certificate.
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+}
+
+// Validate returns an error if the target's type is unknown
+// or its type-specific options are missing or invalid.
+func (t *ScraperTarget) Validate() error {
+	if !ValidScraperType(string(t.Type)) {
+		return &Error{
+			Code: EInvalid,
+			Msg:  fmt.Sprintf("unknown scraper type %q", t.Type),
+		}
+	}
+	if t.URL == "" {
+		return &Error{
+			Code: EInvalid,
+			Msg:  "scraper target requires a url",
+		}
+	}
+	u, err := url.Parse(t.URL)
+	if err != nil {
+		return &Error{
+			Code: EInvalid,
+			Msg:  fmt.Sprintf("invalid scraper target url: %v", err),
+		}
+	}
+	if u.User != nil {
+		return &Error{
+			Code: EInvalid,
+			Msg:  "scraper target url cannot contain credentials; use a basic auth password secret instead",
+		}
+	}
+
+	if t.Interval < 0 || t.Timeout < 0 {
+		return &Error{
+			Code: EInvalid,
+			Msg:  "scraper target interval and timeout must not be negative",
+		}
+	}
+	if t.Interval > 0 && t.Timeout > t.Interval {
+		return &Error{
+			Code: EInvalid,
+			Msg:  "scraper target timeout must not exceed its interval",
+		}
+	}
+	for k := range t.Headers {
+		if k == "" {
+			return &Error{
+				Code: EInvalid,
+				Msg:  "scraper target header names must not be empty",
+			}
+		}
+		if credentialHeaders[http.CanonicalHeaderKey(k)] {
+			return &Error{
+				Code: EInvalid,
+				Msg:  fmt.Sprintf("scraper target cannot set the %s header; use a bearer token or basic auth password secret instead", http.CanonicalHeaderKey(k)),
+			}
+		}
+	}
+	if t.BearerTokenSecret != "" && !t.OrgID.Valid() {
+		return &Error{
+			Code: EInvalid,
+			Msg:  "scraper target with a bearer token secret requires an orgID",
+		}
+	}
+	if (t.BasicAuthUsername == "") != (t.BasicAuthPasswordSecret == "") {
+		return &Error{
+			Code: EInvalid,
+			Msg:  "scraper target basic auth requires both a username and a password secret",
+		}
+	}
+	if t.BasicAuthPasswordSecret != "" && !t.OrgID.Valid() {
+		return &Error{
+			Code: EInvalid,
+			Msg:  "scraper target with a basic auth password secret requires an orgID",
+		}
+	}
+	if t.BearerTokenSecret != "" && t.BasicAuthPasswordSecret != "" {
+		return &Error{
+			Code: EInvalid,
+			Msg:  "scraper target cannot use both a bearer token and basic auth",
+		}
+	}
+	if t.TLS != nil && t.TLS.CA != "" {
+		if ok := x509.NewCertPool().AppendCertsFromPEM([]byte(t.TLS.CA)); !ok {
+			return &Error{
+				Code: EInvalid,
+				Msg:  "scraper target tls ca contains no valid PEM certificates",
+			}
+		}
+	}
+
+	switch t.Type {
+	case JSONScraperType:
+		if t.JSON == nil {
+			return &Error{
+				Code: EInvalid,
+				Msg:  "json scraper target requires json options",
+			}
+		}
+		return t.JSON.Validate()
+	default:
+		if t.JSON != nil {
+			return &Error{
+				Code: EInvalid,
+				Msg:  fmt.Sprintf("json options are not supported by %s scraper targets", t.Type),
+			}
+		}
+	}
+	return nil
+}
+
+// JSONScraperOptions maps values of a JSON document to a metric.
+// Every path is a JSONPath expression rooted at $, such as $.stats.requests or $.nodes[0].name.
+type JSONScraperOptions struct {
+	// Measurement is the name of the gathered metrics.
+	Measurement string `json:"measurement"`
+	// Records optionally selects an array in the document;
+	// each element then yields one metric and the field and tag paths are relative to it.
+	Records string `json:"records,omitempty"`
+	// Fields maps field keys to the paths of their values.
+	Fields map[string]string `json:"fields"`
+	// Tags maps tag keys to the paths of their values.
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// Validate returns an error if the options cannot produce a metric.
+func (o *JSONScraperOptions) Validate() error {
+	if o.Measurement == "" {
+		return &Error{
+			Code: EInvalid,
+			Msg:  "json scraper target requires a measurement",
+		}
+	}
+	if len(o.Fields) == 0 {
+		return &Error{
+			Code: EInvalid,
+			Msg:  "json scraper target requires at least one field",
+		}
+	}
+	if o.Records != "" && !strings.HasPrefix(o.Records, "$") {
+		return &Error{
+			Code: EInvalid,
+			Msg:  fmt.Sprintf("records path %q must start with $", o.Records),
+		}
+	}
+	for k, p := range o.Fields {
+		if !strings.HasPrefix(p, "$") {
+			return &Error{
+				Code: EInvalid,
+				Msg:  fmt.Sprintf("path %q of field %q must start with $", p, k),
+			}
+		}
+	}
+	for k, p := range o.Tags {
+		if !strings.HasPrefix(p, "$") {
+			return &Error{
+				Code: EInvalid,
+				Msg:  fmt.Sprintf("path %q of tag %q must start with $", p, k),
+			}
+		}
+	}
+	return nil
 }
 
 // ScraperTargetStoreService defines the crud service for ScraperTarget.
@@ -32,6 +250,57 @@ type ScraperTargetStoreService interface {
 	UpdateTarget(ctx context.Context, t *ScraperTarget) (*ScraperTarget, error)
 }
 
+// ScraperTargetStatus is the health of a scraper target as of its most recent scrape.
+type ScraperTargetStatus struct {
+	TargetID ID `json:"targetID"`
+	// LastScrape is the time the most recent scrape started.
+	LastScrape time.Time `json:"lastScrape"`
+	// LastScrapeDuration is how long the most recent scrape took.
+	LastScrapeDuration time.Duration `json:"lastScrapeDuration"`
+	// SampleCount is the number of metrics gathered by the most recent scrape.
+	SampleCount int `json:"sampleCount"`
+	// LastError is the error of the most recent scrape, if it failed.
+	LastError string `json:"lastError,omitempty"`
+	// ConsecutiveFailures is the number of scrapes that failed since the last successful one.
+	ConsecutiveFailures int `json:"consecutiveFailures"`
+}
+
+// Up returns true if the most recent scrape succeeded.
+func (s *ScraperTargetStatus) Up() bool {
+	return s.ConsecutiveFailures == 0
+}
+
+// Apply updates the status with the result of a scrape.
+func (s *ScraperTargetStatus) Apply(r ScrapeResult) {
+	s.LastScrape = r.Time
+	s.LastScrapeDuration = r.Duration
+	s.SampleCount = r.SampleCount
+	s.LastError = r.Err
+	if r.Err != "" {
+		s.ConsecutiveFailures++
+	} else {
+		s.ConsecutiveFailures = 0
+	}
+}
+
+// ScrapeResult is the outcome of a single scrape of a scraper target.
+type ScrapeResult struct {
+	Time        time.Time
+	Duration    time.Duration
+	SampleCount int
+	// Err is the error message of a failed scrape, and empty if the scrape succeeded.
+	Err string
+}
+
+// ScraperTargetStatusService records and retrieves the health of scraper targets.
+type ScraperTargetStatusService interface {
+	// GetTargetStatus returns the status of the scraper target with the given id.
+	GetTargetStatus(ctx context.Context, id ID) (*ScraperTargetStatus, error)
+	// RecordScrape updates the status of the scraper target with the given id
+	// with the result of a scrape, and returns the updated status.
+	RecordScrape(ctx context.Context, id ID, r ScrapeResult) (*ScraperTargetStatus, error)
+}
+
 // ScraperTargetFilter represents a set of filter that restrict the returned results.
 type ScraperTargetFilter struct {
 	ID   *ID     `json:"id"`
@@ -45,12 +314,16 @@ type ScraperType string
 const (
 	// PrometheusScraperType parses metrics from a prometheus endpoint.
 	PrometheusScraperType = "prometheus"
+	// LineProtocolScraperType parses metrics in InfluxDB line protocol from an HTTP endpoint.
+	LineProtocolScraperType = "lineprotocol"
+	// JSONScraperType maps values of a JSON document from an HTTP endpoint to a metric.
+	JSONScraperType = "json"
 )
 
 // ValidScraperType returns true is the type string is valid
 func ValidScraperType(s string) bool {
 	switch s {
-	case PrometheusScraperType:
+	case PrometheusScraperType, LineProtocolScraperType, JSONScraperType:
 		return true
 	default:
 		return false
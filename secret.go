@@ -1,6 +1,10 @@
 package platform
 
-import "context"
+import (
+	"context"
+	"sort"
+	"strings"
+)
 
 // SecretService a service for storing and retrieving secrets.
 type SecretService interface {
@@ -22,3 +26,30 @@ type SecretService interface {
 	// DeleteSecret removes a single secret from the secret store.
 	DeleteSecret(ctx context.Context, orgID ID, ks ...string) error
 }
+
+// RedactedSecret replaces the secret values removed by RedactSecrets.
+const RedactedSecret = "[REDACTED]"
+
+// RedactSecrets replaces the values of the secrets of the organization found in
+// s with RedactedSecret, so that s can be logged.
+func RedactSecrets(ctx context.Context, secrets SecretService, orgID ID, s string) string {
+	keys, err := secrets.GetSecretKeys(ctx, orgID)
+	if err != nil {
+		// The organization has no secrets.
+		return s
+	}
+
+	vals := make([]string, 0, len(keys))
+	for _, k := range keys {
+		if v, err := secrets.LoadSecret(ctx, orgID, k); err == nil && v != "" {
+			vals = append(vals, v)
+		}
+	}
+
+	// Replace longer values first, in case a value contains another.
+	sort.Slice(vals, func(i, j int) bool { return len(vals[i]) > len(vals[j]) })
+	for _, v := range vals {
+		s = strings.Replace(s, v, RedactedSecret, -1)
+	}
+	return s
+}
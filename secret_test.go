@@ -0,0 +1,35 @@
+package platform_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/mock"
+)
+
+func TestRedactSecrets(t *testing.T) {
+	ctx := context.Background()
+	secrets := map[string]string{"token": "s3cr3t", "long": "s3cr3t-and-more", "empty": ""}
+
+	s := mock.NewSecretService()
+	s.GetSecretKeysFn = func(ctx context.Context, orgID platform.ID) ([]string, error) {
+		if orgID != 1 {
+			return nil, errors.New("organization has no secret keys")
+		}
+		return []string{"empty", "long", "token"}, nil
+	}
+	s.LoadSecretFn = func(ctx context.Context, orgID platform.ID, k string) (string, error) {
+		return secrets[k], nil
+	}
+
+	got := platform.RedactSecrets(ctx, s, 1, "auth s3cr3t-and-more failed with s3cr3t")
+	if want := "auth [REDACTED] failed with [REDACTED]"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	if got, want := platform.RedactSecrets(ctx, s, 2, "s3cr3t"), "s3cr3t"; got != want {
+		t.Errorf("secrets of another organization should not be redacted: got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,368 @@
+package storage
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/models"
+	intar "github.com/influxdata/platform/pkg/tar"
+	"github.com/influxdata/platform/tsdb"
+	"github.com/influxdata/platform/tsdb/tsi1"
+	"github.com/influxdata/platform/tsdb/tsm1"
+	"go.uber.org/zap"
+)
+
+// rebuildBatchSize is the number of series added to the index at once when
+// it is rebuilt.
+const rebuildBatchSize = 10000
+
+// Backup writes the files of a snapshot of the engine to tw, named by their
+// path relative to the engine's directory and joined to dir. The cache is
+// written to a TSM file first, so the snapshot has no WAL.
+//
+// When the filter has an organization, only the blocks of its buckets, or of
+// its bucket, are written, and the index and series file, which hold the
+// series of every bucket, are left out. RebuildIndex rebuilds them once the
+// files are restored.
+func (e *Engine) Backup(ctx context.Context, tw *tar.Writer, dir string, filter platform.BackupFilter) error {
+	if err := filter.Valid(); err != nil {
+		return err
+	}
+	keep := backupKeyFilter(filter)
+
+	type snapshot struct {
+		dir  string
+		path string
+	}
+	var snapshots []snapshot
+	defer func() {
+		for _, s := range snapshots {
+			os.RemoveAll(s.path)
+		}
+	}()
+
+	// The TSM files are snapshotted before the index, and the index before
+	// the series file, so that the index has the series of all the data and
+	// the series file every series of the index.
+	if err := func() error {
+		e.mu.RLock()
+		defer e.mu.RUnlock()
+		if e.closing == nil {
+			return ErrEngineClosed
+		}
+
+		path, err := e.engine.CreateSnapshot()
+		if err != nil {
+			return err
+		}
+		snapshots = append(snapshots, snapshot{dir: DefaultEngineDirectoryName, path: path})
+		if keep != nil {
+			return nil
+		}
+
+		if path, err = e.index.CreateSnapshot(); err != nil {
+			return err
+		}
+		snapshots = append(snapshots, snapshot{dir: DefaultIndexDirectoryName, path: path})
+
+		if path, err = e.sfile.CreateSnapshot(); err != nil {
+			return err
+		}
+		snapshots = append(snapshots, snapshot{dir: DefaultSeriesFileDirectoryName, path: path})
+		return nil
+	}(); err != nil {
+		return err
+	}
+
+	writeFunc := intar.StreamFile
+	if keep != nil {
+		filtered, err := ioutil.TempDir("", "influxd-backup")
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(filtered)
+
+		kept, err := filterTSMFiles(snapshots[0].path, filtered, keep)
+		if err != nil {
+			return err
+		}
+
+		// TSM files and their stats are written from their filtered copies,
+		// while tombstones still apply to the blocks that are kept.
+		writeFunc = func(f os.FileInfo, relativePath, fullPath string, tw *tar.Writer) error {
+			ext := filepath.Ext(f.Name())
+			if !kept[strings.TrimSuffix(f.Name(), ext)] {
+				return nil
+			}
+			switch strings.TrimPrefix(ext, ".") {
+			case tsm1.TSMFileExtension, tsm1.TSSFileExtension:
+				fullPath = filepath.Join(filtered, f.Name())
+				fi, err := os.Stat(fullPath)
+				if err != nil {
+					return err
+				}
+				return intar.StreamFile(fi, relativePath, fullPath, tw)
+			}
+			return intar.StreamFile(f, relativePath, fullPath, tw)
+		}
+	}
+
+	for _, s := range snapshots {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := intar.Stream(tw, s.path, filepath.Join(dir, s.dir), writeFunc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RestorePath returns the path of the engine at base that the file of a
+// backup archive is restored to, from its name relative to the engine's
+// directory in the archive. It returns an empty path for any other file.
+func (c Config) RestorePath(base, name string) string {
+	parts := strings.SplitN(filepath.ToSlash(name), "/", 2)
+	if len(parts) != 2 {
+		return ""
+	}
+
+	switch parts[0] {
+	case DefaultEngineDirectoryName:
+		return filepath.Join(c.GetEnginePath(base), filepath.FromSlash(parts[1]))
+	case DefaultIndexDirectoryName:
+		return filepath.Join(c.GetIndexPath(base), filepath.FromSlash(parts[1]))
+	case DefaultSeriesFileDirectoryName:
+		return filepath.Join(c.GetSeriesFilePath(base), filepath.FromSlash(parts[1]))
+	default:
+		return ""
+	}
+}
+
+// RebuildIndex replaces the index and series file of the engine at path
+// with new ones built from its TSM files. When the filter has an
+// organization, the data of any other organization or bucket is removed from
+// the TSM files first. The engine must not be open.
+func RebuildIndex(path string, c Config, filter platform.BackupFilter, log *zap.Logger) error {
+	if err := filter.Valid(); err != nil {
+		return err
+	}
+
+	dataPath := c.GetEnginePath(path)
+	if keep := backupKeyFilter(filter); keep != nil {
+		log.Info("Filtering TSM files", zap.String("path", dataPath))
+		if err := filterEngine(dataPath, keep); err != nil {
+			return err
+		}
+	}
+
+	indexPath, sfilePath := c.GetIndexPath(path), c.GetSeriesFilePath(path)
+	if err := os.RemoveAll(indexPath); err != nil {
+		return err
+	} else if err := os.RemoveAll(sfilePath); err != nil {
+		return err
+	}
+
+	sfile := tsdb.NewSeriesFile(sfilePath)
+	sfile.DisableMetrics()
+	sfile.WithLogger(log)
+	if err := sfile.Open(); err != nil {
+		return err
+	}
+
+	index := tsi1.NewIndex(sfile, c.Index, tsi1.WithPath(indexPath), tsi1.DisableMetrics())
+	index.WithLogger(log)
+	if err := index.Open(); err != nil {
+		sfile.Close()
+		return err
+	}
+
+	if err := func() error {
+		files, err := filepath.Glob(filepath.Join(dataPath, "*."+tsm1.TSMFileExtension))
+		if err != nil {
+			return err
+		}
+		for _, file := range files {
+			log.Info("Indexing TSM file", zap.String("path", file))
+			if err := indexTSMFile(index, file); err != nil {
+				return err
+			}
+		}
+
+		index.Compact()
+		index.Wait()
+		return nil
+	}(); err != nil {
+		index.Close()
+		sfile.Close()
+		return err
+	}
+
+	if err := index.Close(); err != nil {
+		sfile.Close()
+		return err
+	}
+	return sfile.Close()
+}
+
+// backupKeyFilter returns the function that keeps the TSM keys of the data
+// of the filter's organization, or of its bucket. It returns nil when the
+// filter has no organization.
+func backupKeyFilter(filter platform.BackupFilter) func(key []byte) bool {
+	if filter.OrganizationID == nil {
+		return nil
+	}
+
+	var prefix []byte
+	if filter.BucketID != nil {
+		name := tsdb.EncodeName(*filter.OrganizationID, *filter.BucketID)
+		prefix = name[:]
+	} else {
+		name := tsdb.EncodeName(*filter.OrganizationID, 0)
+		prefix = name[:8]
+	}
+
+	return func(key []byte) bool {
+		seriesKey, _ := tsm1.SeriesAndFieldFromCompositeKey(key)
+		return bytes.HasPrefix(models.ParseName(seriesKey), prefix)
+	}
+}
+
+// filterTSMFiles writes the blocks of the TSM files in src for which keep
+// returns true to TSM files of the same name in dst. It returns the names,
+// without extension, of the files for which any block was kept.
+func filterTSMFiles(src, dst string, keep func(key []byte) bool) (map[string]bool, error) {
+	files, err := filepath.Glob(filepath.Join(src, "*."+tsm1.TSMFileExtension))
+	if err != nil {
+		return nil, err
+	}
+
+	kept := make(map[string]bool)
+	for _, file := range files {
+		name := filepath.Base(file)
+		ok, err := tsm1.FilterTSMFile(file, filepath.Join(dst, name), keep)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			kept[strings.TrimSuffix(name, filepath.Ext(name))] = true
+		}
+	}
+	return kept, nil
+}
+
+// filterEngine removes the blocks of the TSM files in path for which keep
+// returns false. Files without any block left are removed along with their
+// stats and tombstones. Archives of an engine without data have no TSM
+// files, so a missing path has nothing to filter.
+func filterEngine(path string, keep func(key []byte) bool) error {
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempDir(path, ".filter")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+
+	kept, err := filterTSMFiles(path, tmp, keep)
+	if err != nil {
+		return err
+	}
+
+	files, err := filepath.Glob(filepath.Join(path, "*."+tsm1.TSMFileExtension))
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		name := filepath.Base(file)
+		if !kept[strings.TrimSuffix(name, filepath.Ext(name))] {
+			others, err := filepath.Glob(strings.TrimSuffix(file, filepath.Ext(name)) + ".*")
+			if err != nil {
+				return err
+			}
+			for _, other := range others {
+				if err := os.Remove(other); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		if err := os.Rename(filepath.Join(tmp, name), file); err != nil {
+			return err
+		}
+		statsPath := tsm1.StatsFilename(file)
+		if err := os.Rename(tsm1.StatsFilename(filepath.Join(tmp, name)), statsPath); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// indexTSMFile adds the series of the keys of the TSM file at path to the index.
+func indexTSMFile(index *tsi1.Index, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+
+	r, err := tsm1.NewTSMReader(f)
+	if err != nil {
+		f.Close()
+		return fmt.Errorf("unable to read TSM file %q: %v", path, err)
+	}
+	defer r.Close()
+
+	collection := &tsdb.SeriesCollection{}
+	for i := 0; i < r.KeyCount(); i++ {
+		key, typ := r.KeyAt(i)
+		seriesKey, _ := tsm1.SeriesAndFieldFromCompositeKey(key)
+		name, tags := models.ParseKeyBytes(seriesKey)
+
+		collection.Keys = append(collection.Keys, seriesKey)
+		collection.Names = append(collection.Names, name)
+		collection.Tags = append(collection.Tags, tags)
+		collection.Types = append(collection.Types, fieldType(typ))
+
+		if collection.Length() == rebuildBatchSize {
+			if err := index.CreateSeriesListIfNotExists(collection); err != nil {
+				return err
+			}
+			collection.Truncate(0)
+		}
+	}
+
+	if collection.Length() > 0 {
+		return index.CreateSeriesListIfNotExists(collection)
+	}
+	return nil
+}
+
+// fieldType returns the field type of the values of a TSM block type.
+func fieldType(typ byte) models.FieldType {
+	switch typ {
+	case tsm1.BlockFloat64:
+		return models.Float
+	case tsm1.BlockInteger:
+		return models.Integer
+	case tsm1.BlockBoolean:
+		return models.Boolean
+	case tsm1.BlockString:
+		return models.String
+	case tsm1.BlockUnsigned:
+		return models.Unsigned
+	default:
+		return models.Empty
+	}
+}
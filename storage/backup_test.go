@@ -0,0 +1,164 @@
+package storage_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/models"
+	intar "github.com/influxdata/platform/pkg/tar"
+	"github.com/influxdata/platform/storage"
+	"github.com/influxdata/platform/tsdb"
+	"go.uber.org/zap"
+)
+
+func TestEngine_Backup(t *testing.T) {
+	engine := NewDefaultEngine()
+	defer engine.Close()
+	engine.MustOpen()
+
+	other, _ := platform.IDFromString("3333333333333333")
+	pts := []models.Point{
+		models.MustNewPoint(
+			"cpu",
+			models.NewTags(map[string]string{"host": "a"}),
+			map[string]interface{}{"value": 1.0},
+			time.Unix(1, 2),
+		),
+	}
+	if err := engine.Write1xPoints(pts); err != nil {
+		t.Fatal(err)
+	}
+	points, err := tsdb.ExplodePoints(engine.org, *other, pts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := engine.WritePoints(points); err != nil {
+		t.Fatal(err)
+	}
+
+	name := tsdb.EncodeName(engine.org, engine.bucket)
+	otherName := tsdb.EncodeName(engine.org, *other)
+
+	testCases := []struct {
+		name          string
+		backupFilter  platform.BackupFilter
+		restoreFilter platform.BackupFilter
+		wantIndex     bool
+		wantSeriesN   int64
+		wantNames     []string
+	}{
+		{
+			name:        "all buckets",
+			wantIndex:   true,
+			wantSeriesN: 2,
+			wantNames:   []string{string(name[:]), string(otherName[:])},
+		},
+		{
+			name:         "bucket",
+			backupFilter: platform.BackupFilter{OrganizationID: &engine.org, BucketID: &engine.bucket},
+			wantSeriesN:  1,
+			wantNames:    []string{string(name[:])},
+		},
+		{
+			name:          "restore bucket",
+			restoreFilter: platform.BackupFilter{OrganizationID: &engine.org, BucketID: other},
+			wantIndex:     true,
+			wantSeriesN:   1,
+			wantNames:     []string{string(otherName[:])},
+		},
+		{
+			name:         "organization",
+			backupFilter: platform.BackupFilter{OrganizationID: &engine.org},
+			wantSeriesN:  2,
+			wantNames:    []string{string(name[:]), string(otherName[:])},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			tw := tar.NewWriter(&buf)
+			if err := engine.Backup(context.Background(), tw, "engine", tc.backupFilter); err != nil {
+				t.Fatal(err)
+			}
+			if err := tw.Close(); err != nil {
+				t.Fatal(err)
+			}
+
+			if got := archiveHasIndex(t, bytes.NewReader(buf.Bytes())); got != tc.wantIndex {
+				t.Fatalf("got index in archive %v, exp %v", got, tc.wantIndex)
+			}
+
+			path, err := ioutil.TempDir("", "storage_engine_restore_test")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(path)
+
+			c := storage.NewConfig()
+			if err := intar.Restore(&buf, func(name string) string {
+				return c.RestorePath(path, strings.TrimPrefix(name, "engine"+string(filepath.Separator)))
+			}); err != nil {
+				t.Fatal(err)
+			}
+			if !tc.wantIndex || tc.restoreFilter.OrganizationID != nil {
+				if err := storage.RebuildIndex(path, c, tc.restoreFilter, zap.NewNop()); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			restored := storage.NewEngine(path, c)
+			if err := restored.Open(); err != nil {
+				t.Fatal(err)
+			}
+			defer restored.Close()
+
+			if got, exp := restored.SeriesCardinality(), tc.wantSeriesN; got != exp {
+				t.Fatalf("got %d series, exp %d series in index", got, exp)
+			}
+
+			stats, err := restored.MeasurementStats()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got, exp := stats.MeasurementNames(), tc.wantNames; !reflect.DeepEqual(got, exp) {
+				t.Fatalf("got measurements %q, exp %q", got, exp)
+			}
+		})
+	}
+}
+
+func TestEngine_Backup_Closed(t *testing.T) {
+	engine := NewDefaultEngine()
+	defer engine.Close()
+
+	tw := tar.NewWriter(ioutil.Discard)
+	if got, exp := engine.Backup(context.Background(), tw, "engine", platform.BackupFilter{}), storage.ErrEngineClosed; got != exp {
+		t.Fatalf("got %v, expected %v", got, exp)
+	}
+}
+
+// archiveHasIndex returns true if the archive has index files.
+func archiveHasIndex(t *testing.T, r io.Reader) bool {
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return false
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		if strings.HasPrefix(hdr.Name, "engine/index/") {
+			return true
+		}
+	}
+}
@@ -31,6 +31,12 @@ type Config struct {
 	// Enables trace logging for the engine.
 	TraceLoggingEnabled bool `toml:"trace-logging-enabled"`
 
+	// Maximum number of series in each bucket and in each organization.
+	// Writes that would create more series are partially rejected.
+	// Zero means no limit.
+	MaxSeriesPerBucket int `toml:"max-series-per-bucket"`
+	MaxSeriesPerOrg    int `toml:"max-series-per-org"`
+
 	// Series file config.
 	SeriesFilePath string `toml:"series-file-path"` // Overrides the default path.
 
@@ -0,0 +1,52 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/models"
+	"github.com/influxdata/platform/storage/reads"
+	"github.com/influxdata/platform/tsdb"
+)
+
+var _ platform.DeleteService = (*Engine)(nil)
+
+// DeleteBucketRangePredicate deletes all data belonging to the filter's bucket
+// that lies within the filter's time range, for every series whose tags match
+// the filter's predicate.
+func (e *Engine) DeleteBucketRangePredicate(ctx context.Context, filter platform.DeleteFilter) error {
+	if err := filter.Valid(); err != nil {
+		return err
+	}
+
+	cond, err := reads.ParseTagPredicate(filter.Predicate)
+	if err != nil {
+		return &platform.Error{
+			Code: platform.EInvalid,
+			Op:   platform.OpDeleteBucketRangePredicate,
+			Msg:  "invalid predicate",
+			Err:  err,
+		}
+	}
+
+	name := tsdb.EncodeName(filter.OrganizationID, filter.BucketID)
+	req := SeriesCursorRequest{
+		Measurements: tsdb.NewMeasurementSliceIterator([][]byte{name[:]}),
+	}
+
+	cur, err := e.CreateSeriesCursor(ctx, req, nil)
+	if err != nil {
+		return err
+	}
+	defer cur.Close()
+
+	min, max := filter.Range.Start.UnixNano(), filter.Range.Stop.UnixNano()
+	fn := func(_ []byte, tags models.Tags) (int64, int64, bool) {
+		if cond != nil && !reads.EvalExprBool(cond, reads.TagsValuer(tags)) {
+			return 0, 0, false
+		}
+		return min, max, true
+	}
+
+	return e.DeleteSeriesRangeWithPredicate(newSeriesIteratorAdapter(cur), fn)
+}
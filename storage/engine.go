@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/influxdata/influxql"
+	"github.com/influxdata/platform"
 	"github.com/influxdata/platform/logger"
 	"github.com/influxdata/platform/models"
 	"github.com/influxdata/platform/tsdb"
@@ -38,6 +39,9 @@ type Engine struct {
 	engine            *tsm1.Engine
 	wal               *tsm1.WAL
 	retentionEnforcer *retentionEnforcer
+	timeGrouper       *bucketTimeGrouper
+	schemas           *bucketSchemas
+	seriesLimits      *seriesLimits
 
 	defaultMetricLabels prometheus.Labels
 
@@ -85,6 +89,24 @@ func WithRetentionEnforcer(finder BucketFinder) Option {
 	}
 }
 
+// WithTimeGroups partitions the engine's TSM data into time groups of each
+// bucket's shard group duration, allowing the retention enforcer to drop
+// expired data by removing whole files.
+func WithTimeGroups(finder BucketFinder) Option {
+	return func(e *Engine) {
+		e.timeGrouper = newBucketTimeGrouper(finder)
+		e.engine.WithTimeGrouper(e.timeGrouper)
+	}
+}
+
+// WithBucketSchemas makes the engine drop points that violate the schema of
+// their bucket, reporting them in a partial write error.
+func WithBucketSchemas(finder BucketFinder) Option {
+	return func(e *Engine) {
+		e.schemas = newBucketSchemas(finder)
+	}
+}
+
 // WithFileStoreObserver makes the engine have the provided file store observer.
 func WithFileStoreObserver(obs tsm1.FileStoreObserver) Option {
 	return func(e *Engine) {
@@ -113,8 +135,18 @@ func NewEngine(path string, c Config, options ...Option) *Engine {
 	e.sfile = tsdb.NewSeriesFile(c.GetSeriesFilePath(path))
 
 	// Initialise index.
-	e.index = tsi1.NewIndex(e.sfile, c.Index,
-		tsi1.WithPath(c.GetIndexPath(path)))
+	indexOptions := []tsi1.IndexOption{tsi1.WithPath(c.GetIndexPath(path))}
+	if c.MaxSeriesPerBucket > 0 || c.MaxSeriesPerOrg > 0 {
+		e.seriesLimits = &seriesLimits{
+			maxPerBucket: c.MaxSeriesPerBucket,
+			maxPerOrg:    c.MaxSeriesPerOrg,
+		}
+		indexOptions = append(indexOptions, tsi1.WithSeriesLimiter(e.seriesLimits))
+	}
+	e.index = tsi1.NewIndex(e.sfile, c.Index, indexOptions...)
+	if e.seriesLimits != nil {
+		e.seriesLimits.index = e.index
+	}
 
 	// Initialize WAL
 	var wal tsm1.Log = new(tsm1.NopWAL)
@@ -159,6 +191,12 @@ func (e *Engine) WithLogger(log *zap.Logger) {
 	e.index.WithLogger(e.logger)
 	e.engine.WithLogger(e.logger)
 	e.retentionEnforcer.WithLogger(e.logger)
+	if e.timeGrouper != nil {
+		e.timeGrouper.logger = e.logger.With(zap.String("component", "time_grouper"))
+	}
+	if e.schemas != nil {
+		e.schemas.logger = e.logger.With(zap.String("component", "bucket_schemas"))
+	}
 }
 
 // PrometheusCollectors returns all the prometheus collectors associated with
@@ -197,6 +235,10 @@ func (e *Engine) Open() error {
 
 	e.closing = make(chan struct{})
 
+	if e.schemas != nil {
+		e.runBucketSchemas()
+	}
+
 	// TODO(edd) background tasks will be run in priority order via a scheduler.
 	// For now we will just run on an interval as we only have the retention
 	// policy enforcer.
@@ -247,6 +289,27 @@ func (e *Engine) runRetentionEnforcer() {
 	}()
 }
 
+// runBucketSchemas loads the schemas of the buckets, and keeps refreshing
+// them in a separate goroutine.
+func (e *Engine) runBucketSchemas() {
+	e.schemas.refresh()
+
+	e.wg.Add(1)
+	go func() {
+		defer e.wg.Done()
+		e.schemas.run(e.closing, bucketRefreshInterval)
+	}()
+}
+
+// SetBucketSchema replaces the schema enforced on the points written to the
+// bucket with the given id. A nil schema removes it. Schemas are otherwise
+// only reloaded periodically.
+func (e *Engine) SetBucketSchema(id platform.ID, schema *platform.BucketSchema) {
+	if e.schemas != nil {
+		e.schemas.set(id, schema)
+	}
+}
+
 // Close closes the store and all underlying resources. It returns an error if
 // any of the underlying systems fail to close.
 func (e *Engine) Close() error {
@@ -308,34 +371,30 @@ func (e *Engine) WritePoints(points []models.Point) error {
 
 		if tags.Len() > 0 && bytes.Equal(tags[0].Key, tsdb.FieldKeyTagKeyBytes) && bytes.Equal(tags[0].Value, timeBytes) {
 			// Field key "time" is invalid
-			if collection.Reason == "" {
-				collection.Reason = fmt.Sprintf("invalid field key: input field %q is invalid", timeBytes)
-			}
-			collection.Dropped++
-			collection.DroppedKeys = append(collection.DroppedKeys, iter.Key())
+			collection.Drop(iter.Key(), fmt.Sprintf("invalid field key: input field %q is invalid", timeBytes))
 			continue
 		}
 
 		// Filter out any tags with key equal to "time": they are invalid.
 		if tags.Get(timeBytes) != nil {
-			if collection.Reason == "" {
-				collection.Reason = fmt.Sprintf("invalid tag key: input tag %q on measurement %q is invalid", timeBytes, iter.Name())
-			}
-			collection.Dropped++
-			collection.DroppedKeys = append(collection.DroppedKeys, iter.Key())
+			collection.Drop(iter.Key(), fmt.Sprintf("invalid tag key: input tag %q on measurement %q is invalid", timeBytes, iter.Name()))
 			continue
 		}
 
 		// Drop any series with invalid unicode characters in the key.
 		if e.config.ValidateKeys && !models.ValidKeyTokens(string(iter.Name()), tags) {
-			if collection.Reason == "" {
-				collection.Reason = fmt.Sprintf("key contains invalid unicode: %q", iter.Key())
-			}
-			collection.Dropped++
-			collection.DroppedKeys = append(collection.DroppedKeys, iter.Key())
+			collection.Drop(iter.Key(), fmt.Sprintf("key contains invalid unicode: %q", iter.Key()))
 			continue
 		}
 
+		// Drop any points that violate the schema of their bucket.
+		if e.schemas != nil {
+			if reason := e.schemas.check(iter.Name(), tags, iter.Type()); reason != "" {
+				collection.Drop(iter.Key(), reason)
+				continue
+			}
+		}
+
 		collection.Copy(j, iter.Index())
 		j++
 	}
@@ -375,6 +434,18 @@ func (e *Engine) DeleteSeriesRangeWithPredicate(itr tsdb.SeriesIterator, fn func
 	return e.engine.DeleteSeriesRangeWithPredicate(itr, fn)
 }
 
+// DropTimeGroups removes the files of every time group for which expired
+// returns true, returning the number of files removed and the stats of files
+// that span several time groups.
+func (e *Engine) DropTimeGroups(expired func(owner []byte, max int64) bool) (int, []tsm1.FileStat, error) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	if e.closing == nil {
+		return 0, nil, ErrEngineClosed
+	}
+	return e.engine.DropTimeGroups(expired)
+}
+
 // SeriesCardinality returns the number of series in the engine.
 func (e *Engine) SeriesCardinality() int64 {
 	e.mu.RLock()
@@ -385,6 +456,44 @@ func (e *Engine) SeriesCardinality() int64 {
 	return e.index.SeriesN()
 }
 
+// GetUsage returns the number of series of the filter's bucket, or of its
+// organization if it has no bucket, or of the engine if it has neither.
+// Series counts are current, so the filter's range is ignored.
+func (e *Engine) GetUsage(ctx context.Context, filter platform.UsageFilter) (map[platform.UsageMetric]*platform.Usage, error) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	if e.closing == nil {
+		return nil, ErrEngineClosed
+	}
+
+	var n int64
+	switch {
+	case filter.BucketID != nil:
+		if filter.OrgID == nil {
+			return nil, &platform.Error{
+				Code: platform.EInvalid,
+				Msg:  "series usage of a bucket requires its organization",
+			}
+		}
+		name := tsdb.EncodeName(*filter.OrgID, *filter.BucketID)
+		n = int64(e.index.MeasurementCardinalityByPrefix(name[:]))
+	case filter.OrgID != nil:
+		name := tsdb.EncodeName(*filter.OrgID, 0)
+		n = int64(e.index.MeasurementCardinalityByPrefix(name[:8]))
+	default:
+		n = e.index.SeriesN()
+	}
+
+	return map[platform.UsageMetric]*platform.Usage{
+		platform.UsageSeries: {
+			OrganizationID: filter.OrgID,
+			BucketID:       filter.BucketID,
+			Type:           platform.UsageSeries,
+			Value:          float64(n),
+		},
+	}, nil
+}
+
 // Path returns the path of the engine's base directory.
 func (e *Engine) Path() string {
 	return e.path
@@ -1,12 +1,14 @@
 package storage_test
 
 import (
+	"context"
 	"io/ioutil"
 	"os"
 	"testing"
 	"time"
 
 	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/mock"
 	"github.com/influxdata/platform/models"
 	"github.com/influxdata/platform/storage"
 	"github.com/influxdata/platform/tsdb"
@@ -151,6 +153,159 @@ func TestEngine_WriteAddNewField(t *testing.T) {
 
 // Ensures that when a shard is closed, it removes any series meta-data
 // from the index.
+func TestEngine_WriteBucketSchema(t *testing.T) {
+	path, _ := ioutil.TempDir("", "storage_engine_test")
+	bucketSvc := mock.NewBucketService()
+	engine := &Engine{
+		path:   path,
+		org:    platform.ID(1),
+		bucket: platform.ID(2),
+		Engine: storage.NewEngine(path, storage.NewConfig(), storage.WithBucketSchemas(bucketSvc)),
+	}
+	defer engine.Close()
+
+	// Schemas are loaded when the engine opens.
+	bucketSvc.FindBucketsFn = func(context.Context, platform.BucketFilter, ...platform.FindOptions) ([]*platform.Bucket, int, error) {
+		return []*platform.Bucket{{
+			ID: engine.bucket,
+			Schema: &platform.BucketSchema{
+				Mode: platform.SchemaModeExplicit,
+				Measurements: []platform.MeasurementSchema{{
+					Name:         "cpu",
+					RequiredTags: []string{"host"},
+					Fields:       []platform.FieldSchema{{Name: "value", Type: platform.SchemaFieldTypeFloat}},
+				}},
+			},
+		}}, 1, nil
+	}
+	engine.MustOpen()
+
+	tests := []struct {
+		name   string
+		lp     string
+		reason string
+	}{
+		{
+			name: "conforming point",
+			lp:   "cpu,host=a value=1 1",
+		},
+		{
+			name:   "undeclared measurement",
+			lp:     "mem,host=a value=1 1",
+			reason: `schema violation: measurement "mem" is not in the bucket schema`,
+		},
+		{
+			name:   "missing required tag",
+			lp:     "cpu value=1 1",
+			reason: `schema violation: measurement "cpu" requires tag "host"`,
+		},
+		{
+			name:   "undeclared field",
+			lp:     "cpu,host=a idle=1 1",
+			reason: `schema violation: field "idle" is not in the schema of measurement "cpu"`,
+		},
+		{
+			name:   "wrong field type",
+			lp:     "cpu,host=a value=1i 1",
+			reason: `schema violation: field "value" on measurement "cpu" is integer, the bucket schema declares float`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			points, err := models.ParsePointsString(tt.lp)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = engine.Write1xPoints(points)
+			if tt.reason == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+
+			pwe, ok := err.(tsdb.PartialWriteError)
+			if !ok {
+				t.Fatalf("got error %v, expected a partial write error", err)
+			}
+			if pwe.Reason != tt.reason || pwe.Dropped != 1 {
+				t.Errorf("got reason %q dropped %d, expected reason %q dropped 1", pwe.Reason, pwe.Dropped, tt.reason)
+			}
+		})
+	}
+
+	// Removing the schema applies to the next write.
+	engine.SetBucketSchema(engine.bucket, nil)
+	points, err := models.ParsePointsString("mem,host=a value=1 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := engine.Write1xPoints(points); err != nil {
+		t.Fatalf("unexpected error after removing the schema: %v", err)
+	}
+}
+
+func TestEngine_WriteSeriesLimits(t *testing.T) {
+	c := storage.NewConfig()
+	c.MaxSeriesPerBucket = 3
+	c.MaxSeriesPerOrg = 4
+	engine := NewEngine(c)
+	defer engine.Close()
+	engine.MustOpen()
+
+	other := platform.ID(0x3333333333333333)
+	write := func(bucket platform.ID, lp string) error {
+		points, err := models.ParsePointsString(lp)
+		if err != nil {
+			t.Fatal(err)
+		}
+		exploded, err := tsdb.ExplodePoints(engine.org, bucket, points)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return engine.Engine.WritePoints(exploded)
+	}
+	seriesN := func(bucket *platform.ID) int {
+		u, err := engine.GetUsage(context.Background(), platform.UsageFilter{OrgID: &engine.org, BucketID: bucket})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return int(u[platform.UsageSeries].Value)
+	}
+
+	if err := write(engine.bucket, "cpu,host=a v=1 1\ncpu,host=b v=1 1"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Only one more series fits in the bucket; existing series can still be written.
+	err := write(engine.bucket, "cpu,host=a v=2 2\ncpu,host=c v=1 1\ncpu,host=d v=1 1\ncpu,host=d v=2 2")
+	pwe, ok := err.(tsdb.PartialWriteError)
+	if !ok {
+		t.Fatalf("got error %v, expected a partial write error", err)
+	}
+	if exp := "max series per bucket exceeded: bucket 3232323232323232 has 2 series, the limit is 3"; pwe.Reason != exp || pwe.Dropped != 1 {
+		t.Fatalf("got reason %q dropped %d, expected reason %q dropped 1", pwe.Reason, pwe.Dropped, exp)
+	}
+	if got, exp := seriesN(&engine.bucket), 3; got != exp {
+		t.Fatalf("got %d series in bucket, expected %d", got, exp)
+	}
+
+	// The organization has room for one more series in another bucket.
+	err = write(other, "mem,host=a v=1 1\nmem,host=b v=1 1")
+	pwe, ok = err.(tsdb.PartialWriteError)
+	if !ok {
+		t.Fatalf("got error %v, expected a partial write error", err)
+	}
+	if exp := "max series per organization exceeded: organization 3131313131313131 has 3 series, the limit is 4"; pwe.Reason != exp || pwe.Dropped != 1 {
+		t.Fatalf("got reason %q dropped %d, expected reason %q dropped 1", pwe.Reason, pwe.Dropped, exp)
+	}
+	if got, exp := seriesN(nil), 4; got != exp {
+		t.Fatalf("got %d series in organization, expected %d", got, exp)
+	}
+}
+
 func TestEngineClose_RemoveIndex(t *testing.T) {
 	engine := NewDefaultEngine()
 	defer engine.Close()
@@ -201,8 +356,58 @@ func TestEngine_WALDisabled(t *testing.T) {
 	}
 }
 
+func TestEngine_DeleteBucketRangePredicate(t *testing.T) {
+	engine := NewDefaultEngine()
+	defer engine.Close()
+	engine.MustOpen()
+
+	pts := []models.Point{
+		models.MustNewPoint(
+			"cpu",
+			models.NewTags(map[string]string{"host": "a"}),
+			map[string]interface{}{"value": 1.0},
+			time.Unix(1, 2),
+		),
+		models.MustNewPoint(
+			"cpu",
+			models.NewTags(map[string]string{"host": "b"}),
+			map[string]interface{}{"value": 1.0},
+			time.Unix(1, 2),
+		),
+	}
+	if err := engine.Write1xPoints(pts); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, exp := engine.SeriesCardinality(), int64(2); got != exp {
+		t.Fatalf("got %d series, exp %d series in index", got, exp)
+	}
+
+	filter := platform.DeleteFilter{
+		OrganizationID: engine.org,
+		BucketID:       engine.bucket,
+		Range:          platform.Timespan{Start: time.Unix(0, 0), Stop: time.Unix(10, 0)},
+		Predicate:      `_measurement = 'cpu' AND host = 'a'`,
+	}
+
+	if err := engine.DeleteBucketRangePredicate(context.Background(), filter); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, exp := engine.SeriesCardinality(), int64(1); got != exp {
+		t.Fatalf("got %d series, exp %d series in index", got, exp)
+	}
+
+	filter.Predicate = `host > 'a'`
+	if err := engine.DeleteBucketRangePredicate(context.Background(), filter); err == nil {
+		t.Fatal("expected error for invalid predicate, got nil")
+	}
+}
+
 type Engine struct {
-	path string
+	path   string
+	org    platform.ID
+	bucket platform.ID
 	*storage.Engine
 }
 
@@ -211,8 +416,12 @@ func NewEngine(c storage.Config) *Engine {
 	path, _ := ioutil.TempDir("", "storage_engine_test")
 
 	engine := storage.NewEngine(path, c)
+	org, _ := platform.IDFromString("3131313131313131")
+	bucket, _ := platform.IDFromString("3232323232323232")
 	return &Engine{
 		path:   path,
+		org:    *org,
+		bucket: *bucket,
 		Engine: engine,
 	}
 }
@@ -233,9 +442,7 @@ func (e *Engine) MustOpen() {
 // This allows us to use the old `models` package helper functions and still write
 // the points in the correct format.
 func (e *Engine) Write1xPoints(pts []models.Point) error {
-	org, _ := platform.IDFromString("3131313131313131")
-	bucket, _ := platform.IDFromString("3232323232323232")
-	points, err := tsdb.ExplodePoints(*org, *bucket, pts)
+	points, err := tsdb.ExplodePoints(e.org, e.bucket, pts)
 	if err != nil {
 		return err
 	}
@@ -18,6 +18,7 @@ type retentionMetrics struct {
 	CheckDuration *prometheus.HistogramVec
 	Unprocessable *prometheus.CounterVec
 	Series        *prometheus.CounterVec
+	Files         *prometheus.CounterVec
 }
 
 func newRetentionMetrics(labels prometheus.Labels) *retentionMetrics {
@@ -59,6 +60,13 @@ func newRetentionMetrics(labels prometheus.Labels) *retentionMetrics {
 			Name:      "series_total",
 			Help:      "Number of series that a delete was applied to.",
 		}, names),
+
+		Files: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: retentionSubsystem,
+			Name:      "files_total",
+			Help:      "Number of TSM files removed because their time group expired.",
+		}, names),
 	}
 }
 
@@ -78,5 +86,6 @@ func (rm *retentionMetrics) PrometheusCollectors() []prometheus.Collector {
 		rm.CheckDuration,
 		rm.Unprocessable,
 		rm.Series,
+		rm.Files,
 	}
 }
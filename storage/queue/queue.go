@@ -0,0 +1,285 @@
+// Package queue provides a durable queue in front of storage writes.
+//
+// Points are published to a fixed set of NATS streaming subjects, and written
+// to storage by a single queue subscriber per subject. The organization and
+// bucket of the points are part of the published points, and all writes to a
+// bucket are published to the same subject, so that they are written in order.
+// Writers return as soon as the points are persisted by the streaming server,
+// and writes that fail because storage is unavailable are redelivered, up to
+// MaxAttempts times, before any later write to the subject.
+//
+// Since writers return before the points are written, points that storage
+// rejects are not reported to them. This includes points that conflict with
+// the bucket's schema or exceed its series limits, as well as writes that still
+// fail after MaxAttempts deliveries. Such points are logged by the dead letter
+// logger, named "dead-letter", so that they can be inspected and written again.
+package queue
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"sync"
+
+	"github.com/influxdata/platform/models"
+	"github.com/influxdata/platform/nats"
+	"github.com/influxdata/platform/storage"
+	"github.com/influxdata/platform/tsdb"
+	"go.uber.org/zap"
+)
+
+const (
+	subjectPrefix = "writes"
+
+	// group is the durable queue group consuming every write subject.
+	group = "storage-writers"
+
+	// maxMessageSize bounds the size of published messages, which must stay
+	// below the streaming server's maximum payload.
+	maxMessageSize = 512 * 1024
+
+	// DefaultPartitions is the number of subjects writes are published to
+	// when the Writer does not set Partitions.
+	DefaultPartitions = 4
+
+	// DefaultMaxAttempts is the number of times a message is delivered before
+	// it is dropped when the Writer does not set MaxAttempts. Messages are
+	// redelivered after the streaming server's acknowledgement timeout.
+	DefaultMaxAttempts = 20
+)
+
+// Subject returns the subject of the nth partition of the queue.
+func Subject(n int) string {
+	return fmt.Sprintf("%s.%d", subjectPrefix, n)
+}
+
+// Writer is a storage.PointsWriter that queues points instead of writing them.
+// The points must have been exploded with tsdb.ExplodePoints, so that their
+// organization and bucket can be determined from their measurement.
+type Writer struct {
+	Publisher  nats.Publisher
+	Subscriber nats.Subscriber
+
+	// PointsWriter writes the points consumed from the queue.
+	PointsWriter storage.PointsWriter
+
+	// Partitions is the number of subjects writes are published to, each
+	// consumed by one subscriber. Buckets are assigned to subjects by hash,
+	// so it must not change while writes are queued.
+	Partitions int
+
+	// MaxAttempts is the number of times a message that cannot be written is
+	// delivered before its points are dropped to the dead letter log.
+	MaxAttempts int
+
+	Logger *zap.Logger
+}
+
+var _ storage.PointsWriter = (*Writer)(nil)
+
+// NewWriter creates a Writer publishing with p and consuming with s into w.
+func NewWriter(p nats.Publisher, s nats.Subscriber, w storage.PointsWriter, logger *zap.Logger) *Writer {
+	return &Writer{
+		Publisher:    p,
+		Subscriber:   s,
+		PointsWriter: w,
+		Partitions:   DefaultPartitions,
+		MaxAttempts:  DefaultMaxAttempts,
+		Logger:       logger,
+	}
+}
+
+// Open starts consuming every subject of the queue, including writes that
+// were queued but not written before the last shutdown.
+func (w *Writer) Open() error {
+	maxAttempts := w.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = DefaultMaxAttempts
+	}
+
+	for i := 0; i < w.partitions(); i++ {
+		subject := Subject(i)
+		logger := w.Logger.With(zap.String("subject", subject))
+		h := &handler{
+			PointsWriter:     w.PointsWriter,
+			MaxAttempts:      maxAttempts,
+			Logger:           logger,
+			DeadLetterLogger: logger.Named("dead-letter"),
+			attempts:         make(map[uint64]int),
+		}
+		if err := w.Subscriber.Subscribe(subject, group, h); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *Writer) partitions() int {
+	if w.Partitions <= 0 {
+		return DefaultPartitions
+	}
+	return w.Partitions
+}
+
+// WritePoints publishes the points to the subjects of their buckets. It returns
+// once the points are queued; points that are then rejected by storage are not
+// reported, but logged to the dead letter log.
+func (w *Writer) WritePoints(points []models.Point) error {
+	var subjects []string
+	msgs := make(map[string]*bytes.Buffer)
+
+	for _, pt := range points {
+		subject, err := w.subjectOf(pt)
+		if err != nil {
+			return err
+		}
+
+		b, err := pt.MarshalBinary()
+		if err != nil {
+			return err
+		}
+
+		buf, ok := msgs[subject]
+		if !ok {
+			buf = new(bytes.Buffer)
+			msgs[subject] = buf
+			subjects = append(subjects, subject)
+		}
+
+		if buf.Len() > 0 && buf.Len()+len(b)+binary.MaxVarintLen64 > maxMessageSize {
+			if err := w.Publisher.Publish(subject, buf); err != nil {
+				return err
+			}
+			buf.Reset()
+		}
+		appendPoint(buf, b)
+	}
+
+	for _, subject := range subjects {
+		if err := w.Publisher.Publish(subject, msgs[subject]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// subjectOf returns the subject of the bucket an exploded point belongs to.
+func (w *Writer) subjectOf(pt models.Point) (string, error) {
+	var name [16]byte
+	if n := pt.Name(); len(n) == len(name) {
+		copy(name[:], n)
+	} else {
+		return "", fmt.Errorf("point %q does not belong to a bucket", n)
+	}
+
+	// The name is the encoded organization and bucket of the point.
+	h := fnv.New32a()
+	h.Write(name[:])
+	return Subject(int(h.Sum32() % uint32(w.partitions()))), nil
+}
+
+// handler writes the points of queued messages to storage.
+type handler struct {
+	PointsWriter storage.PointsWriter
+	MaxAttempts  int
+	Logger       *zap.Logger
+
+	// DeadLetterLogger logs the points that are dropped.
+	DeadLetterLogger *zap.Logger
+
+	mu sync.Mutex
+	// attempts counts the failed deliveries of messages by the hash of their data.
+	attempts map[uint64]int
+}
+
+// Process writes the message's points and acknowledges it. Messages that could
+// not be written because of a storage error are not acknowledged, so that they
+// are redelivered once storage recovers, unless they have failed MaxAttempts
+// times. Points that are dropped are logged to the dead letter log.
+func (h *handler) Process(s nats.Subscription, m nats.Message) {
+	points, err := decodePoints(m.Data())
+	if err != nil {
+		h.DeadLetterLogger.Error("Dropping undecodable write", zap.Error(err), zap.Binary("data", m.Data()))
+		m.Ack()
+		return
+	}
+
+	key := hashData(m.Data())
+	if err := h.PointsWriter.WritePoints(points); err != nil {
+		if pwe, ok := err.(tsdb.PartialWriteError); ok {
+			h.DeadLetterLogger.Warn("Queued points rejected by storage",
+				zap.String("reason", pwe.Reason),
+				zap.Int("dropped", pwe.Dropped),
+				zap.ByteStrings("keys", pwe.DroppedKeys))
+		} else if n := h.failed(key); n < h.MaxAttempts {
+			h.Logger.Info("Failed to write queued points, will retry", zap.Int("attempt", n), zap.Error(err))
+			return
+		} else {
+			h.DeadLetterLogger.Error("Dropping queued points after repeated write failures",
+				zap.Int("attempts", n),
+				zap.Error(err),
+				zap.Strings("points", pointStrings(points)))
+		}
+	}
+
+	h.mu.Lock()
+	delete(h.attempts, key)
+	h.mu.Unlock()
+	m.Ack()
+}
+
+// failed records a failed delivery of the message with the key, and returns
+// the number of failed deliveries of the message.
+func (h *handler) failed(key uint64) int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.attempts[key]++
+	return h.attempts[key]
+}
+
+// hashData returns the key of a message in the attempt counts.
+func hashData(data []byte) uint64 {
+	h := fnv.New64a()
+	h.Write(data)
+	return h.Sum64()
+}
+
+// pointStrings returns the line protocol of the points.
+func pointStrings(points []models.Point) []string {
+	lines := make([]string, len(points))
+	for i, pt := range points {
+		lines[i] = pt.String()
+	}
+	return lines
+}
+
+var errShortMessage = errors.New("queued message is truncated")
+
+// appendPoint appends a binary point to buf, prefixed with its length.
+func appendPoint(buf *bytes.Buffer, b []byte) {
+	var n [binary.MaxVarintLen64]byte
+	buf.Write(n[:binary.PutUvarint(n[:], uint64(len(b)))])
+	buf.Write(b)
+}
+
+// decodePoints decodes the points of a message published by a Writer.
+func decodePoints(data []byte) ([]models.Point, error) {
+	var points []models.Point
+	for len(data) > 0 {
+		l, n := binary.Uvarint(data)
+		if n <= 0 || uint64(len(data)-n) < l {
+			return nil, errShortMessage
+		}
+		data = data[n:]
+
+		pt, err := models.NewPointFromBytes(data[:l])
+		if err != nil {
+			return nil, err
+		}
+		points = append(points, pt)
+		data = data[l:]
+	}
+	return points, nil
+}
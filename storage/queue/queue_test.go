@@ -0,0 +1,224 @@
+package queue
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/mock"
+	"github.com/influxdata/platform/models"
+	"github.com/influxdata/platform/nats"
+	"github.com/influxdata/platform/tsdb"
+	"go.uber.org/zap"
+)
+
+// loopback delivers published messages to the handlers subscribed to their subject.
+type loopback struct {
+	handlers map[string][]nats.Handler
+	acked    int
+	pending  []*message
+}
+
+func (l *loopback) Subscribe(subject, group string, h nats.Handler) error {
+	if l.handlers == nil {
+		l.handlers = make(map[string][]nats.Handler)
+	}
+	l.handlers[subject] = append(l.handlers[subject], h)
+	return nil
+}
+
+func (l *loopback) Publish(subject string, r io.Reader) error {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	hs := l.handlers[subject]
+	if len(hs) == 0 {
+		return errors.New("no subscribers on " + subject)
+	}
+	m := &message{subject: subject, data: data, l: l}
+	hs[0].Process(nil, m)
+	if !m.acked {
+		l.pending = append(l.pending, m)
+	}
+	return nil
+}
+
+type message struct {
+	subject string
+	data    []byte
+	acked   bool
+	l       *loopback
+}
+
+func (m *message) Data() []byte { return m.data }
+
+func (m *message) Ack() error {
+	m.acked = true
+	m.l.acked++
+	return nil
+}
+
+func explode(t *testing.T, org, bucket platform.ID, lp string) []models.Point {
+	t.Helper()
+	points, err := models.ParsePointsString(lp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exploded, err := tsdb.ExplodePoints(org, bucket, points)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return exploded
+}
+
+func TestWriter_WritePoints(t *testing.T) {
+	// 0x2c and 0x20 are a comma and a space, which are escaped in series keys.
+	org, bucket, other := platform.ID(0x2c2c), platform.ID(0x2020), platform.ID(3)
+
+	l := &loopback{}
+	pw := &mock.PointsWriter{}
+	w := NewWriter(l, l, pw, zap.NewNop())
+	w.Partitions = 2
+	if err := w.Open(); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < w.Partitions; i++ {
+		if got, want := len(l.handlers[Subject(i)]), 1; got != want {
+			t.Errorf("subject %d: got %d subscribers, want %d", i, got, want)
+		}
+	}
+
+	points := append(explode(t, org, bucket, "m,t=a f=1,g=2 1\nm,t=b s=\"x\" 2"), explode(t, org, other, "m f=3 3")...)
+	if err := w.WritePoints(points); err != nil {
+		t.Fatal(err)
+	}
+
+	if l.acked == 0 || len(l.pending) != 0 {
+		t.Errorf("got %d acked and %d unacknowledged messages, want all acknowledged", l.acked, len(l.pending))
+	}
+
+	if got, want := len(pw.Points), len(points); got != want {
+		t.Fatalf("got %d points written, want %d", got, want)
+	}
+	for i := range points {
+		if got, want := pw.Points[i].String(), points[i].String(); got != want {
+			t.Errorf("point %d: got %q, want %q", i, got, want)
+		}
+	}
+}
+
+func TestWriter_WritePoints_StorageError(t *testing.T) {
+	l := &loopback{}
+	pw := &mock.PointsWriter{}
+	w := NewWriter(l, l, pw, zap.NewNop())
+	if err := w.Open(); err != nil {
+		t.Fatal(err)
+	}
+
+	pw.ForceError(errors.New("engine closed"))
+	if err := w.WritePoints(explode(t, 1, 2, "m f=1 1")); err != nil {
+		t.Fatalf("queued write should not fail: %v", err)
+	}
+	if len(l.pending) != 1 {
+		t.Fatalf("got %d unacknowledged messages, want 1", len(l.pending))
+	}
+
+	pw.ForceError(tsdb.PartialWriteError{Reason: "dropped", Dropped: 1})
+	if err := w.WritePoints(explode(t, 1, 2, "m f=2 2")); err != nil {
+		t.Fatalf("queued write should not fail: %v", err)
+	}
+	if len(l.pending) != 1 {
+		t.Errorf("partial writes should be acknowledged, got %d unacknowledged messages", len(l.pending))
+	}
+}
+
+func TestWriter_WritePoints_MaxAttempts(t *testing.T) {
+	l := &loopback{}
+	pw := &mock.PointsWriter{}
+	w := NewWriter(l, l, pw, zap.NewNop())
+	w.MaxAttempts = 3
+	if err := w.Open(); err != nil {
+		t.Fatal(err)
+	}
+
+	pw.ForceError(errors.New("field type conflict"))
+	if err := w.WritePoints(explode(t, 1, 2, "m f=1 1")); err != nil {
+		t.Fatalf("queued write should not fail: %v", err)
+	}
+
+	// Redeliver the message until it is dropped.
+	h := l.handlers[l.pending[0].subject][0]
+	for i := 1; i < w.MaxAttempts; i++ {
+		m := l.pending[0]
+		if m.acked {
+			t.Fatalf("message acknowledged after %d attempts, want %d", i, w.MaxAttempts)
+		}
+		h.Process(nil, m)
+	}
+	if !l.pending[0].acked {
+		t.Errorf("message not acknowledged after %d attempts", w.MaxAttempts)
+	}
+}
+
+func TestWriter_WritePoints_SameBucketSameSubject(t *testing.T) {
+	l := &loopback{}
+	w := NewWriter(l, l, &mock.PointsWriter{}, zap.NewNop())
+	w.Partitions = 16
+	if err := w.Open(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Writes to a bucket are consumed in order by the single subscriber of its subject.
+	var subjects []string
+	for _, lp := range []string{"m f=1 1", "m,t=a g=2 2", "n f=3 3"} {
+		pt := explode(t, 1, 2, lp)[0]
+		subject, err := w.subjectOf(pt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		subjects = append(subjects, subject)
+	}
+	for _, subject := range subjects[1:] {
+		if subject != subjects[0] {
+			t.Fatalf("writes to a bucket published to different subjects: %v", subjects)
+		}
+	}
+}
+
+func TestWriter_WritePoints_NotExploded(t *testing.T) {
+	l := &loopback{}
+	w := NewWriter(l, l, &mock.PointsWriter{}, zap.NewNop())
+	if err := w.Open(); err != nil {
+		t.Fatal(err)
+	}
+
+	points, err := models.ParsePointsString("m f=1 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.WritePoints(points); err == nil {
+		t.Error("expected error writing points without a bucket")
+	}
+}
+
+func TestDecodePoints_Truncated(t *testing.T) {
+	b, err := explode(t, 1, 2, "m f=1 1")[0].MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf := new(bytes.Buffer)
+	appendPoint(buf, b)
+	data := buf.Bytes()
+
+	if _, err := decodePoints(data); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := decodePoints(data[:len(data)-1]); err != errShortMessage {
+		t.Errorf("got error %v, want %v", err, errShortMessage)
+	}
+}
@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"regexp"
 	"strconv"
+	"strings"
 
 	"github.com/influxdata/flux/ast"
 	"github.com/influxdata/flux/semantic"
 	"github.com/influxdata/influxql"
+	"github.com/influxdata/platform/models"
 	"github.com/influxdata/platform/storage/reads/datatypes"
 	"github.com/influxdata/platform/tsdb"
 	"github.com/pkg/errors"
@@ -580,3 +582,64 @@ func HasFieldValueKey(expr influxql.Expr) bool {
 	influxql.Walk(&refs, expr)
 	return refs.found[0]
 }
+
+// ParseTagPredicate parses s as a conditional expression over tag keys,
+// suitable for evaluation with EvalExprBool against a series' tags.
+//
+// References to _measurement and _field are rewritten to the tag keys used by
+// the storage engine. Only tag comparisons (=, !=, =~, !~) combined with AND
+// and OR are permitted. An empty string returns a nil expression, which
+// callers should treat as matching every series.
+func ParseTagPredicate(s string) (influxql.Expr, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+
+	expr, err := influxql.ParseExpr(s)
+	if err != nil {
+		return nil, err
+	}
+
+	influxql.WalkFunc(expr, func(node influxql.Node) {
+		if err != nil {
+			return
+		}
+
+		switch n := node.(type) {
+		case *influxql.BinaryExpr:
+			switch n.Op {
+			case influxql.AND, influxql.OR:
+			case influxql.EQ, influxql.NEQ, influxql.EQREGEX, influxql.NEQREGEX:
+				if _, ok := n.LHS.(*influxql.VarRef); !ok {
+					err = fmt.Errorf("invalid tag comparison: %s", n)
+				}
+			default:
+				err = fmt.Errorf("invalid tag comparison operator: %s", n.Op)
+			}
+		case *influxql.VarRef:
+			switch n.Val {
+			case measurementKey:
+				n.Val = tsdb.MeasurementTagKey
+			case fieldKey:
+				n.Val = tsdb.FieldKeyTagKey
+			}
+			n.Type = influxql.Tag
+		case *influxql.ParenExpr, *influxql.StringLiteral, *influxql.RegexLiteral:
+		default:
+			err = fmt.Errorf("unsupported expression in tag predicate: %s", n)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return expr, nil
+}
+
+// TagsValuer is a Valuer over a set of series tags. As with InfluxQL, a tag
+// key missing from the series evaluates as the empty string.
+type TagsValuer models.Tags
+
+// Value returns the value of the tag key.
+func (t TagsValuer) Value(key string) (interface{}, bool) {
+	return string(models.Tags(t).Get([]byte(key))), true
+}
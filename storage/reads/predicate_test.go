@@ -3,6 +3,7 @@ package reads_test
 import (
 	"testing"
 
+	"github.com/influxdata/platform/models"
 	"github.com/influxdata/platform/storage/reads"
 	"github.com/influxdata/platform/storage/reads/datatypes"
 )
@@ -56,3 +57,47 @@ func TestPredicateToExprString(t *testing.T) {
 		})
 	}
 }
+
+func TestParseTagPredicate(t *testing.T) {
+	tags := models.NewTags(map[string]string{
+		"_m":     "cpu",
+		"_f":     "usage",
+		"host":   "host1",
+		"region": "us-west",
+	})
+
+	cases := []struct {
+		n   string
+		s   string
+		exp bool
+		err bool
+	}{
+		{n: "equality", s: `host = 'host1'`, exp: true},
+		{n: "measurement and field", s: `_measurement = 'cpu' AND _field = 'usage'`, exp: true},
+		{n: "regex", s: `region =~ /^us-/ AND host != 'host2'`, exp: true},
+		{n: "missing tag", s: `dc = 'a'`, exp: false},
+		{n: "missing tag not equal", s: `dc != 'a'`, exp: true},
+		{n: "or", s: `(host = 'host2' OR host = 'host1')`, exp: true},
+		{n: "invalid operator", s: `host > 'a'`, err: true},
+		{n: "invalid syntax", s: `host = `, err: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.n, func(t *testing.T) {
+			expr, err := reads.ParseTagPredicate(tc.s)
+			if (err != nil) != tc.err {
+				t.Fatalf("got error %v, wanted error %v", err, tc.err)
+			}
+			if tc.err {
+				return
+			}
+			if got := reads.EvalExprBool(expr, reads.TagsValuer(tags)); got != tc.exp {
+				t.Fatal("got:", got, "wanted:", tc.exp)
+			}
+		})
+	}
+
+	if expr, err := reads.ParseTagPredicate(""); err != nil || expr != nil {
+		t.Fatalf("got %v, %v for empty predicate, wanted nil, nil", expr, err)
+	}
+}
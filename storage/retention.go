@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"math"
@@ -13,6 +14,7 @@ import (
 	"github.com/influxdata/platform/logger"
 	"github.com/influxdata/platform/models"
 	"github.com/influxdata/platform/tsdb"
+	"github.com/influxdata/platform/tsdb/tsm1"
 	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
 )
@@ -26,6 +28,7 @@ const (
 type Deleter interface {
 	CreateSeriesCursor(context.Context, SeriesCursorRequest, influxql.Expr) (SeriesCursor, error)
 	DeleteSeriesRangeWithPredicate(tsdb.SeriesIterator, func([]byte, models.Tags) (int64, int64, bool)) error
+	DropTimeGroups(func(owner []byte, max int64) bool) (int, []tsm1.FileStat, error)
 }
 
 // A BucketFinder is responsible for providing access to buckets via a filter.
@@ -78,7 +81,7 @@ func (s *retentionEnforcer) run() {
 	log, logEnd := logger.NewOperation(s.logger, "Data retention check", "data_retention_check")
 	defer logEnd()
 
-	rpByBucketID, err := s.getRetentionPeriodPerBucket()
+	buckets, err := s.getBuckets()
 	if err != nil {
 		log.Error("Unable to determine bucket:RP mapping", zap.Error(err))
 		return
@@ -88,7 +91,7 @@ func (s *retentionEnforcer) run() {
 	labels := s.metrics.Labels()
 	labels["status"] = "ok"
 
-	if err := s.expireData(rpByBucketID, now); err != nil {
+	if err := s.dropExpiredGroups(buckets, now); err != nil {
 		log.Error("Deletion not successful", zap.Error(err))
 		labels["status"] = "error"
 	}
@@ -96,6 +99,86 @@ func (s *retentionEnforcer) run() {
 	s.metrics.Checks.With(labels).Inc()
 }
 
+// dropExpiredGroups removes every time group on the storage engine whose data
+// has fallen outside of the retention period of its bucket, along with the
+// series that only had data in those groups.
+//
+// Files that span several time groups, such as those written before the engine
+// partitioned its data, cannot be dropped. If any of them may hold data that
+// has expired for a bucket whose data the file holds, expireData is used to
+// delete it.
+func (s *retentionEnforcer) dropExpiredGroups(buckets []*platform.Bucket, now time.Time) error {
+	_, logEnd := logger.NewOperation(s.logger, "Time group drop", "time_group_drop")
+	defer logEnd()
+
+	rpByBucketID := make(map[platform.ID]time.Duration, len(buckets))
+	for _, bucket := range buckets {
+		rpByBucketID[bucket.ID] = bucket.RetentionPeriod
+	}
+
+	expired := func(owner []byte, max int64) bool {
+		if len(owner) != platform.IDLength {
+			return false
+		}
+
+		var n [16]byte
+		copy(n[:], owner)
+		_, bucketID := tsdb.DecodeName(n)
+
+		retentionPeriod, ok := rpByBucketID[bucketID]
+		if !ok || retentionPeriod == 0 {
+			return false
+		}
+		return max < now.Add(-retentionPeriod).UnixNano()
+	}
+
+	dropped, ungrouped, err := s.Engine.DropTimeGroups(expired)
+	if s.metrics != nil {
+		labels := s.metrics.Labels()
+		labels["status"] = "ok"
+		s.metrics.Files.With(labels).Add(float64(dropped))
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, st := range ungrouped {
+		if hasExpiredData(st, buckets, now) {
+			return s.expireData(rpByBucketID, now)
+		}
+	}
+	return nil
+}
+
+// hasExpiredData determines if the file described by st may hold data that has
+// fallen outside of the retention period of its bucket.
+//
+// Keys are sorted by their escaped measurement, the encoded org and bucket, so
+// a file can only hold data of the buckets whose owner lies between the owners
+// of its min and max keys.
+func hasExpiredData(st tsm1.FileStat, buckets []*platform.Bucket, now time.Time) bool {
+	minOwner, maxOwner := keyOwner(st.MinKey), keyOwner(st.MaxKey)
+	for _, bucket := range buckets {
+		if bucket.RetentionPeriod == 0 || st.MinTime >= now.Add(-bucket.RetentionPeriod).UnixNano() {
+			continue
+		}
+
+		name := tsdb.EncodeName(bucket.OrganizationID, bucket.ID)
+		owner := models.EscapeMeasurement(name[:])
+		if bytes.Compare(owner, minOwner) >= 0 && bytes.Compare(owner, maxOwner) <= 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// keyOwner returns the escaped measurement, the encoded org and bucket, of the
+// TSM key.
+func keyOwner(key []byte) []byte {
+	seriesKey, _ := tsm1.SeriesAndFieldFromCompositeKey(key)
+	return models.EscapeMeasurement(models.ParseName(seriesKey))
+}
+
 // expireData runs a delete operation on the storage engine.
 //
 // Any series data that (1) belongs to a bucket in the provided map and
@@ -171,20 +254,12 @@ func (s *retentionEnforcer) expireData(rpByBucketID map[platform.ID]time.Duratio
 	return s.Engine.DeleteSeriesRangeWithPredicate(newSeriesIteratorAdapter(cur), fn)
 }
 
-// getRetentionPeriodPerBucket returns a map of (bucket ID -> retention period)
-// for all buckets.
-func (s *retentionEnforcer) getRetentionPeriodPerBucket() (map[platform.ID]time.Duration, error) {
+// getBuckets returns all buckets along with their retention periods.
+func (s *retentionEnforcer) getBuckets() ([]*platform.Bucket, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), bucketAPITimeout)
 	defer cancel()
 	buckets, _, err := s.BucketService.FindBuckets(ctx, platform.BucketFilter{})
-	if err != nil {
-		return nil, err
-	}
-	rpByBucketID := make(map[platform.ID]time.Duration, len(buckets))
-	for _, bucket := range buckets {
-		rpByBucketID[bucket.ID] = bucket.RetentionPeriod
-	}
-	return rpByBucketID, nil
+	return buckets, err
 }
 
 // PrometheusCollectors satisfies the prom.PrometheusCollector interface.
@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"math"
@@ -13,6 +14,7 @@ import (
 	"github.com/influxdata/platform"
 	"github.com/influxdata/platform/models"
 	"github.com/influxdata/platform/tsdb"
+	"github.com/influxdata/platform/tsdb/tsm1"
 )
 
 func TestService_expireData(t *testing.T) {
@@ -110,6 +112,120 @@ func TestService_expireData(t *testing.T) {
 	})
 }
 
+func TestService_dropExpiredGroups(t *testing.T) {
+	engine := NewTestEngine()
+	service := newRetentionEnforcer(engine, NewTestBucketFinder())
+	now := time.Date(2018, 4, 10, 23, 12, 33, 0, time.UTC)
+
+	expiredName, liveName, infiniteName := genMeasurementName(), genMeasurementName(), genMeasurementName()
+	bucket := func(name []byte, rp time.Duration) *platform.Bucket {
+		var n [16]byte
+		copy(n[:], name)
+		orgID, bucketID := tsdb.DecodeName(n)
+		return &platform.Bucket{ID: bucketID, OrganizationID: orgID, RetentionPeriod: rp}
+	}
+
+	buckets := []*platform.Bucket{
+		bucket(expiredName, 3*time.Hour),
+		bucket(liveName, 3*time.Hour),
+		bucket(infiniteName, 0),
+	}
+
+	// key returns a TSM key of a series owned by name.
+	key := func(name []byte) []byte {
+		seriesKey := models.MakeKey(name, models.NewTags(map[string]string{"t": "a"}))
+		return tsm1.SeriesFieldKeyBytes(string(seriesKey), "f")
+	}
+
+	groups := []struct {
+		owner []byte
+		max   int64
+		exp   bool
+	}{
+		{owner: expiredName, max: now.Add(-4 * time.Hour).UnixNano(), exp: true},
+		{owner: liveName, max: now.Add(-2 * time.Hour).UnixNano(), exp: false},
+		{owner: infiniteName, max: now.Add(-400 * time.Hour).UnixNano(), exp: false},
+		{owner: genMeasurementName(), max: now.Add(-400 * time.Hour).UnixNano(), exp: false}, // Missing bucket.
+		{owner: []byte("zyzwrong"), max: now.Add(-400 * time.Hour).UnixNano(), exp: false},
+	}
+
+	var ungrouped []tsm1.FileStat
+	engine.DropTimeGroupsFn = func(fn func([]byte, int64) bool) (int, []tsm1.FileStat, error) {
+		for _, g := range groups {
+			if got := fn(g.owner, g.max); got != g.exp {
+				return 0, nil, fmt.Errorf("group %x: got expired %v, expected %v", g.owner, got, g.exp)
+			}
+		}
+		return 1, ungrouped, nil
+	}
+
+	var deletes int
+	engine.DeleteSeriesRangeWithPredicateFn = func(tsdb.SeriesIterator, func([]byte, models.Tags) (int64, int64, bool)) error {
+		deletes++
+		return nil
+	}
+
+	t.Run("grouped", func(t *testing.T) {
+		if err := service.dropExpiredGroups(buckets, now); err != nil {
+			t.Fatal(err)
+		}
+		if deletes != 0 {
+			t.Fatalf("got %d range deletes, expected 0", deletes)
+		}
+	})
+
+	t.Run("ungrouped live", func(t *testing.T) {
+		ungrouped = []tsm1.FileStat{{MinTime: now.Add(-time.Hour).UnixNano(), MinKey: key(liveName), MaxKey: key(liveName)}}
+		if err := service.dropExpiredGroups(buckets, now); err != nil {
+			t.Fatal(err)
+		}
+		if deletes != 0 {
+			t.Fatalf("got %d range deletes, expected 0", deletes)
+		}
+	})
+
+	t.Run("ungrouped infinite", func(t *testing.T) {
+		// Data older than the retention period of other buckets.
+		ungrouped = []tsm1.FileStat{{MinTime: now.Add(-400 * time.Hour).UnixNano(), MinKey: key(infiniteName), MaxKey: key(infiniteName)}}
+		if err := service.dropExpiredGroups(buckets, now); err != nil {
+			t.Fatal(err)
+		}
+		if deletes != 0 {
+			t.Fatalf("got %d range deletes, expected 0", deletes)
+		}
+	})
+
+	t.Run("ungrouped expired", func(t *testing.T) {
+		ungrouped = []tsm1.FileStat{{MinTime: now.Add(-4 * time.Hour).UnixNano(), MinKey: key(expiredName), MaxKey: key(expiredName)}}
+		if err := service.dropExpiredGroups(buckets, now); err != nil {
+			t.Fatal(err)
+		}
+		if deletes != 1 {
+			t.Fatalf("got %d range deletes, expected 1", deletes)
+		}
+	})
+
+	t.Run("ungrouped spanning buckets", func(t *testing.T) {
+		deletes = 0
+		minKey, maxKey := key(infiniteName), key(infiniteName)
+		for _, name := range [][]byte{expiredName, liveName} {
+			if k := key(name); bytes.Compare(k, minKey) < 0 {
+				minKey = k
+			} else if bytes.Compare(k, maxKey) > 0 {
+				maxKey = k
+			}
+		}
+
+		ungrouped = []tsm1.FileStat{{MinTime: now.Add(-4 * time.Hour).UnixNano(), MinKey: minKey, MaxKey: maxKey}}
+		if err := service.dropExpiredGroups(buckets, now); err != nil {
+			t.Fatal(err)
+		}
+		if deletes != 1 {
+			t.Fatalf("got %d range deletes, expected 1", deletes)
+		}
+	})
+}
+
 // genMeasurementName generates a random measurement name or panics.
 func genMeasurementName() []byte {
 	b := make([]byte, 16)
@@ -131,6 +247,7 @@ func (f *TestSeriesCursor) Next() (*SeriesCursorRow, error) { return f.NextFn()
 type TestEngine struct {
 	CreateSeriesCursorFn             func(context.Context, SeriesCursorRequest, influxql.Expr) (SeriesCursor, error)
 	DeleteSeriesRangeWithPredicateFn func(tsdb.SeriesIterator, func([]byte, models.Tags) (int64, int64, bool)) error
+	DropTimeGroupsFn                 func(func([]byte, int64) bool) (int, []tsm1.FileStat, error)
 
 	SeriesCursor *TestSeriesCursor
 }
@@ -145,6 +262,7 @@ func NewTestEngine() *TestEngine {
 		SeriesCursor:                     cursor,
 		CreateSeriesCursorFn:             func(context.Context, SeriesCursorRequest, influxql.Expr) (SeriesCursor, error) { return cursor, nil },
 		DeleteSeriesRangeWithPredicateFn: func(tsdb.SeriesIterator, func([]byte, models.Tags) (int64, int64, bool)) error { return nil },
+		DropTimeGroupsFn:                 func(func([]byte, int64) bool) (int, []tsm1.FileStat, error) { return 0, nil, nil },
 	}
 }
 
@@ -156,6 +274,10 @@ func (e *TestEngine) DeleteSeriesRangeWithPredicate(itr tsdb.SeriesIterator, fn
 	return e.DeleteSeriesRangeWithPredicateFn(itr, fn)
 }
 
+func (e *TestEngine) DropTimeGroups(fn func([]byte, int64) bool) (int, []tsm1.FileStat, error) {
+	return e.DropTimeGroupsFn(fn)
+}
+
 type TestBucketFinder struct {
 	FindBucketsFn func(context.Context, platform.BucketFilter, ...platform.FindOptions) ([]*platform.Bucket, int, error)
 }
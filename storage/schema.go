@@ -0,0 +1,238 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/models"
+	"github.com/influxdata/platform/tsdb"
+	"go.uber.org/zap"
+)
+
+// bucketSchemas enforces the schemas of buckets on written points.
+//
+// Schemas are cached, and refreshed from the BucketFinder in the background
+// every bucketRefreshInterval, so that writes never wait on the finder.
+// Changes made through a BucketSchemaService are applied immediately. Points
+// written to buckets without a schema, or that cannot be found, are not checked.
+type bucketSchemas struct {
+	finder BucketFinder
+	logger *zap.Logger
+
+	mu      sync.RWMutex
+	schemas map[platform.ID]*compiledSchema
+}
+
+// compiledSchema is a platform.BucketSchema indexed for checking points.
+type compiledSchema struct {
+	explicit     bool
+	measurements map[string]*compiledMeasurement
+}
+
+type compiledMeasurement struct {
+	requiredTags [][]byte
+	fields       map[string]models.FieldType
+}
+
+func newBucketSchemas(finder BucketFinder) *bucketSchemas {
+	return &bucketSchemas{
+		finder:  finder,
+		logger:  zap.NewNop(),
+		schemas: make(map[platform.ID]*compiledSchema),
+	}
+}
+
+// check returns the reason a point of the collection violates the schema of
+// its bucket, or an empty string if it does not.
+func (s *bucketSchemas) check(name []byte, tags models.Tags, typ models.FieldType) string {
+	if len(name) != platform.IDLength {
+		return ""
+	}
+
+	var n [16]byte
+	copy(n[:], name)
+	_, bucketID := tsdb.DecodeName(n)
+
+	s.mu.RLock()
+	schema := s.schemas[bucketID]
+	s.mu.RUnlock()
+
+	if schema == nil {
+		return ""
+	}
+	return schema.check(tags, typ)
+}
+
+// set replaces the schema of the bucket with the given id. A nil schema
+// removes it.
+func (s *bucketSchemas) set(id platform.ID, schema *platform.BucketSchema) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if schema == nil {
+		delete(s.schemas, id)
+		return
+	}
+	s.schemas[id] = compileSchema(schema)
+}
+
+// run refreshes the schemas every interval until closing is closed.
+func (s *bucketSchemas) run(closing <-chan struct{}, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-closing:
+			return
+		case <-ticker.C:
+			s.refresh()
+		}
+	}
+}
+
+// refresh reloads the schemas of all buckets.
+func (s *bucketSchemas) refresh() {
+	ctx, cancel := context.WithTimeout(context.Background(), bucketAPITimeout)
+	defer cancel()
+	buckets, _, err := s.finder.FindBuckets(ctx, platform.BucketFilter{})
+	if err != nil {
+		s.logger.Info("Unable to refresh bucket schemas", zap.Error(err))
+		return
+	}
+
+	schemas := make(map[platform.ID]*compiledSchema)
+	for _, b := range buckets {
+		if b.Schema != nil {
+			schemas[b.ID] = compileSchema(b.Schema)
+		}
+	}
+
+	s.mu.Lock()
+	s.schemas = schemas
+	s.mu.Unlock()
+}
+
+// BucketSchemaService is a platform.BucketService that applies the schemas
+// of the buckets it creates, updates and deletes to the engine immediately,
+// rather than once the engine refreshes them.
+type BucketSchemaService struct {
+	platform.BucketService
+	Engine *Engine
+}
+
+// NewBucketSchemaService returns a BucketSchemaService applying the schema
+// changes made through s to e.
+func NewBucketSchemaService(s platform.BucketService, e *Engine) *BucketSchemaService {
+	return &BucketSchemaService{
+		BucketService: s,
+		Engine:        e,
+	}
+}
+
+// CreateBucket creates the bucket and enforces its schema.
+func (s *BucketSchemaService) CreateBucket(ctx context.Context, b *platform.Bucket) error {
+	if err := s.BucketService.CreateBucket(ctx, b); err != nil {
+		return err
+	}
+
+	if b.Schema != nil {
+		s.Engine.SetBucketSchema(b.ID, b.Schema)
+	}
+	return nil
+}
+
+// UpdateBucket updates the bucket and enforces its updated schema.
+func (s *BucketSchemaService) UpdateBucket(ctx context.Context, id platform.ID, upd platform.BucketUpdate) (*platform.Bucket, error) {
+	b, err := s.BucketService.UpdateBucket(ctx, id, upd)
+	if err != nil {
+		return nil, err
+	}
+
+	if upd.Schema != nil {
+		s.Engine.SetBucketSchema(b.ID, b.Schema)
+	}
+	return b, nil
+}
+
+// DeleteBucket deletes the bucket and stops enforcing its schema.
+func (s *BucketSchemaService) DeleteBucket(ctx context.Context, id platform.ID) error {
+	if err := s.BucketService.DeleteBucket(ctx, id); err != nil {
+		return err
+	}
+
+	s.Engine.SetBucketSchema(id, nil)
+	return nil
+}
+
+func compileSchema(bs *platform.BucketSchema) *compiledSchema {
+	s := &compiledSchema{
+		explicit:     bs.Explicit(),
+		measurements: make(map[string]*compiledMeasurement, len(bs.Measurements)),
+	}
+	for _, m := range bs.Measurements {
+		cm := &compiledMeasurement{
+			fields: make(map[string]models.FieldType, len(m.Fields)),
+		}
+		for _, t := range m.RequiredTags {
+			cm.requiredTags = append(cm.requiredTags, []byte(t))
+		}
+		for _, f := range m.Fields {
+			cm.fields[f.Name] = schemaFieldTypes[f.Type]
+		}
+		s.measurements[m.Name] = cm
+	}
+	return s
+}
+
+var schemaFieldTypes = map[platform.SchemaFieldType]models.FieldType{
+	platform.SchemaFieldTypeFloat:    models.Float,
+	platform.SchemaFieldTypeInteger:  models.Integer,
+	platform.SchemaFieldTypeUnsigned: models.Unsigned,
+	platform.SchemaFieldTypeString:   models.String,
+	platform.SchemaFieldTypeBoolean:  models.Boolean,
+}
+
+var fieldTypeNames = map[models.FieldType]platform.SchemaFieldType{
+	models.Float:    platform.SchemaFieldTypeFloat,
+	models.Integer:  platform.SchemaFieldTypeInteger,
+	models.Unsigned: platform.SchemaFieldTypeUnsigned,
+	models.String:   platform.SchemaFieldTypeString,
+	models.Boolean:  platform.SchemaFieldTypeBoolean,
+}
+
+// check checks an exploded point, whose measurement and field key are held in
+// the tags, against the schema.
+func (s *compiledSchema) check(tags models.Tags, typ models.FieldType) string {
+	measurement := tags.Get(tsdb.MeasurementTagKeyBytes)
+	field := tags.Get(tsdb.FieldKeyTagKeyBytes)
+
+	m, ok := s.measurements[string(measurement)]
+	if !ok {
+		if s.explicit {
+			return fmt.Sprintf("schema violation: measurement %q is not in the bucket schema", measurement)
+		}
+		return ""
+	}
+
+	for _, t := range m.requiredTags {
+		if tags.Get(t) == nil {
+			return fmt.Sprintf("schema violation: measurement %q requires tag %q", measurement, t)
+		}
+	}
+
+	want, ok := m.fields[string(field)]
+	if !ok {
+		if s.explicit {
+			return fmt.Sprintf("schema violation: field %q is not in the schema of measurement %q", field, measurement)
+		}
+		return ""
+	}
+	if typ != want {
+		return fmt.Sprintf("schema violation: field %q on measurement %q is %s, the bucket schema declares %s", field, measurement, fieldTypeNames[typ], fieldTypeNames[want])
+	}
+	return ""
+}
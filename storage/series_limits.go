@@ -0,0 +1,76 @@
+package storage
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/tsdb"
+	"github.com/influxdata/platform/tsdb/tsi1"
+)
+
+// seriesLimits limits the number of series of each bucket and organization.
+// In the index, the measurement of every series is its encoded organization and
+// bucket, so the series of a bucket are those of its measurement and the
+// series of an organization those of all measurements sharing its prefix.
+type seriesLimits struct {
+	index        *tsi1.Index
+	maxPerBucket int
+	maxPerOrg    int
+}
+
+var _ tsi1.SeriesLimiter = (*seriesLimits)(nil)
+
+// LimitNewSeries lowers the number of new series of each bucket so that no
+// bucket or organization goes over its limit.
+func (l *seriesLimits) LimitNewSeries(newSeries map[string]int) string {
+	names := make([]string, 0, len(newSeries))
+	for name := range newSeries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var reason string
+	orgs := make(map[platform.ID]int) // series of each organization, including the ones allowed so far
+	for _, name := range names {
+		if len(name) != platform.IDLength {
+			continue
+		}
+		var n [16]byte
+		copy(n[:], name)
+		orgID, bucketID := tsdb.DecodeName(n)
+
+		allowed := newSeries[name]
+
+		if l.maxPerBucket > 0 {
+			current := l.index.MeasurementCardinalityByPrefix(n[:])
+			if remaining := l.maxPerBucket - current; remaining < allowed {
+				allowed = nonNegative(remaining)
+				reason = fmt.Sprintf("max series per bucket exceeded: bucket %s has %d series, the limit is %d", bucketID, current, l.maxPerBucket)
+			}
+		}
+
+		if l.maxPerOrg > 0 {
+			current, ok := orgs[orgID]
+			if !ok {
+				// The organization is encoded in the first half of the name.
+				current = l.index.MeasurementCardinalityByPrefix(n[:8])
+			}
+			if remaining := l.maxPerOrg - current; remaining < allowed {
+				allowed = nonNegative(remaining)
+				reason = fmt.Sprintf("max series per organization exceeded: organization %s has %d series, the limit is %d", orgID, current, l.maxPerOrg)
+			}
+			orgs[orgID] = current + allowed
+		}
+
+		newSeries[name] = allowed
+	}
+	return reason
+}
+
+func nonNegative(n int) int {
+	if n < 0 {
+		return 0
+	}
+	return n
+}
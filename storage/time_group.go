@@ -0,0 +1,106 @@
+package storage
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/models"
+	"github.com/influxdata/platform/tsdb"
+	"github.com/influxdata/platform/tsdb/tsm1"
+	"go.uber.org/zap"
+)
+
+// bucketRefreshInterval is the minimum time between two refreshes of the
+// shard group durations held by a bucketTimeGrouper, and the interval at which
+// bucket schemas are refreshed.
+const bucketRefreshInterval = 30 * time.Second
+
+// bucketTimeGrouper partitions TSM data by bucket, dividing each bucket's data
+// into groups of the bucket's shard group duration.
+//
+// Durations are cached and refreshed from the BucketFinder at most once every
+// bucketRefreshInterval, either when a bucket is unknown or when the cache has
+// gone stale. Data for buckets that cannot be found is grouped using the
+// default duration for infinite retention.
+type bucketTimeGrouper struct {
+	finder BucketFinder
+	logger *zap.Logger
+
+	mu        sync.RWMutex
+	durations map[platform.ID]time.Duration
+	refreshed time.Time // time of the last refresh attempt
+}
+
+var _ tsm1.TimeGrouper = (*bucketTimeGrouper)(nil)
+
+func newBucketTimeGrouper(finder BucketFinder) *bucketTimeGrouper {
+	return &bucketTimeGrouper{
+		finder:    finder,
+		logger:    zap.NewNop(),
+		durations: make(map[platform.ID]time.Duration),
+	}
+}
+
+// TimeGroup returns the encoded org and bucket name owning key, along with the
+// bucket's shard group duration.
+func (g *bucketTimeGrouper) TimeGroup(key []byte) ([]byte, int64) {
+	seriesKey, _ := tsm1.SeriesAndFieldFromCompositeKey(key)
+	name := models.ParseName(seriesKey)
+	if len(name) != platform.IDLength {
+		return name, 0
+	}
+
+	var n [16]byte
+	copy(n[:], name)
+	_, bucketID := tsdb.DecodeName(n)
+	return name, int64(g.duration(bucketID))
+}
+
+// duration returns the shard group duration of the bucket with the given id.
+func (g *bucketTimeGrouper) duration(id platform.ID) time.Duration {
+	g.mu.RLock()
+	d, ok := g.durations[id]
+	refresh := time.Since(g.refreshed) >= bucketRefreshInterval
+	g.mu.RUnlock()
+
+	if refresh {
+		g.refresh()
+
+		g.mu.RLock()
+		d, ok = g.durations[id]
+		g.mu.RUnlock()
+	}
+
+	if !ok {
+		return platform.DefaultShardGroupDuration(platform.InfiniteRetention)
+	}
+	return d
+}
+
+// refresh reloads the shard group durations of all buckets.
+func (g *bucketTimeGrouper) refresh() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	// Another caller may have refreshed while waiting for the lock.
+	if time.Since(g.refreshed) < bucketRefreshInterval {
+		return
+	}
+	g.refreshed = time.Now()
+
+	ctx, cancel := context.WithTimeout(context.Background(), bucketAPITimeout)
+	defer cancel()
+	buckets, _, err := g.finder.FindBuckets(ctx, platform.BucketFilter{})
+	if err != nil {
+		g.logger.Info("Unable to refresh shard group durations", zap.Error(err))
+		return
+	}
+
+	durations := make(map[platform.ID]time.Duration, len(buckets))
+	for _, b := range buckets {
+		durations[b.ID] = b.ShardGroupDurationOrDefault()
+	}
+	g.durations = durations
+}
@@ -0,0 +1,42 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/models"
+	"github.com/influxdata/platform/tsdb"
+	"github.com/influxdata/platform/tsdb/tsm1"
+)
+
+func TestBucketTimeGrouper_TimeGroup(t *testing.T) {
+	org, bucket, other := platform.ID(0x3131313131313131), platform.ID(0x3232323232323232), platform.ID(0x3333333333333333)
+
+	finder := NewTestBucketFinder()
+	finder.FindBucketsFn = func(context.Context, platform.BucketFilter, ...platform.FindOptions) ([]*platform.Bucket, int, error) {
+		return []*platform.Bucket{{ID: bucket, ShardGroupDuration: 2 * time.Hour}}, 1, nil
+	}
+	g := newBucketTimeGrouper(finder)
+
+	key := func(bucketID platform.ID) []byte {
+		name := tsdb.EncodeName(org, bucketID)
+		tags := models.NewTags(map[string]string{tsdb.MeasurementTagKey: "cpu", tsdb.FieldKeyTagKey: "value"})
+		return tsm1.SeriesFieldKeyBytes(string(models.MakeKey(name[:], tags)), "value")
+	}
+
+	owner, d := g.TimeGroup(key(bucket))
+	if name := tsdb.EncodeName(org, bucket); !bytes.Equal(owner, name[:]) {
+		t.Fatalf("got owner %x, expected %x", owner, name)
+	}
+	if got, exp := time.Duration(d), 2*time.Hour; got != exp {
+		t.Fatalf("got duration %v, expected %v", got, exp)
+	}
+
+	// Unknown buckets use the default for infinite retention.
+	if _, d := g.TimeGroup(key(other)); time.Duration(d) != platform.DefaultShardGroupDuration(platform.InfiniteRetention) {
+		t.Fatalf("got duration %v for unknown bucket", time.Duration(d))
+	}
+}
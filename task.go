@@ -21,6 +21,24 @@ type Task struct {
 	Cron            string `json:"cron,omitempty"`
 	Offset          string `json:"offset,omitempty"`
 	LatestCompleted string `json:"latest_completed,omitempty"`
+	DependsOn       []ID   `json:"dependsOn,omitempty"`
+}
+
+// TaskDependencies is the dependency graph reachable from a single task.
+// DependsOn and Dependents hold the direct upstream and downstream tasks,
+// while Edges holds every edge reachable in either direction.
+type TaskDependencies struct {
+	TaskID     ID               `json:"taskID"`
+	DependsOn  []ID             `json:"dependsOn"`
+	Dependents []ID             `json:"dependents"`
+	Edges      []TaskDependency `json:"edges"`
+}
+
+// TaskDependency is an edge in the task dependency graph.
+// Downstream is triggered once Upstream completes a run successfully.
+type TaskDependency struct {
+	Upstream   ID `json:"upstream"`
+	Downstream ID `json:"downstream"`
 }
 
 // Run is a record created when a run of a task is scheduled.
@@ -70,6 +88,9 @@ type TaskService interface {
 
 	// RetryRun creates and returns a new run (which is a retry of another run).
 	RetryRun(ctx context.Context, taskID, runID ID) (*Run, error)
+
+	// FindTaskDependencies returns the dependency graph reachable from a task.
+	FindTaskDependencies(ctx context.Context, id ID) (*TaskDependencies, error)
 }
 
 // TaskUpdate represents updates to a task
@@ -11,6 +11,9 @@
 //    bucket(/tasks/v1/run_ids) -> Counter for run IDs
 //    bucket(/tasks/v1/orgs).bucket(:org_id) key(:task_id) -> Empty content; presence of :task_id allows for lookup from org to tasks.
 //    bucket(/tasks/v1/users).bucket(:user_id) key(:task_id) -> Empty content; presence of :task_id allows for lookup from user to tasks.
+//    bucket(/tasks/v1/dependencies_by_task_id) key(:task_id) -> Concatenated encoded IDs of the tasks the task depends on.
+//    bucket(/tasks/v1/dependents).bucket(:task_id) key(:dependent_id) -> Empty content; presence of :dependent_id allows for lookup from task to dependent tasks.
+//    bucket(/tasks/v1/dependency_runs).bucket(:task_id) key(:now) -> Concatenated encoded IDs of the upstream tasks that succeeded for the big-endian Unix timestamp :now.
 // Note that task IDs are stored big-endian uint64s for sorting purposes,
 // but presented to the users with leading 0-bytes stripped.
 // Like other components of the system, IDs presented to users may be `0f12` rather than `f12`.
@@ -18,6 +21,7 @@ package bolt
 
 import (
 	"context"
+	"encoding/binary"
 	"errors"
 	"fmt"
 
@@ -60,6 +64,10 @@ var (
 	userByTaskID = []byte(basePath + "user_by_task_id")
 	nameByTaskID = []byte(basePath + "name_by_task_id")
 	runIDs       = []byte(basePath + "run_ids")
+
+	dependenciesByTaskID = []byte(basePath + "dependencies_by_task_id")
+	dependentsPath       = []byte(basePath + "dependents")
+	dependencyRunsPath   = []byte(basePath + "dependency_runs")
 )
 
 // New gives us a new Store based on "github.com/coreos/bbolt"
@@ -80,6 +88,7 @@ func New(db *bolt.DB, rootBucket string) (*Store, error) {
 			tasksPath, orgsPath, usersPath, taskMetaPath,
 			orgByTaskID, userByTaskID,
 			nameByTaskID, runIDs,
+			dependenciesByTaskID, dependentsPath, dependencyRunsPath,
 		} {
 			_, err := root.CreateBucketIfNotExists(b)
 			if err != nil {
@@ -168,6 +177,13 @@ func (s *Store) CreateTask(ctx context.Context, req backend.CreateTaskRequest) (
 			return err
 		}
 
+		if err := backend.StoreValidator.Dependencies(id, req.Org, o.DependsOn, lookupDependencies(b)); err != nil {
+			return err
+		}
+		if err := setDependencies(b, encodedID, o.DependsOn); err != nil {
+			return err
+		}
+
 		stm := backend.NewStoreTaskMeta(req, o)
 		stmBytes, err := stm.Marshal()
 		if err != nil {
@@ -232,6 +248,15 @@ func (s *Store) UpdateTask(ctx context.Context, req backend.UpdateTaskRequest) (
 			return err
 		}
 
+		if req.Script != "" {
+			if err := backend.StoreValidator.Dependencies(req.ID, orgID, op.DependsOn, lookupDependencies(b)); err != nil {
+				return err
+			}
+			if err := setDependencies(b, encodedID, op.DependsOn); err != nil {
+				return err
+			}
+		}
+
 		stmBytes := b.Bucket(taskMetaPath).Get(encodedID)
 		if stmBytes == nil {
 			return backend.ErrTaskNotFound
@@ -243,6 +268,11 @@ func (s *Store) UpdateTask(ctx context.Context, req backend.UpdateTaskRequest) (
 		res.OldStatus = backend.TaskStatus(stm.Status)
 		if req.Status != "" {
 			stm.Status = string(req.Status)
+		}
+		if req.Script != "" {
+			stm.SetRetryOptions(op)
+		}
+		if req.Status != "" || req.Script != "" {
 			stmBytes, err = stm.Marshal()
 			if err != nil {
 				return err
@@ -581,6 +611,9 @@ func (s *Store) DeleteTask(ctx context.Context, id platform.ID) (deleted bool, e
 		if err := b.Bucket(nameByTaskID).Delete(encodedID); err != nil {
 			return err
 		}
+		if err := setDependencies(b, encodedID, nil); err != nil {
+			return err
+		}
 
 		org := b.Bucket(orgByTaskID).Get(encodedID)
 		if len(org) > 0 {
@@ -667,6 +700,302 @@ func (s *Store) FinishRun(ctx context.Context, taskID, runID platform.ID) error
 	})
 }
 
+// RequeueRun increments the try count of runID so that the run can be attempted again.
+func (s *Store) RequeueRun(ctx context.Context, taskID, runID platform.ID) (uint32, error) {
+	encodedID, err := taskID.Encode()
+	if err != nil {
+		return 0, err
+	}
+
+	var try uint32
+	if err := s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(s.bucket)
+		stmBytes := b.Bucket(taskMetaPath).Get(encodedID)
+		if stmBytes == nil {
+			return backend.ErrTaskNotFound
+		}
+
+		var stm backend.StoreTaskMeta
+		if err := stm.Unmarshal(stmBytes); err != nil {
+			return err
+		}
+		if try = stm.RequeueRun(runID); try == 0 {
+			return ErrRunNotFound
+		}
+
+		stmBytes, err := stm.Marshal()
+		if err != nil {
+			return err
+		}
+
+		return tx.Bucket(s.bucket).Bucket(taskMetaPath).Put(encodedID, stmBytes)
+	}); err != nil {
+		return 0, err
+	}
+
+	return try, nil
+}
+
+// ListDependents returns the IDs of the tasks that depend directly on the task with the given ID.
+func (s *Store) ListDependents(ctx context.Context, taskID platform.ID) ([]platform.ID, error) {
+	encodedID, err := taskID.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []platform.ID
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		db := tx.Bucket(s.bucket).Bucket(dependentsPath).Bucket(encodedID)
+		if db == nil {
+			return nil
+		}
+		return db.ForEach(func(k, _ []byte) error {
+			var id platform.ID
+			if err := id.Decode(k); err != nil {
+				return err
+			}
+			ids = append(ids, id)
+			return nil
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// TriggerDependents records that the run of taskID for now succeeded,
+// and queues a run for now on each dependent task whose upstream tasks have all succeeded for now.
+func (s *Store) TriggerDependents(ctx context.Context, taskID platform.ID, now int64) ([]platform.ID, error) {
+	encodedID, err := taskID.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	nowKey := make([]byte, 8)
+	binary.BigEndian.PutUint64(nowKey, uint64(now))
+
+	var triggered []platform.ID
+	if err := s.db.Update(func(tx *bolt.Tx) error {
+		triggered = nil
+
+		b := tx.Bucket(s.bucket)
+		db := b.Bucket(dependentsPath).Bucket(encodedID)
+		if db == nil {
+			return nil
+		}
+
+		var dependents [][]byte
+		if err := db.ForEach(func(k, _ []byte) error {
+			dependents = append(dependents, append([]byte(nil), k...))
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		for _, dk := range dependents {
+			dependsOn, err := decodeIDs(b.Bucket(dependenciesByTaskID).Get(dk))
+			if err != nil {
+				return err
+			}
+
+			runsB, err := b.Bucket(dependencyRunsPath).CreateBucketIfNotExists(dk)
+			if err != nil {
+				return err
+			}
+			succeeded, err := decodeIDs(runsB.Get(nowKey))
+			if err != nil {
+				return err
+			}
+			if !containsID(succeeded, taskID) {
+				succeeded = append(succeeded, taskID)
+			}
+
+			if !containsAllIDs(succeeded, dependsOn) {
+				v, err := encodeIDs(succeeded)
+				if err != nil {
+					return err
+				}
+				if err := runsB.Put(nowKey, v); err != nil {
+					return err
+				}
+				if runsB.Stats().KeyN > backend.MaxPendingDependencyRuns {
+					// Forget the oldest pending run.
+					if k, _ := runsB.Cursor().First(); k != nil {
+						if err := runsB.Delete(k); err != nil {
+							return err
+						}
+					}
+				}
+				continue
+			}
+			if err := runsB.Delete(nowKey); err != nil {
+				return err
+			}
+
+			stmBytes := b.Bucket(taskMetaPath).Get(dk)
+			if stmBytes == nil {
+				continue
+			}
+			var stm backend.StoreTaskMeta
+			if err := stm.Unmarshal(stmBytes); err != nil {
+				return err
+			}
+			makeID := func() (platform.ID, error) { return s.idGen.ID(), nil }
+			if err := stm.ManuallyRunTimeRange(now, now, 0, makeID); err != nil {
+				if _, ok := err.(backend.RetryAlreadyQueuedError); ok {
+					continue
+				}
+				return err
+			}
+			stmBytes, err = stm.Marshal()
+			if err != nil {
+				return err
+			}
+			if err := b.Bucket(taskMetaPath).Put(dk, stmBytes); err != nil {
+				return err
+			}
+
+			var id platform.ID
+			if err := id.Decode(dk); err != nil {
+				return err
+			}
+			triggered = append(triggered, id)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return triggered, nil
+}
+
+// lookupDependencies returns a function looking up the organization and dependencies of a task,
+// for use with backend.StoreValidator.Dependencies.
+func lookupDependencies(b *bolt.Bucket) func(platform.ID) (platform.ID, []platform.ID, error) {
+	return func(id platform.ID) (platform.ID, []platform.ID, error) {
+		encodedID, err := id.Encode()
+		if err != nil {
+			return platform.InvalidID(), nil, err
+		}
+
+		encodedOrg := b.Bucket(orgByTaskID).Get(encodedID)
+		if encodedOrg == nil {
+			return platform.InvalidID(), nil, backend.ErrTaskNotFound
+		}
+		var orgID platform.ID
+		if err := orgID.Decode(encodedOrg); err != nil {
+			return platform.InvalidID(), nil, err
+		}
+
+		dependsOn, err := decodeIDs(b.Bucket(dependenciesByTaskID).Get(encodedID))
+		return orgID, dependsOn, err
+	}
+}
+
+// setDependencies replaces the dependencies of the task with the given encoded ID,
+// and discards the task's pending dependency runs.
+func setDependencies(b *bolt.Bucket, encodedID []byte, dependsOn []platform.ID) error {
+	old, err := decodeIDs(b.Bucket(dependenciesByTaskID).Get(encodedID))
+	if err != nil {
+		return err
+	}
+	for _, dep := range old {
+		encodedDep, err := dep.Encode()
+		if err != nil {
+			return err
+		}
+		if db := b.Bucket(dependentsPath).Bucket(encodedDep); db != nil {
+			if err := db.Delete(encodedID); err != nil {
+				return err
+			}
+		}
+	}
+	if err := b.Bucket(dependenciesByTaskID).Delete(encodedID); err != nil {
+		return err
+	}
+	if b.Bucket(dependencyRunsPath).Bucket(encodedID) != nil {
+		if err := b.Bucket(dependencyRunsPath).DeleteBucket(encodedID); err != nil {
+			return err
+		}
+	}
+
+	if len(dependsOn) == 0 {
+		return nil
+	}
+
+	v, err := encodeIDs(dependsOn)
+	if err != nil {
+		return err
+	}
+	if err := b.Bucket(dependenciesByTaskID).Put(encodedID, v); err != nil {
+		return err
+	}
+	for _, dep := range dependsOn {
+		encodedDep, err := dep.Encode()
+		if err != nil {
+			return err
+		}
+		db, err := b.Bucket(dependentsPath).CreateBucketIfNotExists(encodedDep)
+		if err != nil {
+			return err
+		}
+		if err := db.Put(encodedID, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// encodeIDs concatenates the encoded form of ids.
+func encodeIDs(ids []platform.ID) ([]byte, error) {
+	v := make([]byte, 0, len(ids)*platform.IDLength)
+	for _, id := range ids {
+		encoded, err := id.Encode()
+		if err != nil {
+			return nil, err
+		}
+		v = append(v, encoded...)
+	}
+	return v, nil
+}
+
+// decodeIDs decodes IDs concatenated by encodeIDs.
+func decodeIDs(v []byte) ([]platform.ID, error) {
+	if len(v)%platform.IDLength != 0 {
+		return nil, platform.ErrInvalidIDLength
+	}
+
+	ids := make([]platform.ID, 0, len(v)/platform.IDLength)
+	for i := 0; i < len(v); i += platform.IDLength {
+		var id platform.ID
+		if err := id.Decode(v[i : i+platform.IDLength]); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func containsID(ids []platform.ID, id platform.ID) bool {
+	for _, i := range ids {
+		if i == id {
+			return true
+		}
+	}
+	return false
+}
+
+// containsAllIDs returns true if every ID in want is in ids.
+func containsAllIDs(ids, want []platform.ID) bool {
+	for _, id := range want {
+		if !containsID(ids, id) {
+			return false
+		}
+	}
+	return true
+}
+
 func (s *Store) ManuallyRunTimeRange(_ context.Context, taskID platform.ID, start, end, requestedAt int64) (*backend.StoreTaskMetaManualRun, error) {
 	encodedID, err := taskID.Encode()
 	if err != nil {
@@ -744,6 +1073,9 @@ func (s *Store) DeleteUser(ctx context.Context, id platform.ID) error {
 			if err := b.Bucket(nameByTaskID).Delete(k); err != nil {
 				return err
 			}
+			if err := setDependencies(b, k, nil); err != nil {
+				return err
+			}
 
 			org := b.Bucket(orgByTaskID).Get(k)
 			if len(org) > 0 {
@@ -808,6 +1140,9 @@ func (s *Store) DeleteOrg(ctx context.Context, id platform.ID) error {
 			if err := b.Bucket(nameByTaskID).Delete(k); err != nil {
 				return err
 			}
+			if err := setDependencies(b, k, nil); err != nil {
+				return err
+			}
 			user := b.Bucket(userByTaskID).Get(k)
 			if len(user) > 0 {
 				ub := b.Bucket(usersPath).Bucket(user)
@@ -9,18 +9,52 @@ import (
 
 	"github.com/influxdata/flux"
 	"github.com/influxdata/flux/lang"
+	"github.com/influxdata/platform"
 	"github.com/influxdata/platform/logger"
 	"github.com/influxdata/platform/query"
+	"github.com/influxdata/platform/query/functions"
 	"github.com/influxdata/platform/task/backend"
 	"go.uber.org/zap"
 )
 
+// Option configures an executor.
+type Option func(*options)
+
+type options struct {
+	secrets platform.SecretService
+}
+
+// WithSecretService resolves the secrets requested by task scripts with the
+// secrets of the task's organization. Without it, scripts requesting secrets fail.
+func WithSecretService(s platform.SecretService) Option {
+	return func(o *options) {
+		o.secrets = s
+	}
+}
+
+func newOptions(opts []Option) options {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// compile compiles the script of a task at now.
+func (o options) compile(ctx context.Context, t *backend.StoreTask, now time.Time) (*flux.Spec, error) {
+	if o.secrets == nil {
+		return flux.Compile(ctx, t.Script, now)
+	}
+	return functions.Compile(ctx, t.Script, now, functions.OrganizationSecrets(ctx, o.secrets, t.Org))
+}
+
 // queryServiceExecutor is an implementation of backend.Executor that depends on a QueryService.
 type queryServiceExecutor struct {
 	svc    query.QueryService
 	st     backend.Store
 	logger *zap.Logger
 	wg     sync.WaitGroup
+	opts   options
 }
 
 var _ backend.Executor = (*queryServiceExecutor)(nil)
@@ -28,8 +62,8 @@ var _ backend.Executor = (*queryServiceExecutor)(nil)
 // NewQueryServiceExecutor returns a new executor based on the given QueryService.
 // In general, you should prefer NewAsyncQueryServiceExecutor, as that code is smaller and simpler,
 // because asynchronous queries are more in line with the Executor interface.
-func NewQueryServiceExecutor(logger *zap.Logger, svc query.QueryService, st backend.Store) backend.Executor {
-	return &queryServiceExecutor{logger: logger, svc: svc, st: st}
+func NewQueryServiceExecutor(logger *zap.Logger, svc query.QueryService, st backend.Store, opts ...Option) backend.Executor {
+	return &queryServiceExecutor{logger: logger, svc: svc, st: st, opts: newOptions(opts)}
 }
 
 func (e *queryServiceExecutor) Execute(ctx context.Context, run backend.QueuedRun) (backend.RunPromise, error) {
@@ -49,6 +83,7 @@ func (e *queryServiceExecutor) Wait() {
 type syncRunPromise struct {
 	qr     backend.QueuedRun
 	svc    query.QueryService
+	opts   options
 	t      *backend.StoreTask
 	ctx    context.Context
 	cancel context.CancelFunc
@@ -70,6 +105,7 @@ func newSyncRunPromise(ctx context.Context, qr backend.QueuedRun, e *queryServic
 	rp := &syncRunPromise{
 		qr:     qr,
 		svc:    e.svc,
+		opts:   e.opts,
 		t:      t,
 		logger: log,
 		logEnd: logEnd,
@@ -128,9 +164,10 @@ func (p *syncRunPromise) finish(res *runResult, err error) {
 func (p *syncRunPromise) doQuery(wg *sync.WaitGroup) {
 	defer wg.Done()
 
-	spec, err := flux.Compile(p.ctx, p.t.Script, time.Unix(p.qr.Now, 0))
+	spec, err := p.opts.compile(p.ctx, p.t, time.Unix(p.qr.Now, 0))
 	if err != nil {
-		p.finish(nil, err)
+		// A script that doesn't compile won't compile on a retry either.
+		p.finish(nil, backend.PermanentError{Err: err})
 		return
 	}
 
@@ -143,7 +180,7 @@ func (p *syncRunPromise) doQuery(wg *sync.WaitGroup) {
 	it, err := p.svc.Query(p.ctx, req)
 	if err != nil {
 		// Assume the error should not be part of the runResult.
-		p.finish(nil, err)
+		p.finish(nil, queryError(err))
 		return
 	}
 	defer it.Release()
@@ -158,7 +195,30 @@ func (p *syncRunPromise) doQuery(wg *sync.WaitGroup) {
 	}
 
 	// Is it okay to assume it.Err will be set if the query context is canceled?
-	p.finish(&runResult{err: it.Err()}, nil)
+	// Errors while executing a compiled query are assumed to be transient,
+	// unless they report an invalid query.
+	err = it.Err()
+	p.finish(&runResult{err: err, retryable: err != nil && !isPermanent(err)}, nil)
+}
+
+// isPermanent reports whether err is a query error that retrying a run cannot
+// resolve, such as a query that the query service rejects as invalid.
+func isPermanent(err error) bool {
+	switch platform.ErrorCode(err) {
+	case platform.EInvalid, platform.EEmptyValue, platform.ENotFound, platform.EForbidden:
+		return true
+	default:
+		return false
+	}
+}
+
+// queryError wraps the error of a query that cannot be started in a
+// backend.PermanentError if retrying the run cannot resolve it.
+func queryError(err error) error {
+	if isPermanent(err) {
+		return backend.PermanentError{Err: err}
+	}
+	return err
 }
 
 func (p *syncRunPromise) cancelOnContextDone(wg *sync.WaitGroup) {
@@ -181,13 +241,14 @@ type asyncQueryServiceExecutor struct {
 	st     backend.Store
 	logger *zap.Logger
 	wg     sync.WaitGroup
+	opts   options
 }
 
 var _ backend.Executor = (*asyncQueryServiceExecutor)(nil)
 
 // NewQueryServiceExecutor returns a new executor based on the given AsyncQueryService.
-func NewAsyncQueryServiceExecutor(logger *zap.Logger, svc query.AsyncQueryService, st backend.Store) backend.Executor {
-	return &asyncQueryServiceExecutor{logger: logger, svc: svc, st: st}
+func NewAsyncQueryServiceExecutor(logger *zap.Logger, svc query.AsyncQueryService, st backend.Store, opts ...Option) backend.Executor {
+	return &asyncQueryServiceExecutor{logger: logger, svc: svc, st: st, opts: newOptions(opts)}
 }
 
 func (e *asyncQueryServiceExecutor) Execute(ctx context.Context, run backend.QueuedRun) (backend.RunPromise, error) {
@@ -196,9 +257,10 @@ func (e *asyncQueryServiceExecutor) Execute(ctx context.Context, run backend.Que
 		return nil, err
 	}
 
-	spec, err := flux.Compile(ctx, t.Script, time.Unix(run.Now, 0))
+	spec, err := e.opts.compile(ctx, t, time.Unix(run.Now, 0))
 	if err != nil {
-		return nil, err
+		// A script that doesn't compile won't compile on a retry either.
+		return nil, backend.PermanentError{Err: err}
 	}
 
 	req := &query.Request{
@@ -209,7 +271,7 @@ func (e *asyncQueryServiceExecutor) Execute(ctx context.Context, run backend.Que
 	}
 	q, err := e.svc.Query(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, queryError(err)
 	}
 
 	return newAsyncRunPromise(run, q, e), nil
@@ -287,7 +349,10 @@ func (p *asyncRunPromise) followQuery(wg *sync.WaitGroup) {
 	case results, ok := <-p.q.Ready():
 		if !ok {
 			// Something went wrong with the flux. Set the error in the run result.
-			rr := &runResult{err: p.q.Err()}
+			// Errors while executing a compiled query are assumed to be transient,
+			// unless they report an invalid query.
+			err := p.q.Err()
+			rr := &runResult{err: err, retryable: !isPermanent(err)}
 			p.finish(rr, nil)
 			return
 		}
@@ -20,6 +20,7 @@ import (
 	_ "github.com/influxdata/platform/query/builtin"
 	"github.com/influxdata/platform/task/backend"
 	"github.com/influxdata/platform/task/backend/executor"
+	"github.com/influxdata/platform/task/mock"
 	platformtesting "github.com/influxdata/platform/testing"
 	"go.uber.org/zap"
 )
@@ -28,6 +29,8 @@ type fakeQueryService struct {
 	mu       sync.Mutex
 	queries  map[string]*fakeQuery
 	queryErr error
+	// calls counts the calls to Query.
+	calls int
 }
 
 var _ query.AsyncQueryService = (*fakeQueryService)(nil)
@@ -55,6 +58,7 @@ func newFakeQueryService() *fakeQueryService {
 func (s *fakeQueryService) Query(ctx context.Context, req *query.Request) (flux.Query, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
+	s.calls++
 	if s.queryErr != nil {
 		err := s.queryErr
 		s.queryErr = nil
@@ -262,6 +266,7 @@ func TestExecutor(t *testing.T) {
 	for _, fn := range []createSysFn{createAsyncSystem, createSyncSystem} {
 		testExecutorQuerySuccess(t, fn)
 		testExecutorQueryFailure(t, fn)
+		testExecutorQueryInvalid(t, fn)
 		testExecutorPromiseCancel(t, fn)
 		testExecutorServiceError(t, fn)
 		testExecutorWait(t, fn)
@@ -358,9 +363,74 @@ func testExecutorQueryFailure(t *testing.T, fn createSysFn) {
 		if got := res.Err(); got != expErr {
 			t.Fatalf("expected error %v; got %v", expErr, got)
 		}
+		if !res.IsRetryable() {
+			t.Fatal("expected query failure to be retryable")
+		}
+	})
+}
+
+func testExecutorQueryInvalid(t *testing.T, fn createSysFn) {
+	var orgID = platformtesting.MustIDBase16("aaaaaaaaaaaaaaaa")
+	var userID = platformtesting.MustIDBase16("baaaaaaaaaaaaaab")
+	sys := fn()
+	t.Run(sys.name+"/QueryInvalid", func(t *testing.T) {
+		t.Parallel()
+		script := fmt.Sprintf(fmtTestScript, t.Name())
+		tid, err := sys.st.CreateTask(context.Background(), backend.CreateTaskRequest{Org: orgID, User: userID, Script: script})
+		if err != nil {
+			t.Fatal(err)
+		}
+		qr := backend.QueuedRun{TaskID: tid, RunID: platform.ID(1), Now: 123}
+		rp, err := sys.ex.Execute(context.Background(), qr)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		sys.svc.WaitForQueryLive(t, script)
+		sys.svc.FailQuery(script, &platform.Error{Code: platform.EInvalid, Msg: "bucket has no schema"})
+		res, err := rp.Wait()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.Err() == nil {
+			t.Fatal("expected query failure")
+		}
+		if res.IsRetryable() {
+			t.Fatal("expected invalid query failure not to be retryable")
+		}
 	})
 }
 
+// invalidScriptStore is a backend.Store whose tasks all have a script that does not compile.
+type invalidScriptStore struct {
+	backend.Store
+}
+
+func (s invalidScriptStore) FindTaskByID(ctx context.Context, id platform.ID) (*backend.StoreTask, error) {
+	return &backend.StoreTask{ID: id, Script: `from(bucket: "one") |> undefinedFunction()`}, nil
+}
+
+func TestExecutor_CompileError(t *testing.T) {
+	svc := newFakeQueryService()
+	st := invalidScriptStore{Store: backend.NewInMemStore()}
+	for _, sys := range []*system{
+		{name: "AsyncExecutor", ex: executor.NewAsyncQueryServiceExecutor(zap.NewNop(), svc, st)},
+		{name: "SynchronousExecutor", ex: executor.NewQueryServiceExecutor(zap.NewNop(), query.QueryServiceBridge{AsyncQueryService: svc}, st)},
+	} {
+		t.Run(sys.name, func(t *testing.T) {
+			qr := backend.QueuedRun{TaskID: platform.ID(1), RunID: platform.ID(1), Now: 123}
+			rp, err := sys.ex.Execute(context.Background(), qr)
+			if err == nil {
+				// Synchronous query service compiles after Execute returns.
+				_, err = rp.Wait()
+			}
+			if !backend.IsPermanentError(err) {
+				t.Fatalf("expected permanent error, got %v", err)
+			}
+		})
+	}
+}
+
 func testExecutorPromiseCancel(t *testing.T, fn createSysFn) {
 	var orgID = platformtesting.MustIDBase16("aaaaaaaaaaaaaaaa")
 	var userID = platformtesting.MustIDBase16("baaaaaaaaaaaaaab")
@@ -619,3 +689,69 @@ func testExecutorWait(t *testing.T, createSys createSysFn) {
 		})
 	})
 }
+
+func TestScheduler_InvalidQuery(t *testing.T) {
+	var orgID = platformtesting.MustIDBase16("aaaaaaaaaaaaaaaa")
+	var userID = platformtesting.MustIDBase16("baaaaaaaaaaaaaab")
+	for _, fn := range []createSysFn{createAsyncSystem, createSyncSystem} {
+		sys := fn()
+		t.Run(sys.name, func(t *testing.T) {
+			script := fmt.Sprintf(fmtTestScript, t.Name())
+			tid, err := sys.st.CreateTask(context.Background(), backend.CreateTaskRequest{Org: orgID, User: userID, Script: script})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			d := mock.NewDesiredState()
+			rl := backend.NewInMemRunReaderWriter()
+			s := backend.NewScheduler(d, sys.ex, rl, 0, backend.WithLogger(zap.NewNop()))
+			s.Start(context.Background())
+			defer s.Stop()
+
+			meta := &backend.StoreTaskMeta{
+				MaxConcurrency: 1,
+				EffectiveCron:  "@every 1m",
+				MaxTries:       3,
+				RetryDelay:     1,
+			}
+			d.SetTaskMeta(tid, *meta)
+			if err := s.ClaimTask(&backend.StoreTask{ID: tid, Org: orgID}, meta); err != nil {
+				t.Fatal(err)
+			}
+
+			// The query service rejects the query of the run as invalid.
+			sys.svc.FailNextQuery(&platform.Error{Code: platform.EInvalid, Msg: "bucket has no schema"})
+			s.Tick(60)
+			pollForRunStatus(t, rl, tid, backend.RunFail)
+
+			// An invalid query is not retried, however many tries the task allows.
+			s.Tick(70)
+			time.Sleep(50 * time.Millisecond)
+			sys.svc.mu.Lock()
+			calls := sys.svc.calls
+			sys.svc.mu.Unlock()
+			if calls != 1 {
+				t.Fatalf("expected invalid query to run exactly once, ran %d times", calls)
+			}
+		})
+	}
+}
+
+// pollForRunStatus waits for the only run of the task to have the status.
+func pollForRunStatus(t *testing.T, rl backend.LogReader, taskID platform.ID, status backend.RunStatus) {
+	t.Helper()
+
+	var runs []*platform.Run
+	for i := 0; i < 100; i++ {
+		var err error
+		runs, err = rl.ListRuns(context.Background(), platform.RunFilter{Task: &taskID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(runs) == 1 && runs[0].Status == status.String() {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("expected one run with status %s, got %v", status, runs)
+}
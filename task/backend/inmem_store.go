@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"sync"
 
 	"github.com/influxdata/platform"
@@ -24,6 +25,13 @@ type inmem struct {
 	tasks []StoreTask
 
 	meta map[platform.ID]StoreTaskMeta
+
+	// Task ID -> IDs of the tasks it depends on, and the reverse.
+	dependsOn  map[platform.ID][]platform.ID
+	dependents map[platform.ID]map[platform.ID]struct{}
+
+	// Dependent task ID -> now of a pending run -> IDs of the upstream tasks that succeeded for that now.
+	dependencyRuns map[platform.ID]map[int64][]platform.ID
 }
 
 // NewInMemStore returns a new in-memory store.
@@ -32,6 +40,10 @@ func NewInMemStore() Store {
 	return &inmem{
 		idgen: snowflake.NewIDGenerator(),
 		meta:  map[platform.ID]StoreTaskMeta{},
+
+		dependsOn:      map[platform.ID][]platform.ID{},
+		dependents:     map[platform.ID]map[platform.ID]struct{}{},
+		dependencyRuns: map[platform.ID]map[int64][]platform.ID{},
 	}
 }
 
@@ -57,8 +69,13 @@ func (s *inmem) CreateTask(_ context.Context, req CreateTaskRequest) (platform.I
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
+	if err := StoreValidator.Dependencies(id, req.Org, o.DependsOn, s.lookupDependencies); err != nil {
+		return platform.InvalidID(), err
+	}
+
 	s.tasks = append(s.tasks, task)
 	s.meta[id] = NewStoreTaskMeta(req, o)
+	s.setDependencies(id, o.DependsOn)
 
 	return id, nil
 }
@@ -89,7 +106,11 @@ func (s *inmem) UpdateTask(_ context.Context, req UpdateTaskRequest) (UpdateTask
 				return res, err
 			}
 		} else {
+			if err := StoreValidator.Dependencies(t.ID, t.Org, op.DependsOn, s.lookupDependencies); err != nil {
+				return res, err
+			}
 			t.Script = req.Script
+			s.setDependencies(t.ID, op.DependsOn)
 		}
 		t.Name = op.Name
 
@@ -110,8 +131,12 @@ func (s *inmem) UpdateTask(_ context.Context, req UpdateTaskRequest) (UpdateTask
 	if req.Status != "" {
 		// Changing the status.
 		stm.Status = string(req.Status)
-		s.meta[req.ID] = stm
 	}
+	if req.Script != "" {
+		// The script may have changed its retry options.
+		stm.SetRetryOptions(op)
+	}
+	s.meta[req.ID] = stm
 	res.NewMeta = stm
 
 	return res, nil
@@ -246,9 +271,124 @@ func (s *inmem) DeleteTask(_ context.Context, id platform.ID) (deleted bool, err
 	// Delete entry from slice.
 	s.tasks = append(s.tasks[:idx], s.tasks[idx+1:]...)
 	delete(s.meta, id)
+	s.setDependencies(id, nil)
 	return true, nil
 }
 
+// lookupDependencies returns the organization and dependencies of the task with the given ID.
+// s.mu must be held when calling lookupDependencies.
+func (s *inmem) lookupDependencies(id platform.ID) (platform.ID, []platform.ID, error) {
+	for _, t := range s.tasks {
+		if t.ID == id {
+			return t.Org, s.dependsOn[id], nil
+		}
+	}
+	return platform.InvalidID(), nil, ErrTaskNotFound
+}
+
+// setDependencies replaces the dependencies of the task with the given ID.
+// s.mu must be held for writing when calling setDependencies.
+func (s *inmem) setDependencies(id platform.ID, dependsOn []platform.ID) {
+	for _, dep := range s.dependsOn[id] {
+		delete(s.dependents[dep], id)
+		if len(s.dependents[dep]) == 0 {
+			delete(s.dependents, dep)
+		}
+	}
+	delete(s.dependsOn, id)
+	delete(s.dependencyRuns, id)
+
+	if len(dependsOn) == 0 {
+		return
+	}
+	s.dependsOn[id] = dependsOn
+	for _, dep := range dependsOn {
+		if s.dependents[dep] == nil {
+			s.dependents[dep] = make(map[platform.ID]struct{})
+		}
+		s.dependents[dep][id] = struct{}{}
+	}
+}
+
+func (s *inmem) ListDependents(_ context.Context, taskID platform.ID) ([]platform.ID, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ids := make([]platform.ID, 0, len(s.dependents[taskID]))
+	for id := range s.dependents[taskID] {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids, nil
+}
+
+func (s *inmem) TriggerDependents(_ context.Context, taskID platform.ID, now int64) ([]platform.ID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var triggered []platform.ID
+	for id := range s.dependents[taskID] {
+		runs := s.dependencyRuns[id]
+		if runs == nil {
+			runs = make(map[int64][]platform.ID)
+			s.dependencyRuns[id] = runs
+		}
+
+		succeeded := runs[now]
+		if !containsID(succeeded, taskID) {
+			succeeded = append(succeeded, taskID)
+		}
+		if !containsAllIDs(succeeded, s.dependsOn[id]) {
+			runs[now] = succeeded
+			if len(runs) > MaxPendingDependencyRuns {
+				oldest := now
+				for n := range runs {
+					if n < oldest {
+						oldest = n
+					}
+				}
+				delete(runs, oldest)
+			}
+			continue
+		}
+		delete(runs, now)
+
+		stm, ok := s.meta[id]
+		if !ok {
+			continue
+		}
+		if err := stm.ManuallyRunTimeRange(now, now, 0, func() (platform.ID, error) { return s.idgen.ID(), nil }); err != nil {
+			if _, ok := err.(RetryAlreadyQueuedError); ok {
+				continue
+			}
+			return triggered, err
+		}
+		s.meta[id] = stm
+		triggered = append(triggered, id)
+	}
+
+	return triggered, nil
+}
+
+func containsID(ids []platform.ID, id platform.ID) bool {
+	for _, i := range ids {
+		if i == id {
+			return true
+		}
+	}
+	return false
+}
+
+// containsAllIDs returns true if every ID in want is in ids.
+func containsAllIDs(ids, want []platform.ID) bool {
+	for _, id := range want {
+		if !containsID(ids, id) {
+			return false
+		}
+	}
+	return true
+}
+
 func (s *inmem) Close() error {
 	return nil
 }
@@ -296,6 +436,25 @@ func (s *inmem) FinishRun(ctx context.Context, taskID, runID platform.ID) error
 	return nil
 }
 
+// RequeueRun increments the try count of runID so that the run can be attempted again.
+func (s *inmem) RequeueRun(ctx context.Context, taskID, runID platform.ID) (uint32, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stm, ok := s.meta[taskID]
+	if !ok {
+		return 0, errors.New("task not found")
+	}
+
+	try := stm.RequeueRun(runID)
+	if try == 0 {
+		return 0, errors.New("run not found")
+	}
+
+	s.meta[taskID] = stm
+	return try, nil
+}
+
 func (s *inmem) ManuallyRunTimeRange(_ context.Context, taskID platform.ID, start, end, requestedAt int64) (*StoreTaskMetaManualRun, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -342,6 +501,7 @@ func (s *inmem) delete(ctx context.Context, id platform.ID, f func(StoreTask) pl
 	}
 	for i := range deletingTasks {
 		delete(s.meta, s.tasks[i].ID)
+		s.setDependencies(deletingTasks[i], nil)
 	}
 	s.tasks = newTasks
 	return nil
@@ -12,6 +12,16 @@ import (
 
 // This file contains helper methods for the StoreTaskMeta type defined in protobuf.
 
+const (
+	// DefaultRetryDelay is the delay before the first retry of a failed run,
+	// for tasks that do not set the retryDelay option.
+	DefaultRetryDelay = 10 * time.Second
+
+	// DefaultMaxRetryDelay is the upper limit of the delay between tries of a run,
+	// for tasks that do not set the maxRetryDelay option.
+	DefaultMaxRetryDelay = 10 * time.Minute
+)
+
 // NewStoreTaskMeta returns a new StoreTaskMeta based on the given request and parsed options.
 func NewStoreTaskMeta(req CreateTaskRequest, o options.Options) StoreTaskMeta {
 	stm := StoreTaskMeta{
@@ -21,6 +31,7 @@ func NewStoreTaskMeta(req CreateTaskRequest, o options.Options) StoreTaskMeta {
 		EffectiveCron:   o.EffectiveCronString(),
 		Offset:          int32(o.Offset / time.Second),
 	}
+	stm.SetRetryOptions(o)
 
 	if stm.Status == "" {
 		stm.Status = string(DefaultTaskStatus)
@@ -40,6 +51,13 @@ func NewStoreTaskMeta(req CreateTaskRequest, o options.Options) StoreTaskMeta {
 	return stm
 }
 
+// SetRetryOptions copies the retry, retryDelay and maxRetryDelay options of o into stm.
+func (stm *StoreTaskMeta) SetRetryOptions(o options.Options) {
+	stm.MaxTries = int32(o.Retry)
+	stm.RetryDelay = int32(o.RetryDelay / time.Second)
+	stm.MaxRetryDelay = int32(o.MaxRetryDelay / time.Second)
+}
+
 // FinishRun removes the run matching runID from m's CurrentlyRunning slice,
 // and if that run's Now value is greater than m's LatestCompleted value,
 // updates the value of LatestCompleted to the run's Now value.
@@ -77,10 +95,56 @@ func (stm *StoreTaskMeta) FinishRun(runID platform.ID) bool {
 	return false
 }
 
+// RequeueRun increments the try count of the run matching runID in m's CurrentlyRunning slice,
+// so that the run can be attempted again.
+//
+// If runID matched a run, RequeueRun returns the run's new try count. Otherwise it returns 0.
+func (stm *StoreTaskMeta) RequeueRun(runID platform.ID) uint32 {
+	for _, runner := range stm.CurrentlyRunning {
+		if platform.ID(runner.RunID) == runID {
+			runner.Try++
+			return runner.Try
+		}
+	}
+	return 0
+}
+
+// CanRetry reports whether a run that failed on the given try may be attempted again.
+// A task that does not set its retry option is attempted exactly once.
+func (stm *StoreTaskMeta) CanRetry(try uint32) bool {
+	return int64(try) < int64(stm.MaxTries)
+}
+
+// RetryBackoff returns how long to wait before starting the given try of a run.
+// The delay starts at stm's RetryDelay, or DefaultRetryDelay if unset,
+// doubles on every subsequent try, and is capped at stm's MaxRetryDelay, or DefaultMaxRetryDelay if unset.
+func (stm *StoreTaskMeta) RetryBackoff(try uint32) time.Duration {
+	delay := DefaultRetryDelay
+	if stm.RetryDelay > 0 {
+		delay = time.Duration(stm.RetryDelay) * time.Second
+	}
+	max := DefaultMaxRetryDelay
+	if stm.MaxRetryDelay > 0 {
+		max = time.Duration(stm.MaxRetryDelay) * time.Second
+	}
+	if max < delay {
+		max = delay
+	}
+
+	for i := uint32(2); i < try && delay < max; i++ {
+		delay *= 2
+	}
+	if delay > max {
+		delay = max
+	}
+	return delay
+}
+
 // CreateNextRun attempts to update stm's CurrentlyRunning slice with a new run.
 // The new run's now is assigned the earliest possible time according to stm.EffectiveCron,
 // that is later than any in-progress run and stm's LatestCompleted timestamp.
 // If the run's now would be later than the passed-in now, CreateNextRun returns a RunNotYetDueError.
+// If stm has no EffectiveCron, runs are only created from stm's ManualRuns.
 //
 // makeID is a function provided by the caller to create an ID, in case we can create a run.
 // Because a StoreTaskMeta doesn't know the ID of the task it belongs to, it never sets RunCreation.Created.TaskID.
@@ -89,6 +153,14 @@ func (stm *StoreTaskMeta) CreateNextRun(now int64, makeID func() (platform.ID, e
 		return RunCreation{}, errors.New("cannot create next run when max concurrency already reached")
 	}
 
+	if stm.EffectiveCron == "" {
+		// Tasks without a schedule, such as tasks with dependencies, only run what has been queued for them.
+		if len(stm.ManualRuns) > 0 {
+			return stm.createNextRunFromQueue(now, math.MaxInt64, nil, makeID)
+		}
+		return RunCreation{}, RunNotYetDueError{DueAt: math.MaxInt64}
+	}
+
 	// Not calling stm.DueAt here because we reuse sch.
 	// We can definitely optimize (minimize) cron parsing at a later point in time.
 	sch, err := cron.Parse(stm.EffectiveCron)
@@ -136,6 +208,7 @@ func (stm *StoreTaskMeta) CreateNextRun(now int64, makeID func() (platform.ID, e
 
 // createNextRunFromQueue creates the next run from a queue.
 // This should only be called when the queue is not empty.
+// sch is nil for tasks without a schedule.
 func (stm *StoreTaskMeta) createNextRunFromQueue(now, nextDue int64, sch cron.Schedule, makeID func() (platform.ID, error)) (RunCreation, error) {
 	if len(stm.ManualRuns) == 0 {
 		return RunCreation{}, errors.New("cannot create run from empty queue")
@@ -153,7 +226,13 @@ func (stm *StoreTaskMeta) createNextRunFromQueue(now, nextDue int64, sch cron.Sc
 		}
 	}
 
-	runNow := sch.Next(time.Unix(latest, 0)).Unix()
+	var runNow int64
+	if sch == nil {
+		// Without a schedule, the whole queued range is covered by a single run at its end.
+		runNow = q.End
+	} else {
+		runNow = sch.Next(time.Unix(latest, 0)).Unix()
+	}
 
 	// Already validated that we have room to create another run, in CreateNextRun.
 	id := platform.ID(q.RunID)
@@ -194,7 +273,13 @@ func (stm *StoreTaskMeta) createNextRunFromQueue(now, nextDue int64, sch cron.Sc
 
 // NextDueRun returns the Unix timestamp of when the next call to CreateNextRun will be ready.
 // The returned timestamp reflects the task's delay, so it does not necessarily exactly match the schedule time.
+// For a task without a schedule, NextDueRun returns math.MaxInt64.
 func (stm *StoreTaskMeta) NextDueRun() (int64, error) {
+	if stm.EffectiveCron == "" {
+		// Runs of tasks without a schedule are never due, they are only queued.
+		return math.MaxInt64, nil
+	}
+
 	sch, err := cron.Parse(stm.EffectiveCron)
 	if err != nil {
 		return 0, err
@@ -262,6 +347,9 @@ func (stm StoreTaskMeta) Equal(other StoreTaskMeta) bool {
 		stm.Status != other.Status ||
 		stm.EffectiveCron != other.EffectiveCron ||
 		stm.Offset != other.Offset ||
+		stm.MaxTries != other.MaxTries ||
+		stm.RetryDelay != other.RetryDelay ||
+		stm.MaxRetryDelay != other.MaxRetryDelay ||
 		len(stm.CurrentlyRunning) != len(other.CurrentlyRunning) ||
 		len(stm.ManualRuns) != len(other.ManualRuns) {
 		return false
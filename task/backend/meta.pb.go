@@ -35,17 +35,25 @@ type StoreTaskMeta struct {
 	// effective_cron is the effective cron string as reported by the task's options.
 	EffectiveCron string `protobuf:"bytes,5,opt,name=effective_cron,json=effectiveCron,proto3" json:"effective_cron,omitempty"`
 	// Task's configured delay, in seconds.
-	Offset               int32                     `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
-	ManualRuns           []*StoreTaskMetaManualRun `protobuf:"bytes,16,rep,name=manual_runs,json=manualRuns" json:"manual_runs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
-	XXX_sizecache        int32                     `json:"-"`
+	Offset     int32                     `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	ManualRuns []*StoreTaskMetaManualRun `protobuf:"bytes,16,rep,name=manual_runs,json=manualRuns" json:"manual_runs,omitempty"`
+	// max_tries is the number of times a run is attempted before it is considered failed,
+	// as reported by the task's retry option.
+	MaxTries int32 `protobuf:"varint,17,opt,name=max_tries,json=maxTries,proto3" json:"max_tries,omitempty"`
+	// retry_delay is the delay, in seconds, before the first retry of a failed run.
+	RetryDelay int32 `protobuf:"varint,18,opt,name=retry_delay,json=retryDelay,proto3" json:"retry_delay,omitempty"`
+	// max_retry_delay is the upper bound, in seconds, of the delay before a retry.
+	// The delay doubles with each try until it reaches max_retry_delay.
+	MaxRetryDelay        int32    `protobuf:"varint,19,opt,name=max_retry_delay,json=maxRetryDelay,proto3" json:"max_retry_delay,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *StoreTaskMeta) Reset()         { *m = StoreTaskMeta{} }
 func (m *StoreTaskMeta) String() string { return proto.CompactTextString(m) }
 func (*StoreTaskMeta) ProtoMessage()    {}
 func (*StoreTaskMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_meta_d42b29c328506298, []int{0}
+	return fileDescriptor_meta_f9e47683247e7b8c, []int{0}
 }
 func (m *StoreTaskMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -123,6 +131,27 @@ func (m *StoreTaskMeta) GetManualRuns() []*StoreTaskMetaManualRun {
 	return nil
 }
 
+func (m *StoreTaskMeta) GetMaxTries() int32 {
+	if m != nil {
+		return m.MaxTries
+	}
+	return 0
+}
+
+func (m *StoreTaskMeta) GetRetryDelay() int32 {
+	if m != nil {
+		return m.RetryDelay
+	}
+	return 0
+}
+
+func (m *StoreTaskMeta) GetMaxRetryDelay() int32 {
+	if m != nil {
+		return m.MaxRetryDelay
+	}
+	return 0
+}
+
 type StoreTaskMetaRun struct {
 	// now is the unix timestamp of the "now" value for the run.
 	Now   int64  `protobuf:"varint,1,opt,name=now,proto3" json:"now,omitempty"`
@@ -143,7 +172,7 @@ func (m *StoreTaskMetaRun) Reset()         { *m = StoreTaskMetaRun{} }
 func (m *StoreTaskMetaRun) String() string { return proto.CompactTextString(m) }
 func (*StoreTaskMetaRun) ProtoMessage()    {}
 func (*StoreTaskMetaRun) Descriptor() ([]byte, []int) {
-	return fileDescriptor_meta_d42b29c328506298, []int{1}
+	return fileDescriptor_meta_f9e47683247e7b8c, []int{1}
 }
 func (m *StoreTaskMetaRun) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -235,7 +264,7 @@ func (m *StoreTaskMetaManualRun) Reset()         { *m = StoreTaskMetaManualRun{}
 func (m *StoreTaskMetaManualRun) String() string { return proto.CompactTextString(m) }
 func (*StoreTaskMetaManualRun) ProtoMessage()    {}
 func (*StoreTaskMetaManualRun) Descriptor() ([]byte, []int) {
-	return fileDescriptor_meta_d42b29c328506298, []int{2}
+	return fileDescriptor_meta_f9e47683247e7b8c, []int{2}
 }
 func (m *StoreTaskMetaManualRun) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -372,6 +401,27 @@ func (m *StoreTaskMeta) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.MaxTries != 0 {
+		dAtA[i] = 0x88
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.MaxTries))
+	}
+	if m.RetryDelay != 0 {
+		dAtA[i] = 0x90
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.RetryDelay))
+	}
+	if m.MaxRetryDelay != 0 {
+		dAtA[i] = 0x98
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.MaxRetryDelay))
+	}
 	return i, nil
 }
 
@@ -507,6 +557,15 @@ func (m *StoreTaskMeta) Size() (n int) {
 			n += 2 + l + sovMeta(uint64(l))
 		}
 	}
+	if m.MaxTries != 0 {
+		n += 2 + sovMeta(uint64(m.MaxTries))
+	}
+	if m.RetryDelay != 0 {
+		n += 2 + sovMeta(uint64(m.RetryDelay))
+	}
+	if m.MaxRetryDelay != 0 {
+		n += 2 + sovMeta(uint64(m.MaxRetryDelay))
+	}
 	return n
 }
 
@@ -774,6 +833,63 @@ func (m *StoreTaskMeta) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTries", wireType)
+			}
+			m.MaxTries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTries |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryDelay", wireType)
+			}
+			m.RetryDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryDelay |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetryDelay", wireType)
+			}
+			m.MaxRetryDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRetryDelay |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMeta(dAtA[iNdEx:])
@@ -1209,38 +1325,41 @@ var (
 	ErrIntOverflowMeta   = fmt.Errorf("proto: integer overflow")
 )
 
-func init() { proto.RegisterFile("meta.proto", fileDescriptor_meta_d42b29c328506298) }
+func init() { proto.RegisterFile("meta.proto", fileDescriptor_meta_f9e47683247e7b8c) }
 
-var fileDescriptor_meta_d42b29c328506298 = []byte{
-	// 474 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xc1, 0x6e, 0x13, 0x31,
-	0x10, 0x86, 0xd9, 0x6e, 0x36, 0x90, 0x09, 0x69, 0x17, 0xab, 0xaa, 0x16, 0x90, 0xd2, 0x25, 0x02,
-	0x11, 0x2e, 0x8b, 0x04, 0x12, 0x27, 0x2e, 0x34, 0x70, 0xe8, 0xa1, 0x17, 0x97, 0x13, 0x12, 0x5a,
-	0xb9, 0x5e, 0x6f, 0x14, 0x65, 0xd7, 0x2e, 0xf6, 0x18, 0x92, 0xb7, 0xe0, 0x51, 0xb8, 0xf2, 0x06,
-	0x1c, 0x79, 0x02, 0x84, 0xc2, 0x7b, 0x20, 0x64, 0x3b, 0x0d, 0x50, 0x72, 0x40, 0xbd, 0xcd, 0x7c,
-	0x5a, 0x8f, 0xff, 0xff, 0xdf, 0x31, 0x40, 0x2b, 0x90, 0x15, 0xe7, 0x5a, 0xa1, 0x22, 0xf7, 0xb9,
-	0x6a, 0x8b, 0x99, 0xac, 0x1b, 0xbb, 0xa8, 0x98, 0xa3, 0x0d, 0xc3, 0x5a, 0xe9, 0xb6, 0x40, 0x66,
-	0xe6, 0xc5, 0x19, 0xe3, 0x73, 0x21, 0xab, 0x3b, 0xfb, 0x53, 0x35, 0x55, 0xfe, 0xc0, 0x63, 0x57,
-	0x85, 0xb3, 0xa3, 0x9f, 0x3b, 0x30, 0x38, 0x45, 0xa5, 0xc5, 0x6b, 0x66, 0xe6, 0x27, 0x02, 0x19,
-	0x79, 0x08, 0x7b, 0x2d, 0x5b, 0x94, 0x5c, 0x49, 0x6e, 0xb5, 0x16, 0x92, 0x2f, 0xb3, 0x28, 0x8f,
-	0xc6, 0x09, 0xdd, 0x6d, 0xd9, 0x62, 0xf2, 0x9b, 0x92, 0x47, 0x90, 0x36, 0x0c, 0x85, 0xc1, 0x92,
-	0xab, 0xf6, 0xbc, 0x11, 0x28, 0xaa, 0x6c, 0x27, 0x8f, 0xc6, 0x31, 0xdd, 0x0b, 0x7c, 0x72, 0x81,
-	0xc9, 0x01, 0x74, 0x0d, 0x32, 0xb4, 0x26, 0x8b, 0xf3, 0x68, 0xdc, 0xa3, 0xeb, 0x8e, 0x70, 0xb8,
-	0x15, 0xc6, 0x61, 0xb3, 0x2c, 0xb5, 0x95, 0x72, 0x26, 0xa7, 0x59, 0x27, 0x8f, 0xc7, 0xfd, 0x27,
-	0xcf, 0x8a, 0xff, 0x71, 0x55, 0xfc, 0xa5, 0x9d, 0x5a, 0x49, 0xd3, 0xcd, 0x40, 0x1a, 0xe6, 0x91,
-	0x07, 0xb0, 0x2b, 0xea, 0x5a, 0x70, 0x9c, 0xbd, 0x17, 0x25, 0xd7, 0x4a, 0x66, 0x89, 0x17, 0x31,
-	0xd8, 0xd0, 0x89, 0x56, 0xd2, 0x69, 0x54, 0x75, 0x6d, 0x04, 0x66, 0x5d, 0x6f, 0x77, 0xdd, 0x91,
-	0xb7, 0xd0, 0x6f, 0x99, 0xb4, 0xac, 0x71, 0x02, 0x4d, 0x96, 0x7a, 0x75, 0xcf, 0xaf, 0xa0, 0xee,
-	0xc4, 0x4f, 0x71, 0x1a, 0xa1, 0xbd, 0x28, 0xcd, 0xe8, 0x73, 0x04, 0xe9, 0x65, 0x13, 0x24, 0x85,
-	0x58, 0xaa, 0x0f, 0x3e, 0xf7, 0x98, 0xba, 0xd2, 0x11, 0xd4, 0x4b, 0x9f, 0xef, 0x80, 0xba, 0x92,
-	0xe4, 0xd0, 0xd5, 0x56, 0x96, 0xb3, 0xca, 0x67, 0xda, 0x39, 0xea, 0xad, 0xbe, 0x1d, 0x26, 0xd4,
-	0xca, 0xe3, 0x97, 0x34, 0xd1, 0x56, 0x1e, 0x57, 0xe4, 0x10, 0xfa, 0x9a, 0xc9, 0xa9, 0x28, 0x0d,
-	0x32, 0x8d, 0x59, 0xc7, 0x4f, 0x03, 0x8f, 0x4e, 0x1d, 0x21, 0x77, 0xa1, 0x17, 0x3e, 0x10, 0xb2,
-	0xf2, 0xa1, 0xc4, 0xf4, 0x86, 0x07, 0xaf, 0x64, 0x45, 0xee, 0xc1, 0x4d, 0x2d, 0xde, 0x59, 0x61,
-	0x50, 0x54, 0x25, 0x0b, 0xa9, 0xc4, 0xb4, 0xbf, 0x61, 0x2f, 0x70, 0xf4, 0x29, 0x82, 0x83, 0xed,
-	0x16, 0xc9, 0x3e, 0x24, 0xe1, 0xd6, 0xe0, 0x21, 0x34, 0xce, 0x85, 0xbb, 0x2a, 0x6c, 0x89, 0x2b,
-	0xb7, 0x2e, 0x51, 0xbc, 0x7d, 0x89, 0x2e, 0x0b, 0xea, 0xfc, 0x23, 0xe8, 0x8f, 0x4c, 0x92, 0xed,
-	0x99, 0x1c, 0xdd, 0xfe, 0xb2, 0x1a, 0x46, 0x5f, 0x57, 0xc3, 0xe8, 0xfb, 0x6a, 0x18, 0x7d, 0xfc,
-	0x31, 0xbc, 0xf6, 0xe6, 0xfa, 0xfa, 0x67, 0x9d, 0x75, 0xfd, 0x8b, 0x78, 0xfa, 0x2b, 0x00, 0x00,
-	0xff, 0xff, 0x93, 0x87, 0xe2, 0xd0, 0x5b, 0x03, 0x00, 0x00,
+var fileDescriptor_meta_f9e47683247e7b8c = []byte{
+	// 521 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0xc7, 0x7f, 0xfe, 0x39, 0x0e, 0xcd, 0x84, 0xb4, 0xe9, 0x52, 0x55, 0x06, 0xa4, 0x34, 0x44,
+	0xfc, 0x09, 0x17, 0x23, 0x81, 0xc4, 0x89, 0x0b, 0x4d, 0x39, 0xf4, 0xd0, 0xcb, 0xb6, 0x27, 0x24,
+	0x64, 0x6d, 0xed, 0x71, 0x14, 0xc5, 0xde, 0x2d, 0xbb, 0x63, 0x88, 0xdf, 0x82, 0x47, 0xe1, 0xca,
+	0x1b, 0x70, 0xe4, 0x09, 0x10, 0x0a, 0x3c, 0x08, 0xda, 0xdd, 0x34, 0x94, 0x92, 0x03, 0xe2, 0x36,
+	0xf3, 0xc9, 0x78, 0xf6, 0x3b, 0xdf, 0x99, 0x00, 0x54, 0x48, 0x22, 0xb9, 0xd0, 0x8a, 0x14, 0xbb,
+	0x9f, 0xa9, 0x2a, 0x99, 0xc9, 0xa2, 0xac, 0x17, 0xb9, 0xb0, 0xb4, 0x14, 0x54, 0x28, 0x5d, 0x25,
+	0x24, 0xcc, 0x3c, 0x39, 0x17, 0xd9, 0x1c, 0x65, 0x7e, 0x67, 0x6f, 0xaa, 0xa6, 0xca, 0x7d, 0xf0,
+	0xc4, 0x46, 0xfe, 0xdb, 0xd1, 0x8f, 0x10, 0x7a, 0xa7, 0xa4, 0x34, 0x9e, 0x09, 0x33, 0x3f, 0x41,
+	0x12, 0xec, 0x11, 0xec, 0x54, 0x62, 0x91, 0x66, 0x4a, 0x66, 0xb5, 0xd6, 0x28, 0xb3, 0x26, 0x0e,
+	0x86, 0xc1, 0x38, 0xe2, 0xdb, 0x95, 0x58, 0x4c, 0x7e, 0x51, 0xf6, 0x18, 0xfa, 0xa5, 0x20, 0x34,
+	0x94, 0x66, 0xaa, 0xba, 0x28, 0x91, 0x30, 0x8f, 0xff, 0x1f, 0x06, 0xe3, 0x90, 0xef, 0x78, 0x3e,
+	0xb9, 0xc4, 0x6c, 0x1f, 0xda, 0x86, 0x04, 0xd5, 0x26, 0x0e, 0x87, 0xc1, 0xb8, 0xc3, 0x57, 0x19,
+	0xcb, 0x60, 0xd7, 0xb7, 0xa3, 0xb2, 0x49, 0x75, 0x2d, 0xe5, 0x4c, 0x4e, 0xe3, 0xd6, 0x30, 0x1c,
+	0x77, 0x9f, 0x3e, 0x4f, 0xfe, 0x66, 0xaa, 0xe4, 0x37, 0xed, 0xbc, 0x96, 0xbc, 0xbf, 0x6e, 0xc8,
+	0x7d, 0x3f, 0xf6, 0x00, 0xb6, 0xb1, 0x28, 0x30, 0xa3, 0xd9, 0x3b, 0x4c, 0x33, 0xad, 0x64, 0x1c,
+	0x39, 0x11, 0xbd, 0x35, 0x9d, 0x68, 0x25, 0xad, 0x46, 0x55, 0x14, 0x06, 0x29, 0x6e, 0xbb, 0x71,
+	0x57, 0x19, 0x7b, 0x03, 0xdd, 0x4a, 0xc8, 0x5a, 0x94, 0x56, 0xa0, 0x89, 0xfb, 0x4e, 0xdd, 0x8b,
+	0x7f, 0x50, 0x77, 0xe2, 0xba, 0x58, 0x8d, 0x50, 0x5d, 0x86, 0x86, 0xdd, 0x85, 0x8e, 0xb5, 0x9b,
+	0xf4, 0x0c, 0x4d, 0xbc, 0xeb, 0x5e, 0xde, 0xaa, 0xc4, 0xe2, 0xcc, 0xe6, 0xec, 0x00, 0xba, 0x1a,
+	0x49, 0x37, 0x69, 0x8e, 0xa5, 0x68, 0x62, 0xe6, 0x7e, 0x06, 0x87, 0x8e, 0x2c, 0x61, 0x0f, 0xfd,
+	0xb2, 0xae, 0x16, 0xdd, 0x72, 0x45, 0xbd, 0x4a, 0x2c, 0xf8, 0xba, 0x6e, 0xf4, 0x29, 0x80, 0xfe,
+	0x75, 0xab, 0x58, 0x1f, 0x42, 0xa9, 0xde, 0xbb, 0xed, 0x86, 0xdc, 0x86, 0x96, 0x90, 0x6e, 0xdc,
+	0x16, 0x7b, 0xdc, 0x86, 0x6c, 0x08, 0x6d, 0x5d, 0xcb, 0x74, 0x96, 0xbb, 0xcd, 0xb5, 0x0e, 0x3b,
+	0xcb, 0xaf, 0x07, 0x11, 0xaf, 0xe5, 0xf1, 0x11, 0x8f, 0x74, 0x2d, 0x8f, 0x73, 0xa7, 0x51, 0xc8,
+	0x29, 0xa6, 0x86, 0x84, 0xa6, 0xb8, 0xe5, 0xba, 0x81, 0x43, 0xa7, 0x96, 0xd8, 0x09, 0x7d, 0x01,
+	0xca, 0xdc, 0x59, 0x1f, 0xf2, 0x2d, 0x07, 0x5e, 0xc9, 0x9c, 0xdd, 0x83, 0x9b, 0x1a, 0xdf, 0xd6,
+	0x68, 0x08, 0xf3, 0x54, 0x78, 0xef, 0x43, 0xde, 0x5d, 0xb3, 0x97, 0x34, 0xfa, 0x18, 0xc0, 0xfe,
+	0x66, 0x23, 0xd9, 0x1e, 0x44, 0xfe, 0x55, 0x3f, 0x83, 0x4f, 0xec, 0x14, 0xf6, 0x29, 0x7f, 0x8b,
+	0x36, 0xdc, 0x78, 0xaa, 0xe1, 0xe6, 0x53, 0xbd, 0x2e, 0xa8, 0xf5, 0x87, 0xa0, 0x2b, 0x9e, 0x44,
+	0x9b, 0x3d, 0x39, 0xbc, 0xfd, 0x79, 0x39, 0x08, 0xbe, 0x2c, 0x07, 0xc1, 0xb7, 0xe5, 0x20, 0xf8,
+	0xf0, 0x7d, 0xf0, 0xdf, 0xeb, 0x1b, 0xab, 0x93, 0x38, 0x6f, 0xbb, 0xff, 0xdd, 0xb3, 0x9f, 0x03,
+	0x00, 0x2f, 0xd3, 0xf5, 0xcc, 0xc1, 0x03, 0x00, 0x00,
 }
@@ -213,6 +213,68 @@ func TestMeta_CreateNextRun_Delay(t *testing.T) {
 	}
 }
 
+func TestMeta_RequeueRun(t *testing.T) {
+	stm := backend.StoreTaskMeta{
+		MaxConcurrency:  1,
+		EffectiveCron:   "@every 1s",
+		LatestCompleted: 5,
+		MaxTries:        3,
+	}
+
+	rc, err := stm.CreateNextRun(6, makeID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	runID := rc.Created.RunID
+
+	if !stm.CanRetry(1) {
+		t.Fatal("expected first try to be retryable")
+	}
+	if try := stm.RequeueRun(runID); try != 2 {
+		t.Fatalf("expected try 2 after requeue, got %d", try)
+	}
+	if try := stm.RequeueRun(runID); try != 3 {
+		t.Fatalf("expected try 3 after requeue, got %d", try)
+	}
+	if got := stm.CurrentlyRunning[0].Try; got != 3 {
+		t.Fatalf("expected stored try 3, got %d", got)
+	}
+	if stm.CanRetry(3) {
+		t.Fatal("expected last try not to be retryable")
+	}
+
+	if try := stm.RequeueRun(runID + 1); try != 0 {
+		t.Fatalf("expected try 0 for unknown run, got %d", try)
+	}
+
+	// Without a retry option, runs are attempted once.
+	if (&backend.StoreTaskMeta{}).CanRetry(1) {
+		t.Fatal("expected no retries without a retry option")
+	}
+}
+
+func TestMeta_RetryBackoff(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		stm      backend.StoreTaskMeta
+		try      uint32
+		expDelay time.Duration
+	}{
+		{name: "default first retry", try: 2, expDelay: backend.DefaultRetryDelay},
+		{name: "default capped", try: 10, expDelay: backend.DefaultMaxRetryDelay},
+		{name: "first retry", stm: backend.StoreTaskMeta{RetryDelay: 5, MaxRetryDelay: 60}, try: 2, expDelay: 5 * time.Second},
+		{name: "doubled", stm: backend.StoreTaskMeta{RetryDelay: 5, MaxRetryDelay: 60}, try: 4, expDelay: 20 * time.Second},
+		{name: "capped", stm: backend.StoreTaskMeta{RetryDelay: 5, MaxRetryDelay: 60}, try: 6, expDelay: time.Minute},
+		{name: "max below delay", stm: backend.StoreTaskMeta{RetryDelay: 30, MaxRetryDelay: 10}, try: 3, expDelay: 30 * time.Second},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.stm.RetryBackoff(tt.try); got != tt.expDelay {
+				t.Fatalf("expected delay %s, got %s", tt.expDelay, got)
+			}
+		})
+	}
+}
+
 func TestMeta_ManuallyRunTimeRange(t *testing.T) {
 	now := time.Now().Unix()
 	stm := backend.StoreTaskMeta{
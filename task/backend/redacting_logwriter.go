@@ -0,0 +1,28 @@
+package backend
+
+import (
+	"context"
+	"time"
+
+	"github.com/influxdata/platform"
+)
+
+// RedactingLogWriter is a LogWriter that removes the values of the secrets of
+// a task's organization from the logs of its runs.
+type RedactingLogWriter struct {
+	LogWriter
+	secrets platform.SecretService
+}
+
+// NewRedactingLogWriter returns a RedactingLogWriter writing to lw.
+func NewRedactingLogWriter(lw LogWriter, s platform.SecretService) *RedactingLogWriter {
+	return &RedactingLogWriter{LogWriter: lw, secrets: s}
+}
+
+// AddRunLog adds the redacted log line to the run.
+func (w *RedactingLogWriter) AddRunLog(ctx context.Context, rlb RunLogBase, when time.Time, log string) error {
+	if rlb.Task != nil {
+		log = platform.RedactSecrets(ctx, w.secrets, rlb.Task.Org, log)
+	}
+	return w.LogWriter.AddRunLog(ctx, rlb, when, log)
+}
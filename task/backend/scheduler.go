@@ -39,6 +39,15 @@ type DesiredState interface {
 	// FinishRun indicates that the given run is no longer intended to be executed.
 	// This may be called after a successful or failed execution, or upon cancellation.
 	FinishRun(ctx context.Context, taskID, runID platform.ID) error
+
+	// RequeueRun indicates that the given run failed and is intended to be attempted again,
+	// delegating to (*StoreTaskMeta).RequeueRun. It returns the run's new try count.
+	RequeueRun(ctx context.Context, taskID, runID platform.ID) (uint32, error)
+
+	// TriggerDependents indicates that the run of taskID scheduled for now succeeded,
+	// queuing a run for now on the tasks depending on taskID, delegating to Store.TriggerDependents.
+	// It returns the IDs of the tasks that had a run queued.
+	TriggerDependents(ctx context.Context, taskID platform.ID, now int64) ([]platform.ID, error)
 }
 
 // Executor handles execution of a run.
@@ -111,7 +120,7 @@ type Scheduler interface {
 	// ClaimTask begins control of task execution in this scheduler.
 	ClaimTask(task *StoreTask, meta *StoreTaskMeta) error
 
-	// UpdateTask will update the concurrency and the runners for a task
+	// UpdateTask will update the concurrency, the retry policy and the runners for a task
 	UpdateTask(task *StoreTask, meta *StoreTaskMeta) error
 
 	// ReleaseTask immediately cancels any in-progress runs for the given task ID,
@@ -171,6 +180,7 @@ func NewScheduler(desiredState DesiredState, executor Executor, lw LogWriter, no
 		logger:         zap.NewNop(),
 		wg:             &sync.WaitGroup{},
 		metrics:        newSchedulerMetrics(),
+		triggered:      make(map[platform.ID]struct{}),
 	}
 
 	for _, opt := range opts {
@@ -196,6 +206,9 @@ type TickScheduler struct {
 
 	schedulerMu    sync.Mutex                     // Protects access and modification of taskSchedulers map.
 	taskSchedulers map[platform.ID]*taskScheduler // task ID -> task scheduler.
+
+	triggeredMu sync.Mutex               // Protects access and modification of triggered map.
+	triggered   map[platform.ID]struct{} // IDs of tasks that had a run queued by an upstream task since the last tick.
 }
 
 // CancelRun cancels a run, it has the unused Context argument so that it can implement a task.RunController
@@ -239,9 +252,15 @@ func (s *TickScheduler) Tick(now int64) {
 
 	atomic.StoreInt64(&s.now, now)
 
+	for _, id := range s.takeTriggered() {
+		if ts, ok := s.taskSchedulers[id]; ok {
+			ts.SetHasQueue()
+		}
+	}
+
 	affected := 0
 	for _, ts := range s.taskSchedulers {
-		if nextDue, hasQueue := ts.NextDue(); now >= nextDue || hasQueue {
+		if nextDue, hasQueue := ts.NextDue(); now >= nextDue || hasQueue || ts.RetryDue(now) {
 			ts.Work()
 			affected++
 		}
@@ -250,6 +269,28 @@ func (s *TickScheduler) Tick(now int64) {
 	s.logger.Debug("Ticked", zap.Int64("now", now), zap.Int("tasks_affected", affected))
 }
 
+// addTriggered records that runs were queued for the tasks with the given IDs,
+// so that they are worked on the next tick.
+func (s *TickScheduler) addTriggered(ids []platform.ID) {
+	s.triggeredMu.Lock()
+	defer s.triggeredMu.Unlock()
+	for _, id := range ids {
+		s.triggered[id] = struct{}{}
+	}
+}
+
+// takeTriggered returns and clears the IDs recorded by addTriggered.
+func (s *TickScheduler) takeTriggered() []platform.ID {
+	s.triggeredMu.Lock()
+	defer s.triggeredMu.Unlock()
+	ids := make([]platform.ID, 0, len(s.triggered))
+	for id := range s.triggered {
+		ids = append(ids, id)
+		delete(s.triggered, id)
+	}
+	return ids
+}
+
 func (s *TickScheduler) Start(ctx context.Context) {
 	s.schedulerMu.Lock()
 	defer s.schedulerMu.Unlock()
@@ -340,9 +381,9 @@ func (s *TickScheduler) UpdateTask(task *StoreTask, meta *StoreTaskMeta) error {
 
 	s.taskSchedulers[task.ID] = nts
 
-	next, hasQueue := ts.NextDue()
+	next, hasQueue := nts.NextDue()
 	if now := atomic.LoadInt64(&s.now); now >= next || hasQueue {
-		ts.Work()
+		nts.Work()
 	}
 
 	return nil
@@ -379,6 +420,9 @@ type taskScheduler struct {
 	// Reference to outerScheduler.now. Must be accessed atomically.
 	now *int64
 
+	// Scheduler that owns this taskScheduler.
+	scheduler *TickScheduler
+
 	// Task we are scheduling for.
 	task *StoreTask
 
@@ -395,6 +439,9 @@ type taskScheduler struct {
 
 	metrics *schedulerMetrics
 
+	// Retry policy of the task. Only the MaxTries, RetryDelay and MaxRetryDelay fields are set.
+	retryPolicy StoreTaskMeta
+
 	nextDueMu     sync.RWMutex // Protects following fields.
 	nextDue       int64        // Unix timestamp of next due.
 	nextDueSource int64        // Run time that produced nextDue.
@@ -417,6 +464,7 @@ func newTaskScheduler(
 	ctx, cancel := context.WithCancel(ctx)
 	ts := &taskScheduler{
 		now:           &s.now,
+		scheduler:     s,
 		task:          task,
 		cancel:        cancel,
 		wg:            wg,
@@ -427,6 +475,11 @@ func newTaskScheduler(
 		nextDue:       firstDue,
 		nextDueSource: math.MinInt64,
 		hasQueue:      len(meta.ManualRuns) > 0,
+		retryPolicy: StoreTaskMeta{
+			MaxTries:      meta.MaxTries,
+			RetryDelay:    meta.RetryDelay,
+			MaxRetryDelay: meta.MaxRetryDelay,
+		},
 	}
 
 	for i := range ts.runners {
@@ -454,7 +507,7 @@ func (ts *taskScheduler) WorkCurrentlyRunning(meta *StoreTaskMeta) error {
 		foundWorker := false
 		for _, r := range ts.runners {
 			qr := QueuedRun{TaskID: ts.task.ID, RunID: platform.ID(cr.RunID), Now: cr.Now}
-			if r.RestartRun(qr) {
+			if r.RestartRun(qr, cr.Try) {
 				foundWorker = true
 				break
 			}
@@ -473,6 +526,16 @@ func (ts *taskScheduler) Cancel() {
 	ts.cancel()
 }
 
+// RetryDue returns true if any of ts's runners has a retry that is due at now.
+func (ts *taskScheduler) RetryDue(now int64) bool {
+	for _, r := range ts.runners {
+		if r.RetryDue(now) {
+			return true
+		}
+	}
+	return false
+}
+
 // NextDue returns the next due timestamp, and whether there is a queue.
 func (ts *taskScheduler) NextDue() (int64, bool) {
 	ts.nextDueMu.RLock()
@@ -480,6 +543,14 @@ func (ts *taskScheduler) NextDue() (int64, bool) {
 	return ts.nextDue, ts.hasQueue
 }
 
+// SetHasQueue records that runs were queued for the task outside of its schedule,
+// such as by one of its upstream tasks.
+func (ts *taskScheduler) SetHasQueue() {
+	ts.nextDueMu.Lock()
+	defer ts.nextDueMu.Unlock()
+	ts.hasQueue = true
+}
+
 // SetNextDue sets the next due timestamp and whether the task has a queue,
 // and records the source (the now value of the run who reported nextDue).
 func (ts *taskScheduler) SetNextDue(nextDue int64, hasQueue bool, source int64) {
@@ -509,6 +580,12 @@ type runner struct {
 	ts *taskScheduler
 
 	logger *zap.Logger
+
+	retryMu  sync.Mutex      // Protects following fields, which are only meaningful in the runnerRetrying state.
+	retryRun QueuedRun       // Run waiting to be retried.
+	retryTry uint32          // Try count of the pending retry.
+	retryAt  int64           // Unix timestamp of when the pending retry is due.
+	retryCtx context.Context // Context of the run waiting to be retried, canceled if the run is canceled.
 }
 
 func newRunner(
@@ -542,6 +619,9 @@ const (
 	// Busy, cannot pick up a new run.
 	runnerWorking
 
+	// Waiting to retry a failed run, cannot pick up a new run.
+	runnerRetrying
+
 	// TODO(mr): use more granular runner states, so we can inspect the overall state of a taskScheduler.
 )
 
@@ -553,7 +633,13 @@ func (r *runner) IsIdle() bool {
 
 // Start checks if a new run is ready to be scheduled, and if so,
 // creates a run on this goroutine and begins executing it on a separate goroutine.
+// If the runner is waiting to retry a failed run, Start instead begins the retry once it is due.
 func (r *runner) Start() {
+	if atomic.LoadUint32(r.state) == runnerRetrying {
+		r.startRetry(atomic.LoadInt64(r.ts.now))
+		return
+	}
+
 	if !atomic.CompareAndSwapUint32(r.state, runnerIdle, runnerWorking) {
 		// Already working. Cannot start.
 		return
@@ -563,12 +649,16 @@ func (r *runner) Start() {
 }
 
 // RestartRun attempts to restart a queued run if the runner is available to do the work.
+// try is the try count of the run recorded in the task's meta.
 // If the runner was already busy we return false.
-func (r *runner) RestartRun(qr QueuedRun) bool {
+func (r *runner) RestartRun(qr QueuedRun, try uint32) bool {
 	if !atomic.CompareAndSwapUint32(r.state, runnerIdle, runnerWorking) {
 		// already working
 		return false
 	}
+	if try == 0 {
+		try = 1
+	}
 	// create a QueuedRun because we cant stm.CreateNextRun
 	runLogger := r.logger.With(zap.String("run_id", qr.RunID.String()), zap.Int64("now", qr.Now))
 	r.wg.Add(1)
@@ -580,7 +670,7 @@ func (r *runner) RestartRun(qr QueuedRun) bool {
 		r.ts.running[qr.RunID] = rCtx
 	}
 	r.ts.runningMu.Unlock()
-	go r.executeAndWait(rCtx.Context, qr, runLogger)
+	go r.executeAndWait(rCtx.Context, qr, try, runLogger)
 
 	r.updateRunState(qr, RunStarted, runLogger)
 	return true
@@ -615,11 +705,58 @@ func (r *runner) startFromWorking(now int64) {
 
 	runLogger.Info("Created run; beginning execution")
 	r.wg.Add(1)
-	go r.executeAndWait(ctx, qr, runLogger)
+	go r.executeAndWait(ctx, qr, 1, runLogger)
 
 	r.updateRunState(qr, RunStarted, runLogger)
 }
 
+// RetryDue returns true if the runner is waiting to retry a run,
+// and the retry is due at now or the run has been canceled in the meantime.
+func (r *runner) RetryDue(now int64) bool {
+	if atomic.LoadUint32(r.state) != runnerRetrying {
+		return false
+	}
+
+	r.retryMu.Lock()
+	defer r.retryMu.Unlock()
+	return now >= r.retryAt || r.retryCtx.Err() != nil
+}
+
+// startRetry begins the next try of the run the runner is waiting to retry, if it is due.
+// If the run was canceled while waiting, it is finished as canceled instead.
+func (r *runner) startRetry(now int64) {
+	if !r.RetryDue(now) {
+		return
+	}
+	if !atomic.CompareAndSwapUint32(r.state, runnerRetrying, runnerWorking) {
+		// Another caller already started the retry.
+		return
+	}
+
+	r.retryMu.Lock()
+	qr, try, ctx := r.retryRun, r.retryTry, r.retryCtx
+	r.retryMu.Unlock()
+
+	runLogger := r.logger.With(zap.String("run_id", qr.RunID.String()), zap.Int64("now", qr.Now))
+
+	if ctx.Err() != nil {
+		r.clearRunning(qr.RunID)
+		_ = r.desiredState.FinishRun(r.ctx, qr.TaskID, qr.RunID)
+		r.updateRunState(qr, RunCanceled, runLogger)
+
+		// Move on to the next execution, for a canceled run.
+		r.startFromWorking(now)
+		return
+	}
+
+	runLogger.Info("Retrying run", zap.Uint32("try", try))
+	r.wg.Add(1)
+	go r.executeAndWait(ctx, qr, try, runLogger)
+
+	r.addRunLog(qr, fmt.Sprintf("Started try %d of %d", try, r.ts.retryPolicy.MaxTries))
+	r.setRunState(qr, RunStarted, runLogger)
+}
+
 func (r *runner) clearRunning(id platform.ID) {
 	r.ts.runningMu.Lock()
 	r.ts.running[id].CancelFunc() // cleanup
@@ -627,7 +764,8 @@ func (r *runner) clearRunning(id platform.ID) {
 	r.ts.runningMu.Unlock()
 }
 
-func (r *runner) executeAndWait(ctx context.Context, qr QueuedRun, runLogger *zap.Logger) {
+// executeAndWait executes the given try of qr and waits for its result.
+func (r *runner) executeAndWait(ctx context.Context, qr QueuedRun, try uint32, runLogger *zap.Logger) {
 	defer r.wg.Done()
 
 	sp, spCtx := opentracing.StartSpanFromContext(ctx, "task.run.execution")
@@ -636,14 +774,16 @@ func (r *runner) executeAndWait(ctx context.Context, qr QueuedRun, runLogger *za
 	rp, err := r.executor.Execute(spCtx, qr)
 
 	if err != nil {
-		// TODO(mr): retry? and log error.
-		atomic.StoreUint32(r.state, runnerIdle)
-		r.updateRunState(qr, RunFail, runLogger)
+		runLogger.Info("Failed to begin execution", zap.Error(err))
+		r.clearRunning(qr.RunID)
+		r.fail(qr, try, err, !IsPermanentError(err), runLogger)
 		return
 	}
 
 	ready := make(chan struct{})
+	cleared := make(chan struct{})
 	go func() {
+		defer close(cleared)
 		// If the runner's context is canceled, cancel the RunPromise.
 		select {
 		case <-ctx.Done():
@@ -659,8 +799,7 @@ func (r *runner) executeAndWait(ctx context.Context, qr QueuedRun, runLogger *za
 		}
 	}()
 
-	// TODO(mr): handle res.IsRetryable().
-	_, err = rp.Wait()
+	res, err := rp.Wait()
 	close(ready)
 	if err != nil {
 		if err == ErrRunCanceled {
@@ -673,9 +812,15 @@ func (r *runner) executeAndWait(ctx context.Context, qr QueuedRun, runLogger *za
 		}
 
 		runLogger.Info("Failed to wait for execution result", zap.Error(err))
-		// TODO(mr): retry?
-		r.updateRunState(qr, RunFail, runLogger)
-		atomic.StoreUint32(r.state, runnerIdle)
+		<-cleared
+		r.fail(qr, try, err, !IsPermanentError(err), runLogger)
+		return
+	}
+
+	if err := res.Err(); err != nil {
+		runLogger.Info("Execution failed", zap.Error(err))
+		<-cleared
+		r.fail(qr, try, err, res.IsRetryable(), runLogger)
 		return
 	}
 
@@ -689,42 +834,117 @@ func (r *runner) executeAndWait(ctx context.Context, qr QueuedRun, runLogger *za
 	}
 	r.updateRunState(qr, RunSuccess, runLogger)
 	runLogger.Info("Execution succeeded")
+	r.triggerDependents(qr, runLogger)
 
 	// Check again if there is a new run available, without returning to idle state.
 	r.startFromWorking(atomic.LoadInt64(r.ts.now))
 }
 
-func (r *runner) updateRunState(qr QueuedRun, s RunStatus, runLogger *zap.Logger) {
-	rlb := RunLogBase{
-		Task:            r.task,
-		RunID:           qr.RunID,
-		RunScheduledFor: qr.Now,
-		RequestedAt:     qr.RequestedAt,
+// triggerDependents queues a run for qr's now on the tasks depending on r's task,
+// which the scheduler starts on its next tick.
+func (r *runner) triggerDependents(qr QueuedRun, runLogger *zap.Logger) {
+	ids, err := r.desiredState.TriggerDependents(r.ctx, qr.TaskID, qr.Now)
+	if err != nil {
+		runLogger.Info("Failed to trigger dependent tasks", zap.Error(err))
+	}
+	if len(ids) == 0 {
+		return
+	}
+
+	runLogger.Info("Triggered dependent tasks", zap.Int("count", len(ids)))
+	r.ts.scheduler.addTriggered(ids)
+}
+
+// fail handles a failed try of qr.
+// If the failure is retryable and the task allows another try, the run is requeued,
+// and the runner waits for the run's backoff to elapse before retrying it.
+// Otherwise, the run is finished as failed and the runner returns to idle.
+// The run must no longer be present in r.ts.running when fail is called.
+func (r *runner) fail(qr QueuedRun, try uint32, err error, retryable bool, runLogger *zap.Logger) {
+	policy := r.ts.retryPolicy
+
+	if retryable && policy.CanRetry(try) {
+		next, rerr := r.desiredState.RequeueRun(r.ctx, qr.TaskID, qr.RunID)
+		if rerr == nil {
+			delay := policy.RetryBackoff(next)
+			r.addRunLog(qr, fmt.Sprintf("Try %d of %d failed: %v; retrying in %s", try, policy.MaxTries, err, delay))
+			r.scheduleRetry(qr, next, atomic.LoadInt64(r.ts.now)+int64(delay/time.Second))
+			r.setRunState(qr, RunScheduled, runLogger)
+			return
+		}
+		runLogger.Info("Failed to requeue run", zap.Error(rerr))
+	}
+
+	if retryable {
+		r.addRunLog(qr, fmt.Sprintf("Try %d failed: %v", try, err))
+	} else {
+		r.addRunLog(qr, fmt.Sprintf("Try %d failed with a permanent error: %v", try, err))
+	}
+	if err := r.desiredState.FinishRun(r.ctx, qr.TaskID, qr.RunID); err != nil {
+		runLogger.Info("Failed to finish run", zap.Error(err))
 	}
+	atomic.StoreUint32(r.state, runnerIdle)
+	r.updateRunState(qr, RunFail, runLogger)
+}
+
+// scheduleRetry puts the runner in the retrying state, to begin the given try of qr at the Unix timestamp at.
+// The run is registered as running again, so that it can be canceled while it waits.
+func (r *runner) scheduleRetry(qr QueuedRun, try uint32, at int64) {
+	ctx, cancel := context.WithCancel(r.ctx)
+	r.ts.runningMu.Lock()
+	r.ts.running[qr.RunID] = runCtx{Context: ctx, CancelFunc: cancel}
+	r.ts.runningMu.Unlock()
+
+	r.retryMu.Lock()
+	r.retryRun, r.retryTry, r.retryAt, r.retryCtx = qr, try, at, ctx
+	r.retryMu.Unlock()
+
+	atomic.StoreUint32(r.state, runnerRetrying)
+}
 
+func (r *runner) updateRunState(qr QueuedRun, s RunStatus, runLogger *zap.Logger) {
 	switch s {
 	case RunStarted:
 		r.ts.metrics.StartRun(r.task.ID.String())
-		r.logWriter.AddRunLog(r.ctx, rlb, time.Now(), fmt.Sprintf("Started task from script: %q", r.task.Script))
+		r.addRunLog(qr, fmt.Sprintf("Started task from script: %q", r.task.Script))
 	case RunSuccess:
 		r.ts.metrics.FinishRun(r.task.ID.String(), true)
-		r.logWriter.AddRunLog(r.ctx, rlb, time.Now(), "Completed successfully")
+		r.addRunLog(qr, "Completed successfully")
 	case RunFail:
 		r.ts.metrics.FinishRun(r.task.ID.String(), false)
-		r.logWriter.AddRunLog(r.ctx, rlb, time.Now(), "Failed")
+		r.addRunLog(qr, "Failed")
 	case RunCanceled:
 		r.ts.metrics.FinishRun(r.task.ID.String(), false)
-		r.logWriter.AddRunLog(r.ctx, rlb, time.Now(), "Canceled")
+		r.addRunLog(qr, "Canceled")
 	default: // We are deliberately not handling RunQueued yet.
 		// There is not really a notion of being queued in this runner architecture.
 		runLogger.Warn("Unhandled run state", zap.Stringer("state", s))
 	}
 
+	r.setRunState(qr, s, runLogger)
+}
+
+// setRunState records the state of qr through the LogWriter, without updating metrics or adding a run log.
+func (r *runner) setRunState(qr QueuedRun, s RunStatus, runLogger *zap.Logger) {
 	// Arbitrarily chosen short time limit for how fast the log write must complete.
 	// If we start seeing errors from this, we know the time limit is too short or the system is overloaded.
 	ctx, cancel := context.WithTimeout(r.ctx, 10*time.Millisecond)
 	defer cancel()
-	if err := r.logWriter.UpdateRunState(ctx, rlb, time.Now(), s); err != nil {
+	if err := r.logWriter.UpdateRunState(ctx, r.runLogBase(qr), time.Now(), s); err != nil {
 		runLogger.Info("Error updating run state", zap.Stringer("state", s), zap.Error(err))
 	}
 }
+
+// addRunLog adds msg to the log of qr.
+func (r *runner) addRunLog(qr QueuedRun, msg string) {
+	r.logWriter.AddRunLog(r.ctx, r.runLogBase(qr), time.Now(), msg)
+}
+
+func (r *runner) runLogBase(qr QueuedRun) RunLogBase {
+	return RunLogBase{
+		Task:            r.task,
+		RunID:           qr.RunID,
+		RunScheduledFor: qr.Now,
+		RequestedAt:     qr.RequestedAt,
+	}
+}
@@ -509,6 +509,184 @@ func TestScheduler_RunLog(t *testing.T) {
 	pollForRunStatus(t, rl, task.ID, 3, 2, backend.RunCanceled.String())
 }
 
+func TestScheduler_Retry(t *testing.T) {
+	d := mock.NewDesiredState()
+	e := mock.NewExecutor()
+	rl := backend.NewInMemRunReaderWriter()
+	s := backend.NewScheduler(d, e, rl, 5, backend.WithLogger(zaptest.NewLogger(t)))
+	s.Start(context.Background())
+	defer s.Stop()
+
+	task := &backend.StoreTask{
+		ID: platform.ID(1),
+	}
+	meta := &backend.StoreTaskMeta{
+		MaxConcurrency:  1,
+		EffectiveCron:   "@every 1s",
+		LatestCompleted: 5,
+		MaxTries:        3,
+		RetryDelay:      10,
+		MaxRetryDelay:   15,
+	}
+
+	d.SetTaskMeta(task.ID, *meta)
+	if err := s.ClaimTask(task, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	s.Tick(6)
+	promises, err := e.PollForNumberRunning(task.ID, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	runID := promises[0].Run().RunID
+
+	// Fail the first try; it should be retried after the retry delay.
+	promises[0].Finish(mock.NewRunResult(errors.New("transient failure"), true), nil)
+	pollForRunStatus(t, rl, task.ID, 1, 0, backend.RunScheduled.String())
+
+	s.Tick(15)
+	if _, err := e.PollForNumberRunning(task.ID, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	s.Tick(16)
+	promises, err = e.PollForNumberRunning(task.ID, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := promises[0].Run().RunID; got != runID {
+		t.Fatalf("expected retry of run %s, got run %s", runID, got)
+	}
+	pollForRunStatus(t, rl, task.ID, 1, 0, backend.RunStarted.String())
+
+	// Fail the second try; the doubled delay is capped at the max retry delay.
+	promises[0].Finish(nil, errors.New("forced failure"))
+	pollForRunStatus(t, rl, task.ID, 1, 0, backend.RunScheduled.String())
+
+	s.Tick(30)
+	if _, err := e.PollForNumberRunning(task.ID, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	s.Tick(31)
+	promises, err = e.PollForNumberRunning(task.ID, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Fail the last try; the run is finished and the next run can start.
+	promises[0].Finish(mock.NewRunResult(errors.New("transient failure"), true), nil)
+	pollForRunStatus(t, rl, task.ID, 1, 0, backend.RunFail.String())
+
+	runs, err := rl.ListRuns(context.Background(), platform.RunFilter{Task: &task.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"Try 1 of 3 failed: transient failure; retrying in 10s",
+		"Started try 2 of 3",
+		"Try 2 of 3 failed: forced failure; retrying in 15s",
+		"Started try 3 of 3",
+		"Try 3 failed: transient failure",
+	} {
+		if !strings.Contains(string(runs[0].Log), want) {
+			t.Errorf("expected run log to contain %q, got:\n%s", want, runs[0].Log)
+		}
+	}
+
+	s.Tick(32)
+	promises, err = e.PollForNumberRunning(task.ID, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Permanent failures are not retried.
+	promises[0].Finish(nil, backend.PermanentError{Err: errors.New("bad script")})
+	pollForRunStatus(t, rl, task.ID, 2, 1, backend.RunFail.String())
+}
+
+func TestScheduler_Dependencies(t *testing.T) {
+	store := backend.NewInMemStore()
+	e := mock.NewExecutor()
+	rl := backend.NewInMemRunReaderWriter()
+	s := backend.NewScheduler(store, e, rl, 5, backend.WithLogger(zaptest.NewLogger(t)))
+	s.Start(context.Background())
+	defer s.Stop()
+
+	const upstreamScript = `option task = {
+	name: "upstream",
+	every: 1s,
+}
+
+from(bucket:"test") |> range(start:-1h)`
+	upID, err := store.CreateTask(context.Background(), backend.CreateTaskRequest{Org: 1, User: 2, Script: upstreamScript, ScheduleAfter: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	downScript := fmt.Sprintf(`option task = {
+	name: "downstream",
+	dependsOn: [%q],
+}
+
+from(bucket:"test") |> range(start:-1h)`, upID.String())
+	downID, err := store.CreateTask(context.Background(), backend.CreateTaskRequest{Org: 1, User: 2, Script: downScript, ScheduleAfter: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, id := range []platform.ID{upID, downID} {
+		task, meta, err := store.FindTaskByIDWithMeta(context.Background(), id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := s.ClaimTask(task, meta); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	s.Tick(7)
+	promises, err := e.PollForNumberRunning(upID, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.PollForNumberRunning(downID, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	// A failed upstream run does not trigger the dependent task.
+	promises[0].Finish(nil, errors.New("forced failure"))
+	pollForRunStatus(t, rl, upID, 1, 0, backend.RunFail.String())
+
+	s.Tick(8)
+	promises, err = e.PollForNumberRunning(upID, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.PollForNumberRunning(downID, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	// A successful upstream run triggers the dependent task for the same now on a following tick.
+	promises[0].Finish(mock.NewRunResult(nil, false), nil)
+	pollForRunStatus(t, rl, upID, 2, 1, backend.RunSuccess.String())
+
+	var downPromises []*mock.RunPromise
+	for i := 0; i < 100; i++ {
+		s.Tick(9)
+		if downPromises = e.RunningFor(downID); len(downPromises) > 0 {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if len(downPromises) != 1 {
+		t.Fatalf("expected 1 dependent run, got %d", len(downPromises))
+	}
+	if now := downPromises[0].Run().Now; now != 8 {
+		t.Fatalf("expected dependent run for now 8, got %d", now)
+	}
+}
+
 func TestScheduler_Metrics(t *testing.T) {
 	d := mock.NewDesiredState()
 	e := mock.NewExecutor()
@@ -93,6 +93,26 @@ func (e RunNotYetDueError) Error() string {
 	return "run not due until " + time.Unix(e.DueAt, 0).UTC().Format(time.RFC3339)
 }
 
+// PermanentError wraps an error that retrying a run cannot resolve,
+// such as a script that fails to compile.
+// Runs that fail with a PermanentError are not retried, regardless of the task's retry option.
+type PermanentError struct {
+	Err error
+}
+
+func (e PermanentError) Error() string {
+	return e.Err.Error()
+}
+
+// IsPermanentError returns true if err is a PermanentError.
+func IsPermanentError(err error) bool {
+	switch err.(type) {
+	case PermanentError, *PermanentError:
+		return true
+	}
+	return false
+}
+
 // RetryAlreadyQueuedError is returned when attempting to retry a run which has not yet completed.
 type RetryAlreadyQueuedError struct {
 	// Unix timestamps matching existing request's start and end.
@@ -223,6 +243,19 @@ type Store interface {
 	// FinishRun removes runID from the list of running tasks and if its `now` is later then last completed update it.
 	FinishRun(ctx context.Context, taskID, runID platform.ID) error
 
+	// RequeueRun increments the try count of runID so that the run can be attempted again.
+	// Internally, the Store should rely on the underlying task's StoreTaskMeta to requeue the run.
+	RequeueRun(ctx context.Context, taskID, runID platform.ID) (uint32, error)
+
+	// ListDependents returns the IDs of the tasks that depend directly on the task with the given ID.
+	ListDependents(ctx context.Context, taskID platform.ID) ([]platform.ID, error)
+
+	// TriggerDependents records that the run of taskID scheduled for the Unix timestamp now succeeded.
+	// Every task depending on taskID whose upstream tasks have now all succeeded for now,
+	// gets a run for now queued, through its StoreTaskMeta's ManuallyRunTimeRange method.
+	// TriggerDependents returns the IDs of the tasks that had a run queued.
+	TriggerDependents(ctx context.Context, taskID platform.ID, now int64) ([]platform.ID, error)
+
 	// ManuallyRunTimeRange enqueues a request to run the task with the given ID for all schedules no earlier than start and no later than end (Unix timestamps).
 	// requestedAt is the Unix timestamp when the request was initiated.
 	// ManuallyRunTimeRange must delegate to an underlying StoreTaskMeta's ManuallyRunTimeRange method.
@@ -340,6 +373,11 @@ type StoreTaskWithMeta struct {
 	Meta StoreTaskMeta
 }
 
+// MaxPendingDependencyRuns is the number of runs a Store keeps track of per dependent task,
+// while waiting for all of the task's upstream tasks to succeed.
+// When the limit is reached, the oldest pending run is forgotten.
+const MaxPendingDependencyRuns = 100
+
 // StoreValidator is a package-level StoreValidation, so that you can write
 //    backend.StoreValidator.CreateArgs(...)
 var StoreValidator StoreValidation
@@ -381,6 +419,61 @@ func (StoreValidation) CreateArgs(req CreateTaskRequest) (options.Options, error
 	return o, nil
 }
 
+// Dependencies returns an error if the task with the given ID, owned by org, cannot depend on the tasks in dependsOn.
+// That is the case if any of those tasks does not exist, belongs to another organization,
+// or if it depends on the task itself, directly or indirectly.
+//
+// lookup returns the organization and the dependencies of the task with the given ID,
+// or ErrTaskNotFound if there is no such task.
+func (StoreValidation) Dependencies(id, org platform.ID, dependsOn []platform.ID, lookup func(platform.ID) (platform.ID, []platform.ID, error)) error {
+	for _, dep := range dependsOn {
+		depOrg, _, err := lookup(dep)
+		if err == ErrTaskNotFound {
+			return fmt.Errorf("dependsOn: task %s not found", dep)
+		}
+		if err != nil {
+			return err
+		}
+		if depOrg != org {
+			return fmt.Errorf("dependsOn: task %s belongs to another organization", dep)
+		}
+	}
+
+	// Walk the graph upstream from the dependencies; reaching id means there would be a cycle.
+	visited := make(map[platform.ID]bool)
+	var walk func(path []platform.ID, deps []platform.ID) error
+	walk = func(path []platform.ID, deps []platform.ID) error {
+		for _, dep := range deps {
+			if dep == id {
+				ids := make([]string, 0, len(path)+1)
+				for _, p := range path {
+					ids = append(ids, p.String())
+				}
+				ids = append(ids, dep.String())
+				return fmt.Errorf("dependsOn would create a cycle: %s", strings.Join(ids, " -> "))
+			}
+			if visited[dep] {
+				continue
+			}
+			visited[dep] = true
+
+			_, upstream, err := lookup(dep)
+			if err == ErrTaskNotFound {
+				// Dependencies of deleted tasks can't be part of a cycle.
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			if err := walk(append(path, dep), upstream); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return walk([]platform.ID{id}, dependsOn)
+}
+
 // UpdateArgs validates the UpdateTaskRequest.
 // If the update only includes a new status (i.e. req.Script is empty), the returned options are zero.
 // If the update contains neither a new script nor a new status, or if the script is invalid, an error is returned.
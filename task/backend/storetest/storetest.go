@@ -32,7 +32,9 @@ func NewStoreTest(name string, cf CreateStoreFunc, df DestroyStoreFunc, funcName
 			"DeleteTask",
 			"CreateNextRun",
 			"FinishRun",
+			"RequeueRun",
 			"ManuallyRunTimeRange",
+			"Dependencies",
 		}
 	}
 	availableFuncs := map[string]TestFunc{
@@ -45,7 +47,9 @@ func NewStoreTest(name string, cf CreateStoreFunc, df DestroyStoreFunc, funcName
 		"DeleteTask":           testStoreDelete,
 		"CreateNextRun":        testStoreCreateNextRun,
 		"FinishRun":            testStoreFinishRun,
+		"RequeueRun":           testStoreRequeueRun,
 		"ManuallyRunTimeRange": testStoreManuallyRunTimeRange,
+		"Dependencies":         testStoreDependencies,
 		"DeleteOrg":            testStoreDeleteOrg,
 		"DeleteUser":           testStoreDeleteUser,
 	}
@@ -219,6 +223,61 @@ from(bucket:"y") |> range(start:-1h)`
 		}
 	})
 
+	t.Run("retry options", func(t *testing.T) {
+		const scriptRetry = `option task = {
+		name: "a task",
+		cron: "* * * * *",
+		retry: 3,
+		retryDelay: 30s,
+		maxRetryDelay: 5m,
+	}
+
+from(bucket:"x") |> range(start:-1h)`
+
+		s := create(t)
+		defer destroy(t, s)
+
+		id, err := s.CreateTask(context.Background(), backend.CreateTaskRequest{Org: 1, User: 2, Script: script})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := s.UpdateTask(context.Background(), backend.UpdateTaskRequest{ID: id, Script: scriptRetry}); err != nil {
+			t.Fatal(err)
+		}
+		meta, err := s.FindTaskMetaByID(context.Background(), id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if meta.MaxTries != 3 || meta.RetryDelay != 30 || meta.MaxRetryDelay != 300 {
+			t.Fatalf("retry options did not update: max tries %d, retry delay %d, max retry delay %d", meta.MaxTries, meta.RetryDelay, meta.MaxRetryDelay)
+		}
+
+		// Updating only the status keeps the retry options.
+		if _, err := s.UpdateTask(context.Background(), backend.UpdateTaskRequest{ID: id, Status: backend.TaskInactive}); err != nil {
+			t.Fatal(err)
+		}
+		meta, err = s.FindTaskMetaByID(context.Background(), id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if meta.MaxTries != 3 {
+			t.Fatalf("expected retry options to be kept on status update, got max tries %d", meta.MaxTries)
+		}
+
+		// Removing the retry options from the script restores the defaults.
+		if _, err := s.UpdateTask(context.Background(), backend.UpdateTaskRequest{ID: id, Script: script}); err != nil {
+			t.Fatal(err)
+		}
+		meta, err = s.FindTaskMetaByID(context.Background(), id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if meta.MaxTries != 1 || meta.RetryDelay != 0 || meta.MaxRetryDelay != 0 {
+			t.Fatalf("retry options were not reset: max tries %d, retry delay %d, max retry delay %d", meta.MaxTries, meta.RetryDelay, meta.MaxRetryDelay)
+		}
+	})
+
 	for _, args := range []struct {
 		caseName string
 		req      backend.UpdateTaskRequest
@@ -947,6 +1006,60 @@ from(bucket:"test") |> range(start:-1h)`
 	}
 }
 
+func testStoreRequeueRun(t *testing.T, create CreateStoreFunc, destroy DestroyStoreFunc) {
+	const script = `option task = {
+		name: "a task",
+		cron: "* * * * *",
+		retry: 3,
+		retryDelay: 30s,
+	}
+
+from(bucket:"test") |> range(start:-1h)`
+	s := create(t)
+	defer destroy(t, s)
+
+	task, err := s.CreateTask(context.Background(), backend.CreateTaskRequest{Org: 1, User: 2, Script: script})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	meta, err := s.FindTaskMetaByID(context.Background(), task)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if meta.MaxTries != 3 || meta.RetryDelay != 30 {
+		t.Fatalf("unexpected retry options in meta: max tries %d, retry delay %d", meta.MaxTries, meta.RetryDelay)
+	}
+
+	rc, err := s.CreateNextRun(context.Background(), task, 60)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	try, err := s.RequeueRun(context.Background(), task, rc.Created.RunID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if try != 2 {
+		t.Fatalf("expected try 2 after requeue, got %d", try)
+	}
+
+	meta, err = s.FindTaskMetaByID(context.Background(), task)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(meta.CurrentlyRunning) != 1 || meta.CurrentlyRunning[0].Try != 2 {
+		t.Fatalf("expected requeued run to still be running with try 2, got %v", meta.CurrentlyRunning)
+	}
+
+	if err := s.FinishRun(context.Background(), task, rc.Created.RunID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.RequeueRun(context.Background(), task, rc.Created.RunID); err == nil {
+		t.Fatal("expected failure when requeuing run that doesnt exist")
+	}
+}
+
 func testStoreManuallyRunTimeRange(t *testing.T, create CreateStoreFunc, destroy DestroyStoreFunc) {
 	const script = `option task = {
 		name: "a task",
@@ -984,6 +1097,146 @@ from(bucket:"test") |> range(start:-1h)`
 	}
 }
 
+func testStoreDependencies(t *testing.T, create CreateStoreFunc, destroy DestroyStoreFunc) {
+	const scheduledScript = `option task = {
+		name: "a task",
+		cron: "* * * * *",
+	}
+
+from(bucket:"test") |> range(start:-1h)`
+	dependentScript := func(ids ...platform.ID) string {
+		deps := ""
+		for i, id := range ids {
+			if i > 0 {
+				deps += ", "
+			}
+			deps += fmt.Sprintf("%q", id.String())
+		}
+		return fmt.Sprintf(`option task = {
+		name: "a dependent task",
+		dependsOn: [%s],
+	}
+
+from(bucket:"test") |> range(start:-1h)`, deps)
+	}
+
+	s := create(t)
+	defer destroy(t, s)
+
+	org, user := idGen.ID(), idGen.ID()
+	a, err := s.CreateTask(context.Background(), backend.CreateTaskRequest{Org: org, User: user, Script: scheduledScript})
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := s.CreateTask(context.Background(), backend.CreateTaskRequest{Org: org, User: user, Script: scheduledScript})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := s.CreateTask(context.Background(), backend.CreateTaskRequest{Org: org, User: user, Script: dependentScript(a, b)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("list dependents", func(t *testing.T) {
+		for _, up := range []platform.ID{a, b} {
+			ids, err := s.ListDependents(context.Background(), up)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(ids) != 1 || ids[0] != c {
+				t.Fatalf("expected dependents of %s to be [%s], got %v", up, c, ids)
+			}
+		}
+
+		ids, err := s.ListDependents(context.Background(), c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(ids) != 0 {
+			t.Fatalf("expected no dependents of %s, got %v", c, ids)
+		}
+	})
+
+	t.Run("invalid dependencies", func(t *testing.T) {
+		if _, err := s.CreateTask(context.Background(), backend.CreateTaskRequest{Org: org, User: user, Script: dependentScript(idGen.ID())}); err == nil {
+			t.Fatal("expected error when depending on a task that does not exist")
+		}
+		if _, err := s.CreateTask(context.Background(), backend.CreateTaskRequest{Org: idGen.ID(), User: user, Script: dependentScript(a)}); err == nil {
+			t.Fatal("expected error when depending on a task in another org")
+		}
+		if _, err := s.UpdateTask(context.Background(), backend.UpdateTaskRequest{ID: a, Script: dependentScript(c)}); err == nil {
+			t.Fatal("expected error when update would create a dependency cycle")
+		}
+		if _, err := s.UpdateTask(context.Background(), backend.UpdateTaskRequest{ID: c, Script: dependentScript(c)}); err == nil {
+			t.Fatal("expected error when task depends on itself")
+		}
+	})
+
+	t.Run("trigger dependents", func(t *testing.T) {
+		ids, err := s.TriggerDependents(context.Background(), a, 60)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(ids) != 0 {
+			t.Fatalf("expected no dependents triggered before all upstream tasks succeeded, got %v", ids)
+		}
+
+		// A run of b for a different now must not complete the set for a's run.
+		ids, err = s.TriggerDependents(context.Background(), b, 120)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(ids) != 0 {
+			t.Fatalf("expected no dependents triggered for mismatched now, got %v", ids)
+		}
+
+		ids, err = s.TriggerDependents(context.Background(), b, 60)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(ids) != 1 || ids[0] != c {
+			t.Fatalf("expected [%s] to be triggered, got %v", c, ids)
+		}
+
+		rc, err := s.CreateNextRun(context.Background(), c, 60)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rc.Created.Now != 60 {
+			t.Fatalf("expected dependent run for now 60, got %d", rc.Created.Now)
+		}
+		if rc.NextDue != math.MaxInt64 {
+			t.Fatalf("expected dependent task to never be due on its own, got next due %d", rc.NextDue)
+		}
+
+		if _, err := s.CreateNextRun(context.Background(), c, 120); err == nil {
+			t.Fatal("expected no further runs for dependent task")
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		if _, err := s.DeleteTask(context.Background(), c); err != nil {
+			t.Fatal(err)
+		}
+
+		ids, err := s.ListDependents(context.Background(), a)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(ids) != 0 {
+			t.Fatalf("expected deleted task to be removed from dependents, got %v", ids)
+		}
+
+		ids, err = s.TriggerDependents(context.Background(), b, 180)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(ids) != 0 {
+			t.Fatalf("expected no dependents triggered after delete, got %v", ids)
+		}
+	})
+}
+
 func testStoreDeleteUser(t *testing.T, create CreateStoreFunc, destroy DestroyStoreFunc) {
 	s := create(t)
 	defer destroy(t, s)
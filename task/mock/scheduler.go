@@ -243,6 +243,25 @@ func (d *DesiredState) FinishRun(_ context.Context, taskID, runID platform.ID) e
 	return nil
 }
 
+func (d *DesiredState) RequeueRun(_ context.Context, taskID, runID platform.ID) (uint32, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	tid := taskID.String()
+	m := d.meta[tid]
+	try := m.RequeueRun(runID)
+	if try == 0 {
+		return 0, fmt.Errorf("unknown run ID %s", runID)
+	}
+	d.meta[tid] = m
+	return try, nil
+}
+
+// TriggerDependents is a no-op, as DesiredState does not track dependencies between tasks.
+func (d *DesiredState) TriggerDependents(_ context.Context, taskID platform.ID, now int64) ([]platform.ID, error) {
+	return nil, nil
+}
+
 func (d *DesiredState) CreatedFor(taskID platform.ID) []backend.QueuedRun {
 	d.mu.Lock()
 	defer d.mu.Unlock()
@@ -10,6 +10,8 @@ import (
 
 	"github.com/influxdata/flux"
 	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+	"github.com/influxdata/platform"
 	cron "gopkg.in/robfig/cron.v2"
 )
 
@@ -27,6 +29,7 @@ func EnableScriptCacheForTest() {
 
 const maxConcurrency = 100
 const maxRetry = 10
+const maxRetryDelay = 24 * time.Hour
 
 // Options are the task-related options that can be specified in a Flux script.
 type Options struct {
@@ -42,9 +45,24 @@ type Options struct {
 	// Offset represents a delay before execution.
 	Offset time.Duration
 
+	// DependsOn lists the IDs of the tasks this task depends on, and can be used in place of Cron or Every.
+	// A task with dependencies runs, for the same now, once all of its upstream tasks have succeeded.
+	DependsOn []platform.ID
+
 	Concurrency int64
 
+	// Retry is the number of times a run is attempted before it is marked as failed.
+	// A value of 1 means failed runs are not retried.
 	Retry int64
+
+	// RetryDelay is the delay before the first retry of a failed run.
+	// The delay doubles with each subsequent try, up to MaxRetryDelay.
+	// If zero, the scheduler's default is used.
+	RetryDelay time.Duration
+
+	// MaxRetryDelay is the upper bound of the delay before a retry.
+	// If zero, the scheduler's default is used.
+	MaxRetryDelay time.Duration
 }
 
 // FromScript extracts Options from a Flux script.
@@ -85,10 +103,14 @@ func FromScript(script string) (Options, error) {
 
 	crVal, cronOK := optObject.Get("cron")
 	everyVal, everyOK := optObject.Get("every")
+	dependsOnVal, dependsOnOK := optObject.Get("dependsOn")
 	if cronOK && everyOK {
 		return opt, errors.New("cannot use both cron and every in task options")
 	}
-	if !cronOK && !everyOK {
+	if dependsOnOK && (cronOK || everyOK) {
+		return opt, errors.New("cannot use dependsOn with cron or every in task options")
+	}
+	if !cronOK && !everyOK && !dependsOnOK {
 		return opt, errors.New("cron or every is required")
 	}
 
@@ -106,6 +128,14 @@ func FromScript(script string) (Options, error) {
 		opt.Every = everyVal.Duration().Duration()
 	}
 
+	if dependsOnOK {
+		deps, err := dependsOnFromValue(dependsOnVal)
+		if err != nil {
+			return opt, err
+		}
+		opt.DependsOn = deps
+	}
+
 	if offsetVal, ok := optObject.Get("offset"); ok {
 		if err := checkNature(offsetVal.PolyType().Nature(), semantic.Duration); err != nil {
 			return opt, err
@@ -127,6 +157,20 @@ func FromScript(script string) (Options, error) {
 		opt.Retry = retryVal.Int()
 	}
 
+	if retryDelayVal, ok := optObject.Get("retryDelay"); ok {
+		if err := checkNature(retryDelayVal.PolyType().Nature(), semantic.Duration); err != nil {
+			return opt, err
+		}
+		opt.RetryDelay = retryDelayVal.Duration().Duration()
+	}
+
+	if maxRetryDelayVal, ok := optObject.Get("maxRetryDelay"); ok {
+		if err := checkNature(maxRetryDelayVal.PolyType().Nature(), semantic.Duration); err != nil {
+			return opt, err
+		}
+		opt.MaxRetryDelay = maxRetryDelayVal.Duration().Duration()
+	}
+
 	if err := opt.Validate(); err != nil {
 		return opt, err
 	}
@@ -149,7 +193,23 @@ func (o *Options) Validate() error {
 
 	cronPresent := o.Cron != ""
 	everyPresent := o.Every != 0
-	if cronPresent == everyPresent {
+	if len(o.DependsOn) > 0 {
+		if cronPresent || everyPresent {
+			errs = append(errs, "dependsOn cannot be combined with cron or every")
+		}
+		if o.Offset != 0 {
+			errs = append(errs, "offset option cannot be combined with dependsOn")
+		}
+		seen := make(map[platform.ID]bool, len(o.DependsOn))
+		for _, id := range o.DependsOn {
+			if !id.Valid() {
+				errs = append(errs, "dependsOn contains an invalid task ID")
+			} else if seen[id] {
+				errs = append(errs, fmt.Sprintf("dependsOn contains task %s more than once", id))
+			}
+			seen[id] = true
+		}
+	} else if cronPresent == everyPresent {
 		// They're both present or both missing.
 		errs = append(errs, "must specify exactly one of either cron or every")
 	} else if cronPresent {
@@ -182,6 +242,25 @@ func (o *Options) Validate() error {
 		errs = append(errs, fmt.Sprintf("retry exceeded max of %d", maxRetry))
 	}
 
+	for _, d := range []struct {
+		name string
+		d    time.Duration
+	}{
+		{"retryDelay", o.RetryDelay},
+		{"maxRetryDelay", o.MaxRetryDelay},
+	} {
+		if d.d < 0 {
+			errs = append(errs, d.name+" option must not be negative")
+		} else if d.d > maxRetryDelay {
+			errs = append(errs, fmt.Sprintf("%s option exceeded max of %s", d.name, maxRetryDelay))
+		} else if d.d.Truncate(time.Second) != d.d {
+			errs = append(errs, d.name+" option must be expressible as whole seconds")
+		}
+	}
+	if o.MaxRetryDelay != 0 && o.MaxRetryDelay < o.RetryDelay {
+		errs = append(errs, "maxRetryDelay option must not be less than retryDelay")
+	}
+
 	if len(errs) == 0 {
 		return nil
 	}
@@ -192,7 +271,7 @@ func (o *Options) Validate() error {
 // EffectiveCronString returns the effective cron string of the options.
 // If the cron option was specified, it is returned.
 // If the every option was specified, it is converted into a cron string using "@every".
-// Otherwise, the empty string is returned.
+// Otherwise, such as for a task scheduled by its dependsOn option, the empty string is returned.
 // The value of the offset option is not considered.
 func (o *Options) EffectiveCronString() string {
 	if o.Cron != "" {
@@ -204,6 +283,39 @@ func (o *Options) EffectiveCronString() string {
 	return ""
 }
 
+// dependsOnFromValue converts the value of the dependsOn option, an array of task ID strings, to task IDs.
+func dependsOnFromValue(v values.Value) ([]platform.ID, error) {
+	if err := checkNature(v.PolyType().Nature(), semantic.Array); err != nil {
+		return nil, err
+	}
+
+	arr := v.Array()
+	if arr.Len() == 0 {
+		return nil, errors.New("dependsOn must list at least one task ID")
+	}
+
+	deps := make([]platform.ID, 0, arr.Len())
+	var err error
+	arr.Range(func(i int, v values.Value) {
+		if err != nil {
+			return
+		}
+		if err = checkNature(v.PolyType().Nature(), semantic.String); err != nil {
+			return
+		}
+		var id platform.ID
+		if err = id.DecodeFromString(v.Str()); err != nil {
+			err = fmt.Errorf("invalid task ID %q in dependsOn: %v", v.Str(), err)
+			return
+		}
+		deps = append(deps, id)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return deps, nil
+}
+
 // checkNature returns a clean error of got and expected dont match.
 func checkNature(got, exp semantic.Nature) error {
 	if got != exp {
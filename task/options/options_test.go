@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/platform"
 	_ "github.com/influxdata/platform/query/builtin"
 	"github.com/influxdata/platform/task/options"
 )
@@ -22,6 +23,16 @@ func scriptGenerator(opt options.Options, body string) string {
 	if opt.Every != 0 {
 		taskData = fmt.Sprintf("%s  every: %s,\n", taskData, opt.Every.String())
 	}
+	if len(opt.DependsOn) > 0 {
+		ids := ""
+		for i, id := range opt.DependsOn {
+			if i > 0 {
+				ids += ", "
+			}
+			ids += fmt.Sprintf("%q", id.String())
+		}
+		taskData = fmt.Sprintf("%s  dependsOn: [%s],\n", taskData, ids)
+	}
 	if opt.Offset != 0 {
 		taskData = fmt.Sprintf("%s  offset: %s,\n", taskData, opt.Offset.String())
 	}
@@ -31,6 +42,12 @@ func scriptGenerator(opt options.Options, body string) string {
 	if opt.Retry != 0 {
 		taskData = fmt.Sprintf("%s  retry: %d,\n", taskData, opt.Retry)
 	}
+	if opt.RetryDelay != 0 {
+		taskData = fmt.Sprintf("%s  retryDelay: %s,\n", taskData, opt.RetryDelay.String())
+	}
+	if opt.MaxRetryDelay != 0 {
+		taskData = fmt.Sprintf("%s  maxRetryDelay: %s,\n", taskData, opt.MaxRetryDelay.String())
+	}
 	if body == "" {
 		body = `from(bucket: "test")
     |> range(start:-1h)`
@@ -58,6 +75,15 @@ func TestFromScript(t *testing.T) {
 		{script: "option task = {\n  name: \"name\",\n  concurrency: 1,\n  every: 1,\n\n}\n\nfrom(bucket: \"test\")\n    |> range(start:-1h)", shouldErr: true},
 		{script: scriptGenerator(options.Options{Name: "name", Retry: 20, Every: time.Hour}, ""), shouldErr: true},
 		{script: "option task = {\n  name: \"name\",\n  retry: 0,\n  every: 1m0s,\n\n}\n\nfrom(bucket: \"test\")\n    |> range(start:-1h)", shouldErr: true},
+		{script: scriptGenerator(options.Options{Name: "name", Every: time.Hour, Retry: 5, RetryDelay: 30 * time.Second, MaxRetryDelay: 10 * time.Minute}, ""), exp: options.Options{Name: "name", Every: time.Hour, Concurrency: 1, Retry: 5, RetryDelay: 30 * time.Second, MaxRetryDelay: 10 * time.Minute}},
+		{script: scriptGenerator(options.Options{Name: "name", Every: time.Hour, RetryDelay: time.Minute, MaxRetryDelay: time.Second}, ""), shouldErr: true},
+		{script: "option task = {\n  name: \"name\",\n  retryDelay: 1,\n  every: 1m0s,\n\n}\n\nfrom(bucket: \"test\")\n    |> range(start:-1h)", shouldErr: true},
+		{script: scriptGenerator(options.Options{Name: "name", DependsOn: []platform.ID{1, 2}}, ""), exp: options.Options{Name: "name", DependsOn: []platform.ID{1, 2}, Concurrency: 1, Retry: 1}},
+		{script: scriptGenerator(options.Options{Name: "name", DependsOn: []platform.ID{1}, Every: time.Hour}, ""), shouldErr: true},
+		{script: scriptGenerator(options.Options{Name: "name", DependsOn: []platform.ID{1}, Offset: time.Minute}, ""), shouldErr: true},
+		{script: scriptGenerator(options.Options{Name: "name", DependsOn: []platform.ID{1, 1}}, ""), shouldErr: true},
+		{script: "option task = {\n  name: \"name\",\n  dependsOn: [\"not an id\"],\n\n}\n\nfrom(bucket: \"test\")\n    |> range(start:-1h)", shouldErr: true},
+		{script: "option task = {\n  name: \"name\",\n  dependsOn: \"0000000000000001\",\n\n}\n\nfrom(bucket: \"test\")\n    |> range(start:-1h)", shouldErr: true},
 		{script: scriptGenerator(options.Options{Name: "name"}, ""), shouldErr: true},
 		{script: scriptGenerator(options.Options{}, ""), shouldErr: true},
 	} {
@@ -144,6 +170,48 @@ func TestValidate(t *testing.T) {
 	if err := bad.Validate(); err == nil {
 		t.Error("expected error for retry too large")
 	}
+
+	*bad = good
+	bad.RetryDelay = -time.Second
+	if err := bad.Validate(); err == nil {
+		t.Error("expected error for negative retry delay")
+	}
+
+	*bad = good
+	bad.RetryDelay = 1500 * time.Millisecond
+	if err := bad.Validate(); err == nil {
+		t.Error("expected error for sub-second retry delay resolution")
+	}
+
+	*bad = good
+	bad.MaxRetryDelay = 48 * time.Hour
+	if err := bad.Validate(); err == nil {
+		t.Error("expected error for max retry delay too large")
+	}
+
+	*bad = good
+	bad.RetryDelay = time.Minute
+	bad.MaxRetryDelay = time.Second
+	if err := bad.Validate(); err == nil {
+		t.Error("expected error for max retry delay less than retry delay")
+	}
+
+	dependent := options.Options{Name: "x", DependsOn: []platform.ID{1}, Concurrency: 1, Retry: 1}
+	if err := dependent.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	*bad = dependent
+	bad.Cron = "* * * * *"
+	if err := bad.Validate(); err == nil {
+		t.Error("expected error for options with both cron and dependsOn")
+	}
+
+	*bad = dependent
+	bad.DependsOn = []platform.ID{0}
+	if err := bad.Validate(); err == nil {
+		t.Error("expected error for invalid dependency ID")
+	}
 }
 
 func TestEffectiveCronString(t *testing.T) {
@@ -97,6 +97,7 @@ func (p pAdapter) CreateTask(ctx context.Context, t *platform.Task) error {
 	t.ID = id
 	t.Every = opts.Every.String()
 	t.Cron = opts.Cron
+	t.DependsOn = opts.DependsOn
 
 	return nil
 }
@@ -124,14 +125,15 @@ func (p pAdapter) UpdateTask(ctx context.Context, id platform.ID, upd platform.T
 	}
 
 	task := &platform.Task{
-		ID:     id,
-		Name:   opts.Name,
-		Status: res.NewMeta.Status,
-		Owner:  platform.User{},
-		Flux:   res.NewTask.Script,
-		Every:  opts.Every.String(),
-		Cron:   opts.Cron,
-		Offset: opts.Offset.String(),
+		ID:        id,
+		Name:      opts.Name,
+		Status:    res.NewMeta.Status,
+		Owner:     platform.User{},
+		Flux:      res.NewTask.Script,
+		Every:     opts.Every.String(),
+		Cron:      opts.Cron,
+		Offset:    opts.Offset.String(),
+		DependsOn: opts.DependsOn,
 	}
 
 	t, err := p.s.FindTaskByID(ctx, id)
@@ -209,6 +211,71 @@ func (p pAdapter) CancelRun(ctx context.Context, taskID, runID platform.ID) erro
 	return p.rc.CancelRun(ctx, taskID, runID)
 }
 
+func (p pAdapter) FindTaskDependencies(ctx context.Context, id platform.ID) (*platform.TaskDependencies, error) {
+	deps := &platform.TaskDependencies{
+		TaskID:     id,
+		DependsOn:  []platform.ID{},
+		Dependents: []platform.ID{},
+		Edges:      []platform.TaskDependency{},
+	}
+
+	// Walk upstream through the dependsOn option of each task's script.
+	seen := map[platform.ID]bool{id: true}
+	queue := []platform.ID{id}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+
+		t, err := p.s.FindTaskByID(ctx, cur)
+		if err != nil {
+			if err == backend.ErrTaskNotFound && cur != id {
+				// The upstream task was deleted; the edge remains but the walk stops here.
+				continue
+			}
+			return nil, err
+		}
+		opts, err := options.FromScript(t.Script)
+		if err != nil {
+			return nil, err
+		}
+		for _, up := range opts.DependsOn {
+			if cur == id {
+				deps.DependsOn = append(deps.DependsOn, up)
+			}
+			deps.Edges = append(deps.Edges, platform.TaskDependency{Upstream: up, Downstream: cur})
+			if !seen[up] {
+				seen[up] = true
+				queue = append(queue, up)
+			}
+		}
+	}
+
+	// Walk downstream through the store's dependents index.
+	seen = map[platform.ID]bool{id: true}
+	queue = []platform.ID{id}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+
+		downs, err := p.s.ListDependents(ctx, cur)
+		if err != nil {
+			return nil, err
+		}
+		for _, down := range downs {
+			if cur == id {
+				deps.Dependents = append(deps.Dependents, down)
+			}
+			deps.Edges = append(deps.Edges, platform.TaskDependency{Upstream: cur, Downstream: down})
+			if !seen[down] {
+				seen[down] = true
+				queue = append(queue, down)
+			}
+		}
+	}
+
+	return deps, nil
+}
+
 func toPlatformTask(t backend.StoreTask, m *backend.StoreTaskMeta) (*platform.Task, error) {
 	opts, err := options.FromScript(t.Script)
 	if err != nil {
@@ -223,8 +290,9 @@ func toPlatformTask(t backend.StoreTask, m *backend.StoreTaskMeta) (*platform.Ta
 			ID:   t.User,
 			Name: "", // TODO(mr): how to get owner name?
 		},
-		Flux: t.Script,
-		Cron: opts.Cron,
+		Flux:      t.Script,
+		Cron:      opts.Cron,
+		DependsOn: opts.DependsOn,
 	}
 	if opts.Every != 0 {
 		pt.Every = opts.Every.String()
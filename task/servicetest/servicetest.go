@@ -76,6 +76,11 @@ func TestTaskService(t *testing.T, fn BackendComponentFactory) {
 			t.Parallel()
 			testMetaUpdate(t, sys)
 		})
+
+		t.Run("Task Dependencies", func(t *testing.T) {
+			t.Parallel()
+			testTaskDependencies(t, sys)
+		})
 	})
 }
 
@@ -274,6 +279,56 @@ func testMetaUpdate(t *testing.T, sys *System) {
 	}
 }
 
+func testTaskDependencies(t *testing.T, sys *System) {
+	orgID, userID, _ := creds(t, sys)
+
+	up := &platform.Task{Organization: orgID, Owner: platform.User{ID: userID}, Flux: fmt.Sprintf(scriptFmt, 0)}
+	if err := sys.ts.CreateTask(sys.Ctx, up); err != nil {
+		t.Fatal(err)
+	}
+	down := &platform.Task{Organization: orgID, Owner: platform.User{ID: userID}, Flux: fmt.Sprintf(dependentScriptFmt, up.ID.String())}
+	if err := sys.ts.CreateTask(sys.Ctx, down); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := sys.ts.FindTaskByID(sys.Ctx, down.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(f.DependsOn) != 1 || f.DependsOn[0] != up.ID {
+		t.Fatalf("expected task to depend on %s, got %v", up.ID, f.DependsOn)
+	}
+
+	edge := platform.TaskDependency{Upstream: up.ID, Downstream: down.ID}
+	deps, err := sys.ts.FindTaskDependencies(sys.Ctx, down.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deps.DependsOn) != 1 || deps.DependsOn[0] != up.ID || len(deps.Dependents) != 0 {
+		t.Fatalf("unexpected dependencies of downstream task: %#v", deps)
+	}
+	if len(deps.Edges) != 1 || deps.Edges[0] != edge {
+		t.Fatalf("unexpected edges of downstream task: %#v", deps.Edges)
+	}
+
+	deps, err = sys.ts.FindTaskDependencies(sys.Ctx, up.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deps.Dependents) != 1 || deps.Dependents[0] != down.ID || len(deps.DependsOn) != 0 {
+		t.Fatalf("unexpected dependencies of upstream task: %#v", deps)
+	}
+	if len(deps.Edges) != 1 || deps.Edges[0] != edge {
+		t.Fatalf("unexpected edges of upstream task: %#v", deps.Edges)
+	}
+
+	// Making the upstream task depend on its dependent would create a cycle.
+	cyclic := fmt.Sprintf(dependentScriptFmt, down.ID.String())
+	if _, err := sys.ts.UpdateTask(sys.Ctx, up.ID, platform.TaskUpdate{Flux: &cyclic}); err == nil {
+		t.Fatal("expected error when update would create a dependency cycle")
+	}
+}
+
 func testTaskRuns(t *testing.T, sys *System) {
 	orgID, userID, _ := creds(t, sys)
 
@@ -769,4 +824,10 @@ const scriptFmt = `option task = {
 }
 from(bucket:"b") |> toHTTP(url:"http://example.com")`
 
+const dependentScriptFmt = `option task = {
+	name: "dependent task",
+	dependsOn: [%q],
+}
+from(bucket:"b") |> toHTTP(url:"http://example.com")`
+
 var idGen = snowflake.NewIDGenerator()
@@ -6,10 +6,10 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/influxdata/flux"
 	"github.com/influxdata/platform"
 	platcontext "github.com/influxdata/platform/context"
 	"github.com/influxdata/platform/query"
+	"github.com/influxdata/platform/query/functions"
 )
 
 type authError struct {
@@ -41,13 +41,28 @@ func (ts *taskServiceValidator) CreateTask(ctx context.Context, t *platform.Task
 		return err
 	}
 
-	if err := validateBucket(ctx, t.Flux, ts.preAuth); err != nil {
+	if err := validateScript(ctx, t.Flux, t.Organization, ts.preAuth); err != nil {
 		return err
 	}
 
 	return ts.TaskService.CreateTask(ctx, t)
 }
 
+func (ts *taskServiceValidator) UpdateTask(ctx context.Context, id platform.ID, upd platform.TaskUpdate) (*platform.Task, error) {
+	if upd.Flux != nil {
+		t, err := ts.TaskService.FindTaskByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := validateScript(ctx, *upd.Flux, t.Organization, ts.preAuth); err != nil {
+			return nil, err
+		}
+	}
+
+	return ts.TaskService.UpdateTask(ctx, id, upd)
+}
+
 // TODO(lh): add permission checking for the all the platform.TaskService functions.
 
 func validatePermission(ctx context.Context, perm platform.Permission) error {
@@ -63,17 +78,31 @@ func validatePermission(ctx context.Context, perm platform.Permission) error {
 	return nil
 }
 
-func validateBucket(ctx context.Context, script string, preAuth query.PreAuthorizer) error {
+// validateScript checks that the authorizer on the context may read and write
+// the buckets of a task script, and read the secrets of orgID if the script
+// requests any, as task runs resolve them without an authorization.
+func validateScript(ctx context.Context, script string, orgID platform.ID, preAuth query.PreAuthorizer) error {
 	auth, err := platcontext.GetAuthorizer(ctx)
 	if err != nil {
 		return err
 	}
 
-	spec, err := flux.Compile(ctx, script, time.Now())
+	// Secrets do not affect the buckets a script reads and writes.
+	var usesSecrets bool
+	spec, err := functions.Compile(ctx, script, time.Now(), func(key string) (string, error) {
+		usesSecrets = true
+		return functions.IgnoreSecrets(key)
+	})
 	if err != nil {
 		return err
 	}
 
+	if usesSecrets {
+		if err := validatePermission(ctx, platform.NewPermission(platform.ReadAction, platform.SecretResourceType, orgID)); err != nil {
+			return err
+		}
+	}
+
 	if err := preAuth.PreAuthorize(ctx, spec, auth); err != nil {
 		return err
 	}
@@ -0,0 +1,91 @@
+package task_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/platform"
+	pctx "github.com/influxdata/platform/context"
+	"github.com/influxdata/platform/mock"
+	_ "github.com/influxdata/platform/query/builtin"
+	"github.com/influxdata/platform/task"
+)
+
+const (
+	validatorOrgID    = platform.ID(1)
+	validatorBucketID = platform.ID(10)
+	validatorTaskID   = platform.ID(100)
+)
+
+const (
+	plainScript  = `from(bucket: "b") |> range(start: -1h)`
+	secretScript = `token = secrets.get(key: "token")
+from(bucket: "b") |> range(start: -1h)`
+)
+
+func newValidator() platform.TaskService {
+	ts := &mock.TaskService{
+		CreateTaskFn: func(context.Context, *platform.Task) error { return nil },
+		FindTaskByIDFn: func(_ context.Context, id platform.ID) (*platform.Task, error) {
+			return &platform.Task{ID: id, Organization: validatorOrgID, Flux: plainScript}, nil
+		},
+		UpdateTaskFn: func(_ context.Context, id platform.ID, upd platform.TaskUpdate) (*platform.Task, error) {
+			return &platform.Task{ID: id, Organization: validatorOrgID, Flux: *upd.Flux}, nil
+		},
+	}
+	bs := mock.NewBucketService()
+	bs.FindBucketFn = func(context.Context, platform.BucketFilter) (*platform.Bucket, error) {
+		return &platform.Bucket{ID: validatorBucketID, Name: "b", OrganizationID: validatorOrgID}, nil
+	}
+	return task.NewValidator(ts, bs)
+}
+
+func validatorContext(perms ...platform.Permission) context.Context {
+	perms = append(perms,
+		platform.NewPermission(platform.CreateAction, platform.TaskResourceType, validatorOrgID),
+		platform.NewPermission(platform.WriteAction, platform.TaskResourceType, validatorOrgID),
+		platform.NewPermissionAtID(validatorBucketID, platform.ReadAction, platform.BucketResourceType, validatorOrgID),
+	)
+	return pctx.SetAuthorizer(context.Background(), &platform.Authorization{
+		ID:          1,
+		Status:      platform.Active,
+		Permissions: perms,
+	})
+}
+
+func TestValidator_Secrets(t *testing.T) {
+	readSecrets := platform.NewPermission(platform.ReadAction, platform.SecretResourceType, validatorOrgID)
+
+	tests := []struct {
+		name    string
+		script  string
+		perms   []platform.Permission
+		wantErr bool
+	}{
+		{name: "no secrets", script: plainScript},
+		{name: "task-only authorization", script: secretScript, wantErr: true},
+		{name: "secret read permission", script: secretScript, perms: []platform.Permission{readSecrets}},
+		{
+			name:    "secret read permission of another org",
+			script:  secretScript,
+			perms:   []platform.Permission{platform.NewPermission(platform.ReadAction, platform.SecretResourceType, 2)},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := validatorContext(tt.perms...)
+			ts := newValidator()
+
+			err := ts.CreateTask(ctx, &platform.Task{Organization: validatorOrgID, Flux: tt.script})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CreateTask: got error %v, want error %v", err, tt.wantErr)
+			}
+
+			_, err = ts.UpdateTask(ctx, validatorTaskID, platform.TaskUpdate{Flux: &tt.script})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UpdateTask: got error %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,213 @@
+package testing
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/platform"
+)
+
+const (
+	auditOrgOneID   = "020f755c3c082000"
+	auditOrgTwoID   = "020f755c3c082001"
+	auditActorOneID = "020f755c3c082010"
+	auditActorTwoID = "020f755c3c082011"
+)
+
+// FindAuditEvents testing.
+func FindAuditEvents(
+	init func(*testing.T) (platform.AuditLogService, string, func()),
+	t *testing.T,
+) {
+	now := time.Date(2018, 12, 1, 17, 0, 0, 0, time.UTC)
+	orgOne := MustIDBase16(auditOrgOneID)
+	orgTwo := MustIDBase16(auditOrgTwoID)
+	actorOne := MustIDBase16(auditActorOneID)
+	actorTwo := MustIDBase16(auditActorTwoID)
+	bucketType := platform.BucketResourceType
+
+	events := []platform.AuditEvent{
+		{
+			Time:         now,
+			ActorID:      actorOne,
+			ActorKind:    "authorization",
+			Action:       platform.CreateAction,
+			ResourceType: platform.BucketResourceType,
+			ResourceID:   MustIDBase16(oneID),
+			OrgID:        orgOne,
+			After:        json.RawMessage(`{"name":"b1"}`),
+		},
+		{
+			Time:         now.Add(time.Minute),
+			ActorID:      actorTwo,
+			ActorKind:    "session",
+			Action:       platform.WriteAction,
+			ResourceType: platform.BucketResourceType,
+			ResourceID:   MustIDBase16(oneID),
+			OrgID:        orgOne,
+			Before:       json.RawMessage(`{"name":"b1"}`),
+			After:        json.RawMessage(`{"name":"b2"}`),
+		},
+		{
+			Time:         now.Add(2 * time.Minute),
+			ActorID:      actorOne,
+			ActorKind:    "authorization",
+			Action:       platform.DeleteAction,
+			ResourceType: platform.TaskResourceType,
+			ResourceID:   MustIDBase16(twoID),
+			OrgID:        orgTwo,
+			Before:       json.RawMessage(`{"name":"t1"}`),
+		},
+		{
+			Time:         now.Add(3 * time.Minute),
+			ActorID:      actorTwo,
+			ActorKind:    "session",
+			Action:       platform.CreateAction,
+			ResourceType: platform.DashboardResourceType,
+			ResourceID:   MustIDBase16(threeID),
+			After:        json.RawMessage(`{"name":"d1"}`),
+		},
+	}
+
+	type wants struct {
+		events []int
+		count  int
+	}
+
+	tests := []struct {
+		name   string
+		filter platform.AuditFilter
+		opts   platform.FindOptions
+		wants  wants
+	}{
+		{
+			name: "all events in time order",
+			wants: wants{
+				events: []int{0, 1, 2, 3},
+				count:  4,
+			},
+		},
+		{
+			name: "most recent first",
+			opts: platform.FindOptions{Descending: true},
+			wants: wants{
+				events: []int{3, 2, 1, 0},
+				count:  4,
+			},
+		},
+		{
+			name:   "filter by org",
+			filter: platform.AuditFilter{OrgID: &orgOne},
+			wants: wants{
+				events: []int{0, 1},
+				count:  2,
+			},
+		},
+		{
+			name:   "filter by resource type",
+			filter: platform.AuditFilter{ResourceType: &bucketType},
+			wants: wants{
+				events: []int{0, 1},
+				count:  2,
+			},
+		},
+		{
+			name:   "filter by actor",
+			filter: platform.AuditFilter{ActorID: &actorTwo},
+			wants: wants{
+				events: []int{1, 3},
+				count:  2,
+			},
+		},
+		{
+			name:   "filter by time range",
+			filter: platform.AuditFilter{Start: now.Add(time.Minute), Stop: now.Add(3 * time.Minute)},
+			wants: wants{
+				events: []int{1, 2},
+				count:  2,
+			},
+		},
+		{
+			name:   "filter by time range, most recent first",
+			filter: platform.AuditFilter{Start: now.Add(time.Minute), Stop: now.Add(3 * time.Minute)},
+			opts:   platform.FindOptions{Descending: true},
+			wants: wants{
+				events: []int{2, 1},
+				count:  2,
+			},
+		},
+		{
+			name:   "filter by time range after the last event",
+			filter: platform.AuditFilter{Start: now.Add(90 * time.Second), Stop: now.Add(time.Hour)},
+			opts:   platform.FindOptions{Descending: true},
+			wants: wants{
+				events: []int{3, 2},
+				count:  2,
+			},
+		},
+		{
+			name: "allowed events are paged",
+			filter: platform.AuditFilter{Allowed: func(e *platform.AuditEvent) bool {
+				return e.OrgID == orgOne
+			}},
+			opts: platform.FindOptions{Offset: 1, Limit: 1},
+			wants: wants{
+				events: []int{1},
+				count:  2,
+			},
+		},
+		{
+			name: "limit and offset",
+			opts: platform.FindOptions{Offset: 1, Limit: 2},
+			wants: wants{
+				events: []int{1, 2},
+				count:  4,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _, done := init(t)
+			defer done()
+			ctx := context.Background()
+
+			ids := map[platform.ID]bool{}
+			for i := range events {
+				e := events[i]
+				if err := s.RecordAuditEvent(ctx, &e); err != nil {
+					t.Fatalf("failed to record audit event: %v", err)
+				}
+				if !e.ID.Valid() || ids[e.ID] {
+					t.Fatalf("expected a unique valid ID for audit event, got %s", e.ID)
+				}
+				ids[e.ID] = true
+			}
+
+			es, n, err := s.FindAuditEvents(ctx, tt.filter, tt.opts)
+			if err != nil {
+				t.Fatalf("failed to find audit events: %v", err)
+			}
+			if n != tt.wants.count {
+				t.Errorf("audit event count is %d, want %d", n, tt.wants.count)
+			}
+
+			got := make([]platform.AuditEvent, 0, len(es))
+			for _, e := range es {
+				ev := *e
+				ev.ID = 0
+				got = append(got, ev)
+			}
+			want := make([]platform.AuditEvent, 0, len(tt.wants.events))
+			for _, i := range tt.wants.events {
+				want = append(want, events[i])
+			}
+			if diff := cmp.Diff(got, want); diff != "" {
+				t.Errorf("audit events are different -got/+want\ndiff %s", diff)
+			}
+		})
+	}
+}
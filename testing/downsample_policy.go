@@ -0,0 +1,548 @@
+package testing
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/platform"
+	"github.com/influxdata/platform/mock"
+)
+
+const (
+	downsampleOrgID        = "020f755c3c083000"
+	downsampleSourceID     = "020f755c3c084000"
+	downsampleSourceTwoID  = "020f755c3c084001"
+	downsampleDestID       = "020f755c3c085000"
+	downsampleTaskOneID    = "020f755c3c086000"
+	downsampleTaskTwoID    = "020f755c3c086001"
+	downsamplePolicyOneID  = "020f755c3c087000"
+	downsamplePolicyTwoID  = "020f755c3c087001"
+	downsamplePolicyNewID  = "020f755c3c087002"
+	downsamplePolicyNoneID = "020f755c3c087003"
+)
+
+var downsamplePolicyCmpOptions = cmp.Options{
+	cmp.Comparer(func(x, y []byte) bool {
+		return bytes.Equal(x, y)
+	}),
+	cmp.Transformer("Sort", func(in []*platform.DownsamplePolicy) []*platform.DownsamplePolicy {
+		out := append([]*platform.DownsamplePolicy(nil), in...)
+		sort.Slice(out, func(i, j int) bool {
+			return out[i].ID.String() > out[j].ID.String()
+		})
+		return out
+	}),
+}
+
+// DownsamplePolicyFields will include the IDGenerator and downsample policies.
+type DownsamplePolicyFields struct {
+	IDGenerator        platform.IDGenerator
+	DownsamplePolicies []*platform.DownsamplePolicy
+}
+
+// newTestDownsamplePolicy returns a policy downsampling sourceID in the test organization.
+func newTestDownsamplePolicy(id, name, sourceID string) *platform.DownsamplePolicy {
+	return &platform.DownsamplePolicy{
+		ID:                  MustIDBase16(id),
+		OrganizationID:      MustIDBase16(downsampleOrgID),
+		Name:                name,
+		SourceBucketID:      MustIDBase16(sourceID),
+		DestinationBucketID: MustIDBase16(downsampleDestID),
+		Window:              time.Hour,
+		Functions:           []string{platform.DownsampleMean},
+		TaskIDs: map[string]platform.ID{
+			platform.DownsampleMean: MustIDBase16(downsampleTaskOneID),
+		},
+	}
+}
+
+// DownsamplePolicyService tests all the service functions.
+func DownsamplePolicyService(
+	init func(DownsamplePolicyFields, *testing.T) (platform.DownsamplePolicyService, string, func()), t *testing.T,
+) {
+	tests := []struct {
+		name string
+		fn   func(init func(DownsamplePolicyFields, *testing.T) (platform.DownsamplePolicyService, string, func()),
+			t *testing.T)
+	}{
+		{
+			name: "CreateDownsamplePolicy",
+			fn:   CreateDownsamplePolicy,
+		},
+		{
+			name: "FindDownsamplePolicyByID",
+			fn:   FindDownsamplePolicyByID,
+		},
+		{
+			name: "FindDownsamplePolicies",
+			fn:   FindDownsamplePolicies,
+		},
+		{
+			name: "UpdateDownsamplePolicy",
+			fn:   UpdateDownsamplePolicy,
+		},
+		{
+			name: "DeleteDownsamplePolicy",
+			fn:   DeleteDownsamplePolicy,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.fn(init, t)
+		})
+	}
+}
+
+// CreateDownsamplePolicy tests platform.DownsamplePolicyService CreateDownsamplePolicy interface method.
+func CreateDownsamplePolicy(
+	init func(DownsamplePolicyFields, *testing.T) (platform.DownsamplePolicyService, string, func()),
+	t *testing.T,
+) {
+	type args struct {
+		policy *platform.DownsamplePolicy
+	}
+	type wants struct {
+		err      error
+		policies []*platform.DownsamplePolicy
+	}
+
+	tests := []struct {
+		name   string
+		fields DownsamplePolicyFields
+		args   args
+		wants  wants
+	}{
+		{
+			name: "creating a policy assigns it an id and adds it to the store",
+			fields: DownsamplePolicyFields{
+				IDGenerator: &mock.IDGenerator{
+					IDFn: func() platform.ID {
+						return MustIDBase16(downsamplePolicyNewID)
+					},
+				},
+				DownsamplePolicies: []*platform.DownsamplePolicy{
+					newTestDownsamplePolicy(downsamplePolicyOneID, "existing", downsampleSourceID),
+				},
+			},
+			args: args{
+				policy: &platform.DownsamplePolicy{
+					OrganizationID:      MustIDBase16(downsampleOrgID),
+					Name:                "new",
+					SourceBucketID:      MustIDBase16(downsampleSourceTwoID),
+					DestinationBucketID: MustIDBase16(downsampleDestID),
+					Window:              5 * time.Minute,
+					Functions:           []string{platform.DownsampleMax},
+					FieldOverrides: map[string][]string{
+						"requests": {platform.DownsampleSum},
+					},
+				},
+			},
+			wants: wants{
+				policies: []*platform.DownsamplePolicy{
+					newTestDownsamplePolicy(downsamplePolicyOneID, "existing", downsampleSourceID),
+					{
+						ID:                  MustIDBase16(downsamplePolicyNewID),
+						OrganizationID:      MustIDBase16(downsampleOrgID),
+						Name:                "new",
+						SourceBucketID:      MustIDBase16(downsampleSourceTwoID),
+						DestinationBucketID: MustIDBase16(downsampleDestID),
+						Window:              5 * time.Minute,
+						Functions:           []string{platform.DownsampleMax},
+						FieldOverrides: map[string][]string{
+							"requests": {platform.DownsampleSum},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, opPrefix, done := init(tt.fields, t)
+			defer done()
+			ctx := context.Background()
+
+			err := s.CreateDownsamplePolicy(ctx, tt.args.policy)
+			diffPlatformErrors(tt.name, err, tt.wants.err, opPrefix, t)
+
+			policies, _, err := s.FindDownsamplePolicies(ctx, platform.DownsamplePolicyFilter{})
+			if err != nil {
+				t.Fatalf("failed to retrieve downsample policies: %v", err)
+			}
+			if diff := cmp.Diff(policies, tt.wants.policies, downsamplePolicyCmpOptions...); diff != "" {
+				t.Errorf("downsample policies are different -got/+want\ndiff %s", diff)
+			}
+		})
+	}
+}
+
+// FindDownsamplePolicyByID tests platform.DownsamplePolicyService FindDownsamplePolicyByID interface method.
+func FindDownsamplePolicyByID(
+	init func(DownsamplePolicyFields, *testing.T) (platform.DownsamplePolicyService, string, func()),
+	t *testing.T,
+) {
+	type args struct {
+		id platform.ID
+	}
+	type wants struct {
+		err    error
+		policy *platform.DownsamplePolicy
+	}
+
+	tests := []struct {
+		name   string
+		fields DownsamplePolicyFields
+		args   args
+		wants  wants
+	}{
+		{
+			name: "find policy by id",
+			fields: DownsamplePolicyFields{
+				DownsamplePolicies: []*platform.DownsamplePolicy{
+					newTestDownsamplePolicy(downsamplePolicyOneID, "one", downsampleSourceID),
+					newTestDownsamplePolicy(downsamplePolicyTwoID, "two", downsampleSourceTwoID),
+				},
+			},
+			args: args{
+				id: MustIDBase16(downsamplePolicyTwoID),
+			},
+			wants: wants{
+				policy: newTestDownsamplePolicy(downsamplePolicyTwoID, "two", downsampleSourceTwoID),
+			},
+		},
+		{
+			name: "find policy by id not found",
+			fields: DownsamplePolicyFields{
+				DownsamplePolicies: []*platform.DownsamplePolicy{
+					newTestDownsamplePolicy(downsamplePolicyOneID, "one", downsampleSourceID),
+				},
+			},
+			args: args{
+				id: MustIDBase16(downsamplePolicyNoneID),
+			},
+			wants: wants{
+				err: &platform.Error{
+					Code: platform.ENotFound,
+					Op:   platform.OpFindDownsamplePolicyByID,
+					Msg:  "downsample policy not found",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, opPrefix, done := init(tt.fields, t)
+			defer done()
+			ctx := context.Background()
+
+			policy, err := s.FindDownsamplePolicyByID(ctx, tt.args.id)
+			diffPlatformErrors(tt.name, err, tt.wants.err, opPrefix, t)
+
+			if diff := cmp.Diff(policy, tt.wants.policy); diff != "" {
+				t.Errorf("downsample policy is different -got/+want\ndiff %s", diff)
+			}
+		})
+	}
+}
+
+// FindDownsamplePolicies tests platform.DownsamplePolicyService FindDownsamplePolicies interface method.
+func FindDownsamplePolicies(
+	init func(DownsamplePolicyFields, *testing.T) (platform.DownsamplePolicyService, string, func()),
+	t *testing.T,
+) {
+	type args struct {
+		filter platform.DownsamplePolicyFilter
+	}
+	type wants struct {
+		policies []*platform.DownsamplePolicy
+	}
+
+	one := MustIDBase16(downsamplePolicyOneID)
+	none := MustIDBase16(downsamplePolicyNoneID)
+	org := MustIDBase16(downsampleOrgID)
+	otherOrg := MustIDBase16(downsampleDestID)
+	source := MustIDBase16(downsampleSourceTwoID)
+
+	fields := DownsamplePolicyFields{
+		DownsamplePolicies: []*platform.DownsamplePolicy{
+			newTestDownsamplePolicy(downsamplePolicyOneID, "one", downsampleSourceID),
+			newTestDownsamplePolicy(downsamplePolicyTwoID, "two", downsampleSourceTwoID),
+		},
+	}
+
+	tests := []struct {
+		name   string
+		fields DownsamplePolicyFields
+		args   args
+		wants  wants
+	}{
+		{
+			name:   "find all policies",
+			fields: fields,
+			wants: wants{
+				policies: []*platform.DownsamplePolicy{
+					newTestDownsamplePolicy(downsamplePolicyOneID, "one", downsampleSourceID),
+					newTestDownsamplePolicy(downsamplePolicyTwoID, "two", downsampleSourceTwoID),
+				},
+			},
+		},
+		{
+			name:   "find policies by id",
+			fields: fields,
+			args: args{
+				filter: platform.DownsamplePolicyFilter{ID: &one},
+			},
+			wants: wants{
+				policies: []*platform.DownsamplePolicy{
+					newTestDownsamplePolicy(downsamplePolicyOneID, "one", downsampleSourceID),
+				},
+			},
+		},
+		{
+			name:   "find policies by id not found",
+			fields: fields,
+			args: args{
+				filter: platform.DownsamplePolicyFilter{ID: &none},
+			},
+			wants: wants{
+				policies: []*platform.DownsamplePolicy{},
+			},
+		},
+		{
+			name:   "find policies by organization and source bucket",
+			fields: fields,
+			args: args{
+				filter: platform.DownsamplePolicyFilter{
+					OrganizationID: &org,
+					SourceBucketID: &source,
+				},
+			},
+			wants: wants{
+				policies: []*platform.DownsamplePolicy{
+					newTestDownsamplePolicy(downsamplePolicyTwoID, "two", downsampleSourceTwoID),
+				},
+			},
+		},
+		{
+			name:   "find policies of another organization",
+			fields: fields,
+			args: args{
+				filter: platform.DownsamplePolicyFilter{OrganizationID: &otherOrg},
+			},
+			wants: wants{
+				policies: []*platform.DownsamplePolicy{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _, done := init(tt.fields, t)
+			defer done()
+			ctx := context.Background()
+
+			policies, n, err := s.FindDownsamplePolicies(ctx, tt.args.filter)
+			if err != nil {
+				t.Fatalf("failed to retrieve downsample policies: %v", err)
+			}
+			if n != len(tt.wants.policies) {
+				t.Errorf("unexpected number of policies: got %d, want %d", n, len(tt.wants.policies))
+			}
+			if diff := cmp.Diff(policies, tt.wants.policies, downsamplePolicyCmpOptions...); diff != "" {
+				t.Errorf("downsample policies are different -got/+want\ndiff %s", diff)
+			}
+		})
+	}
+}
+
+// UpdateDownsamplePolicy tests platform.DownsamplePolicyService UpdateDownsamplePolicy interface method.
+func UpdateDownsamplePolicy(
+	init func(DownsamplePolicyFields, *testing.T) (platform.DownsamplePolicyService, string, func()),
+	t *testing.T,
+) {
+	type args struct {
+		id  platform.ID
+		upd platform.DownsamplePolicyUpdate
+	}
+	type wants struct {
+		err    error
+		policy *platform.DownsamplePolicy
+	}
+
+	name := "renamed"
+	window := 10 * time.Minute
+
+	tests := []struct {
+		name   string
+		fields DownsamplePolicyFields
+		args   args
+		wants  wants
+	}{
+		{
+			name: "update policy",
+			fields: DownsamplePolicyFields{
+				DownsamplePolicies: []*platform.DownsamplePolicy{
+					newTestDownsamplePolicy(downsamplePolicyOneID, "one", downsampleSourceID),
+				},
+			},
+			args: args{
+				id: MustIDBase16(downsamplePolicyOneID),
+				upd: platform.DownsamplePolicyUpdate{
+					Name:      &name,
+					Window:    &window,
+					Functions: []string{platform.DownsampleMean, platform.DownsampleMax},
+					TaskIDs: map[string]platform.ID{
+						platform.DownsampleMean: MustIDBase16(downsampleTaskOneID),
+						platform.DownsampleMax:  MustIDBase16(downsampleTaskTwoID),
+					},
+				},
+			},
+			wants: wants{
+				policy: &platform.DownsamplePolicy{
+					ID:                  MustIDBase16(downsamplePolicyOneID),
+					OrganizationID:      MustIDBase16(downsampleOrgID),
+					Name:                "renamed",
+					SourceBucketID:      MustIDBase16(downsampleSourceID),
+					DestinationBucketID: MustIDBase16(downsampleDestID),
+					Window:              10 * time.Minute,
+					Functions:           []string{platform.DownsampleMean, platform.DownsampleMax},
+					TaskIDs: map[string]platform.ID{
+						platform.DownsampleMean: MustIDBase16(downsampleTaskOneID),
+						platform.DownsampleMax:  MustIDBase16(downsampleTaskTwoID),
+					},
+				},
+			},
+		},
+		{
+			name: "update policy not found",
+			fields: DownsamplePolicyFields{
+				DownsamplePolicies: []*platform.DownsamplePolicy{
+					newTestDownsamplePolicy(downsamplePolicyOneID, "one", downsampleSourceID),
+				},
+			},
+			args: args{
+				id: MustIDBase16(downsamplePolicyNoneID),
+				upd: platform.DownsamplePolicyUpdate{
+					Name: &name,
+				},
+			},
+			wants: wants{
+				err: &platform.Error{
+					Code: platform.ENotFound,
+					Op:   platform.OpUpdateDownsamplePolicy,
+					Msg:  "downsample policy not found",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, opPrefix, done := init(tt.fields, t)
+			defer done()
+			ctx := context.Background()
+
+			policy, err := s.UpdateDownsamplePolicy(ctx, tt.args.id, tt.args.upd)
+			diffPlatformErrors(tt.name, err, tt.wants.err, opPrefix, t)
+
+			if diff := cmp.Diff(policy, tt.wants.policy); diff != "" {
+				t.Errorf("downsample policy is different -got/+want\ndiff %s", diff)
+			}
+
+			if tt.wants.policy != nil {
+				stored, err := s.FindDownsamplePolicyByID(ctx, tt.args.id)
+				if err != nil {
+					t.Fatalf("failed to retrieve downsample policy: %v", err)
+				}
+				if diff := cmp.Diff(stored, tt.wants.policy); diff != "" {
+					t.Errorf("stored downsample policy is different -got/+want\ndiff %s", diff)
+				}
+			}
+		})
+	}
+}
+
+// DeleteDownsamplePolicy tests platform.DownsamplePolicyService DeleteDownsamplePolicy interface method.
+func DeleteDownsamplePolicy(
+	init func(DownsamplePolicyFields, *testing.T) (platform.DownsamplePolicyService, string, func()),
+	t *testing.T,
+) {
+	type args struct {
+		id platform.ID
+	}
+	type wants struct {
+		err      error
+		policies []*platform.DownsamplePolicy
+	}
+
+	tests := []struct {
+		name   string
+		fields DownsamplePolicyFields
+		args   args
+		wants  wants
+	}{
+		{
+			name: "delete policy",
+			fields: DownsamplePolicyFields{
+				DownsamplePolicies: []*platform.DownsamplePolicy{
+					newTestDownsamplePolicy(downsamplePolicyOneID, "one", downsampleSourceID),
+					newTestDownsamplePolicy(downsamplePolicyTwoID, "two", downsampleSourceTwoID),
+				},
+			},
+			args: args{
+				id: MustIDBase16(downsamplePolicyOneID),
+			},
+			wants: wants{
+				policies: []*platform.DownsamplePolicy{
+					newTestDownsamplePolicy(downsamplePolicyTwoID, "two", downsampleSourceTwoID),
+				},
+			},
+		},
+		{
+			name: "delete policy not found",
+			fields: DownsamplePolicyFields{
+				DownsamplePolicies: []*platform.DownsamplePolicy{
+					newTestDownsamplePolicy(downsamplePolicyOneID, "one", downsampleSourceID),
+				},
+			},
+			args: args{
+				id: MustIDBase16(downsamplePolicyNoneID),
+			},
+			wants: wants{
+				err: &platform.Error{
+					Code: platform.ENotFound,
+					Op:   platform.OpDeleteDownsamplePolicy,
+					Msg:  "downsample policy not found",
+				},
+				policies: []*platform.DownsamplePolicy{
+					newTestDownsamplePolicy(downsamplePolicyOneID, "one", downsampleSourceID),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, opPrefix, done := init(tt.fields, t)
+			defer done()
+			ctx := context.Background()
+
+			err := s.DeleteDownsamplePolicy(ctx, tt.args.id)
+			diffPlatformErrors(tt.name, err, tt.wants.err, opPrefix, t)
+
+			policies, _, err := s.FindDownsamplePolicies(ctx, platform.DownsamplePolicyFilter{})
+			if err != nil {
+				t.Fatalf("failed to retrieve downsample policies: %v", err)
+			}
+			if diff := cmp.Diff(policies, tt.wants.policies, downsamplePolicyCmpOptions...); diff != "" {
+				t.Errorf("downsample policies are different -got/+want\ndiff %s", diff)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/influxdata/platform"
@@ -556,3 +557,104 @@ func UpdateTarget(
 		})
 	}
 }
+
+// RecordScrape testing.
+func RecordScrape(
+	init func(*testing.T) (platform.ScraperTargetStatusService, string, func()),
+	t *testing.T,
+) {
+	type wants struct {
+		err    error
+		status *platform.ScraperTargetStatus
+	}
+	now := time.Date(2018, 12, 1, 17, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		id      platform.ID
+		results []platform.ScrapeResult
+		wants   wants
+	}{
+		{
+			name: "record successful scrape",
+			id:   MustIDBase16(targetOneID),
+			results: []platform.ScrapeResult{
+				{Time: now, Duration: time.Second, SampleCount: 10},
+			},
+			wants: wants{
+				status: &platform.ScraperTargetStatus{
+					TargetID:           MustIDBase16(targetOneID),
+					LastScrape:         now,
+					LastScrapeDuration: time.Second,
+					SampleCount:        10,
+				},
+			},
+		},
+		{
+			name: "count consecutive failures",
+			id:   MustIDBase16(targetOneID),
+			results: []platform.ScrapeResult{
+				{Time: now, Duration: time.Second, SampleCount: 10},
+				{Time: now.Add(time.Minute), Duration: 2 * time.Second, Err: "connection refused"},
+				{Time: now.Add(2 * time.Minute), Duration: 3 * time.Second, Err: "timeout"},
+			},
+			wants: wants{
+				status: &platform.ScraperTargetStatus{
+					TargetID:            MustIDBase16(targetOneID),
+					LastScrape:          now.Add(2 * time.Minute),
+					LastScrapeDuration:  3 * time.Second,
+					LastError:           "timeout",
+					ConsecutiveFailures: 2,
+				},
+			},
+		},
+		{
+			name: "reset failures on success",
+			id:   MustIDBase16(targetOneID),
+			results: []platform.ScrapeResult{
+				{Time: now, Duration: time.Second, Err: "connection refused"},
+				{Time: now.Add(time.Minute), Duration: time.Second, SampleCount: 3},
+			},
+			wants: wants{
+				status: &platform.ScraperTargetStatus{
+					TargetID:           MustIDBase16(targetOneID),
+					LastScrape:         now.Add(time.Minute),
+					LastScrapeDuration: time.Second,
+					SampleCount:        3,
+				},
+			},
+		},
+		{
+			name: "status of target never scraped",
+			id:   MustIDBase16(targetTwoID),
+			wants: wants{
+				err: &platform.Error{
+					Code: platform.ENotFound,
+					Op:   platform.OpGetTargetStatus,
+					Msg:  "scraper target status is not found",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, opPrefix, done := init(t)
+			defer done()
+			ctx := context.TODO()
+
+			for _, r := range tt.results {
+				if _, err := s.RecordScrape(ctx, tt.id, r); err != nil {
+					t.Fatalf("failed to record scrape: %v", err)
+				}
+			}
+
+			status, err := s.GetTargetStatus(ctx, tt.id)
+			diffPlatformErrors(tt.name, err, tt.wants.err, opPrefix, t)
+
+			if diff := cmp.Diff(status, tt.wants.status); diff != "" {
+				t.Errorf("target status is different -got/+want\ndiff %s", diff)
+			}
+		})
+	}
+}
@@ -45,8 +45,20 @@ type PartialWriteError struct {
 
 	// A sorted slice of series keys that were dropped.
 	DroppedKeys [][]byte
+
+	// DroppedReasons maps the dropped series keys to the reason each one was
+	// dropped. Reason is the reason of the keys missing from it.
+	DroppedReasons map[string]string
 }
 
 func (e PartialWriteError) Error() string {
 	return fmt.Sprintf("partial write: %s dropped=%d", e.Reason, e.Dropped)
 }
+
+// DroppedReason returns the reason the series key was dropped.
+func (e PartialWriteError) DroppedReason(key []byte) string {
+	if reason, ok := e.DroppedReasons[string(key)]; ok {
+		return reason
+	}
+	return e.Reason
+}
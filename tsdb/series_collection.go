@@ -25,6 +25,9 @@ type SeriesCollection struct {
 	DroppedKeys [][]byte
 	Reason      string
 
+	// reasons maps the dropped keys to the reason each one was dropped.
+	reasons map[string]string
+
 	// Used by the concurrent iterators to stage drops. Inefficient, but should be
 	// very infrequently used.
 	state *seriesCollectionState
@@ -34,7 +37,7 @@ type SeriesCollection struct {
 type seriesCollectionState struct {
 	mu     sync.Mutex
 	reason string
-	index  map[int]struct{}
+	index  map[int]string
 }
 
 // NewSeriesCollection builds a SeriesCollection from a slice of points. It does some filtering
@@ -198,13 +201,28 @@ func (s *SeriesCollection) Advance(length int) {
 	}
 }
 
-// InvalidateAll causes all of the entries to become invalid.
-func (s *SeriesCollection) InvalidateAll(reason string) {
+// Drop records the key as dropped for the reason. It does not remove the entry
+// of the key, which the caller is expected to do.
+func (s *SeriesCollection) Drop(key []byte, reason string) {
 	if s.Reason == "" {
 		s.Reason = reason
 	}
-	s.Dropped += uint64(len(s.Keys))
-	s.DroppedKeys = append(s.DroppedKeys, s.Keys...)
+	s.Dropped++
+	s.DroppedKeys = append(s.DroppedKeys, key)
+
+	if s.reasons == nil {
+		s.reasons = make(map[string]string)
+	}
+	if _, ok := s.reasons[string(key)]; !ok {
+		s.reasons[string(key)] = reason
+	}
+}
+
+// InvalidateAll causes all of the entries to become invalid.
+func (s *SeriesCollection) InvalidateAll(reason string) {
+	for _, key := range s.Keys {
+		s.Drop(key, reason)
+	}
 	s.Truncate(0)
 }
 
@@ -216,13 +234,17 @@ func (s *SeriesCollection) ApplyConcurrentDrops() {
 		return
 	}
 
+	if s.Reason == "" {
+		s.Reason = state.reason
+	}
+
 	length, j := s.Length(), 0
 	for i := 0; i < length; i++ {
-		if _, ok := state.index[i]; ok {
-			s.Dropped++
-
+		if reason, ok := state.index[i]; ok {
 			if i < len(s.Keys) {
-				s.DroppedKeys = append(s.DroppedKeys, s.Keys[i])
+				s.Drop(s.Keys[i], reason)
+			} else {
+				s.Dropped++
 			}
 
 			continue
@@ -233,10 +255,6 @@ func (s *SeriesCollection) ApplyConcurrentDrops() {
 	}
 	s.Truncate(j)
 
-	if s.Reason == "" {
-		s.Reason = state.reason
-	}
-
 	// clear concurrent state
 	atomic.StorePointer((*unsafe.Pointer)(unsafe.Pointer(&s.state)), nil)
 }
@@ -266,9 +284,11 @@ func (s *SeriesCollection) invalidIndex(index int, reason string) {
 
 	state.mu.Lock()
 	if state.index == nil {
-		state.index = make(map[int]struct{})
+		state.index = make(map[int]string)
+	}
+	if _, ok := state.index[index]; !ok {
+		state.index[index] = reason
 	}
-	state.index[index] = struct{}{}
 	if state.reason == "" {
 		state.reason = reason
 	}
@@ -283,9 +303,10 @@ func (s *SeriesCollection) PartialWriteError() error {
 	}
 	droppedKeys := bytesutil.SortDedup(s.DroppedKeys)
 	return PartialWriteError{
-		Reason:      s.Reason,
-		Dropped:     len(droppedKeys),
-		DroppedKeys: droppedKeys,
+		Reason:         s.Reason,
+		Dropped:        len(droppedKeys),
+		DroppedKeys:    droppedKeys,
+		DroppedReasons: s.reasons,
 	}
 }
 
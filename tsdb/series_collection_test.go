@@ -118,12 +118,38 @@ func TestSeriesCollection(t *testing.T) {
 		collection.InvalidateAll("test reason")
 		assertEqual(t, "length", collection.Length(), 0)
 		assertEqual(t, "error", collection.PartialWriteError(), PartialWriteError{
-			Reason:      "test reason",
-			Dropped:     3,
-			DroppedKeys: bs("ka", "kb", "kc"),
+			Reason:         "test reason",
+			Dropped:        3,
+			DroppedKeys:    bs("ka", "kb", "kc"),
+			DroppedReasons: map[string]string{"ka": "test reason", "kb": "test reason", "kc": "test reason"},
 		})
 	})
 
+	t.Run("Drop", func(t *testing.T) {
+		collection := &SeriesCollection{Keys: bs("ka", "kb", "kc")}
+
+		j := 0
+		for iter := collection.Iterator(); iter.Next(); {
+			switch iter.Index() {
+			case 0:
+				collection.Drop(iter.Key(), "first reason")
+			case 2:
+				collection.Drop(iter.Key(), "second reason")
+			default:
+				collection.Copy(j, iter.Index())
+				j++
+			}
+		}
+		collection.Truncate(j)
+
+		assertEqual(t, "keys", collection.Keys, bs("kb"))
+		err := collection.PartialWriteError().(PartialWriteError)
+		assertEqual(t, "reason", err.Reason, "first reason")
+		assertEqual(t, "dropped keys", err.DroppedKeys, bs("ka", "kc"))
+		assertEqual(t, "reason of ka", err.DroppedReason(b("ka")), "first reason")
+		assertEqual(t, "reason of kc", err.DroppedReason(b("kc")), "second reason")
+	})
+
 	t.Run("Invalid", func(t *testing.T) {
 		collection := &SeriesCollection{Keys: bs("ka", "kb", "kc")}
 
@@ -141,9 +167,10 @@ func TestSeriesCollection(t *testing.T) {
 		collection.ApplyConcurrentDrops()
 		assertEqual(t, "length", collection.Length(), 1)
 		assertEqual(t, "error", collection.PartialWriteError(), PartialWriteError{
-			Reason:      "test reason",
-			Dropped:     2,
-			DroppedKeys: bs("ka", "kc"),
+			Reason:         "test reason",
+			Dropped:        2,
+			DroppedKeys:    bs("ka", "kc"),
+			DroppedReasons: map[string]string{"ka": "test reason", "kc": "test reason"},
 		})
 	})
 }
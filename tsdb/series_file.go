@@ -5,6 +5,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"sort"
@@ -167,6 +168,27 @@ func (f *SeriesFile) SeriesPartitionPath(i int) string {
 	return filepath.Join(f.path, fmt.Sprintf("%02x", i))
 }
 
+// CreateSnapshot creates a snapshot of every partition of the series file in a
+// temporary directory within the series file path, whose path is returned.
+// The caller must remove the directory when done with it.
+func (f *SeriesFile) CreateSnapshot() (string, error) {
+	f.refs.RLock()
+	defer f.refs.RUnlock()
+
+	path, err := ioutil.TempDir(f.path, ".snapshot")
+	if err != nil {
+		return "", err
+	}
+
+	for _, p := range f.partitions {
+		if err := p.snapshotTo(filepath.Join(path, filepath.Base(p.Path()))); err != nil {
+			os.RemoveAll(path)
+			return "", err
+		}
+	}
+	return path, nil
+}
+
 // Partitions returns all partitions.
 func (f *SeriesFile) Partitions() []*SeriesPartition { return f.partitions }
 
@@ -179,6 +179,65 @@ func (p *SeriesPartition) Path() string { return p.path }
 // IndexPath returns the path to the series index.
 func (p *SeriesPartition) IndexPath() string { return filepath.Join(p.path, "index") }
 
+// snapshotTo creates a snapshot of the partition's files in path. The index
+// and the full segments are hard linked, while the current contents of the
+// active segment, which is still appended to, are copied.
+func (p *SeriesPartition) snapshotTo(path string) error {
+	if err := os.MkdirAll(path, 0777); err != nil {
+		return err
+	}
+
+	// Hold the lock so that no series are written to the active segment, and
+	// compactions do not replace the index.
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed {
+		return ErrSeriesPartitionClosed
+	}
+
+	active := p.activeSegment()
+	for _, s := range p.segments {
+		newpath := filepath.Join(path, filepath.Base(s.path))
+		if s != active {
+			if err := os.Link(s.path, newpath); err != nil {
+				return fmt.Errorf("error creating segment hard link: %q", err)
+			}
+			continue
+		}
+
+		if err := s.Flush(); err != nil {
+			return err
+		}
+		if err := copySegment(newpath, s); err != nil {
+			return err
+		}
+	}
+
+	// The index does not exist until the partition is first compacted.
+	if err := os.Link(p.IndexPath(), filepath.Join(path, filepath.Base(p.IndexPath()))); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("error creating index hard link: %q", err)
+	}
+	return nil
+}
+
+// copySegment copies the data of the segment to a new file at path with the
+// size of the segment, since segments are memory mapped at their full size.
+func copySegment(path string, s *SeriesSegment) error {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR|os.O_EXCL, 0666)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if _, err := f.Write(s.data[:s.size]); err != nil {
+		return err
+	} else if err := f.Truncate(int64(SeriesSegmentSize(s.id))); err != nil {
+		return err
+	}
+	return f.Close()
+}
+
 // CreateSeriesListIfNotExists creates a list of series in bulk if they don't exist.
 // The ids parameter is modified to contain series IDs for all keys belonging to this partition.
 // If the type does not match the existing type for the key, a zero id is stored.
@@ -96,6 +96,21 @@ var DisableMetrics = func() IndexOption {
 	}
 }
 
+// WithSeriesLimiter limits the series the Index creates with l.
+var WithSeriesLimiter = func(l SeriesLimiter) IndexOption {
+	return func(i *Index) {
+		i.seriesLimiter = l
+	}
+}
+
+// A SeriesLimiter limits the creation of new series.
+type SeriesLimiter interface {
+	// LimitNewSeries is given the number of series a write would create for each
+	// measurement, and lowers them to the numbers that may be created. It returns
+	// the reason any were lowered.
+	LimitNewSeries(newSeries map[string]int) string
+}
+
 // Index represents a collection of layered index files and WAL.
 type Index struct {
 	mu         sync.RWMutex
@@ -117,6 +132,9 @@ type Index struct {
 	logger             *zap.Logger // Index's logger.
 	config             Config      // The index configuration
 
+	seriesLimiter SeriesLimiter // Limits new series, if set.
+	seriesLimitMu sync.Mutex    // Serializes writes that create series while limited.
+
 	// The following must be set when initializing an Index.
 	sfile *tsdb.SeriesFile // series lookup file
 
@@ -333,6 +351,31 @@ func (i *Index) Close() error {
 	return nil
 }
 
+// CreateSnapshot creates a snapshot of every partition of the index in a
+// temporary directory within the index path, whose path is returned. The
+// caller must remove the directory when done with it.
+func (i *Index) CreateSnapshot() (string, error) {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	if !i.opened {
+		return "", errors.New("index is not open")
+	}
+
+	path, err := ioutil.TempDir(i.path, ".snapshot")
+	if err != nil {
+		return "", err
+	}
+
+	for j, p := range i.partitions {
+		if err := p.snapshotTo(filepath.Join(path, fmt.Sprint(j))); err != nil {
+			os.RemoveAll(path)
+			return "", err
+		}
+	}
+	return path, nil
+}
+
 // Path returns the path the index was opened with.
 func (i *Index) Path() string { return i.path }
 
@@ -604,6 +647,14 @@ func (i *Index) DropMeasurement(name []byte) error {
 
 // CreateSeriesListIfNotExists creates a list of series if they doesn't exist in bulk.
 func (i *Index) CreateSeriesListIfNotExists(collection *tsdb.SeriesCollection) error {
+	if i.seriesLimiter != nil && i.hasNewSeries(collection) {
+		// Creating series changes the counts the limiter decides on, so writes
+		// that create series are serialized until their series are created.
+		i.seriesLimitMu.Lock()
+		defer i.seriesLimitMu.Unlock()
+		i.limitNewSeries(collection)
+	}
+
 	// Create the series list on the series file first. This validates all of the types for
 	// the collection.
 	err := i.sfile.CreateSeriesListIfNotExists(collection)
@@ -698,6 +749,72 @@ func (i *Index) CreateSeriesListIfNotExists(collection *tsdb.SeriesCollection) e
 	return nil
 }
 
+// hasNewSeries returns true if any series in the collection does not exist yet.
+func (i *Index) hasNewSeries(collection *tsdb.SeriesCollection) bool {
+	var buf []byte
+	for iter := collection.Iterator(); iter.Next(); {
+		buf = tsdb.AppendSeriesKey(buf[:0], iter.Name(), iter.Tags())
+		if i.sfile.SeriesIDTypedBySeriesKey(buf).SeriesID().IsZero() {
+			return true
+		}
+	}
+	return false
+}
+
+// MeasurementCardinalityByPrefix returns the number of series of all measurements
+// whose name begins with prefix.
+func (i *Index) MeasurementCardinalityByPrefix(prefix []byte) int {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	var n int
+	for _, p := range i.partitions {
+		n += p.measurementCardinalityByPrefix(prefix)
+	}
+	return n
+}
+
+// limitNewSeries drops the entries of series in the collection that the
+// series limiter does not allow to be created. Within a measurement, the
+// series first seen in the collection are created first.
+func (i *Index) limitNewSeries(collection *tsdb.SeriesCollection) {
+	var buf []byte
+	newSeries := make(map[string]int)
+	order := make(map[string]int) // order of each new series within its measurement
+	for iter := collection.Iterator(); iter.Next(); {
+		buf = tsdb.AppendSeriesKey(buf[:0], iter.Name(), iter.Tags())
+		if _, ok := order[string(buf)]; ok {
+			continue
+		}
+		if !i.sfile.SeriesIDTypedBySeriesKey(buf).SeriesID().IsZero() {
+			continue
+		}
+		order[string(buf)] = newSeries[string(iter.Name())]
+		newSeries[string(iter.Name())]++
+	}
+	if len(newSeries) == 0 {
+		return
+	}
+
+	allowed := make(map[string]int, len(newSeries))
+	for name, n := range newSeries {
+		allowed[name] = n
+	}
+	reason := i.seriesLimiter.LimitNewSeries(allowed)
+
+	j := 0
+	for iter := collection.Iterator(); iter.Next(); {
+		buf = tsdb.AppendSeriesKey(buf[:0], iter.Name(), iter.Tags())
+		if n, ok := order[string(buf)]; ok && n >= allowed[string(iter.Name())] {
+			collection.Drop(iter.Key(), reason)
+			continue
+		}
+		collection.Copy(j, iter.Index())
+		j++
+	}
+	collection.Truncate(j)
+}
+
 // InitializeSeries is a no-op. This only applies to the in-memory index.
 func (i *Index) InitializeSeries(*tsdb.SeriesCollection) error {
 	return nil
@@ -215,6 +215,20 @@ func (f *LogFile) FlushAndSync() error {
 	return f.file.Sync()
 }
 
+// flush flushes buffered data to the file, even when syncing is disabled,
+// and returns the size of the file.
+func (f *LogFile) flush() (int64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.w != nil {
+		if err := f.w.Flush(); err != nil {
+			return 0, err
+		}
+	}
+	return f.size, nil
+}
+
 // ID returns the file sequence identifier.
 func (f *LogFile) ID() int { return f.id }
 
@@ -1013,6 +1027,14 @@ func (f *LogFile) MeasurementCardinalityStats() MeasurementCardinalityStats {
 	return f.stats.Clone()
 }
 
+// measurementCardinalityByPrefix returns the net number of series this log file
+// has added to measurements whose name begins with prefix.
+func (f *LogFile) measurementCardinalityByPrefix(prefix []byte) int {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.stats.PrefixSum(prefix)
+}
+
 // LogEntry represents a single log entry in the write-ahead log.
 type LogEntry struct {
 	Flag     byte          // flag
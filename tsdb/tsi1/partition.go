@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -451,6 +452,67 @@ func (p *Partition) retainFileSet() *FileSet {
 	return fs
 }
 
+// snapshotTo creates a snapshot of the partition's files in path. Index files
+// are hard linked, while the current contents of the log files, which are
+// still appended to, are copied.
+func (p *Partition) snapshotTo(path string) error {
+	if err := os.MkdirAll(path, 0777); err != nil {
+		return err
+	}
+
+	// Hold the lock so that compactions do not replace the files of the file set.
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	for _, f := range p.fileSet.files {
+		newpath := filepath.Join(path, filepath.Base(f.Path()))
+		switch f := f.(type) {
+		case *LogFile:
+			size, err := f.flush()
+			if err != nil {
+				return err
+			}
+			if err := copyFileN(newpath, f.Path(), size); err != nil {
+				return err
+			}
+		default:
+			if err := os.Link(f.Path(), newpath); err != nil {
+				return fmt.Errorf("error creating index file hard link: %q", err)
+			}
+		}
+	}
+
+	// The stats file is replaced by a rename, so it can be linked.
+	if err := os.Link(p.StatsPath(), filepath.Join(path, StatsFileName)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("error creating stats hard link: %q", err)
+	}
+
+	m := p.Manifest()
+	m.path = filepath.Join(path, ManifestFileName)
+	_, err := m.Write()
+	return err
+}
+
+// copyFileN copies the first n bytes of the file at src to a new file at dst.
+func copyFileN(dst, src string, n int64) error {
+	r, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	w, err := os.OpenFile(dst, os.O_CREATE|os.O_RDWR|os.O_EXCL, 0666)
+	if err != nil {
+		return err
+	}
+	defer w.Close()
+
+	if _, err := io.CopyN(w, r, n); err != nil {
+		return err
+	}
+	return w.Close()
+}
+
 // FileN returns the active files in the file set.
 func (p *Partition) FileN() int { return len(p.fileSet.files) }
 
@@ -1291,6 +1353,19 @@ func (p *Partition) MeasurementCardinalityStats() MeasurementCardinalityStats {
 	return stats
 }
 
+// measurementCardinalityByPrefix returns the number of series of all measurements
+// whose name begins with prefix.
+func (p *Partition) measurementCardinalityByPrefix(prefix []byte) int {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	n := p.stats.PrefixSum(prefix)
+	if p.activeLogFile != nil {
+		n += p.activeLogFile.measurementCardinalityByPrefix(prefix)
+	}
+	return n
+}
+
 type partitionTracker struct {
 	metrics *partitionMetrics
 	labels  prometheus.Labels
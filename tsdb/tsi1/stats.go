@@ -7,6 +7,7 @@ import (
 	"hash/crc32"
 	"io"
 	"sort"
+	"strings"
 
 	"github.com/influxdata/platform/pkg/binaryutil"
 )
@@ -67,6 +68,17 @@ func (s MeasurementCardinalityStats) Sub(other MeasurementCardinalityStats) {
 	}
 }
 
+// PrefixSum returns the total count of all measurements whose name begins with prefix.
+func (s MeasurementCardinalityStats) PrefixSum(prefix []byte) int {
+	var n int
+	for name, v := range s {
+		if strings.HasPrefix(name, string(prefix)) {
+			n += v
+		}
+	}
+	return n
+}
+
 // Clone returns a copy of s.
 func (s MeasurementCardinalityStats) Clone() MeasurementCardinalityStats {
 	other := make(MeasurementCardinalityStats, len(s))
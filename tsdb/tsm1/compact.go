@@ -695,6 +695,10 @@ type Compactor struct {
 	// RateLimit is the limit for disk writes for all concurrent compactions.
 	RateLimit limiter.Rate
 
+	// TimeGrouper, if set, splits snapshots so that each TSM file only holds
+	// data from a single time group.
+	TimeGrouper TimeGrouper
+
 	formatFileName FormatFileNameFunc
 	parseFileName  ParseFileNameFunc
 
@@ -836,16 +840,29 @@ func (c *Compactor) WriteSnapshot(cache *Cache) ([]string, error) {
 		throttle = false
 	}
 
-	splits := cache.Split(concurrency)
+	var splits []*Cache
+	if c.TimeGrouper != nil {
+		var err error
+		if splits, err = splitByTimeGroup(cache, c.TimeGrouper); err != nil {
+			return nil, err
+		}
+	} else {
+		splits = cache.Split(concurrency)
+	}
 
 	type res struct {
 		files []string
 		err   error
 	}
 
-	resC := make(chan res, concurrency)
-	for i := 0; i < concurrency; i++ {
+	// Write at most concurrency splits at a time.
+	sem := make(chan struct{}, concurrency)
+	resC := make(chan res, len(splits))
+	for i := range splits {
 		go func(sp *Cache) {
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
 			iter := NewCacheKeyIterator(sp, MaxPointsPerBlock, intC)
 			files, err := c.writeNewFiles(c.FileStore.NextGeneration(), 0, nil, iter, throttle)
 			resC <- res{files: files, err: err}
@@ -854,8 +871,8 @@ func (c *Compactor) WriteSnapshot(cache *Cache) ([]string, error) {
 	}
 
 	var err error
-	files := make([]string, 0, concurrency)
-	for i := 0; i < concurrency; i++ {
+	files := make([]string, 0, len(splits))
+	for range splits {
 		result := <-resC
 		if result.err != nil {
 			err = result.err
@@ -2,6 +2,7 @@
 package tsm1 // import "github.com/influxdata/platform/tsdb/tsm1"
 
 import (
+	"archive/tar"
 	"bytes"
 	"context"
 	"errors"
@@ -24,6 +25,7 @@ import (
 	"github.com/influxdata/platform/pkg/bytesutil"
 	"github.com/influxdata/platform/pkg/limiter"
 	"github.com/influxdata/platform/pkg/metrics"
+	intar "github.com/influxdata/platform/pkg/tar"
 	"github.com/influxdata/platform/query"
 	"github.com/influxdata/platform/tsdb"
 	"github.com/influxdata/platform/tsdb/tsi1"
@@ -100,8 +102,14 @@ var WithTraceLogging = func(logging bool) EngineOption {
 // WithCompactionPlanner sets the compaction planner for the engine.
 var WithCompactionPlanner = func(planner CompactionPlanner) EngineOption {
 	return func(e *Engine) {
-		planner.SetFileStore(e.FileStore)
-		e.CompactionPlan = planner
+		e.WithCompactionPlanner(planner)
+	}
+}
+
+// WithTimeGrouper sets the time grouper used to partition TSM files.
+var WithTimeGrouper = func(grouper TimeGrouper) EngineOption {
+	return func(e *Engine) {
+		e.WithTimeGrouper(grouper)
 	}
 }
 
@@ -137,6 +145,7 @@ type Engine struct {
 	Compactor      *Compactor
 	CompactionPlan CompactionPlanner
 	FileStore      *FileStore
+	TimeGrouper    TimeGrouper
 
 	MaxPointsPerBlock int
 
@@ -246,9 +255,26 @@ func (e *Engine) WithFileStoreObserver(obs FileStoreObserver) {
 
 func (e *Engine) WithCompactionPlanner(planner CompactionPlanner) {
 	planner.SetFileStore(e.FileStore)
+	if e.TimeGrouper != nil {
+		planner = newTimeGroupPlanner(planner, e.FileStore, e.TimeGrouper)
+	}
 	e.CompactionPlan = planner
 }
 
+// WithTimeGrouper partitions the engine's TSM files into the time groups
+// determined by grouper. It must be called before the Engine is opened.
+func (e *Engine) WithTimeGrouper(grouper TimeGrouper) {
+	if p, ok := e.CompactionPlan.(*timeGroupPlanner); ok {
+		e.CompactionPlan = p.CompactionPlanner
+	}
+
+	e.TimeGrouper = grouper
+	e.Compactor.TimeGrouper = grouper
+	if grouper != nil {
+		e.CompactionPlan = newTimeGroupPlanner(e.CompactionPlan, e.FileStore, grouper)
+	}
+}
+
 // SetDefaultMetricLabels sets the default labels for metrics on the engine.
 // It must be called before the Engine is opened.
 func (e *Engine) SetDefaultMetricLabels(labels prometheus.Labels) {
@@ -871,6 +897,36 @@ func (e *Engine) deleteSeriesRange(seriesKeys [][]byte, min, max int64) error {
 		return err
 	}
 
+	return e.dropSeriesIfNotExist(seriesKeys)
+}
+
+// dropSeriesIfNotExist removes the series of seriesKeys that no longer have
+// data in any TSM file or in the cache from the index and the series file.
+// seriesKeys must be sorted, and is modified.
+func (e *Engine) dropSeriesIfNotExist(seriesKeys [][]byte) error {
+	if len(seriesKeys) == 0 {
+		return nil
+	}
+
+	// find the keys of the series in the cache
+	deleteKeys := make([][]byte, 0, len(seriesKeys))
+
+	// ApplySerialEntryFn cannot return an error in this invocation.
+	_ = e.Cache.ApplyEntryFn(func(k []byte, _ *entry) error {
+		seriesKey, _ := SeriesAndFieldFromCompositeKey([]byte(k))
+
+		// Cache does not walk keys in sorted order, so search the sorted
+		// series to see if any of the cache keys match.
+		i := bytesutil.SearchBytes(seriesKeys, seriesKey)
+		if i < len(seriesKeys) && bytes.Equal(seriesKey, seriesKeys[i]) {
+			deleteKeys = append(deleteKeys, k)
+		}
+		return nil
+	})
+
+	// Sort the series keys because ApplyEntryFn iterates over the keys randomly.
+	bytesutil.Sort(deleteKeys)
+
 	// The series are deleted on disk, but the index may still say they exist.
 	// Depending on the the min,max time passed in, the series may or not actually
 	// exists now.  To reconcile the index, we walk the series keys that still exists
@@ -985,6 +1041,119 @@ func (e *Engine) deleteSeriesRange(seriesKeys [][]byte, min, max int64) error {
 	return nil
 }
 
+// DropTimeGroups removes the TSM files of every time group for which expired
+// returns true. expired is called with the owner of each group and the latest
+// timestamp stored in it. It returns the number of files removed along with
+// the stats of any files that span several time groups, whose expired data
+// must be removed with a range delete instead.
+//
+// Series that no longer have data in any file or in the cache once the files
+// are removed are also removed from the index and the series file.
+//
+// Only level compactions, which rewrite existing files, are paused while the
+// files are removed. Snapshots keep writing the cache to new files, which are
+// never removed by the drop in progress; their series are kept in the index.
+func (e *Engine) DropTimeGroups(expired func(owner []byte, max int64) bool) (int, []FileStat, error) {
+	if e.TimeGrouper == nil {
+		return 0, append([]FileStat(nil), e.FileStore.Stats()...), nil
+	}
+
+	// Disable and abort running level compactions so that the files being
+	// removed are not being compacted.
+	e.disableLevelCompactions(true)
+	defer e.enableLevelCompactions(true)
+
+	var (
+		order     []timeGroup
+		ungrouped []FileStat
+		files     = make(map[timeGroup][]string)
+		maxTimes  = make(map[timeGroup]int64)
+	)
+	for _, st := range e.FileStore.Stats() {
+		tg, ok := fileTimeGroup(e.TimeGrouper, st)
+		if !ok {
+			ungrouped = append(ungrouped, st)
+			continue
+		}
+
+		if _, ok := files[tg]; !ok {
+			order = append(order, tg)
+			maxTimes[tg] = st.MaxTime
+		} else if st.MaxTime > maxTimes[tg] {
+			maxTimes[tg] = st.MaxTime
+		}
+		files[tg] = append(files[tg], st.Path)
+	}
+
+	var drop []string
+	for _, tg := range order {
+		if expired([]byte(tg.owner), maxTimes[tg]) {
+			drop = append(drop, files[tg]...)
+		}
+	}
+
+	if len(drop) == 0 {
+		return 0, ungrouped, nil
+	}
+
+	seriesKeys, err := e.fileSeriesKeys(drop)
+	if err != nil {
+		return 0, ungrouped, err
+	}
+
+	if err := e.FileStore.Replace(drop, nil); err != nil {
+		return 0, ungrouped, err
+	}
+
+	e.logger.Info("Dropped expired time groups", zap.Int("files", len(drop)), zap.Int("series", len(seriesKeys)))
+
+	if err := e.dropSeriesIfNotExist(seriesKeys); err != nil {
+		return len(drop), ungrouped, err
+	}
+	return len(drop), ungrouped, nil
+}
+
+// fileSeriesKeys returns the sorted keys of the series stored in the TSM files
+// at paths.
+func (e *Engine) fileSeriesKeys(paths []string) ([][]byte, error) {
+	files := make(map[string]struct{}, len(paths))
+	for _, path := range paths {
+		files[path] = struct{}{}
+	}
+
+	var (
+		mu         sync.Mutex
+		seriesKeys [][]byte
+	)
+	if err := e.FileStore.Apply(func(r TSMFile) error {
+		if _, ok := files[r.Path()]; !ok {
+			return nil
+		}
+
+		var keys [][]byte
+		var last []byte
+		for i, n := 0, r.KeyCount(); i < n; i++ {
+			indexKey, _ := r.KeyAt(i)
+			seriesKey, _ := SeriesAndFieldFromCompositeKey(indexKey)
+			if bytes.Equal(seriesKey, last) {
+				continue
+			}
+			// Copy the key, as the file is unmapped once removed.
+			last = bytesutil.Clone(seriesKey)
+			keys = append(keys, last)
+		}
+
+		mu.Lock()
+		seriesKeys = append(seriesKeys, keys...)
+		mu.Unlock()
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return bytesutil.SortDedup(seriesKeys), nil
+}
+
 // DeleteMeasurement deletes a measurement and all related series.
 func (e *Engine) DeleteMeasurement(name []byte) error {
 	// Delete the bulk of data outside of the fields lock.
@@ -1016,8 +1185,34 @@ func (e *Engine) CreateSeriesListIfNotExists(collection *tsdb.SeriesCollection)
 	return e.index.CreateSeriesListIfNotExists(collection)
 }
 
-// WriteTo is not implemented.
-func (e *Engine) WriteTo(w io.Writer) (n int64, err error) { panic("not implemented") }
+// WriteTo writes a tar archive of a snapshot of the engine's TSM files to w.
+func (e *Engine) WriteTo(w io.Writer) (n int64, err error) {
+	path, err := e.CreateSnapshot()
+	if err != nil {
+		return 0, err
+	}
+	defer os.RemoveAll(path)
+
+	cw := &countingWriter{w: w}
+	tw := tar.NewWriter(cw)
+	if err := intar.Stream(tw, path, "", nil); err != nil {
+		return cw.n, err
+	}
+	err = tw.Close()
+	return cw.n, err
+}
+
+// countingWriter counts the bytes written to w.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	w.n += int64(n)
+	return n, err
+}
 
 // compactionLevel describes a snapshot or levelled compaction.
 type compactionLevel int
@@ -1227,6 +1422,19 @@ func (e *Engine) WriteSnapshot() error {
 	return e.writeSnapshotAndCommit(log, closedFiles, snapshot)
 }
 
+// CreateSnapshot writes the cache to a new TSM file and creates hard links
+// to every TSM file of the engine in a temporary directory, whose path is
+// returned. The caller must remove the directory when done with it.
+func (e *Engine) CreateSnapshot() (string, error) {
+	if err := e.WriteSnapshot(); err != nil {
+		return "", err
+	}
+
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.FileStore.CreateSnapshot()
+}
+
 // writeSnapshotAndCommit will write the passed cache to a new TSM file and remove the closed WAL segments.
 func (e *Engine) writeSnapshotAndCommit(log *zap.Logger, closedFiles []string, snapshot *Cache) (err error) {
 	defer func() {
@@ -891,6 +891,74 @@ func BenchmarkEngine_WritePoints_Parallel(b *testing.B) {
 	}
 }
 
+func TestEngine_DropTimeGroups(t *testing.T) {
+	e, err := NewEngine()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Group data by measurement into 10s windows.
+	e.WithTimeGrouper(&measurementGrouper{duration: int64(10 * time.Second)})
+	if err := e.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer e.Close()
+
+	if err := e.WritePointsString(
+		"cpu,host=A value=1.1 1000000000",
+		"cpu,host=A value=1.2 2000000000",
+		"cpu,host=A value=1.3 12000000000",
+		"cpu,host=B value=2.1 1000000000",
+		"mem,host=A value=1.4 1000000000",
+	); err != nil {
+		t.Fatalf("failed to write points: %s", err.Error())
+	}
+
+	if err := e.WriteSnapshot(); err != nil {
+		t.Fatalf("failed to snapshot: %s", err.Error())
+	}
+
+	// Each time group is written to its own file.
+	if got, exp := len(e.FileStore.Stats()), 3; got != exp {
+		t.Fatalf("file count mismatch: got %d, exp %d", got, exp)
+	}
+
+	n, ungrouped, err := e.DropTimeGroups(func(owner []byte, max int64) bool {
+		return string(owner) == "cpu" && max < int64(10*time.Second)
+	})
+	if err != nil {
+		t.Fatal(err)
+	} else if n != 1 {
+		t.Fatalf("dropped file count mismatch: got %d, exp 1", n)
+	} else if len(ungrouped) != 0 {
+		t.Fatalf("unexpected ungrouped files: %v", ungrouped)
+	}
+
+	stats := e.FileStore.Stats()
+	if got, exp := len(stats), 2; got != exp {
+		t.Fatalf("file count mismatch: got %d, exp %d", got, exp)
+	}
+	for _, st := range stats {
+		if bytes.HasPrefix(st.MinKey, []byte("cpu")) && st.MinTime != int64(12*time.Second) {
+			t.Fatalf("unexpected cpu data remaining: min time %d", st.MinTime)
+		}
+	}
+
+	// cpu,host=B only had data in the dropped group.
+	if got, exp := e.SeriesN(), int64(2); got != exp {
+		t.Fatalf("series count mismatch: got %d, exp %d", got, exp)
+	}
+}
+
+// measurementGrouper groups TSM keys by measurement.
+type measurementGrouper struct {
+	duration int64
+}
+
+func (g *measurementGrouper) TimeGroup(key []byte) ([]byte, int64) {
+	return models.ParseName(key), g.duration
+}
+
 // Engine is a test wrapper for tsm1.Engine.
 type Engine struct {
 	*tsm1.Engine
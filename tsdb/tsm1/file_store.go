@@ -1126,6 +1126,13 @@ func (f *FileStore) CreateSnapshot() (string, error) {
 				return "", fmt.Errorf("error creating tombstone hard link: %q", err)
 			}
 		}
+
+		// Files written before measurement stats were added have no stats file.
+		statsPath := StatsFilename(tsmf.Path())
+		newpath = filepath.Join(tmpPath, filepath.Base(statsPath))
+		if err := os.Link(statsPath, newpath); err != nil && !os.IsNotExist(err) {
+			return "", fmt.Errorf("error creating stats hard link: %q", err)
+		}
 	}
 
 	return tmpPath, nil
@@ -0,0 +1,203 @@
+package tsm1
+
+import (
+	"bytes"
+	"math"
+	"sort"
+	"time"
+)
+
+// A TimeGrouper partitions the data stored by an Engine into time groups.
+//
+// Every key belongs to an owner (for example a bucket) and the owner's data is
+// divided into consecutive windows of a fixed duration. When a TimeGrouper is
+// set on an Engine, snapshots and compactions never write data from more than
+// one time group into the same TSM file, so expired groups can later be
+// dropped by removing whole files rather than by range deletes.
+type TimeGrouper interface {
+	// TimeGroup returns the owner of the TSM key and the duration, in
+	// nanoseconds, of the owner's time groups. A non-positive duration places
+	// all of the owner's data into a single group.
+	TimeGroup(key []byte) (owner []byte, duration int64)
+}
+
+// timeGroup identifies a single window of data belonging to an owner.
+type timeGroup struct {
+	owner string
+	start int64
+}
+
+// timeGroupStart returns the start of the window of length d containing t.
+func timeGroupStart(t, d int64) int64 {
+	if d <= 0 {
+		return math.MinInt64
+	}
+
+	start := t - t%d
+	if t%d < 0 {
+		if start < math.MinInt64+d {
+			return math.MinInt64
+		}
+		start -= d
+	}
+	return start
+}
+
+// fileTimeGroup returns the time group holding all of the data in the file
+// described by st. It returns false if the file spans more than one group,
+// which is the case for files written before grouping was enabled or before
+// an owner's group duration was changed.
+func fileTimeGroup(g TimeGrouper, st FileStat) (timeGroup, bool) {
+	minOwner, d := g.TimeGroup(st.MinKey)
+	maxOwner, _ := g.TimeGroup(st.MaxKey)
+	if !bytes.Equal(minOwner, maxOwner) {
+		return timeGroup{}, false
+	}
+
+	start := timeGroupStart(st.MinTime, d)
+	if timeGroupStart(st.MaxTime, d) != start {
+		return timeGroup{}, false
+	}
+	return timeGroup{owner: string(minOwner), start: start}, true
+}
+
+// splitByTimeGroup splits the deduplicated snapshot cache c into one cache per
+// time group. The returned caches share their values with c.
+func splitByTimeGroup(c *Cache, g TimeGrouper) ([]*Cache, error) {
+	groups := make(map[timeGroup]*Cache)
+	var caches []*Cache
+
+	err := c.store.applySerial(func(key []byte, e *entry) error {
+		owner, d := g.TimeGroup(key)
+
+		e.mu.RLock()
+		values := e.values
+		e.mu.RUnlock()
+
+		for len(values) > 0 {
+			start := timeGroupStart(values[0].UnixNano(), d)
+			n := sort.Search(len(values), func(i int) bool {
+				return timeGroupStart(values[i].UnixNano(), d) != start
+			})
+
+			tg := timeGroup{owner: string(owner), start: start}
+			gc := groups[tg]
+			if gc == nil {
+				store, err := newring(partitions)
+				if err != nil {
+					return err
+				}
+				gc = &Cache{store: store}
+				groups[tg] = gc
+				caches = append(caches, gc)
+			}
+
+			gc.store.add(key, &entry{values: values[:n], vtype: e.vtype})
+			values = values[n:]
+		}
+		return nil
+	})
+	return caches, err
+}
+
+// timeGroupPlanner wraps a CompactionPlanner so that every planned compaction
+// only contains files from a single time group.
+type timeGroupPlanner struct {
+	CompactionPlanner
+
+	fs      *FileStore
+	grouper TimeGrouper
+}
+
+func newTimeGroupPlanner(planner CompactionPlanner, fs *FileStore, grouper TimeGrouper) *timeGroupPlanner {
+	return &timeGroupPlanner{
+		CompactionPlanner: planner,
+		fs:                fs,
+		grouper:           grouper,
+	}
+}
+
+func (p *timeGroupPlanner) Plan(lastWrite time.Time) []CompactionGroup {
+	return p.split(p.CompactionPlanner.Plan(lastWrite))
+}
+
+func (p *timeGroupPlanner) PlanLevel(level int) []CompactionGroup {
+	return p.split(p.CompactionPlanner.PlanLevel(level))
+}
+
+func (p *timeGroupPlanner) PlanOptimize() []CompactionGroup {
+	return p.split(p.CompactionPlanner.PlanOptimize())
+}
+
+func (p *timeGroupPlanner) SetFileStore(fs *FileStore) {
+	p.fs = fs
+	p.CompactionPlanner.SetFileStore(fs)
+}
+
+// split divides each compaction group into groups of files that belong to the
+// same time group. Files spanning several time groups are only compacted with
+// each other. Groups reduced to a single file are released back to the
+// underlying planner, as compacting them would achieve nothing.
+func (p *timeGroupPlanner) split(groups []CompactionGroup) []CompactionGroup {
+	if len(groups) == 0 || p.fs == nil {
+		return groups
+	}
+
+	stats := make(map[string]FileStat)
+	for _, st := range p.fs.Stats() {
+		stats[st.Path] = st
+	}
+
+	var planned, released []CompactionGroup
+	for _, group := range groups {
+		if len(group) < 2 {
+			planned = append(planned, group)
+			continue
+		}
+
+		var (
+			order   []timeGroup
+			mixed   CompactionGroup
+			byGroup = make(map[timeGroup]CompactionGroup)
+		)
+		for _, path := range group {
+			st, ok := stats[path]
+			if !ok {
+				mixed = append(mixed, path)
+				continue
+			}
+
+			tg, ok := fileTimeGroup(p.grouper, st)
+			if !ok {
+				mixed = append(mixed, path)
+				continue
+			}
+
+			if _, ok := byGroup[tg]; !ok {
+				order = append(order, tg)
+			}
+			byGroup[tg] = append(byGroup[tg], path)
+		}
+
+		subgroups := make([]CompactionGroup, 0, len(order)+1)
+		if len(mixed) > 0 {
+			subgroups = append(subgroups, mixed)
+		}
+		for _, tg := range order {
+			subgroups = append(subgroups, byGroup[tg])
+		}
+
+		for _, g := range subgroups {
+			if len(g) > 1 {
+				planned = append(planned, g)
+			} else {
+				released = append(released, g)
+			}
+		}
+	}
+
+	if len(released) > 0 {
+		p.CompactionPlanner.Release(released)
+	}
+	return planned
+}
@@ -879,3 +879,66 @@ func verifyVersion(r io.ReadSeeker) error {
 
 	return nil
 }
+
+// FilterTSMFile writes the blocks of the keys of the TSM file at src for which
+// keep returns true to a new TSM file at dst, along with its stats file. It
+// returns false, without creating dst, when no key is kept.
+//
+// Blocks are copied as is, so the tombstones of src also apply to dst.
+func FilterTSMFile(src, dst string, keep func(key []byte) bool) (kept bool, err error) {
+	f, err := os.Open(src)
+	if err != nil {
+		return false, err
+	}
+
+	r, err := NewTSMReader(f)
+	if err != nil {
+		f.Close()
+		return false, err
+	}
+	defer r.Close()
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_RDWR|os.O_EXCL, 0666)
+	if err != nil {
+		return false, err
+	}
+
+	w, err := NewTSMWriter(out)
+	if err != nil {
+		out.Close()
+		os.Remove(dst)
+		return false, err
+	}
+	defer func() {
+		if cerr := w.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil || !kept {
+			os.Remove(StatsFilename(dst))
+			os.Remove(dst)
+		}
+	}()
+
+	itr := r.BlockIterator()
+	for itr.Next() {
+		key, minTime, maxTime, _, _, block, err := itr.Read()
+		if err != nil {
+			return false, err
+		}
+		if !keep(key) {
+			continue
+		}
+		if err := w.WriteBlock(key, minTime, maxTime, block); err != nil {
+			return false, err
+		}
+		kept = true
+	}
+	if err := itr.Err(); err != nil {
+		return false, err
+	}
+
+	if !kept {
+		return false, nil
+	}
+	return true, w.WriteIndex()
+}
@@ -5,6 +5,12 @@ import (
 	"time"
 )
 
+// usage service operations.
+const (
+	OpRecordUsage = "RecordUsage"
+	OpGetUsage    = "GetUsage"
+)
+
 // UsageMetric used to track classes of usage.
 type UsageMetric string
 
@@ -38,6 +44,14 @@ type UsageService interface {
 	GetUsage(ctx context.Context, filter UsageFilter) (map[UsageMetric]*Usage, error)
 }
 
+// UsageRecorder records the usage of organizations and buckets.
+type UsageRecorder interface {
+	// RecordUsage adds the values of the usages to the counters of their
+	// organization, bucket and metric at time t. Usages without a bucket
+	// are counted for their organization only.
+	RecordUsage(ctx context.Context, t time.Time, usages ...Usage) error
+}
+
 // UsageFilter is used to filter usage.
 type UsageFilter struct {
 	OrgID    *ID
@@ -0,0 +1,242 @@
+// Package usage counts the write and query usage of organizations and buckets
+// and reports it along with their series counts.
+//
+// Usage is added to in-memory counters per interval, which are periodically
+// added to the counters of a store, so that recording usage does not write to
+// the store on every request.
+package usage
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/influxdata/platform"
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultInterval is the length of the intervals usage is counted in.
+	DefaultInterval = time.Hour
+
+	// DefaultFlushInterval is how often counters are added to the store.
+	DefaultFlushInterval = 10 * time.Second
+)
+
+// Store persists the counters of each interval.
+type Store interface {
+	platform.UsageRecorder
+	platform.UsageService
+}
+
+// counted are the metrics counted by the Service. Other metrics, such as
+// series, are reported by the SeriesService.
+var counted = []platform.UsageMetric{
+	platform.UsageWriteRequestCount,
+	platform.UsageWriteRequestBytes,
+	platform.UsageValues,
+	platform.UsageQueryRequestCount,
+	platform.UsageQueryRequestBytes,
+}
+
+var _ platform.UsageService = (*Service)(nil)
+var _ platform.UsageRecorder = (*Service)(nil)
+
+// Service counts usage in intervals and persists the counters in a store.
+type Service struct {
+	Store Store
+
+	// SeriesService reports the current series counts.
+	SeriesService platform.UsageService
+
+	// Interval is the length of the intervals usage is counted in, so it is
+	// the resolution of the ranges of GetUsage.
+	Interval time.Duration
+	// FlushInterval is how often counters are added to the store.
+	FlushInterval time.Duration
+
+	Logger *zap.Logger
+
+	mu       sync.Mutex
+	counters map[counterKey]float64
+
+	flushMu sync.Mutex
+	closing chan struct{}
+	wg      sync.WaitGroup
+}
+
+// counterKey identifies the counter of a metric in an interval. bucketID is
+// zero for counters of an organization.
+type counterKey struct {
+	orgID    platform.ID
+	bucketID platform.ID
+	metric   platform.UsageMetric
+	interval int64
+}
+
+// NewService constructs a Service that persists counters in store and reports
+// series counts from series.
+func NewService(store Store, series platform.UsageService, logger *zap.Logger) *Service {
+	return &Service{
+		Store:         store,
+		SeriesService: series,
+		Interval:      DefaultInterval,
+		FlushInterval: DefaultFlushInterval,
+		Logger:        logger,
+		counters:      make(map[counterKey]float64),
+	}
+}
+
+// Open starts flushing counters to the store.
+func (s *Service) Open() error {
+	s.closing = make(chan struct{})
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		s.run()
+	}()
+	return nil
+}
+
+// Close stops flushing and flushes the remaining counters.
+func (s *Service) Close() error {
+	if s.closing != nil {
+		close(s.closing)
+		s.wg.Wait()
+		s.closing = nil
+	}
+	return s.Flush(context.Background())
+}
+
+func (s *Service) run() {
+	ticker := time.NewTicker(s.FlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.closing:
+			return
+		case <-ticker.C:
+			if err := s.Flush(context.Background()); err != nil {
+				s.Logger.Info("Failed to flush usage", zap.Error(err))
+			}
+		}
+	}
+}
+
+// RecordUsage adds the values of the usages to the counters of the interval
+// of t. Usages without an organization are ignored.
+func (s *Service) RecordUsage(ctx context.Context, t time.Time, usages ...platform.Usage) error {
+	interval := t.Truncate(s.Interval).UnixNano()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, u := range usages {
+		if u.OrganizationID == nil {
+			continue
+		}
+		k := counterKey{
+			orgID:    *u.OrganizationID,
+			metric:   u.Type,
+			interval: interval,
+		}
+		if u.BucketID != nil {
+			k.bucketID = *u.BucketID
+		}
+		s.counters[k] += u.Value
+	}
+	return nil
+}
+
+// Flush adds the in-memory counters to the store. Counters that could not be
+// added are kept for the next flush.
+func (s *Service) Flush(ctx context.Context) error {
+	s.flushMu.Lock()
+	defer s.flushMu.Unlock()
+
+	s.mu.Lock()
+	counters := s.counters
+	s.counters = make(map[counterKey]float64)
+	s.mu.Unlock()
+
+	intervals := make(map[int64][]platform.Usage)
+	for k, v := range counters {
+		k := k
+		u := platform.Usage{
+			OrganizationID: &k.orgID,
+			Type:           k.metric,
+			Value:          v,
+		}
+		if k.bucketID.Valid() {
+			u.BucketID = &k.bucketID
+		}
+		intervals[k.interval] = append(intervals[k.interval], u)
+	}
+
+	for interval, usages := range intervals {
+		if err := s.Store.RecordUsage(ctx, time.Unix(0, interval), usages...); err != nil {
+			s.restore(counters)
+			return err
+		}
+		for k := range counters {
+			if k.interval == interval {
+				delete(counters, k)
+			}
+		}
+	}
+	return nil
+}
+
+// restore adds counters that failed to flush back to the in-memory counters.
+func (s *Service) restore(counters map[counterKey]float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for k, v := range counters {
+		s.counters[k] += v
+	}
+}
+
+// GetUsage returns the counted metrics of the intervals that overlap the
+// filter's range, or of all intervals if it has no range, and the current
+// series counts. Counters of an organization include all of its buckets.
+func (s *Service) GetUsage(ctx context.Context, filter platform.UsageFilter) (map[platform.UsageMetric]*platform.Usage, error) {
+	if err := s.Flush(ctx); err != nil {
+		return nil, err
+	}
+
+	f := filter
+	if filter.Range != nil {
+		f.Range = &platform.Timespan{
+			Start: filter.Range.Start.Truncate(s.Interval),
+			Stop:  filter.Range.Stop,
+		}
+	}
+
+	usages, err := s.Store.GetUsage(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, m := range counted {
+		if _, ok := usages[m]; !ok {
+			usages[m] = &platform.Usage{
+				OrganizationID: filter.OrgID,
+				BucketID:       filter.BucketID,
+				Type:           m,
+			}
+		}
+	}
+
+	if s.SeriesService == nil {
+		return usages, nil
+	}
+
+	series, err := s.SeriesService.GetUsage(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	for m, u := range series {
+		usages[m] = u
+	}
+	return usages, nil
+}
@@ -0,0 +1,151 @@
+package usage_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/influxdata/platform"
+	platformtesting "github.com/influxdata/platform/testing"
+	"github.com/influxdata/platform/usage"
+	"go.uber.org/zap"
+)
+
+var (
+	orgID    = platformtesting.MustIDBase16("020f755c3c082000")
+	bucketID = platformtesting.MustIDBase16("020f755c3c082001")
+)
+
+// store records usages in memory.
+type store struct {
+	err      error
+	recorded map[int64][]platform.Usage
+}
+
+func newStore() *store {
+	return &store{recorded: make(map[int64][]platform.Usage)}
+}
+
+func (s *store) RecordUsage(ctx context.Context, t time.Time, usages ...platform.Usage) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.recorded[t.UnixNano()] = append(s.recorded[t.UnixNano()], usages...)
+	return nil
+}
+
+func (s *store) GetUsage(ctx context.Context, filter platform.UsageFilter) (map[platform.UsageMetric]*platform.Usage, error) {
+	usages := make(map[platform.UsageMetric]*platform.Usage)
+	for ns, us := range s.recorded {
+		t := time.Unix(0, ns)
+		if filter.Range != nil && (t.Before(filter.Range.Start) || !t.Before(filter.Range.Stop)) {
+			continue
+		}
+		for _, u := range us {
+			if _, ok := usages[u.Type]; !ok {
+				usages[u.Type] = &platform.Usage{Type: u.Type}
+			}
+			usages[u.Type].Value += u.Value
+		}
+	}
+	return usages, nil
+}
+
+// seriesService reports a fixed series count.
+type seriesService float64
+
+func (s seriesService) GetUsage(ctx context.Context, filter platform.UsageFilter) (map[platform.UsageMetric]*platform.Usage, error) {
+	return map[platform.UsageMetric]*platform.Usage{
+		platform.UsageSeries: {Type: platform.UsageSeries, Value: float64(s)},
+	}, nil
+}
+
+func TestService_GetUsage(t *testing.T) {
+	ctx := context.Background()
+	st := newStore()
+	s := usage.NewService(st, seriesService(3), zap.NewNop())
+
+	t1 := time.Date(2018, 11, 1, 10, 15, 0, 0, time.UTC)
+	t2 := t1.Add(30 * time.Minute)
+	t3 := t1.Add(time.Hour)
+	values := func(v float64) platform.Usage {
+		return platform.Usage{OrganizationID: &orgID, BucketID: &bucketID, Type: platform.UsageValues, Value: v}
+	}
+	for _, r := range []struct {
+		t time.Time
+		u platform.Usage
+	}{
+		{t1, values(1)},
+		{t2, values(2)},
+		{t3, values(4)},
+		{t1, platform.Usage{Type: platform.UsageValues, Value: 8}},
+	} {
+		if err := s.RecordUsage(ctx, r.t, r.u); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	usages, err := s.GetUsage(ctx, platform.UsageFilter{
+		Range: &platform.Timespan{Start: t2, Stop: t3.Truncate(time.Hour)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// t1 and t2 share an interval that overlaps the range, and usage
+	// without an organization is ignored.
+	want := map[platform.UsageMetric]float64{
+		platform.UsageWriteRequestCount: 0,
+		platform.UsageWriteRequestBytes: 0,
+		platform.UsageValues:            3,
+		platform.UsageQueryRequestCount: 0,
+		platform.UsageQueryRequestBytes: 0,
+		platform.UsageSeries:            3,
+	}
+	if len(usages) != len(want) {
+		t.Fatalf("got %d metrics, want %d", len(usages), len(want))
+	}
+	for m, v := range want {
+		if u, ok := usages[m]; !ok || u.Value != v {
+			t.Errorf("got %v for %q, want %v", u, m, v)
+		}
+	}
+
+	if got, want := len(st.recorded), 2; got != want {
+		t.Fatalf("got %d intervals, want %d", got, want)
+	}
+	if got := st.recorded[t1.Truncate(time.Hour).UnixNano()]; len(got) != 1 || got[0].Value != 3 {
+		t.Errorf("got %v for the first interval, want one counter of 3", got)
+	}
+}
+
+func TestService_Flush_Error(t *testing.T) {
+	ctx := context.Background()
+	st := newStore()
+	s := usage.NewService(st, nil, zap.NewNop())
+
+	now := time.Now()
+	u := platform.Usage{OrganizationID: &orgID, Type: platform.UsageQueryRequestCount, Value: 1}
+	if err := s.RecordUsage(ctx, now, u); err != nil {
+		t.Fatal(err)
+	}
+
+	st.err = errors.New("store unavailable")
+	if err := s.Flush(ctx); err != st.err {
+		t.Fatalf("got error %v, want %v", err, st.err)
+	}
+
+	st.err = nil
+	if err := s.RecordUsage(ctx, now, u); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	got := st.recorded[now.Truncate(usage.DefaultInterval).UnixNano()]
+	if len(got) != 1 || got[0].Value != 2 {
+		t.Fatalf("got %v, want the counters kept after the failed flush", got)
+	}
+}
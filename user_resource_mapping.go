@@ -3,7 +3,6 @@ package platform
 import (
 	"context"
 	"errors"
-	"fmt"
 )
 
 type UserType string
@@ -55,7 +54,7 @@ func (m UserResourceMapping) Validate() error {
 		return errors.New("a valid user type is required")
 	}
 	switch m.ResourceType {
-	case DashboardResourceType, BucketResourceType, TaskResourceType, OrgResourceType, ViewResourceType, TelegrafResourceType:
+	case DashboardResourceType, BucketResourceType, TaskResourceType, OrgResourceType, ViewResourceType, TelegrafResourceType, MacroResourceType:
 	default:
 		return errors.New("a valid resource type is required")
 	}
@@ -70,31 +69,28 @@ type UserResourceMappingFilter struct {
 	UserType     UserType
 }
 
-var ownerActions = []action{WriteAction, CreateAction, DeleteAction}
-var memberActions = []action{ReadAction}
+var ownerActions = []Action{WriteAction, CreateAction, DeleteAction}
+var memberActions = []Action{ReadAction}
 
-// ToPermission converts a user resource mapping into a set of permissions.
+// ToPermissions converts a user resource mapping into a set of permissions.
+// Mappings to an organization grant access to every resource within that organization.
 func (m *UserResourceMapping) ToPermissions() []Permission {
-	// TODO(desa): we'll have to do something more fine-grained eventually
-	// but this should be good enough for now.
+	if m.ResourceType == OrgResourceType {
+		if m.UserType == Owner {
+			return OwnerPermissions(m.ResourceID)
+		}
+		return MemberPermissions(m.ResourceID)
+	}
+
 	ps := []Permission{}
-	r := resource(fmt.Sprintf("%s/%s", m.ResourceType, m.ResourceID))
 	if m.UserType == Owner {
 		for _, a := range ownerActions {
-			p := Permission{
-				Resource: r,
-				Action:   a,
-			}
-			ps = append(ps, p)
+			ps = append(ps, NewResourcePermission(m.ResourceID, a, m.ResourceType))
 		}
 	}
 
 	for _, a := range memberActions {
-		p := Permission{
-			Resource: r,
-			Action:   a,
-		}
-		ps = append(ps, p)
+		ps = append(ps, NewResourcePermission(m.ResourceID, a, m.ResourceType))
 	}
 
 	return ps